@@ -3,6 +3,8 @@ package bootstrap
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 
 	"github.com/spf13/viper"
 )
@@ -12,8 +14,6 @@ type Env struct {
 	AppEnv       string `mapstructure:"APP_ENV"`
 	DB_Uri       string `mapstructure:"DB_URI"`
 	DB_Name      string `mapstructure:"DB_NAME"`
-	RTS          string `mapstructure:"REFRESH_TOKEN_SECRET"`
-	ATS          string `mapstructure:"ACCESS_TOKEN_SECRET"`
 	RefTEHours   int    `mapstructure:"REFRESH_TOKEN_EXPIRE_HOURS"`
 	AccTEMinutes int    `mapstructure:"ACCESS_TOKEN_EXPIRE_MINUTES"`
 	CtxTSeconds  int    `mapstructure:"CONTEXT_TIMEOUT_SECONDS"`
@@ -35,11 +35,59 @@ type Env struct {
 	// user refresh token collection
 	RefreshTokenCollection string `mapstructure:"REFRESH_TOKEN_COLLECTION"`
 
+	// in-app notifications, and the types each user turned off
+	NotificationCollection           string `mapstructure:"NOTIFICATION_COLLECTION"`
+	NotificationPreferenceCollection string `mapstructure:"NOTIFICATION_PREFERENCE_COLLECTION"`
+
+	// live streams, the events kept per topic for clients resuming with Last-Event-ID
+	StreamRetainEvents int `mapstructure:"STREAM_RETAIN_EVENTS"`
+	StreamRetainHours  int `mapstructure:"STREAM_RETAIN_HOURS"` // a topic without events is dropped after this
+
+	// security event collection, e.g. refresh token reuse
+	SecurityEventCollection string `mapstructure:"SECURITY_EVENT_COLLECTION"`
+
+	// personal access token collection
+	PersonalAccessTokenCollection string `mapstructure:"PERSONAL_ACCESS_TOKEN_COLLECTION"`
+
 	// password reset token collection
 	PasswordResetCollection string `mapstructure:"PASSWORD_RESET_TOKEN_COLLECTION"`
 	// password reset token expiry
 	PasswordResetExpiry int `mapstructure:"PASSWORD_RESET_TOKEN_EXPIRE_MINUTES"` // in minutes
 
+	// password policy, checked on registration, password change and reset
+	PasswordMinLength         int    `mapstructure:"PASSWORD_MIN_LENGTH"`
+	PasswordMaxLength         int    `mapstructure:"PASSWORD_MAX_LENGTH"`
+	PasswordRequireUppercase  bool   `mapstructure:"PASSWORD_REQUIRE_UPPERCASE"`
+	PasswordRequireLowercase  bool   `mapstructure:"PASSWORD_REQUIRE_LOWERCASE"`
+	PasswordRequireDigit      bool   `mapstructure:"PASSWORD_REQUIRE_DIGIT"`
+	PasswordRequireSymbol     bool   `mapstructure:"PASSWORD_REQUIRE_SYMBOL"`
+	PasswordHistory           int    `mapstructure:"PASSWORD_HISTORY"` // previous passwords that may not be reused, 0 turns the check off
+	PasswordHistoryCollection string `mapstructure:"PASSWORD_HISTORY_COLLECTION"`
+	PasswordBreachedFile      string `mapstructure:"PASSWORD_BREACHED_FILE"` // SHA-1 hashes of breached passwords sorted by hash, one per line, empty turns the check off
+
+	// password hashing, stored hashes of another algorithm or cost are replaced on the next login
+	PasswordHashAlgorithm     string `mapstructure:"PASSWORD_HASH_ALGORITHM"`   // argon2id (default) or bcrypt
+	PasswordArgon2MemoryKB    int    `mapstructure:"PASSWORD_ARGON2_MEMORY_KB"` // in KiB
+	PasswordArgon2Iterations  int    `mapstructure:"PASSWORD_ARGON2_ITERATIONS"`
+	PasswordArgon2Parallelism int    `mapstructure:"PASSWORD_ARGON2_PARALLELISM"`
+	PasswordBcryptCost        int    `mapstructure:"PASSWORD_BCRYPT_COST"`
+
+	// passwordless login links
+	MagicLinkCollection    string `mapstructure:"MAGIC_LINK_COLLECTION"`
+	MagicLinkURL           string `mapstructure:"MAGIC_LINK_URL"`            // page that posts the token to /auth/magic-link/verify
+	MagicLinkExpireMinutes int    `mapstructure:"MAGIC_LINK_EXPIRE_MINUTES"` // in minutes
+
+	// failed login limits, counted in Redis per identifier and per IP
+	LoginMaxFailures             int    `mapstructure:"LOGIN_MAX_FAILURES"`           // failures per identifier before it is locked
+	LoginIPMaxFailures           int    `mapstructure:"LOGIN_IP_MAX_FAILURES"`        // failures from one IP before it is blocked
+	LoginFailureWindowMinutes    int    `mapstructure:"LOGIN_FAILURE_WINDOW_MINUTES"` // failures older than this are forgotten
+	LoginLockoutMinutes          int    `mapstructure:"LOGIN_LOCKOUT_MINUTES"`
+	LoginDelayAfterFailures      int    `mapstructure:"LOGIN_DELAY_AFTER_FAILURES"` // failures before each attempt has to wait
+	LoginDelayBaseSeconds        int    `mapstructure:"LOGIN_DELAY_BASE_SECONDS"`   // doubles with every further failure
+	LoginDelayMaxSeconds         int    `mapstructure:"LOGIN_DELAY_MAX_SECONDS"`
+	LoginUnlockURL               string `mapstructure:"LOGIN_UNLOCK_URL"`                 // page that posts the token to /auth/unlock
+	LoginSecondFactorMaxFailures int    `mapstructure:"LOGIN_SECOND_FACTOR_MAX_FAILURES"` // wrong two-factor codes per user before it is locked
+
 	// email configuration
 	SMTPHost     string `mapstructure:"SMTP_HOST"`
 	SMTPPort     int    `mapstructure:"SMTP_PORT"`
@@ -48,6 +96,50 @@ type Env struct {
 	SMTPPassword string `mapstructure:"SMTP_PASSWORD"` // App Password for Gmail
 	ResetURL     string `mapstructure:"RESET_URL"`
 
+	// email templates, the built-in ones are used unless EMAIL_TEMPLATES_DIR points to a copy
+	EmailTemplatesDir  string `mapstructure:"EMAIL_TEMPLATES_DIR"`
+	EmailDefaultLocale string `mapstructure:"EMAIL_DEFAULT_LOCALE"` // used when the recipient's language has no translation
+
+	// email delivery, emails are queued in the outbox and sent by a worker with retries
+	EmailTransport              string `mapstructure:"EMAIL_TRANSPORT"` // smtp (default) or file
+	EmailFileDir                string `mapstructure:"EMAIL_FILE_DIR"`  // where the file transport writes .eml files
+	EmailOutboxCollection       string `mapstructure:"EMAIL_OUTBOX_COLLECTION"`
+	EmailOutboxMaxAttempts      int    `mapstructure:"EMAIL_OUTBOX_MAX_ATTEMPTS"`       // attempts before an email is dead
+	EmailOutboxRetryBaseSeconds int    `mapstructure:"EMAIL_OUTBOX_RETRY_BASE_SECONDS"` // doubles with every further failure
+	EmailOutboxRetryMaxMinutes  int    `mapstructure:"EMAIL_OUTBOX_RETRY_MAX_MINUTES"`
+	EmailOutboxPollSeconds      int    `mapstructure:"EMAIL_OUTBOX_POLL_SECONDS"`
+
+	// outbound webhooks, deliveries are queued and sent by a worker with retries
+	WebhookCollection         string `mapstructure:"WEBHOOK_COLLECTION"`
+	WebhookDeliveryCollection string `mapstructure:"WEBHOOK_DELIVERY_COLLECTION"`
+	WebhookMaxAttempts        int    `mapstructure:"WEBHOOK_MAX_ATTEMPTS"`       // attempts before a delivery is dead
+	WebhookRetryBaseSeconds   int    `mapstructure:"WEBHOOK_RETRY_BASE_SECONDS"` // doubles with every further failure
+	WebhookRetryMaxMinutes    int    `mapstructure:"WEBHOOK_RETRY_MAX_MINUTES"`
+	WebhookTimeoutSeconds     int    `mapstructure:"WEBHOOK_TIMEOUT_SECONDS"` // a receiver that does not answer within it failed
+	WebhookPollSeconds        int    `mapstructure:"WEBHOOK_POLL_SECONDS"`
+
+	// email digests of the new posts of followed authors and tags
+	DigestCollection     string `mapstructure:"DIGEST_COLLECTION"`
+	DigestSecret         string `mapstructure:"DIGEST_SECRET"`          // signs the unsubscribe links
+	DigestUnsubscribeURL string `mapstructure:"DIGEST_UNSUBSCRIBE_URL"` // opened with the token, /api/digests/unsubscribe works too
+	DigestPostURL        string `mapstructure:"DIGEST_POST_URL"`        // posts link to it followed by their ID
+	DigestMaxPosts       int    `mapstructure:"DIGEST_MAX_POSTS"`
+	DigestPollMinutes    int    `mapstructure:"DIGEST_POLL_MINUTES"`
+
+	// domain events, stored in the outbox with the change and handed to the subscribers by a dispatcher
+	EventOutboxCollection string `mapstructure:"EVENT_OUTBOX_COLLECTION"`
+	EventMaxAttempts      int    `mapstructure:"EVENT_MAX_ATTEMPTS"`       // attempts before an event is dead
+	EventRetryBaseSeconds int    `mapstructure:"EVENT_RETRY_BASE_SECONDS"` // doubles with every further failure
+	EventRetryMaxMinutes  int    `mapstructure:"EVENT_RETRY_MAX_MINUTES"`
+	EventPollSeconds      int    `mapstructure:"EVENT_POLL_SECONDS"`
+	DBTransactions        bool   `mapstructure:"DB_TRANSACTIONS"` // needs a replica set, events are written after the change without
+
+	// canonical tags with their synonyms and usage counts
+	TagCollection string `mapstructure:"TAG_COLLECTION"`
+
+	// the category tree of posts
+	CategoryCollection string `mapstructure:"CATEGORY_COLLECTION"`
+
 	// Gemini AI configuration
 	GeminiAPIKey    string `mapstructure:"GEMINI_API_KEY"`
 	GeminiModelName string `mapstructure:"GEMINI_MODEL_NAME"`
@@ -58,10 +150,28 @@ type Env struct {
 	ImageKitEndpoint   string `mapstructure:"IMAGEKIT_URL_ENDPOINT"`
 
 	// OTP secret salt
-	SecretSalt         string `mapstructure:"MY_SUPER_SECRET_SALT"`
-	OtpCollection      string `mapstructure:"OTP_COLLECTION"`
-	OtpExpireMinutes   int    `mapstructure:"OTP_EXPIRE_MINUTES"`
-	OtpMaximumAttempts int    `mapstructure:"OTP_MAXIMUM_ATTEMPTS"`
+	SecretSalt               string `mapstructure:"MY_SUPER_SECRET_SALT"`
+	OtpCollection            string `mapstructure:"OTP_COLLECTION"`
+	OtpExpireMinutes         int    `mapstructure:"OTP_EXPIRE_MINUTES"`
+	OtpMaximumAttempts       int    `mapstructure:"OTP_MAXIMUM_ATTEMPTS"`        // codes sent per day
+	OtpMaximumFailedAttempts int    `mapstructure:"OTP_MAXIMUM_FAILED_ATTEMPTS"` // wrong guesses per code
+
+	// per purpose OTP settings, unset ones fall back to the ones above
+	OtpEmailVerificationExpireMinutes         int `mapstructure:"OTP_EMAIL_VERIFICATION_EXPIRE_MINUTES"`
+	OtpEmailVerificationMaximumAttempts       int `mapstructure:"OTP_EMAIL_VERIFICATION_MAXIMUM_ATTEMPTS"`
+	OtpEmailVerificationMaximumFailedAttempts int `mapstructure:"OTP_EMAIL_VERIFICATION_MAXIMUM_FAILED_ATTEMPTS"`
+	OtpLoginExpireMinutes                     int `mapstructure:"OTP_LOGIN_EXPIRE_MINUTES"`
+	OtpLoginMaximumAttempts                   int `mapstructure:"OTP_LOGIN_MAXIMUM_ATTEMPTS"`
+	OtpLoginMaximumFailedAttempts             int `mapstructure:"OTP_LOGIN_MAXIMUM_FAILED_ATTEMPTS"`
+	OtpSensitiveActionExpireMinutes           int `mapstructure:"OTP_SENSITIVE_ACTION_EXPIRE_MINUTES"`
+	OtpSensitiveActionMaximumAttempts         int `mapstructure:"OTP_SENSITIVE_ACTION_MAXIMUM_ATTEMPTS"`
+	OtpSensitiveActionMaximumFailedAttempts   int `mapstructure:"OTP_SENSITIVE_ACTION_MAXIMUM_FAILED_ATTEMPTS"`
+
+	// session cookie attributes, COOKIE_SECURE should be on wherever the API is served over HTTPS
+	CookieSecure   bool   `mapstructure:"COOKIE_SECURE"`
+	CookieDomain   string `mapstructure:"COOKIE_DOMAIN"`   // empty keeps the cookies on the API host
+	CookieSameSite string `mapstructure:"COOKIE_SAMESITE"` // strict (default), lax or none, none needs COOKIE_SECURE
+
 	// Redis configuration
 	RedisHost     string `mapstructure:"REDIS_HOST"`
 	RedisPort     int    `mapstructure:"REDIS_PORT"`
@@ -71,10 +181,40 @@ type Env struct {
 	// Redis cache configuration
 	CacheExpirationSeconds int `mapstructure:"CACHE_EXPIRATION_SECONDS"` // in seconds
 
+	// request rate limits as <requests>/<window>, e.g. 100/1m, counted in Redis per user or IP.
+	// Empty uses the built-in default, off disables the limit.
+	RateLimitGlobal   string `mapstructure:"RATE_LIMIT_GLOBAL"`   // every API request, per IP
+	RateLimitAuth     string `mapstructure:"RATE_LIMIT_AUTH"`     // each login, registration, password, OTP and unlock route
+	RateLimitAI       string `mapstructure:"RATE_LIMIT_AI"`       // AI content generation
+	RateLimitComments string `mapstructure:"RATE_LIMIT_COMMENTS"` // creating comments
+
 	// Google OAuth2 Configuration
 	GoogleClientID     string `mapstructure:"GOOGLE_CLIENT_ID"`
 	GoogleClientSecret string `mapstructure:"GOOGLE_CLIENT_SECRET"`
 	GoogleRedirectURL  string `mapstructure:"GOOGLE_REDIRECT_URL"`
+
+	// OpenID Connect login providers, each one is configured with OIDC_<NAME>_* keys
+	OIDCProviderNames   string            `mapstructure:"OIDC_PROVIDERS"`         // comma separated, e.g. google,keycloak
+	OIDCRedirectBaseURL string            `mapstructure:"OIDC_REDIRECT_BASE_URL"` // callbacks default to <base>/<name>/callback
+	OIDCStateCollection string            `mapstructure:"OIDC_STATE_COLLECTION"`
+	OIDCProviders       []OIDCProviderEnv `mapstructure:"-"`
+
+	// providers linked to user accounts
+	LinkedIdentityCollection string `mapstructure:"LINKED_IDENTITY_COLLECTION"`
+
+	// JWT signing keys, tokens are signed with RS256 or EdDSA and published as a JWKS
+	SigningKeyCollection   string `mapstructure:"SIGNING_KEY_COLLECTION"`
+	JWTSigningAlgorithm    string `mapstructure:"JWT_SIGNING_ALGORITHM"`     // RS256 or EdDSA, used for newly generated keys
+	JWTKeyEncryptionSecret string `mapstructure:"JWT_KEY_ENCRYPTION_SECRET"` // encrypts private keys at rest
+	JWTKeyRetentionHours   int    `mapstructure:"JWT_KEY_RETENTION_HOURS"`   // how long a retired key still verifies, defaults to the refresh token lifetime
+
+	// Two-factor authentication configuration
+	TwoFactorCollection       string `mapstructure:"TWO_FACTOR_COLLECTION"`
+	TwoFactorPolicyCollection string `mapstructure:"TWO_FACTOR_POLICY_COLLECTION"`
+	TwoFactorIssuer           string `mapstructure:"TWO_FACTOR_ISSUER"`
+	TwoFactorSecretKey        string `mapstructure:"TWO_FACTOR_SECRET_KEY"`         // encrypts TOTP secrets at rest
+	TwoFactorRequiredRoles    string `mapstructure:"TWO_FACTOR_REQUIRED_ROLES"`     // comma separated, used until an admin saves a policy
+	PreAuthTokenExpireMinutes int    `mapstructure:"PRE_AUTH_TOKEN_EXPIRE_MINUTES"` // in minutes
 }
 
 // Viper can be made injectable
@@ -91,9 +231,75 @@ func NewEnv(env_file_path string) (*Env, error) {
 		return nil, fmt.Errorf("failed to unmarshal env: %w", err)
 	}
 
+	providers, err := oidcProviders(v, &env)
+	if err != nil {
+		return nil, err
+	}
+	env.OIDCProviders = providers
+
 	if env.AppEnv == "development" {
 		log.Println("The App is running in development env")
 	}
 
 	return &env, nil
 }
+
+// OIDCProviderEnv configures one OpenID Connect provider
+type OIDCProviderEnv struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	TrustEmail   bool
+}
+
+var oidcProviderName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// oidcProviders reads OIDC_<NAME>_ISSUER, _CLIENT_ID, _CLIENT_SECRET, _REDIRECT_URL, _SCOPES
+// and _TRUST_EMAIL for every name in OIDC_PROVIDERS
+func oidcProviders(v *viper.Viper, env *Env) ([]OIDCProviderEnv, error) {
+	providers := []OIDCProviderEnv{}
+	seen := map[string]bool{}
+	for _, name := range strings.Split(env.OIDCProviderNames, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		if !oidcProviderName.MatchString(name) {
+			return nil, fmt.Errorf("invalid OIDC provider name %q", name)
+		}
+		seen[name] = true
+
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		provider := OIDCProviderEnv{
+			Name:         name,
+			Issuer:       v.GetString(prefix + "ISSUER"),
+			ClientID:     v.GetString(prefix + "CLIENT_ID"),
+			ClientSecret: v.GetString(prefix + "CLIENT_SECRET"),
+			RedirectURL:  v.GetString(prefix + "REDIRECT_URL"),
+			Scopes:       strings.Fields(strings.ReplaceAll(v.GetString(prefix+"SCOPES"), ",", " ")),
+			TrustEmail:   v.GetBool(prefix + "TRUST_EMAIL"),
+		}
+		if provider.RedirectURL == "" && env.OIDCRedirectBaseURL != "" {
+			provider.RedirectURL = strings.TrimSuffix(env.OIDCRedirectBaseURL, "/") + "/" + name + "/callback"
+		}
+		if provider.Issuer == "" || provider.ClientID == "" || provider.RedirectURL == "" {
+			return nil, fmt.Errorf("OIDC provider %q needs %sISSUER, %sCLIENT_ID and a redirect URL", name, prefix, prefix)
+		}
+		providers = append(providers, provider)
+	}
+
+	// the Google settings predate the provider list and still enable Google on their own
+	if env.GoogleClientID != "" && !seen["google"] {
+		providers = append(providers, OIDCProviderEnv{
+			Name:         "google",
+			Issuer:       "https://accounts.google.com",
+			ClientID:     env.GoogleClientID,
+			ClientSecret: env.GoogleClientSecret,
+			RedirectURL:  env.GoogleRedirectURL,
+		})
+	}
+	return providers, nil
+}
@@ -1,11 +1,9 @@
 package controllers
 
 import (
-	"context"
 	"g6/blog-api/Delivery/bootstrap"
 	dto "g6/blog-api/Delivery/dto"
 	domain "g6/blog-api/Domain"
-	"g6/blog-api/Infrastructure/oauth"
 	"g6/blog-api/Infrastructure/security"
 	utils "g6/blog-api/Utils"
 	"net/http"
@@ -13,8 +11,6 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
-	oauth2v2 "google.golang.org/api/oauth2/v2"
-	"google.golang.org/api/option"
 )
 
 type AuthController struct {
@@ -23,6 +19,12 @@ type AuthController struct {
 	OTP                  domain.IOTPUsecase
 	RefreshTokenUsecase  domain.IRefreshTokenUsecase
 	PasswordResetUsecase domain.IPasswordResetUsecase
+	TwoFactorUsecase     domain.ITwoFactorUsecase
+	OIDCUsecase          domain.IOIDCUsecase
+	MagicLinkUsecase     domain.IMagicLinkUsecase
+	LoginAttemptUsecase  domain.ILoginAttemptUsecase
+	SecurityEventUsecase domain.ISecurityEventUsecase
+	Cookies              utils.CookieSettings
 	Env                  *bootstrap.Env
 }
 
@@ -38,14 +40,31 @@ func (ac *AuthController) RegisterRequest(c *gin.Context) {
 	}
 
 	user := dto.ToDomainUser(newUser)
+	if user.Language == "" {
+		user.Language = acceptedLanguage(c)
+	}
 	err := ac.UserUsecase.Register(&user)
 	if err != nil {
+		if passwordRefused(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 	c.JSON(http.StatusCreated, dto.ToUserResponse(user))
 }
 
+// acceptedLanguage is the first language of the Accept-Language header, emails default to it
+func acceptedLanguage(c *gin.Context) string {
+	first, _, _ := strings.Cut(c.GetHeader("Accept-Language"), ",")
+	tag, _, _ := strings.Cut(first, ";")
+	tag = strings.TrimSpace(tag)
+	if validate.Var(tag, "bcp47_language_tag") != nil {
+		return ""
+	}
+	return tag
+}
+
 func (ac *AuthController) LoginRequest(c *gin.Context) {
 	var loginRequest dto.LoginRequest
 	if err := c.ShouldBindJSON(&loginRequest); err != nil {
@@ -57,88 +76,45 @@ func (ac *AuthController) LoginRequest(c *gin.Context) {
 		return
 	}
 
+	// Locked identifiers and throttled clients are turned away before the password is checked.
+	// Other errors let the login through, Redis being down must not lock everybody out.
+	ip := c.ClientIP()
+	if retryAfter, err := ac.LoginAttemptUsecase.Check(loginRequest.Identifier, ip); err == domain.ErrAccountLocked || err == domain.ErrLoginThrottled {
+		refuseLogin(c, err, retryAfter)
+		return
+	}
+
 	// Check if user exists
 	user, err := ac.UserUsecase.FindByUsernameOrEmail(c.Request.Context(), loginRequest.Identifier)
 	if err != nil {
 		// Use generic error message for both user not found and password mismatch
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+		ac.loginFailed(c, loginRequest.Identifier, ip, "")
 		return
 	}
 
 	// Validate the password
 	if err := security.ValidatePassword(user.Password, loginRequest.Password); err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
-		return
-	}
-
-	// Generate access and refresh tokens
-	response, err := ac.AuthService.GenerateTokens(*user)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		ac.loginFailed(c, loginRequest.Identifier, ip, user.ID)
 		return
 	}
+	_ = ac.LoginAttemptUsecase.RecordSuccess(loginRequest.Identifier)
 
-	// Prepare refresh token for DB
-	refreshToken := &domain.RefreshToken{
-		Token:     response.RefreshToken,
-		UserID:    user.ID,
-		Revoked:   false,
-		ExpiresAt: response.RefreshTokenExpiresAt,
-		CreatedAt: time.Now(),
+	// Hashes from before the current algorithm or parameters are replaced while the password is at hand.
+	// The login goes ahead either way, the old hash still verifies.
+	if security.PasswordNeedsRehash(user.Password) {
+		_ = ac.UserUsecase.RehashPassword(user, loginRequest.Password)
 	}
 
-	// Check if the user already has a refresh token in DB
-	existingToken, findErr := ac.RefreshTokenUsecase.FindByUserID(user.ID)
-	if findErr != nil && findErr.Error() != "refresh token not found" {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check existing refresh token"})
+	// Privileged or opted-in accounts need a second factor before any session is issued
+	if ac.requireSecondFactor(c, user) {
 		return
 	}
-	if findErr != nil && findErr.Error() == "refresh token not found" {
-		existingToken = nil // Explicitly set to nil if no token is found
-	}
 
-	if existingToken != nil {
-		// Revoke the old token
-		if err := ac.RefreshTokenUsecase.RevokedToken(existingToken); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke existing refresh token"})
-			return
-		}
-		// Replace with the new token
-		if err := ac.RefreshTokenUsecase.ReplaceToken(refreshToken); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update existing refresh token"})
-			return
-		}
-	} else {
-		// Save the new refresh token
-		if err := ac.RefreshTokenUsecase.Save(refreshToken); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save refresh token"})
-			return
-		}
+	response, ok := ac.issueSession(c, user, "password")
+	if !ok {
+		return
 	}
 
-	// Set the refresh token in the cookies
-	utils.SetCookie(c, utils.CookieOptions{
-		Name:     "refresh_token",
-		Value:    response.RefreshToken,
-		MaxAge:   int(time.Until(response.RefreshTokenExpiresAt).Seconds()),
-		Path:     "/",
-		Domain:   "",
-		Secure:   false,
-		HttpOnly: true,
-		SameSite: http.SameSiteStrictMode,
-	})
-	// Set the access token in the cookies
-	utils.SetCookie(c, utils.CookieOptions{
-		Name:     "access_token",
-		Value:    response.AccessToken,
-		MaxAge:   int(time.Until(response.AccessTokenExpiresAt).Seconds()),
-		Path:     "/",
-		Domain:   "",
-		Secure:   false,
-		HttpOnly: true,
-		SameSite: http.SameSiteStrictMode,
-	})
-
 	c.JSON(http.StatusOK,
 		gin.H{
 			"message": "Login successful",
@@ -149,6 +125,92 @@ func (ac *AuthController) LoginRequest(c *gin.Context) {
 			}})
 }
 
+// issueSession generates the token pair, stores the refresh token and sets both cookies.
+// The login is recorded with the method that authenticated the user.
+// On failure it writes the error response and returns false.
+func (ac *AuthController) issueSession(c *gin.Context, user *domain.User, method string) (*domain.RefreshTokenResponse, bool) {
+	// Generate access and refresh tokens
+	response, err := ac.AuthService.GenerateTokens(*user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		return nil, false
+	}
+
+	// Every login is its own session, other devices stay logged in
+	if err := ac.RefreshTokenUsecase.Save(newSession(c, user.ID, response)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save refresh token"})
+		return nil, false
+	}
+
+	if err := ac.setSessionCookies(c, response); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		return nil, false
+	}
+
+	recordSecurityEvent(c, ac.SecurityEventUsecase, domain.SecurityEvent{
+		Type:    domain.SecurityEventLogin,
+		ActorID: user.ID,
+		UserID:  user.ID,
+		Outcome: domain.SecurityEventSuccess,
+		Details: "method " + method,
+	})
+	return &response, true
+}
+
+// sessionCookie applies the configured cookie attributes, session cookies live on every path
+func (ac *AuthController) sessionCookie(name, value string, maxAge int, httpOnly bool) utils.CookieOptions {
+	sameSite := ac.Cookies.SameSite
+	if sameSite == 0 {
+		sameSite = http.SameSiteStrictMode
+	}
+	return utils.CookieOptions{
+		Name:     name,
+		Value:    value,
+		MaxAge:   maxAge,
+		Path:     "/",
+		Domain:   ac.Cookies.Domain,
+		Secure:   ac.Cookies.Secure,
+		HttpOnly: httpOnly,
+		SameSite: sameSite,
+	}
+}
+
+// setSessionCookies sets the token cookies and a fresh CSRF token. The CSRF cookie is readable by the page
+// and also sent in the X-CSRF-Token header, unsafe requests authenticated by the cookies have to echo it.
+func (ac *AuthController) setSessionCookies(c *gin.Context, response domain.RefreshTokenResponse) error {
+	csrfToken, err := security.GenerateOpaqueToken("")
+	if err != nil {
+		return err
+	}
+	refreshMaxAge := int(time.Until(response.RefreshTokenExpiresAt).Seconds())
+	utils.SetCookie(c, ac.sessionCookie("refresh_token", response.RefreshToken, refreshMaxAge, true))
+	utils.SetCookie(c, ac.sessionCookie("access_token", response.AccessToken, int(time.Until(response.AccessTokenExpiresAt).Seconds()), true))
+	utils.SetCookie(c, ac.sessionCookie(utils.CSRFCookieName, csrfToken, refreshMaxAge, false))
+	c.Header(utils.CSRFHeaderName, csrfToken)
+	return nil
+}
+
+func (ac *AuthController) clearSessionCookies(c *gin.Context) {
+	utils.DeleteCookie(c, ac.sessionCookie("refresh_token", "", -1, true))
+	utils.DeleteCookie(c, ac.sessionCookie("access_token", "", -1, true))
+	utils.DeleteCookie(c, ac.sessionCookie(utils.CSRFCookieName, "", -1, false))
+}
+
+// newSession builds the stored refresh token for a fresh login on the requesting device
+func newSession(c *gin.Context, userID string, response domain.RefreshTokenResponse) *domain.RefreshToken {
+	now := time.Now()
+	return &domain.RefreshToken{
+		Token:      response.RefreshToken,
+		UserID:     userID,
+		UserAgent:  c.Request.UserAgent(),
+		IP:         c.ClientIP(),
+		Revoked:    false,
+		ExpiresAt:  response.RefreshTokenExpiresAt,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+}
+
 func (ac *AuthController) RefreshToken(c *gin.Context) {
 	var req dto.RefreshTokenRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -158,10 +220,20 @@ func (ac *AuthController) RefreshToken(c *gin.Context) {
 
 	// find token from db
 	tokenDoc, err := ac.RefreshTokenUsecase.FindByToken(req.RefreshToken)
-	if err != nil || tokenDoc == nil || tokenDoc.Revoked || time.Now().After(tokenDoc.ExpiresAt) {
-		if tokenDoc != nil {
-			_ = ac.RefreshTokenUsecase.DeleteByUserID(tokenDoc.UserID)
-		}
+	if err != nil || tokenDoc == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+		return
+	}
+
+	// a token that was already exchanged is being replayed, so it was probably stolen:
+	// end the whole session for both the attacker and the victim
+	if tokenDoc.Used {
+		_ = ac.RefreshTokenUsecase.ReportReuse(tokenDoc, c.ClientIP(), c.Request.UserAgent())
+		ac.refreshTokenReused(c)
+		return
+	}
+
+	if tokenDoc.Revoked || time.Now().After(tokenDoc.ExpiresAt) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
 		return
 	}
@@ -187,82 +259,51 @@ func (ac *AuthController) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	// generate new access token
+	// generate new access and refresh tokens
 	response, err := ac.AuthService.GenerateTokens(*user)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create token"})
 		return
 	}
 
-	// Decide whether to rotate the refresh token
-	rotateThreshold := 2 * time.Hour // 2 hours before expiry
-	shouldRotate := time.Until(tokenDoc.ExpiresAt) < rotateThreshold
-
-	var refreshTokenValue string
-	var refreshTokenExpiry time.Time
-
-	if shouldRotate {
-		// Rotate: generate and store a new refresh token
-		refreshToken := &domain.RefreshToken{
-			Token:     response.RefreshToken,
-			UserID:    user.ID,
-			Revoked:   false,
-			ExpiresAt: response.RefreshTokenExpiresAt,
-			CreatedAt: time.Now(),
-		}
-		if err := ac.RefreshTokenUsecase.RevokedToken(tokenDoc); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke old refresh token"})
-			return
-
-		}
-		if err := ac.RefreshTokenUsecase.ReplaceToken(refreshToken); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update refresh token"})
+	// Always rotate: the presented token is marked used and replaced by the new one
+	next := &domain.RefreshToken{
+		Token:     response.RefreshToken,
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+		ExpiresAt: response.RefreshTokenExpiresAt,
+	}
+	if err := ac.RefreshTokenUsecase.Rotate(tokenDoc, next); err != nil {
+		if err == domain.ErrRefreshTokenReused {
+			ac.refreshTokenReused(c)
 			return
 		}
-		refreshTokenValue = response.RefreshToken
-		refreshTokenExpiry = response.RefreshTokenExpiresAt
-	} else {
-		// Do not rotate: keep the old refresh token
-		refreshTokenValue = tokenDoc.Token
-		refreshTokenExpiry = tokenDoc.ExpiresAt
-	}
-
-	// Set the refresh token in the cookies
-	utils.SetCookie(c, utils.CookieOptions{
-		Name:     "refresh_token",
-		Value:    refreshTokenValue,
-		MaxAge:   int(time.Until(refreshTokenExpiry).Seconds()),
-		Path:     "/",
-		Domain:   "",
-		Secure:   false,
-		HttpOnly: true,
-		SameSite: http.SameSiteStrictMode,
-	})
-	// Set the access token in the cookies
-	utils.SetCookie(c, utils.CookieOptions{
-		Name:     "access_token",
-		Value:    response.AccessToken,
-		MaxAge:   int(time.Until(response.AccessTokenExpiresAt).Seconds()),
-		Path:     "/",
-		Domain:   "",
-		Secure:   false,
-		HttpOnly: true,
-		SameSite: http.SameSiteStrictMode,
-	})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update refresh token"})
+		return
+	}
+
+	if err := ac.setSessionCookies(c, response); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create token"})
+		return
+	}
 
 	c.JSON(http.StatusOK, dto.LoginResponse{
 		AccessToken:  response.AccessToken,
-		RefreshToken: refreshTokenValue,
+		RefreshToken: response.RefreshToken,
 	})
 }
 
+func (ac *AuthController) refreshTokenReused(c *gin.Context) {
+	ac.clearSessionCookies(c)
+	c.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token was already used, please login again"})
+}
+
 // log out here
 func (ac *AuthController) LogoutRequest(c *gin.Context) {
 
 	// get the refresh token from cookies
 	refreshToken, err := utils.GetCookie(c, "refresh_token")
-	utils.DeleteCookie(c, "refresh_token")
-	utils.DeleteCookie(c, "access_token")
+	ac.clearSessionCookies(c)
 
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "You are not logged in or your session has expired"})
@@ -274,17 +315,18 @@ func (ac *AuthController) LogoutRequest(c *gin.Context) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "You are not logged in or your session has expired"})
 		return
 	}
-	// revoke the token
+	// revoke the token, this ends the current session only
 	if err := ac.RefreshTokenUsecase.RevokedToken(tokenDoc); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke token"})
 		return
 	}
-
-	// delete the token from the database
-	if err := ac.RefreshTokenUsecase.DeleteByUserID(tokenDoc.UserID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete token"})
-		return
-	}
+	recordSecurityEvent(c, ac.SecurityEventUsecase, domain.SecurityEvent{
+		Type:    domain.SecurityEventTokenRevoked,
+		ActorID: tokenDoc.UserID,
+		UserID:  tokenDoc.UserID,
+		Outcome: domain.SecurityEventSuccess,
+		Details: "logout, session " + tokenDoc.FamilyID,
+	})
 
 	c.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
 }
@@ -307,6 +349,12 @@ func (ac *AuthController) ChangeRoleRequest(c *gin.Context) {
 	err := ac.UserUsecase.ChangeRole(initiator, req.UserID, domain.User{
 		Role: domain.UserRole(req.Role),
 	})
+	recordSecurityEvent(c, ac.SecurityEventUsecase, domain.SecurityEvent{
+		Type:    domain.SecurityEventRoleChange,
+		UserID:  req.UserID,
+		Outcome: securityEventOutcome(err),
+		Details: "role " + req.Role,
+	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to change user role", "error": err.Error()})
 		return
@@ -352,7 +400,20 @@ func (ac *AuthController) ResetPasswordRequest(c *gin.Context) {
 	}
 
 	err := ac.PasswordResetUsecase.ResetPassword(req.Email, req.Token, req.NewPassword)
+	event := domain.SecurityEvent{
+		Type:    domain.SecurityEventPasswordReset,
+		Outcome: securityEventOutcome(err),
+		Details: "email " + req.Email,
+	}
+	// nobody is logged in, the account is found by its email so it shows in the owner's activity
+	if user, lookupErr := ac.UserUsecase.GetUserByEmail(req.Email); lookupErr == nil && user != nil {
+		event.UserID = user.ID
+	}
+	recordSecurityEvent(c, ac.SecurityEventUsecase, event)
 	if err != nil {
+		if passwordRefused(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to reset password", "error": err.Error()})
 		return
 	}
@@ -379,7 +440,7 @@ func (ac *AuthController) VerifyEmailRequest(c *gin.Context) {
 		return
 	}
 
-	err = ac.OTP.RequestOTP(user.Email)
+	err = ac.OTP.RequestOTP(user.Email, domain.OTPPurposeEmailVerification)
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
@@ -408,7 +469,14 @@ func (ac *AuthController) VerifyOTPRequest(c *gin.Context) {
 		return
 	}
 
-	otp, err := ac.OTP.VerifyOTP(user.Email, req.Code)
+	// the code is consumed by VerifyOTP
+	_, err = ac.OTP.VerifyOTP(user.Email, domain.OTPPurposeEmailVerification, req.Code)
+	recordSecurityEvent(c, ac.SecurityEventUsecase, domain.SecurityEvent{
+		Type:    domain.SecurityEventOTPVerification,
+		UserID:  user.ID,
+		Outcome: securityEventOutcome(err),
+		Details: "email verification",
+	})
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
@@ -421,12 +489,6 @@ func (ac *AuthController) VerifyOTPRequest(c *gin.Context) {
 		return
 	}
 
-	// delete the OTP after successful verification
-	if err := ac.OTP.DeleteByID(otp.ID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": domain.ErrOTPFailedToDelete.Error()})
-		return
-	}
-
 	c.JSON(http.StatusOK, gin.H{"message": "Email verified successfully"})
 }
 
@@ -439,7 +501,7 @@ func (ac *AuthController) ResendOTPRequest(c *gin.Context) {
 		return
 	}
 
-	err = ac.OTP.RequestOTP(user.Email)
+	err = ac.OTP.RequestOTP(user.Email, domain.OTPPurposeEmailVerification)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to resend OTP", "error": err.Error()})
 		return
@@ -447,151 +509,3 @@ func (ac *AuthController) ResendOTPRequest(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"message": "OTP resent successfully"})
 }
-
-//Oauth Google handlers
-
-func (ac *AuthController) GoogleLogin(c *gin.Context) {
-	conf := oauth.GetGoogleOAuthConfig(ac.Env.GoogleClientID, ac.Env.GoogleClientSecret, ac.Env.GoogleRedirectURL)
-	url := conf.AuthCodeURL("random-state")
-	c.Redirect(http.StatusTemporaryRedirect, url)
-}
-
-func (ac *AuthController) GoogleCallback(c *gin.Context) {
-	code := c.Query("code")
-	if code == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "code not found"})
-		return
-	}
-
-	conf := oauth.GetGoogleOAuthConfig(ac.Env.GoogleClientID, ac.Env.GoogleClientSecret, ac.Env.GoogleRedirectURL)
-	token, err := conf.Exchange(context.Background(), code)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to exchange token"})
-		return
-	}
-
-	client := conf.Client(context.Background(), token)
-	service, err := oauth2v2.NewService(c.Request.Context(), option.WithHTTPClient(client))
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create oauth service"})
-		return
-	}
-
-	userInfo, err := service.Userinfo.Get().Do()
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get user info"})
-		return
-	}
-
-	// Try finding user by email
-	user, err := ac.UserUsecase.FindByUsernameOrEmail(c.Request.Context(), userInfo.Email)
-	if err == nil && user != nil {
-		// User exists - login flow
-		response, err := ac.AuthService.GenerateTokens(*user)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
-			return
-		}
-
-		refreshToken := &domain.RefreshToken{
-			Token:     response.RefreshToken,
-			UserID:    user.ID,
-			ExpiresAt: response.RefreshTokenExpiresAt,
-			Revoked:   false,
-			CreatedAt: time.Now(),
-		}
-
-		if existingToken, err := ac.RefreshTokenUsecase.FindByUserID(user.ID); err == nil {
-			ac.RefreshTokenUsecase.RevokedToken(existingToken)
-			ac.RefreshTokenUsecase.ReplaceToken(refreshToken)
-		} else {
-			ac.RefreshTokenUsecase.Save(refreshToken)
-		}
-
-		utils.SetCookie(c, utils.CookieOptions{
-			Name:     "refresh_token",
-			Value:    response.RefreshToken,
-			MaxAge:   int(time.Until(response.RefreshTokenExpiresAt).Seconds()),
-			Path:     "/",
-			HttpOnly: true,
-			Secure:   false,
-			SameSite: http.SameSiteStrictMode,
-		})
-
-		utils.SetCookie(c, utils.CookieOptions{
-			Name:     "access_token",
-			Value:    response.AccessToken,
-			MaxAge:   int(time.Until(response.AccessTokenExpiresAt).Seconds()),
-			Path:     "/",
-			HttpOnly: true,
-			Secure:   false,
-			SameSite: http.SameSiteStrictMode,
-		})
-
-		c.JSON(http.StatusOK, gin.H{"message": "Login successful via Google", "user": dto.ToUserResponse(*user)})
-		return
-	}
-
-	// If user not found – register new one
-	newUser := &domain.User{
-		Username:  strings.Split(userInfo.Email, "@")[0],
-		Email:     userInfo.Email,
-		FirstName: userInfo.GivenName,
-		LastName:  userInfo.FamilyName,
-		Role:      "user",
-		Bio:       "",
-		AvatarURL: userInfo.Picture,
-		Provider:  "google",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}
-
-	if err := ac.UserUsecase.Register(newUser); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to register user"})
-		return
-	}
-
-	// Generate token for new user
-	response, err := ac.AuthService.GenerateTokens(*newUser)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
-		return
-	}
-
-	refreshToken := &domain.RefreshToken{
-		Token:     response.RefreshToken,
-		UserID:    newUser.ID,
-		ExpiresAt: response.RefreshTokenExpiresAt,
-		Revoked:   false,
-		CreatedAt: time.Now(),
-	}
-
-	if existingToken, err := ac.RefreshTokenUsecase.FindByUserID(newUser.ID); err == nil {
-		ac.RefreshTokenUsecase.RevokedToken(existingToken)
-		ac.RefreshTokenUsecase.ReplaceToken(refreshToken)
-	} else {
-		ac.RefreshTokenUsecase.Save(refreshToken)
-	}
-
-	utils.SetCookie(c, utils.CookieOptions{
-		Name:     "refresh_token",
-		Value:    response.RefreshToken,
-		MaxAge:   int(time.Until(response.RefreshTokenExpiresAt).Seconds()),
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   false,
-		SameSite: http.SameSiteStrictMode,
-	})
-
-	utils.SetCookie(c, utils.CookieOptions{
-		Name:     "access_token",
-		Value:    response.AccessToken,
-		MaxAge:   int(time.Until(response.AccessTokenExpiresAt).Seconds()),
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   false,
-		SameSite: http.SameSiteStrictMode,
-	})
-
-	c.JSON(http.StatusCreated, gin.H{"message": "User registered successfully via Google", "user": dto.ToUserResponse(*newUser)})
-}
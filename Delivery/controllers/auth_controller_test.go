@@ -19,6 +19,7 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // AuthControllerSuite defines the test suite for AuthController
@@ -29,6 +30,11 @@ type AuthControllerSuite struct {
 	mockOTPUsecase           *domain_mocks.MockIOTPUsecase
 	mockRefreshTokenUsecase  *domain_mocks.MockIRefreshTokenUsecase
 	mockPasswordResetUsecase *domain_mocks.MockIPasswordResetUsecase
+	mockTwoFactorUsecase     *domain_mocks.MockITwoFactorUsecase
+	mockOIDCUsecase          *domain_mocks.MockIOIDCUsecase
+	mockMagicLinkUsecase     *domain_mocks.MockIMagicLinkUsecase
+	mockLoginAttemptUsecase  *domain_mocks.MockILoginAttemptUsecase
+	mockSecurityEventUsecase *domain_mocks.MockISecurityEventUsecase
 	handler                  *AuthController
 	validate                 *validator.Validate
 }
@@ -40,6 +46,11 @@ func (s *AuthControllerSuite) SetupTest() {
 	s.mockOTPUsecase = domain_mocks.NewMockIOTPUsecase(s.T())
 	s.mockRefreshTokenUsecase = domain_mocks.NewMockIRefreshTokenUsecase(s.T())
 	s.mockPasswordResetUsecase = domain_mocks.NewMockIPasswordResetUsecase(s.T())
+	s.mockTwoFactorUsecase = domain_mocks.NewMockITwoFactorUsecase(s.T())
+	s.mockOIDCUsecase = domain_mocks.NewMockIOIDCUsecase(s.T())
+	s.mockMagicLinkUsecase = domain_mocks.NewMockIMagicLinkUsecase(s.T())
+	s.mockLoginAttemptUsecase = domain_mocks.NewMockILoginAttemptUsecase(s.T())
+	s.mockSecurityEventUsecase = domain_mocks.NewMockISecurityEventUsecase(s.T())
 
 	s.handler = &AuthController{
 		UserUsecase:          s.mockUserUsecase,
@@ -47,8 +58,14 @@ func (s *AuthControllerSuite) SetupTest() {
 		OTP:                  s.mockOTPUsecase,
 		RefreshTokenUsecase:  s.mockRefreshTokenUsecase,
 		PasswordResetUsecase: s.mockPasswordResetUsecase,
+		TwoFactorUsecase:     s.mockTwoFactorUsecase,
+		OIDCUsecase:          s.mockOIDCUsecase,
+		MagicLinkUsecase:     s.mockMagicLinkUsecase,
+		LoginAttemptUsecase:  s.mockLoginAttemptUsecase,
+		SecurityEventUsecase: s.mockSecurityEventUsecase,
 	}
 	s.validate = validator.New()
+	s.resetMocks()
 }
 
 // TestAuthControllerSuite runs the test suite
@@ -89,6 +106,52 @@ func (s *AuthControllerSuite) TestRegisterRequest() {
 		s.resetMocks()
 	})
 
+	s.Run("LanguageFromAcceptLanguage", func() {
+		userRequest := dto.UserRequest{
+			Username:  "testuser",
+			Email:     "test@example.com",
+			Password:  "password123",
+			FirstName: "Test",
+			LastName:  "User",
+			Provider:  "manual",
+		}
+		s.mockUserUsecase.On("Register", mock.MatchedBy(func(u *domain.User) bool {
+			return u.Language == "fr-CA"
+		})).Return(nil)
+
+		c, w := s.createTestRequest(http.MethodPost, "/register", userRequest, nil)
+		c.Request.Header.Set("Accept-Language", "fr-CA,fr;q=0.9,en;q=0.8")
+		s.handler.RegisterRequest(c)
+
+		s.Equal(http.StatusCreated, w.Code)
+		var response dto.UserResponse
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("fr-CA", response.Language)
+		s.resetMocks()
+	})
+
+	s.Run("ExplicitLanguageWins", func() {
+		userRequest := dto.UserRequest{
+			Username:  "testuser",
+			Email:     "test@example.com",
+			Password:  "password123",
+			FirstName: "Test",
+			LastName:  "User",
+			Provider:  "manual",
+			Language:  "en",
+		}
+		s.mockUserUsecase.On("Register", mock.MatchedBy(func(u *domain.User) bool {
+			return u.Language == "en"
+		})).Return(nil)
+
+		c, w := s.createTestRequest(http.MethodPost, "/register", userRequest, nil)
+		c.Request.Header.Set("Accept-Language", "fr")
+		s.handler.RegisterRequest(c)
+
+		s.Equal(http.StatusCreated, w.Code)
+		s.resetMocks()
+	})
+
 	s.Run("InvalidJSON", func() {
 		c, w := s.createTestRequest(http.MethodPost, "/register", "{invalid json}", nil)
 		s.handler.RegisterRequest(c)
@@ -138,6 +201,36 @@ func (s *AuthControllerSuite) TestRegisterRequest() {
 		s.Equal("registration failed", response["error"])
 		s.resetMocks()
 	})
+
+	s.Run("PasswordPolicyViolation", func() {
+		userRequest := dto.UserRequest{
+			Username:  "testuser",
+			Email:     "test@example.com",
+			Password:  "password",
+			Provider:  "manual",
+			FirstName: "Test",
+			LastName:  "User",
+		}
+		user := dto.ToDomainUser(userRequest)
+		s.mockUserUsecase.On("Register", &user).Return(&domain.PasswordPolicyError{Violations: []domain.PasswordViolation{
+			{Rule: domain.PasswordRuleDigit, Message: "must contain a digit"},
+			{Rule: domain.PasswordRuleNotBreached, Message: "appears in a known data breach, choose another one"},
+		}})
+		c, w := s.createTestRequest(http.MethodPost, "/register", userRequest, nil)
+		s.handler.RegisterRequest(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		var response struct {
+			Error      string                     `json:"error"`
+			Violations []domain.PasswordViolation `json:"violations"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("Password does not meet the password policy", response.Error)
+		s.Len(response.Violations, 2)
+		s.Equal(domain.PasswordRuleDigit, response.Violations[0].Rule)
+		s.Equal(domain.PasswordRuleNotBreached, response.Violations[1].Rule)
+		s.resetMocks()
+	})
 }
 
 // TestLoginRequest tests the LoginRequest method
@@ -159,9 +252,12 @@ func (s *AuthControllerSuite) TestLoginRequest() {
 			AccessTokenExpiresAt:  time.Now().Add(time.Hour),
 			RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
 		}
+		s.mockLoginAttemptUsecase.On("Check", loginRequest.Identifier, mock.Anything).Return(time.Duration(0), nil)
+		s.mockLoginAttemptUsecase.On("RecordSuccess", loginRequest.Identifier).Return(nil)
 		s.mockUserUsecase.On("FindByUsernameOrEmail", mock.Anything, loginRequest.Identifier).Return(user, nil)
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(false, nil)
+		s.mockTwoFactorUsecase.On("IsRequired", user.Role).Return(false, nil)
 		s.mockAuthService.On("GenerateTokens", *user).Return(tokenResponse, nil)
-		s.mockRefreshTokenUsecase.On("FindByUserID", user.ID).Return(nil, errors.New("refresh token not found"))
 		s.mockRefreshTokenUsecase.On("Save", mock.Anything).Return(nil)
 		c, w := s.createTestRequest(http.MethodPost, "/login", loginRequest, nil)
 
@@ -177,6 +273,37 @@ func (s *AuthControllerSuite) TestLoginRequest() {
 		tokens := response["tokens"].(map[string]any)
 		s.Equal(tokenResponse.AccessToken, tokens["access_token"])
 		s.Equal(tokenResponse.RefreshToken, tokens["refresh_token"])
+		s.mockSecurityEventUsecase.AssertCalled(s.T(), "Record", mock.MatchedBy(func(e *domain.SecurityEvent) bool {
+			return e.Type == domain.SecurityEventLogin && e.Outcome == domain.SecurityEventSuccess &&
+				e.UserID == user.ID && e.ActorID == user.ID && e.Details == "method password"
+		}))
+		s.resetMocks()
+	})
+
+	s.Run("RehashesLegacyPassword", func() {
+		loginRequest := dto.LoginRequest{
+			Identifier: "test@example.com",
+			Password:   "password123",
+		}
+		// a cheaper cost than the configured one, as left behind by an older configuration
+		legacyHash, _ := security.BcryptHasher{Cost: bcrypt.MinCost}.Hash("password123")
+		user := &domain.User{ID: "1", Email: "test@example.com", Password: legacyHash}
+		tokenResponse := domain.RefreshTokenResponse{AccessToken: "access-token", RefreshToken: "refresh-token"}
+		s.mockLoginAttemptUsecase.On("Check", loginRequest.Identifier, mock.Anything).Return(time.Duration(0), nil)
+		s.mockLoginAttemptUsecase.On("RecordSuccess", loginRequest.Identifier).Return(nil)
+		s.mockUserUsecase.On("FindByUsernameOrEmail", mock.Anything, loginRequest.Identifier).Return(user, nil)
+		s.mockUserUsecase.On("RehashPassword", user, loginRequest.Password).Return(errors.New("update failed"))
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(false, nil)
+		s.mockTwoFactorUsecase.On("IsRequired", user.Role).Return(false, nil)
+		s.mockAuthService.On("GenerateTokens", *user).Return(tokenResponse, nil)
+		s.mockRefreshTokenUsecase.On("Save", mock.Anything).Return(nil)
+		c, w := s.createTestRequest(http.MethodPost, "/login", loginRequest, nil)
+
+		s.handler.LoginRequest(c)
+
+		// a failed upgrade does not stop the login
+		s.Equal(http.StatusOK, w.Code)
+		s.mockUserUsecase.AssertCalled(s.T(), "RehashPassword", user, loginRequest.Password)
 		s.resetMocks()
 	})
 
@@ -211,6 +338,8 @@ func (s *AuthControllerSuite) TestLoginRequest() {
 			Identifier: "test@example.com",
 			Password:   "password123",
 		}
+		s.mockLoginAttemptUsecase.On("Check", loginRequest.Identifier, mock.Anything).Return(time.Duration(0), nil)
+		s.mockLoginAttemptUsecase.On("RecordFailure", loginRequest.Identifier, mock.Anything).Return(nil)
 		s.mockUserUsecase.On("FindByUsernameOrEmail", mock.Anything, loginRequest.Identifier).Return(nil, errors.New("user not found"))
 		c, w := s.createTestRequest(http.MethodPost, "/login", loginRequest, nil)
 
@@ -233,6 +362,8 @@ func (s *AuthControllerSuite) TestLoginRequest() {
 			Email:    "test@example.com",
 			Password: string(hashedPassword),
 		}
+		s.mockLoginAttemptUsecase.On("Check", loginRequest.Identifier, mock.Anything).Return(time.Duration(0), nil)
+		s.mockLoginAttemptUsecase.On("RecordFailure", loginRequest.Identifier, mock.Anything).Return(nil)
 		s.mockUserUsecase.On("FindByUsernameOrEmail", mock.Anything, loginRequest.Identifier).Return(user, nil)
 
 		c, w := s.createTestRequest(http.MethodPost, "/login", loginRequest, nil)
@@ -242,6 +373,10 @@ func (s *AuthControllerSuite) TestLoginRequest() {
 		var response gin.H
 		json.Unmarshal(w.Body.Bytes(), &response)
 		s.Equal("Invalid email or password", response["error"])
+		s.mockSecurityEventUsecase.AssertCalled(s.T(), "Record", mock.MatchedBy(func(e *domain.SecurityEvent) bool {
+			return e.Type == domain.SecurityEventLogin && e.Outcome == domain.SecurityEventFailure &&
+				e.UserID == user.ID && e.ActorID == "" && e.IP != ""
+		}))
 		s.resetMocks()
 	})
 
@@ -256,7 +391,11 @@ func (s *AuthControllerSuite) TestLoginRequest() {
 			Email:    "test@example.com",
 			Password: string(hashedPassword),
 		}
+		s.mockLoginAttemptUsecase.On("Check", loginRequest.Identifier, mock.Anything).Return(time.Duration(0), nil)
+		s.mockLoginAttemptUsecase.On("RecordSuccess", loginRequest.Identifier).Return(nil)
 		s.mockUserUsecase.On("FindByUsernameOrEmail", mock.Anything, loginRequest.Identifier).Return(user, nil)
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(false, nil)
+		s.mockTwoFactorUsecase.On("IsRequired", user.Role).Return(false, nil)
 		s.mockAuthService.On("GenerateTokens", *user).Return(domain.RefreshTokenResponse{}, errors.New("token generation failed"))
 
 		c, w := s.createTestRequest(http.MethodPost, "/login", loginRequest, nil)
@@ -271,13 +410,193 @@ func (s *AuthControllerSuite) TestLoginRequest() {
 	})
 }
 
+// TestLoginTwoFactor tests the two-step login flow
+func (s *AuthControllerSuite) TestLoginTwoFactor() {
+	hashedPassword, _ := security.HashPassword("password123")
+	user := &domain.User{
+		ID:       "1",
+		Email:    "test@example.com",
+		Password: string(hashedPassword),
+		Role:     domain.RoleAdmin,
+	}
+	loginRequest := dto.LoginRequest{
+		Identifier: "test@example.com",
+		Password:   "password123",
+	}
+
+	s.Run("ChallengeWhenEnabled", func() {
+		expiresAt := time.Now().Add(5 * time.Minute)
+		s.mockLoginAttemptUsecase.On("Check", loginRequest.Identifier, mock.Anything).Return(time.Duration(0), nil)
+		s.mockLoginAttemptUsecase.On("RecordSuccess", loginRequest.Identifier).Return(nil)
+		s.mockUserUsecase.On("FindByUsernameOrEmail", mock.Anything, loginRequest.Identifier).Return(user, nil)
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(true, nil)
+		s.mockAuthService.On("GeneratePreAuthToken", *user).Return("pre-auth-token", expiresAt, nil)
+		c, w := s.createTestRequest(http.MethodPost, "/login", loginRequest, nil)
+
+		s.handler.LoginRequest(c)
+
+		s.Equal(http.StatusAccepted, w.Code)
+		var response dto.TwoFactorChallengeResponse
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.True(response.TwoFactorRequired)
+		s.False(response.TwoFactorSetupRequired)
+		s.Equal("pre-auth-token", response.PreAuthToken)
+		s.mockAuthService.AssertNotCalled(s.T(), "GenerateTokens", mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("SetupRequiredByPolicy", func() {
+		s.mockLoginAttemptUsecase.On("Check", loginRequest.Identifier, mock.Anything).Return(time.Duration(0), nil)
+		s.mockLoginAttemptUsecase.On("RecordSuccess", loginRequest.Identifier).Return(nil)
+		s.mockUserUsecase.On("FindByUsernameOrEmail", mock.Anything, loginRequest.Identifier).Return(user, nil)
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(false, nil)
+		s.mockTwoFactorUsecase.On("IsRequired", domain.RoleAdmin).Return(true, nil)
+		s.mockAuthService.On("GeneratePreAuthToken", *user).Return("pre-auth-token", time.Now(), nil)
+		c, w := s.createTestRequest(http.MethodPost, "/login", loginRequest, nil)
+
+		s.handler.LoginRequest(c)
+
+		s.Equal(http.StatusAccepted, w.Code)
+		var response dto.TwoFactorChallengeResponse
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.False(response.TwoFactorRequired)
+		s.True(response.TwoFactorSetupRequired)
+		s.resetMocks()
+	})
+
+	s.Run("VerifySuccess", func() {
+		tokenResponse := domain.RefreshTokenResponse{
+			AccessToken:           "access-token",
+			RefreshToken:          "refresh-token",
+			AccessTokenExpiresAt:  time.Now().Add(time.Hour),
+			RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+		}
+		s.mockAuthService.On("ValidatePreAuthToken", "pre-auth-token").Return(jwt.MapClaims{"sub": user.ID}, nil)
+		s.mockUserUsecase.On("FindUserByID", user.ID).Return(user, nil)
+		s.mockLoginAttemptUsecase.On("CheckUser", user.ID).Return(time.Duration(0), nil)
+		s.mockTwoFactorUsecase.On("Verify", user.ID, "123456").Return(nil)
+		s.mockLoginAttemptUsecase.On("RecordSecondFactorSuccess", user.ID).Return(nil)
+		s.mockAuthService.On("GenerateTokens", *user).Return(tokenResponse, nil)
+		s.mockRefreshTokenUsecase.On("Save", mock.Anything).Return(nil)
+		body := dto.TwoFactorLoginRequest{PreAuthToken: "pre-auth-token", Code: "123456"}
+		c, w := s.createTestRequest(http.MethodPost, "/2fa/verify", body, nil)
+
+		s.handler.VerifyTwoFactorLogin(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response gin.H
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("Login successful", response["message"])
+		tokens := response["tokens"].(map[string]any)
+		s.Equal(tokenResponse.AccessToken, tokens["access_token"])
+		s.resetMocks()
+	})
+
+	s.Run("VerifyInvalidCode", func() {
+		s.mockAuthService.On("ValidatePreAuthToken", "pre-auth-token").Return(jwt.MapClaims{"sub": user.ID}, nil)
+		s.mockUserUsecase.On("FindUserByID", user.ID).Return(user, nil)
+		s.mockLoginAttemptUsecase.On("CheckUser", user.ID).Return(time.Duration(0), nil)
+		s.mockTwoFactorUsecase.On("Verify", user.ID, "000000").Return(domain.ErrTwoFactorInvalidCode)
+		s.mockLoginAttemptUsecase.On("RecordSecondFactorFailure", user.ID, mock.Anything).Return(nil)
+		body := dto.TwoFactorLoginRequest{PreAuthToken: "pre-auth-token", Code: "000000"}
+		c, w := s.createTestRequest(http.MethodPost, "/2fa/verify", body, nil)
+
+		s.handler.VerifyTwoFactorLogin(c)
+
+		s.Equal(http.StatusUnauthorized, w.Code)
+		s.mockLoginAttemptUsecase.AssertCalled(s.T(), "RecordSecondFactorFailure", user.ID, mock.Anything)
+		s.mockAuthService.AssertNotCalled(s.T(), "GenerateTokens", mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("VerifyInvalidCodeLocks", func() {
+		s.mockAuthService.On("ValidatePreAuthToken", "pre-auth-token").Return(jwt.MapClaims{"sub": user.ID}, nil)
+		s.mockUserUsecase.On("FindUserByID", user.ID).Return(user, nil)
+		s.mockLoginAttemptUsecase.On("CheckUser", user.ID).Return(time.Duration(0), nil).Once()
+		s.mockTwoFactorUsecase.On("Verify", user.ID, "000000").Return(domain.ErrTwoFactorInvalidCode)
+		s.mockLoginAttemptUsecase.On("RecordSecondFactorFailure", user.ID, mock.Anything).Return(domain.ErrAccountLocked)
+		s.mockLoginAttemptUsecase.On("CheckUser", user.ID).Return(30*time.Minute, domain.ErrAccountLocked).Once()
+		body := dto.TwoFactorLoginRequest{PreAuthToken: "pre-auth-token", Code: "000000"}
+		c, w := s.createTestRequest(http.MethodPost, "/2fa/verify", body, nil)
+
+		s.handler.VerifyTwoFactorLogin(c)
+
+		s.Equal(http.StatusLocked, w.Code)
+		s.Equal("1800", w.Header().Get("Retry-After"))
+		s.resetMocks()
+	})
+
+	s.Run("VerifyLockedRefusesPreAuthToken", func() {
+		s.mockAuthService.On("ValidatePreAuthToken", "pre-auth-token").Return(jwt.MapClaims{"sub": user.ID}, nil)
+		s.mockUserUsecase.On("FindUserByID", user.ID).Return(user, nil)
+		s.mockLoginAttemptUsecase.On("CheckUser", user.ID).Return(10*time.Minute, domain.ErrAccountLocked)
+		body := dto.TwoFactorLoginRequest{PreAuthToken: "pre-auth-token", Code: "123456"}
+		c, w := s.createTestRequest(http.MethodPost, "/2fa/verify", body, nil)
+
+		s.handler.VerifyTwoFactorLogin(c)
+
+		s.Equal(http.StatusLocked, w.Code)
+		s.mockTwoFactorUsecase.AssertNotCalled(s.T(), "Verify", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("SetupConfirmInvalidCodeCounted", func() {
+		s.mockAuthService.On("ValidatePreAuthToken", "pre-auth-token").Return(jwt.MapClaims{"sub": user.ID}, nil)
+		s.mockUserUsecase.On("FindUserByID", user.ID).Return(user, nil)
+		s.mockLoginAttemptUsecase.On("CheckUser", user.ID).Return(time.Duration(0), nil)
+		s.mockTwoFactorUsecase.On("Confirm", user.ID, "000000").Return(nil, domain.ErrTwoFactorInvalidCode)
+		s.mockLoginAttemptUsecase.On("RecordSecondFactorFailure", user.ID, mock.Anything).Return(nil).Once()
+		body := dto.TwoFactorLoginRequest{PreAuthToken: "pre-auth-token", Code: "000000"}
+		c, w := s.createTestRequest(http.MethodPost, "/2fa/setup/confirm", body, nil)
+
+		s.handler.TwoFactorSetupConfirm(c)
+
+		s.Equal(http.StatusUnauthorized, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("SetupConfirmInvalidCodeLocks", func() {
+		s.mockAuthService.On("ValidatePreAuthToken", "pre-auth-token").Return(jwt.MapClaims{"sub": user.ID}, nil)
+		s.mockUserUsecase.On("FindUserByID", user.ID).Return(user, nil)
+		s.mockLoginAttemptUsecase.On("CheckUser", user.ID).Return(time.Duration(0), nil).Once()
+		s.mockTwoFactorUsecase.On("Confirm", user.ID, "000000").Return(nil, domain.ErrTwoFactorInvalidCode)
+		s.mockLoginAttemptUsecase.On("RecordSecondFactorFailure", user.ID, mock.Anything).Return(domain.ErrAccountLocked)
+		s.mockLoginAttemptUsecase.On("CheckUser", user.ID).Return(30*time.Minute, domain.ErrAccountLocked).Once()
+		body := dto.TwoFactorLoginRequest{PreAuthToken: "pre-auth-token", Code: "000000"}
+		c, w := s.createTestRequest(http.MethodPost, "/2fa/setup/confirm", body, nil)
+
+		s.handler.TwoFactorSetupConfirm(c)
+
+		s.Equal(http.StatusLocked, w.Code)
+		s.Equal("1800", w.Header().Get("Retry-After"))
+		s.mockAuthService.AssertNotCalled(s.T(), "GenerateTokens", mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("VerifyInvalidPreAuthToken", func() {
+		s.mockAuthService.On("ValidatePreAuthToken", "bad-token").Return(nil, errors.New("invalid token"))
+		body := dto.TwoFactorLoginRequest{PreAuthToken: "bad-token", Code: "123456"}
+		c, w := s.createTestRequest(http.MethodPost, "/2fa/verify", body, nil)
+
+		s.handler.VerifyTwoFactorLogin(c)
+
+		s.Equal(http.StatusUnauthorized, w.Code)
+		var response gin.H
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("Invalid or expired pre-auth token", response["error"])
+		s.resetMocks()
+	})
+}
+
 // TestRefreshToken tests the RefreshToken method
 func (s *AuthControllerSuite) TestRefreshToken() {
-	s.Run("SuccessWithoutRotation", func() {
+	s.Run("SuccessRotates", func() {
 		refreshRequest := dto.RefreshTokenRequest{
 			RefreshToken: "refresh-token",
 		}
 		tokenDoc := &domain.RefreshToken{
+			ID:        "token-1",
+			FamilyID:  "session-1",
 			Token:     "refresh-token",
 			UserID:    "1",
 			ExpiresAt: time.Now().Add(3 * time.Hour),
@@ -289,14 +608,17 @@ func (s *AuthControllerSuite) TestRefreshToken() {
 		}
 		tokenResponse := &domain.RefreshTokenResponse{
 			AccessToken:           "new-access-token",
-			RefreshToken:          "refresh-token",
+			RefreshToken:          "new-refresh-token",
 			AccessTokenExpiresAt:  time.Now().Add(time.Hour),
-			RefreshTokenExpiresAt: tokenDoc.ExpiresAt,
+			RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
 		}
 		s.mockRefreshTokenUsecase.On("FindByToken", refreshRequest.RefreshToken).Return(tokenDoc, nil)
 		s.mockAuthService.On("ValidateRefreshToken", refreshRequest.RefreshToken).Return(jwt.MapClaims{"user_id": "1"}, nil)
 		s.mockUserUsecase.On("FindUserByID", tokenDoc.UserID).Return(user, nil)
 		s.mockAuthService.On("GenerateTokens", *user).Return(*tokenResponse, nil)
+		s.mockRefreshTokenUsecase.On("Rotate", tokenDoc, mock.MatchedBy(func(next *domain.RefreshToken) bool {
+			return next.Token == "new-refresh-token"
+		})).Return(nil)
 
 		cookie := []*http.Cookie{{Name: "refresh_token", Value: "refresh-token"}}
 		c, w := s.createTestRequest(http.MethodPost, "/refresh", refreshRequest, cookie)
@@ -334,7 +656,6 @@ func (s *AuthControllerSuite) TestRefreshToken() {
 			Revoked:   false,
 		}
 		s.mockRefreshTokenUsecase.On("FindByToken", refreshRequest.RefreshToken).Return(tokenDoc, nil)
-		s.mockRefreshTokenUsecase.On("DeleteByUserID", mock.Anything).Return(nil)
 		c, w := s.createTestRequest(http.MethodPost, "/refresh", refreshRequest, nil)
 
 		s.handler.RefreshToken(c)
@@ -346,6 +667,56 @@ func (s *AuthControllerSuite) TestRefreshToken() {
 		s.resetMocks()
 	})
 
+	s.Run("ReusedToken", func() {
+		refreshRequest := dto.RefreshTokenRequest{
+			RefreshToken: "stolen-refresh-token",
+		}
+		tokenDoc := &domain.RefreshToken{
+			FamilyID:  "session-1",
+			UserID:    "1",
+			ExpiresAt: time.Now().Add(3 * time.Hour),
+			Used:      true,
+		}
+		s.mockRefreshTokenUsecase.On("FindByToken", refreshRequest.RefreshToken).Return(tokenDoc, nil)
+		s.mockRefreshTokenUsecase.On("ReportReuse", tokenDoc, mock.Anything, mock.Anything).Return(nil)
+		c, w := s.createTestRequest(http.MethodPost, "/refresh", refreshRequest, nil)
+
+		s.handler.RefreshToken(c)
+
+		s.Equal(http.StatusUnauthorized, w.Code)
+		var response gin.H
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("Refresh token was already used, please login again", response["error"])
+		s.mockAuthService.AssertNotCalled(s.T(), "GenerateTokens", mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("ReusedDuringRotation", func() {
+		refreshRequest := dto.RefreshTokenRequest{
+			RefreshToken: "refresh-token",
+		}
+		tokenDoc := &domain.RefreshToken{
+			ID:        "token-1",
+			FamilyID:  "session-1",
+			UserID:    "1",
+			ExpiresAt: time.Now().Add(3 * time.Hour),
+		}
+		user := &domain.User{ID: "1"}
+		s.mockRefreshTokenUsecase.On("FindByToken", refreshRequest.RefreshToken).Return(tokenDoc, nil)
+		s.mockAuthService.On("ValidateRefreshToken", refreshRequest.RefreshToken).Return(jwt.MapClaims{}, nil)
+		s.mockUserUsecase.On("FindUserByID", "1").Return(user, nil)
+		s.mockAuthService.On("GenerateTokens", *user).Return(domain.RefreshTokenResponse{RefreshToken: "new-refresh-token"}, nil)
+		s.mockRefreshTokenUsecase.On("Rotate", tokenDoc, mock.Anything).Return(domain.ErrRefreshTokenReused)
+
+		cookie := []*http.Cookie{{Name: "refresh_token", Value: "refresh-token"}}
+		c, w := s.createTestRequest(http.MethodPost, "/refresh", refreshRequest, cookie)
+
+		s.handler.RefreshToken(c)
+
+		s.Equal(http.StatusUnauthorized, w.Code)
+		s.resetMocks()
+	})
+
 	s.Run("NoTokenOnDB", func() {
 		refreshRequest := dto.RefreshTokenRequest{
 			RefreshToken: "refresh-token",
@@ -367,7 +738,6 @@ func (s *AuthControllerSuite) TestRefreshToken() {
 			RefreshToken: "invalid-token",
 		}
 		s.mockRefreshTokenUsecase.On("FindByToken", refreshRequest.RefreshToken).Return(nil, errors.New("token not found"))
-		s.mockRefreshTokenUsecase.On("DeleteByUserID", mock.Anything).Return(nil)
 		c, w := s.createTestRequest(http.MethodPost, "/refresh", refreshRequest, nil)
 
 		s.handler.RefreshToken(c)
@@ -439,7 +809,6 @@ func (s *AuthControllerSuite) TestLogoutRequest() {
 		}
 		s.mockRefreshTokenUsecase.On("FindByToken", "refresh-token").Return(tokenDoc, nil)
 		s.mockRefreshTokenUsecase.On("RevokedToken", tokenDoc).Return(nil)
-		s.mockRefreshTokenUsecase.On("DeleteByUserID", tokenDoc.UserID).Return(nil)
 
 		cookie := []*http.Cookie{{Name: "refresh_token", Value: "refresh-token"}, {Name: "access_token", Value: "access-token"}}
 		c, w := s.createTestRequest(http.MethodPost, "/logout", nil, cookie)
@@ -515,6 +884,7 @@ func (s *AuthControllerSuite) TestChangeRoleRequest() {
 
 		c, w := s.createTestRequest(http.MethodPost, "/change-role", changeRoleRequest, nil)
 		c.Set("role", "admin")
+		c.Set("user_id", "admin-1")
 
 		s.handler.ChangeRoleRequest(c)
 
@@ -522,6 +892,10 @@ func (s *AuthControllerSuite) TestChangeRoleRequest() {
 		var response gin.H
 		json.Unmarshal(w.Body.Bytes(), &response)
 		s.Equal("User role changed successfully", response["message"])
+		s.mockSecurityEventUsecase.AssertCalled(s.T(), "Record", mock.MatchedBy(func(e *domain.SecurityEvent) bool {
+			return e.Type == domain.SecurityEventRoleChange && e.Outcome == domain.SecurityEventSuccess &&
+				e.ActorID == "admin-1" && e.UserID == "1" && e.Details == "role admin"
+		}))
 		s.resetMocks()
 	})
 
@@ -646,6 +1020,7 @@ func (s *AuthControllerSuite) TestResetPasswordRequest() {
 			Token:       "reset-token",
 			NewPassword: "newpassword123",
 		}
+		s.mockUserUsecase.On("GetUserByEmail", resetPasswordRequest.Email).Return(&domain.User{ID: "1", Email: resetPasswordRequest.Email}, nil)
 		s.mockPasswordResetUsecase.On("ResetPassword", resetPasswordRequest.Email, resetPasswordRequest.Token, resetPasswordRequest.NewPassword).Return(nil)
 		c, w := s.createTestRequest(http.MethodPost, "/reset-password", resetPasswordRequest, nil)
 
@@ -676,6 +1051,7 @@ func (s *AuthControllerSuite) TestResetPasswordRequest() {
 			Token:       "reset-token",
 			NewPassword: "newpassword123",
 		}
+		s.mockUserUsecase.On("GetUserByEmail", resetPasswordRequest.Email).Return(&domain.User{ID: "1", Email: resetPasswordRequest.Email}, nil)
 		s.mockPasswordResetUsecase.On("ResetPassword", resetPasswordRequest.Email, resetPasswordRequest.Token, resetPasswordRequest.NewPassword).Return(errors.New("reset failed"))
 
 		c, w := s.createTestRequest(http.MethodPost, "/reset-password", resetPasswordRequest, nil)
@@ -688,6 +1064,30 @@ func (s *AuthControllerSuite) TestResetPasswordRequest() {
 		s.Equal("Failed to reset password", response["message"])
 		s.resetMocks()
 	})
+
+	s.Run("PasswordPolicyViolation", func() {
+		resetPasswordRequest := dto.ResetPasswordRequest{
+			Email:       "test@example.com",
+			Token:       "reset-token",
+			NewPassword: "oldpassword123",
+		}
+		s.mockUserUsecase.On("GetUserByEmail", resetPasswordRequest.Email).Return(&domain.User{ID: "1", Email: resetPasswordRequest.Email}, nil)
+		s.mockPasswordResetUsecase.On("ResetPassword", resetPasswordRequest.Email, resetPasswordRequest.Token, resetPasswordRequest.NewPassword).Return(&domain.PasswordPolicyError{Violations: []domain.PasswordViolation{
+			{Rule: domain.PasswordRuleNotReused, Message: "must differ from your last 5 passwords"},
+		}})
+
+		c, w := s.createTestRequest(http.MethodPost, "/reset-password", resetPasswordRequest, nil)
+
+		s.handler.ResetPasswordRequest(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		var response struct {
+			Violations []domain.PasswordViolation `json:"violations"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal([]domain.PasswordViolation{{Rule: domain.PasswordRuleNotReused, Message: "must differ from your last 5 passwords"}}, response.Violations)
+		s.resetMocks()
+	})
 }
 
 // TestVerifyEmailRequest tests the VerifyEmailRequest method
@@ -701,7 +1101,7 @@ func (s *AuthControllerSuite) TestVerifyEmailRequest() {
 			Email: "test@example.com",
 		}
 		s.mockUserUsecase.On("GetUserByEmail", verifyEmailRequest.Email).Return(user, nil)
-		s.mockOTPUsecase.On("RequestOTP", user.Email).Return(nil)
+		s.mockOTPUsecase.On("RequestOTP", user.Email, domain.OTPPurposeEmailVerification).Return(nil)
 		c, w := s.createTestRequest(http.MethodPost, "/verify-email", verifyEmailRequest, nil)
 
 		s.handler.VerifyEmailRequest(c)
@@ -774,11 +1174,10 @@ func (s *AuthControllerSuite) TestVerifyOTPRequest() {
 			CodeHash: "123456",
 		}
 		s.mockUserUsecase.On("FindUserByID", "1").Return(user, nil)
-		s.mockOTPUsecase.On("VerifyOTP", user.Email, verifyOTPRequest.Code).Return(otp, nil)
+		s.mockOTPUsecase.On("VerifyOTP", user.Email, domain.OTPPurposeEmailVerification, verifyOTPRequest.Code).Return(otp, nil)
 		s.mockUserUsecase.On("UpdateUser", user.ID, mock.MatchedBy(func(u *domain.User) bool {
 			return u.IsVerified
 		})).Return(user, nil)
-		s.mockOTPUsecase.On("DeleteByID", otp.ID).Return(nil)
 		c, w := s.createTestRequest(http.MethodPost, "/verify-otp", verifyOTPRequest, nil)
 		c.Set("user_id", "1")
 
@@ -847,7 +1246,7 @@ func (s *AuthControllerSuite) TestVerifyOTPRequest() {
 			Email: "test@example.com",
 		}
 		s.mockUserUsecase.On("FindUserByID", "1").Return(user, nil)
-		s.mockOTPUsecase.On("VerifyOTP", user.Email, verifyOTPRequest.Code).Return(nil, errors.New("invalid OTP"))
+		s.mockOTPUsecase.On("VerifyOTP", user.Email, domain.OTPPurposeEmailVerification, verifyOTPRequest.Code).Return(nil, errors.New("invalid OTP"))
 
 		c, w := s.createTestRequest(http.MethodPost, "/verify-otp", verifyOTPRequest, nil)
 		c.Set("user_id", "1")
@@ -870,7 +1269,7 @@ func (s *AuthControllerSuite) TestResendOTPRequest() {
 			Email: "test@example.com",
 		}
 		s.mockUserUsecase.On("FindUserByID", "1").Return(user, nil)
-		s.mockOTPUsecase.On("RequestOTP", user.Email).Return(nil)
+		s.mockOTPUsecase.On("RequestOTP", user.Email, domain.OTPPurposeEmailVerification).Return(nil)
 
 		req := httptest.NewRequest(http.MethodPost, "/resend-otp", nil)
 		w := httptest.NewRecorder()
@@ -911,7 +1310,7 @@ func (s *AuthControllerSuite) TestResendOTPRequest() {
 			Email: "test@example.com",
 		}
 		s.mockUserUsecase.On("FindUserByID", "1").Return(user, nil)
-		s.mockOTPUsecase.On("RequestOTP", user.Email).Return(errors.New("OTP request failed"))
+		s.mockOTPUsecase.On("RequestOTP", user.Email, domain.OTPPurposeEmailVerification).Return(errors.New("OTP request failed"))
 
 		req := httptest.NewRequest(http.MethodPost, "/resend-otp", nil)
 		w := httptest.NewRecorder()
@@ -940,6 +1339,18 @@ func (s *AuthControllerSuite) resetMocks() {
 	s.mockRefreshTokenUsecase.Calls = nil
 	s.mockPasswordResetUsecase.ExpectedCalls = nil
 	s.mockPasswordResetUsecase.Calls = nil
+	s.mockTwoFactorUsecase.ExpectedCalls = nil
+	s.mockTwoFactorUsecase.Calls = nil
+	s.mockOIDCUsecase.ExpectedCalls = nil
+	s.mockOIDCUsecase.Calls = nil
+	s.mockMagicLinkUsecase.ExpectedCalls = nil
+	s.mockMagicLinkUsecase.Calls = nil
+	s.mockLoginAttemptUsecase.ExpectedCalls = nil
+	s.mockLoginAttemptUsecase.Calls = nil
+	s.mockSecurityEventUsecase.ExpectedCalls = nil
+	s.mockSecurityEventUsecase.Calls = nil
+	// the audit log is written on most paths, tests that care assert the recorded events
+	s.mockSecurityEventUsecase.On("Record", mock.Anything).Return(nil).Maybe()
 }
 
 func (s *AuthControllerSuite) createTestRequest(method, url string, body interface{}, cookies []*http.Cookie) (*gin.Context, *httptest.ResponseRecorder) {
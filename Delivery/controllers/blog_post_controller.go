@@ -22,6 +22,7 @@ func (b *BlogPostController) parseBlogPostFilter(ctx *gin.Context) *domain.BlogP
 	page_size := ctx.DefaultQuery("pageSize", fmt.Sprint(b.Env.PageSize))
 	recency := ctx.DefaultQuery("recency", b.Env.Recency)
 	most_popular := ctx.DefaultQuery("mostPopular", "false")
+	include_subcategories := ctx.DefaultQuery("includeSubcategories", "false")
 
 	// check if the page and pageSize are valid numbers
 	if _, err := strconv.Atoi(page); err != nil {
@@ -45,13 +46,15 @@ func (b *BlogPostController) parseBlogPostFilter(ctx *gin.Context) *domain.BlogP
 	}
 
 	return &domain.BlogPostFilter{
-		Page:       pageInt,
-		PageSize:   pageSizeInt,
-		Recency:    domain.Recency(recency),
-		Tags:       tags,
-		AuthorName: ctx.Query("authorName"),
-		Title:      ctx.Query("title"),
-		Popular:    most_popular == "true", // convert string to bool
+		Page:                 pageInt,
+		PageSize:             pageSizeInt,
+		Recency:              domain.Recency(recency),
+		Tags:                 tags,
+		AuthorName:           ctx.Query("authorName"),
+		Title:                ctx.Query("title"),
+		Popular:              most_popular == "true",                   // convert string to bool
+		Category:             strings.TrimSpace(ctx.Query("category")), // ID or slug
+		IncludeSubcategories: include_subcategories == "true",          // with the posts of the categories under it
 	}
 }
 
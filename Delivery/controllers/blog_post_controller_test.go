@@ -215,7 +215,7 @@ func (s *BlogPostControllerSuite) TestCreateBlog() {
 		ctx, _ := gin.CreateTestContext(res)
 
 		// Prepare valid JSON request body
-		body := strings.NewReader(`{"title":"Test Blog","content":"Some content","tags":["test","tags"]}`)
+		body := strings.NewReader(`{"title":"Test Blog","content":"Some content","tags":["test","tags"],"category":"backend"}`)
 		ctx.Request = httptest.NewRequest("POST", "/api/blogs", body)
 		ctx.Request.Header.Set("Content-Type", "application/json")
 		ctx.Set("user_id", userId)
@@ -235,8 +235,9 @@ func (s *BlogPostControllerSuite) TestCreateBlog() {
 
 	s.Run("Successful creation", func() {
 		blogReq := dto.BlogPostRequest{
-			Title:   "Success Blog",
-			Content: "Some content here",
+			Title:    "Success Blog",
+			Content:  "Some content here",
+			Category: "backend",
 		}
 		userId := "user-321"
 
@@ -247,7 +248,7 @@ func (s *BlogPostControllerSuite) TestCreateBlog() {
 		res := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(res)
 
-		body := strings.NewReader(`{"title":"Success Blog","content":"Some content here"}`)
+		body := strings.NewReader(`{"title":"Success Blog","content":"Some content here","category":"backend"}`)
 		ctx.Request = httptest.NewRequest("POST", "/api/blogs", body)
 		ctx.Request.Header.Set("Content-Type", "application/json")
 		ctx.Set("user_id", userId)
@@ -267,8 +268,9 @@ func (s *BlogPostControllerSuite) TestCreateBlog() {
 
 	s.Run("Missing user_id in context", func() {
 		blogReq := dto.BlogPostRequest{
-			Title:   "No UserID Blog",
-			Content: "Content without user id",
+			Title:    "No UserID Blog",
+			Content:  "Content without user id",
+			Category: "backend",
 		}
 		createdBlog := blogReq.ToDomain()
 		createdBlog.ID = "blog-100"
@@ -277,7 +279,7 @@ func (s *BlogPostControllerSuite) TestCreateBlog() {
 		res := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(res)
 
-		body := strings.NewReader(`{"title":"No UserID Blog","content":"Content without user id"}`)
+		body := strings.NewReader(`{"title":"No UserID Blog","content":"Content without user id","category":"backend"}`)
 		ctx.Request = httptest.NewRequest("POST", "/api/blogs", body)
 		ctx.Request.Header.Set("Content-Type", "application/json")
 		// No user_id set in context here
@@ -330,7 +332,7 @@ func (s *BlogPostControllerSuite) TestUpdateBlog() {
 		res := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(res)
 
-		body := strings.NewReader(`{"title":"Update Fail","content":"Broken content"}`)
+		body := strings.NewReader(`{"title":"Update Fail","content":"Broken content","category":"backend"}`)
 		ctx.Request = httptest.NewRequest("PUT", "/api/blogs/", body)
 		ctx.Request.Header.Set("Content-Type", "application/json")
 		ctx.Params = gin.Params{{Key: "id", Value: blogID}}
@@ -355,8 +357,9 @@ func (s *BlogPostControllerSuite) TestUpdateBlog() {
 
 	s.Run("Successful update", func() {
 		blogReq := dto.BlogPostRequest{
-			Title:   "Updated Blog",
-			Content: "Updated content here",
+			Title:    "Updated Blog",
+			Content:  "Updated content here",
+			Category: "backend",
 		}
 		updatedDomain := blogReq.ToDomain()
 		updatedDomain.ID = blogID
@@ -365,7 +368,7 @@ func (s *BlogPostControllerSuite) TestUpdateBlog() {
 		res := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(res)
 
-		body := strings.NewReader(`{"title":"Updated Blog","content":"Updated content here"}`)
+		body := strings.NewReader(`{"title":"Updated Blog","content":"Updated content here","category":"backend"}`)
 		ctx.Request = httptest.NewRequest("PUT", "/api/blogs/", body)
 		ctx.Request.Header.Set("Content-Type", "application/json")
 		ctx.Params = gin.Params{{Key: "id", Value: blogID}}
@@ -389,8 +392,9 @@ func (s *BlogPostControllerSuite) TestUpdateBlog() {
 
 	s.Run("Missing user_id in context", func() {
 		blogReq := dto.BlogPostRequest{
-			Title:   "No User",
-			Content: "Updated without user id",
+			Title:    "No User",
+			Content:  "Updated without user id",
+			Category: "backend",
 		}
 		updatedDomain := blogReq.ToDomain()
 		updatedDomain.ID = blogID
@@ -399,7 +403,7 @@ func (s *BlogPostControllerSuite) TestUpdateBlog() {
 		res := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(res)
 
-		body := strings.NewReader(`{"title":"No User","content":"Updated without user id"}`)
+		body := strings.NewReader(`{"title":"No User","content":"Updated without user id","category":"backend"}`)
 		ctx.Request = httptest.NewRequest("PUT", "/api/blogs/", body)
 		ctx.Request.Header.Set("Content-Type", "application/json")
 		ctx.Params = gin.Params{{Key: "id", Value: blogID}}
@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryController struct {
+	Categories domain.ICategoryUsecase
+}
+
+func NewCategoryController(categories domain.ICategoryUsecase) *CategoryController {
+	return &CategoryController{Categories: categories}
+}
+
+// GetTree lists the top level categories with their subcategories
+func (cc *CategoryController) GetTree(c *gin.Context) {
+	tree, err := cc.Categories.Tree()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load categories"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"categories": dto.ToCategoryTreeResponse(tree)})
+}
+
+// GetCategory finds a category by its ID or slug
+func (cc *CategoryController) GetCategory(c *gin.Context) {
+	category, err := cc.Categories.Get(c.Param("id"))
+	if err != nil {
+		c.JSON(categoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToCategoryResponse(category))
+}
+
+func (cc *CategoryController) CreateCategory(c *gin.Context) {
+	req, ok := bindCategoryRequest(c)
+	if !ok {
+		return
+	}
+	created, err := cc.Categories.Create(req.ToDomain())
+	if err != nil {
+		c.JSON(categoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.ToCategoryResponse(created))
+}
+
+// UpdateCategory renames and describes a category, and moves it with its subcategories when the
+// parent changes
+func (cc *CategoryController) UpdateCategory(c *gin.Context) {
+	req, ok := bindCategoryRequest(c)
+	if !ok {
+		return
+	}
+	updated, err := cc.Categories.Update(c.Param("id"), req.ToDomain())
+	if err != nil {
+		c.JSON(categoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToCategoryResponse(updated))
+}
+
+// DeleteCategory removes a category that has no subcategories and no posts
+func (cc *CategoryController) DeleteCategory(c *gin.Context) {
+	if err := cc.Categories.Delete(c.Param("id")); err != nil {
+		c.JSON(categoryErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Category deleted"})
+}
+
+func bindCategoryRequest(c *gin.Context) (dto.CategoryRequest, bool) {
+	var req dto.CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return req, false
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return req, false
+	}
+	return req, true
+}
+
+func categoryErrorStatus(err error) int {
+	switch err {
+	case domain.ErrCategoryNotFound:
+		return http.StatusNotFound
+	case domain.ErrCategoryConflict, domain.ErrCategoryInUse:
+		return http.StatusConflict
+	case domain.ErrInvalidCategoryName, domain.ErrCategoryCycle:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
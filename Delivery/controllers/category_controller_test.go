@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"encoding/json"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// CategoryControllerSuite defines the test suite for CategoryController
+type CategoryControllerSuite struct {
+	suite.Suite
+	mockCategories *domain_mocks.MockICategoryUsecase
+	handler        *CategoryController
+}
+
+func (s *CategoryControllerSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.mockCategories = domain_mocks.NewMockICategoryUsecase(s.T())
+	s.handler = NewCategoryController(s.mockCategories)
+}
+
+func TestCategoryControllerSuite(t *testing.T) {
+	suite.Run(t, new(CategoryControllerSuite))
+}
+
+func (s *CategoryControllerSuite) TestGetTree() {
+	backend := &domain.Category{ID: "c2", Name: "Backend", Slug: "backend", ParentID: "c1", Ancestors: []string{"c1"}}
+	s.mockCategories.On("Tree").Return([]*domain.CategoryNode{{
+		Category: &domain.Category{ID: "c1", Name: "Engineering", Slug: "engineering"},
+		Children: []*domain.CategoryNode{{Category: backend, Children: []*domain.CategoryNode{}}},
+	}}, nil)
+
+	c, w := newTestContext(http.MethodGet, "/categories", "", "1")
+	s.handler.GetTree(c)
+
+	s.Equal(http.StatusOK, w.Code)
+	var response struct {
+		Categories []struct {
+			Slug      string   `json:"slug"`
+			Ancestors []string `json:"ancestors"`
+			Children  []struct {
+				Slug     string `json:"slug"`
+				ParentID string `json:"parent_id"`
+			} `json:"children"`
+		} `json:"categories"`
+	}
+	s.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	s.Equal("engineering", response.Categories[0].Slug)
+	s.Equal([]string{}, response.Categories[0].Ancestors)
+	s.Equal("backend", response.Categories[0].Children[0].Slug)
+	s.Equal("c1", response.Categories[0].Children[0].ParentID)
+}
+
+func (s *CategoryControllerSuite) TestCreateCategory() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockCategories.On("Create", &domain.Category{Name: "Go", ParentID: "backend"}).
+			Return(&domain.Category{ID: "c3", Name: "Go", Slug: "go", ParentID: "c2", Ancestors: []string{"c1", "c2"}}, nil)
+
+		c, w := newTestContext(http.MethodPost, "/categories", `{"name":"Go","parent":"backend"}`, "1")
+		s.handler.CreateCategory(c)
+
+		s.Equal(http.StatusCreated, w.Code)
+	})
+
+	s.Run("MissingName", func() {
+		s.SetupTest()
+
+		c, w := newTestContext(http.MethodPost, "/categories", `{"parent":"backend"}`, "1")
+		s.handler.CreateCategory(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("ParentNotFound", func() {
+		s.SetupTest()
+		s.mockCategories.On("Create", mock.Anything).Return(nil, domain.ErrCategoryNotFound)
+
+		c, w := newTestContext(http.MethodPost, "/categories", `{"name":"Go","parent":"nope"}`, "1")
+		s.handler.CreateCategory(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+	})
+}
+
+func (s *CategoryControllerSuite) TestUpdateCategory() {
+	s.mockCategories.On("Update", "c1", &domain.Category{Name: "Engineering", ParentID: "c3"}).Return(nil, domain.ErrCategoryCycle)
+
+	c, w := newTestContext(http.MethodPut, "/categories/c1", `{"name":"Engineering","parent":"c3"}`, "1")
+	c.Params = gin.Params{{Key: "id", Value: "c1"}}
+	s.handler.UpdateCategory(c)
+
+	s.Equal(http.StatusBadRequest, w.Code)
+}
+
+func (s *CategoryControllerSuite) TestDeleteCategory() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockCategories.On("Delete", "design").Return(nil)
+
+		c, w := newTestContext(http.MethodDelete, "/categories/design", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: "design"}}
+		s.handler.DeleteCategory(c)
+
+		s.Equal(http.StatusOK, w.Code)
+	})
+
+	s.Run("InUse", func() {
+		s.SetupTest()
+		s.mockCategories.On("Delete", "backend").Return(domain.ErrCategoryInUse)
+
+		c, w := newTestContext(http.MethodDelete, "/categories/backend", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: "backend"}}
+		s.handler.DeleteCategory(c)
+
+		s.Equal(http.StatusConflict, w.Code)
+	})
+}
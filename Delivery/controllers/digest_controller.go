@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DigestController struct {
+	Digests domain.IDigestUsecase
+}
+
+func NewDigestController(digests domain.IDigestUsecase) *DigestController {
+	return &DigestController{Digests: digests}
+}
+
+// GetSubscription shows how often the user gets a digest and which authors and tags it follows
+func (dc *DigestController) GetSubscription(c *gin.Context) {
+	subscription, err := dc.Digests.Subscription(c.GetString("user_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load digest settings"})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToDigestSubscriptionResponse(subscription))
+}
+
+func (dc *DigestController) UpdateSubscription(c *gin.Context) {
+	var req dto.DigestSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	subscription, err := dc.Digests.UpdateSubscription(req.ToDomain(c.GetString("user_id")))
+	if err == domain.ErrUnknownDigestFrequency {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update digest settings"})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToDigestSubscriptionResponse(subscription))
+}
+
+// Unsubscribe turns digests off with the signed token of the link in every digest, no login needed
+func (dc *DigestController) Unsubscribe(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing token"})
+		return
+	}
+	if err := dc.Digests.Unsubscribe(token); err != nil {
+		if err == domain.ErrInvalidUnsubscribeToken {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unsubscribe"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "You will not get digests anymore"})
+}
@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// DigestControllerSuite defines the test suite for DigestController
+type DigestControllerSuite struct {
+	suite.Suite
+	mockDigests *domain_mocks.MockIDigestUsecase
+	handler     *DigestController
+}
+
+func (s *DigestControllerSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.mockDigests = domain_mocks.NewMockIDigestUsecase(s.T())
+	s.handler = NewDigestController(s.mockDigests)
+}
+
+func TestDigestControllerSuite(t *testing.T) {
+	suite.Run(t, new(DigestControllerSuite))
+}
+
+func (s *DigestControllerSuite) TestGetSubscription() {
+	s.mockDigests.On("Subscription", "1").Return(&domain.DigestSubscription{UserID: "1", Frequency: domain.DigestOff}, nil)
+
+	c, w := newTestContext(http.MethodGet, "/digests/settings", "", "1")
+	s.handler.GetSubscription(c)
+
+	s.Equal(http.StatusOK, w.Code)
+	s.JSONEq(`{"frequency":"off","authors":[],"tags":[]}`, w.Body.String())
+}
+
+func (s *DigestControllerSuite) TestUpdateSubscription() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		nextSendAt := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+		s.mockDigests.On("UpdateSubscription", &domain.DigestSubscription{
+			UserID:    "1",
+			Frequency: domain.DigestWeekly,
+			Authors:   []string{"64b7f0c2a1b2c3d4e5f60718"},
+			Tags:      []string{"go"},
+		}).Return(&domain.DigestSubscription{
+			UserID:     "1",
+			Frequency:  domain.DigestWeekly,
+			Authors:    []string{"64b7f0c2a1b2c3d4e5f60718"},
+			Tags:       []string{"go"},
+			NextSendAt: nextSendAt,
+		}, nil)
+
+		c, w := newTestContext(http.MethodPut, "/digests/settings", `{"frequency":"weekly","authors":["64b7f0c2a1b2c3d4e5f60718"],"tags":["go"]}`, "1")
+		s.handler.UpdateSubscription(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), `"next_send_at":"2026-01-02T08:00:00Z"`)
+	})
+
+	s.Run("UnknownFrequency", func() {
+		s.SetupTest()
+
+		c, w := newTestContext(http.MethodPut, "/digests/settings", `{"frequency":"hourly"}`, "1")
+		s.handler.UpdateSubscription(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("InvalidAuthor", func() {
+		s.SetupTest()
+
+		c, w := newTestContext(http.MethodPut, "/digests/settings", `{"frequency":"daily","authors":["jane"]}`, "1")
+		s.handler.UpdateSubscription(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("Failure", func() {
+		s.SetupTest()
+		s.mockDigests.On("UpdateSubscription", mock.Anything).Return(nil, errors.New("db error"))
+
+		c, w := newTestContext(http.MethodPut, "/digests/settings", `{"frequency":"daily"}`, "1")
+		s.handler.UpdateSubscription(c)
+
+		s.Equal(http.StatusInternalServerError, w.Code)
+	})
+}
+
+func (s *DigestControllerSuite) TestUnsubscribe() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockDigests.On("Unsubscribe", "1.signature").Return(nil)
+
+		c, w := newTestContext(http.MethodPost, "/digests/unsubscribe?token=1.signature", "", "")
+		s.handler.Unsubscribe(c)
+
+		s.Equal(http.StatusOK, w.Code)
+	})
+
+	s.Run("InvalidToken", func() {
+		s.SetupTest()
+		s.mockDigests.On("Unsubscribe", "1.forged").Return(domain.ErrInvalidUnsubscribeToken)
+
+		c, w := newTestContext(http.MethodGet, "/digests/unsubscribe?token=1.forged", "", "")
+		s.handler.Unsubscribe(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("MissingToken", func() {
+		s.SetupTest()
+
+		c, w := newTestContext(http.MethodGet, "/digests/unsubscribe", "", "")
+		s.handler.Unsubscribe(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailOutboxController struct {
+	Outbox domain.IEmailOutboxUsecase
+}
+
+func NewEmailOutboxController(outbox domain.IEmailOutboxUsecase) *EmailOutboxController {
+	return &EmailOutboxController{Outbox: outbox}
+}
+
+// ListOutbox lists the queued emails newest first, filtered by status and recipient
+func (ec *EmailOutboxController) ListOutbox(c *gin.Context) {
+	var query dto.OutboxEmailQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query"})
+		return
+	}
+	if err := validate.Struct(query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filter := query.ToFilter()
+	emails, total, err := ec.Outbox.List(filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load queued emails"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"emails": dto.ToOutboxEmailResponses(emails),
+		"total":  total,
+		"page":   max(filter.Page, 1),
+	})
+}
+
+// GetOutboxEmail shows the delivery status of one email
+func (ec *EmailOutboxController) GetOutboxEmail(c *gin.Context) {
+	email, err := ec.Outbox.FindByID(c.Param("id"))
+	if err == domain.ErrOutboxEmailNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Email not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load email"})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToOutboxEmailResponse(email))
+}
+
+// RetryOutboxEmail queues a dead email again
+func (ec *EmailOutboxController) RetryOutboxEmail(c *gin.Context) {
+	email, err := ec.Outbox.Retry(c.Param("id"))
+	switch err {
+	case nil:
+		c.JSON(http.StatusOK, dto.ToOutboxEmailResponse(email))
+	case domain.ErrOutboxEmailNotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Email not found"})
+	case domain.ErrOutboxEmailNotDead:
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retry email"})
+	}
+}
@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+// EmailOutboxControllerSuite defines the test suite for EmailOutboxController
+type EmailOutboxControllerSuite struct {
+	suite.Suite
+	mockOutbox *domain_mocks.MockIEmailOutboxUsecase
+	handler    *EmailOutboxController
+}
+
+func (s *EmailOutboxControllerSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.mockOutbox = domain_mocks.NewMockIEmailOutboxUsecase(s.T())
+	s.handler = NewEmailOutboxController(s.mockOutbox)
+}
+
+func TestEmailOutboxControllerSuite(t *testing.T) {
+	suite.Run(t, new(EmailOutboxControllerSuite))
+}
+
+func (s *EmailOutboxControllerSuite) TestListOutbox() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		emails := []*domain.OutboxEmail{{ID: "e1", To: "a@example.com", Template: domain.EmailTemplateOTP, Data: domain.EmailTemplateData{"Code": "123456"}, Status: domain.OutboxEmailDead, Attempts: 8}}
+		s.mockOutbox.On("List", domain.OutboxEmailFilter{Status: domain.OutboxEmailDead, Page: 2, PageSize: 10}).Return(emails, int64(11), nil)
+
+		c, w := s.createTestRequest(http.MethodGet, "/emails/outbox?status=dead&page=2&page_size=10")
+		s.handler.ListOutbox(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.NotContains(w.Body.String(), "123456")
+		var response struct {
+			Emails []map[string]any `json:"emails"`
+			Total  int64            `json:"total"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal(int64(11), response.Total)
+		s.Len(response.Emails, 1)
+		s.Equal("dead", response.Emails[0]["status"])
+	})
+
+	s.Run("InvalidStatus", func() {
+		s.SetupTest()
+
+		c, w := s.createTestRequest(http.MethodGet, "/emails/outbox?status=lost")
+		s.handler.ListOutbox(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("Failure", func() {
+		s.SetupTest()
+		s.mockOutbox.On("List", domain.OutboxEmailFilter{}).Return(nil, int64(0), errors.New("db error"))
+
+		c, w := s.createTestRequest(http.MethodGet, "/emails/outbox")
+		s.handler.ListOutbox(c)
+
+		s.Equal(http.StatusInternalServerError, w.Code)
+	})
+}
+
+func (s *EmailOutboxControllerSuite) TestGetOutboxEmail() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockOutbox.On("FindByID", "e1").Return(&domain.OutboxEmail{ID: "e1", Subject: "Hello", Body: "<p>secret</p>", Status: domain.OutboxEmailPending}, nil)
+
+		c, w := s.createTestRequest(http.MethodGet, "/emails/outbox/e1")
+		c.Params = gin.Params{{Key: "id", Value: "e1"}}
+		s.handler.GetOutboxEmail(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), `"next_attempt_at"`)
+		s.NotContains(w.Body.String(), "secret")
+	})
+
+	s.Run("NotFound", func() {
+		s.SetupTest()
+		s.mockOutbox.On("FindByID", "e1").Return(nil, domain.ErrOutboxEmailNotFound)
+
+		c, w := s.createTestRequest(http.MethodGet, "/emails/outbox/e1")
+		c.Params = gin.Params{{Key: "id", Value: "e1"}}
+		s.handler.GetOutboxEmail(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+	})
+}
+
+func (s *EmailOutboxControllerSuite) TestRetryOutboxEmail() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockOutbox.On("Retry", "e1").Return(&domain.OutboxEmail{ID: "e1", Status: domain.OutboxEmailPending}, nil)
+
+		c, w := s.createTestRequest(http.MethodPost, "/emails/outbox/e1/retry")
+		c.Params = gin.Params{{Key: "id", Value: "e1"}}
+		s.handler.RetryOutboxEmail(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), `"status":"pending"`)
+	})
+
+	s.Run("NotDead", func() {
+		s.SetupTest()
+		s.mockOutbox.On("Retry", "e1").Return(nil, domain.ErrOutboxEmailNotDead)
+
+		c, w := s.createTestRequest(http.MethodPost, "/emails/outbox/e1/retry")
+		c.Params = gin.Params{{Key: "id", Value: "e1"}}
+		s.handler.RetryOutboxEmail(c)
+
+		s.Equal(http.StatusConflict, w.Code)
+	})
+
+	s.Run("NotFound", func() {
+		s.SetupTest()
+		s.mockOutbox.On("Retry", "e1").Return(nil, domain.ErrOutboxEmailNotFound)
+
+		c, w := s.createTestRequest(http.MethodPost, "/emails/outbox/e1/retry")
+		c.Params = gin.Params{{Key: "id", Value: "e1"}}
+		s.handler.RetryOutboxEmail(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+	})
+}
+
+func (s *EmailOutboxControllerSuite) createTestRequest(method, url string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, url, nil)
+	return c, w
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	domain "g6/blog-api/Domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailTemplateController struct {
+	Templates domain.IEmailTemplates
+}
+
+func NewEmailTemplateController(templates domain.IEmailTemplates) *EmailTemplateController {
+	return &EmailTemplateController{Templates: templates}
+}
+
+// ListTemplates lists the email templates and the locales they are translated to
+func (ec *EmailTemplateController) ListTemplates(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"templates": ec.Templates.Names(),
+		"locales":   ec.Templates.Locales(),
+	})
+}
+
+// PreviewTemplate renders a template with made-up data, as JSON or with ?format=html or
+// ?format=text as the part itself
+func (ec *EmailTemplateController) PreviewTemplate(c *gin.Context) {
+	email, err := ec.Templates.Preview(c.Param("name"), c.Query("locale"))
+	if err == domain.ErrEmailTemplateNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Email template not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to render email template", "details": err.Error()})
+		return
+	}
+
+	switch c.Query("format") {
+	case "html":
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(email.HTML))
+	case "text":
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(email.Text))
+	default:
+		c.JSON(http.StatusOK, gin.H{
+			"locale":  email.Locale,
+			"subject": email.Subject,
+			"html":    email.HTML,
+			"text":    email.Text,
+		})
+	}
+}
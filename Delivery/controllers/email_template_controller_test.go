@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+// EmailTemplateControllerSuite defines the test suite for EmailTemplateController
+type EmailTemplateControllerSuite struct {
+	suite.Suite
+	mockTemplates *domain_mocks.MockIEmailTemplates
+	handler       *EmailTemplateController
+}
+
+func (s *EmailTemplateControllerSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.mockTemplates = domain_mocks.NewMockIEmailTemplates(s.T())
+	s.handler = NewEmailTemplateController(s.mockTemplates)
+}
+
+func TestEmailTemplateControllerSuite(t *testing.T) {
+	suite.Run(t, new(EmailTemplateControllerSuite))
+}
+
+func (s *EmailTemplateControllerSuite) TestListTemplates() {
+	s.mockTemplates.On("Names").Return([]string{"otp", "password_reset"})
+	s.mockTemplates.On("Locales").Return([]string{"en", "fr"})
+
+	c, w := s.createTestRequest("/emails/templates")
+	s.handler.ListTemplates(c)
+
+	s.Equal(http.StatusOK, w.Code)
+	var response struct {
+		Templates []string `json:"templates"`
+		Locales   []string `json:"locales"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &response)
+	s.Equal([]string{"otp", "password_reset"}, response.Templates)
+	s.Equal([]string{"en", "fr"}, response.Locales)
+}
+
+func (s *EmailTemplateControllerSuite) TestPreviewTemplate() {
+	rendered := &domain.RenderedEmail{Locale: "fr", Subject: "Votre code", HTML: "<h1>123456</h1>", Text: "123456\n"}
+
+	s.Run("JSON", func() {
+		s.SetupTest()
+		s.mockTemplates.On("Preview", "otp", "fr-CA").Return(rendered, nil)
+
+		c, w := s.createTestRequest("/emails/templates/otp/preview?locale=fr-CA")
+		c.Params = gin.Params{{Key: "name", Value: "otp"}}
+		s.handler.PreviewTemplate(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response map[string]string
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("fr", response["locale"])
+		s.Equal("Votre code", response["subject"])
+		s.Equal("123456\n", response["text"])
+	})
+
+	s.Run("HTML", func() {
+		s.SetupTest()
+		s.mockTemplates.On("Preview", "otp", "").Return(rendered, nil)
+
+		c, w := s.createTestRequest("/emails/templates/otp/preview?format=html")
+		c.Params = gin.Params{{Key: "name", Value: "otp"}}
+		s.handler.PreviewTemplate(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Equal("text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		s.Equal("<h1>123456</h1>", w.Body.String())
+	})
+
+	s.Run("NotFound", func() {
+		s.SetupTest()
+		s.mockTemplates.On("Preview", "newsletter", "").Return(nil, domain.ErrEmailTemplateNotFound)
+
+		c, w := s.createTestRequest("/emails/templates/newsletter/preview")
+		c.Params = gin.Params{{Key: "name", Value: "newsletter"}}
+		s.handler.PreviewTemplate(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+	})
+
+	s.Run("RenderError", func() {
+		s.SetupTest()
+		s.mockTemplates.On("Preview", "otp", "").Return(nil, errors.New("map has no entry for key \"Code\""))
+
+		c, w := s.createTestRequest("/emails/templates/otp/preview")
+		c.Params = gin.Params{{Key: "name", Value: "otp"}}
+		s.handler.PreviewTemplate(c)
+
+		s.Equal(http.StatusInternalServerError, w.Code)
+	})
+}
+
+func (s *EmailTemplateControllerSuite) createTestRequest(url string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, url, nil)
+	return c, w
+}
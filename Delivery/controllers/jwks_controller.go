@@ -0,0 +1,28 @@
+package controllers
+
+import (
+	domain "g6/blog-api/Domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JWKSController struct {
+	Keys domain.IJWKSProvider
+}
+
+func NewJWKSController(keys domain.IJWKSProvider) *JWKSController {
+	return &JWKSController{Keys: keys}
+}
+
+// GetJWKS publishes the public keys other services use to verify our tokens
+func (jc *JWKSController) GetJWKS(c *gin.Context) {
+	jwks, err := jc.Keys.JWKS(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load signing keys"})
+		return
+	}
+	// verifiers may cache for a short while, rotated keys appear within minutes
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, jwks)
+}
@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// JWKSControllerSuite defines the test suite for JWKSController
+type JWKSControllerSuite struct {
+	suite.Suite
+	mockKeys *domain_mocks.MockIJWKSProvider
+	handler  *JWKSController
+}
+
+func (s *JWKSControllerSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.mockKeys = domain_mocks.NewMockIJWKSProvider(s.T())
+	s.handler = NewJWKSController(s.mockKeys)
+}
+
+func TestJWKSControllerSuite(t *testing.T) {
+	suite.Run(t, new(JWKSControllerSuite))
+}
+
+func (s *JWKSControllerSuite) TestGetJWKS() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockKeys.On("JWKS", mock.Anything).Return(&domain.JSONWebKeySet{Keys: []domain.JSONWebKey{
+			{Kty: "OKP", Kid: "k1", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "x"},
+		}}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+		s.handler.GetJWKS(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Equal("public, max-age=300", w.Header().Get("Cache-Control"))
+		var response struct {
+			Keys []map[string]string `json:"keys"`
+		}
+		s.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		s.Equal([]map[string]string{{"kty": "OKP", "kid": "k1", "use": "sig", "alg": "EdDSA", "crv": "Ed25519", "x": "x"}}, response.Keys)
+	})
+
+	s.Run("Failure", func() {
+		s.SetupTest()
+		s.mockKeys.On("JWKS", mock.Anything).Return(nil, errors.New("db error"))
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+		s.handler.GetJWKS(c)
+
+		s.Equal(http.StatusInternalServerError, w.Code)
+		s.Empty(w.Header().Get("Cache-Control"))
+	})
+}
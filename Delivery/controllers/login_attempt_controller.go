@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// loginFailed counts and records the failure and answers with the generic login error. Unknown
+// identifiers are counted and locked like real ones, the answer never tells them apart.
+// userID is empty when no account has the identifier.
+func (ac *AuthController) loginFailed(c *gin.Context, identifier, ip, userID string) {
+	_ = ac.LoginAttemptUsecase.RecordFailure(identifier, ip)
+	recordSecurityEvent(c, ac.SecurityEventUsecase, domain.SecurityEvent{
+		Type:    domain.SecurityEventLogin,
+		UserID:  userID,
+		Outcome: domain.SecurityEventFailure,
+		Details: "method password, identifier " + strconv.Quote(identifier),
+	})
+	c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+}
+
+// refuseLogin answers a locked identifier with 423 and a throttled one with 429, both with Retry-After
+func refuseLogin(c *gin.Context, err error, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	c.Header("Retry-After", strconv.Itoa(seconds))
+	status := http.StatusTooManyRequests
+	if err == domain.ErrAccountLocked {
+		status = http.StatusLocked
+	}
+	c.JSON(status, gin.H{"error": err.Error(), "retry_after": seconds})
+}
+
+// UnlockAccount lifts a lock with the token from the unlock email
+func (ac *AuthController) UnlockAccount(c *gin.Context) {
+	var req dto.UnlockAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := ac.LoginAttemptUsecase.Unlock(req.Token); err != nil {
+		if err == domain.ErrUnlockTokenInvalid {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unlock account"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Account unlocked"})
+}
+
+// ListAccountLocks shows admins which identifiers are locked and until when
+func (ac *AuthController) ListAccountLocks(c *gin.Context) {
+	locks, err := ac.LoginAttemptUsecase.ListLocks()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to list locked accounts"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"locks": dto.ToAccountLockResponses(locks)})
+}
+
+func (ac *AuthController) LiftAccountLock(c *gin.Context) {
+	if err := ac.LoginAttemptUsecase.UnlockIdentifier(c.Param("identifier")); err != nil {
+		if err == domain.ErrAccountLockNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unlock account"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Account unlocked"})
+}
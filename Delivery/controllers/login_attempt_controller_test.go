@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"encoding/json"
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *AuthControllerSuite) TestLoginLockout() {
+	loginRequest := dto.LoginRequest{
+		Identifier: "test@example.com",
+		Password:   "password123",
+	}
+
+	s.Run("LockedBeforePasswordCheck", func() {
+		s.mockLoginAttemptUsecase.On("Check", loginRequest.Identifier, mock.Anything).Return(90*time.Second+time.Millisecond, domain.ErrAccountLocked)
+		c, w := s.createTestRequest(http.MethodPost, "/login", loginRequest, nil)
+
+		s.handler.LoginRequest(c)
+
+		s.Equal(http.StatusLocked, w.Code)
+		s.Equal("91", w.Header().Get("Retry-After"))
+		s.mockUserUsecase.AssertNotCalled(s.T(), "FindByUsernameOrEmail", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("Throttled", func() {
+		s.mockLoginAttemptUsecase.On("Check", loginRequest.Identifier, mock.Anything).Return(4*time.Second, domain.ErrLoginThrottled)
+		c, w := s.createTestRequest(http.MethodPost, "/login", loginRequest, nil)
+
+		s.handler.LoginRequest(c)
+
+		s.Equal(http.StatusTooManyRequests, w.Code)
+		s.Equal("4", w.Header().Get("Retry-After"))
+		s.resetMocks()
+	})
+}
+
+func (s *AuthControllerSuite) TestUnlockAccount() {
+	s.Run("Success", func() {
+		s.mockLoginAttemptUsecase.On("Unlock", "unlock-token").Return(nil)
+		c, w := s.createTestRequest(http.MethodPost, "/unlock", dto.UnlockAccountRequest{Token: "unlock-token"}, nil)
+
+		s.handler.UnlockAccount(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("InvalidToken", func() {
+		s.mockLoginAttemptUsecase.On("Unlock", "unlock-token").Return(domain.ErrUnlockTokenInvalid)
+		c, w := s.createTestRequest(http.MethodPost, "/unlock", dto.UnlockAccountRequest{Token: "unlock-token"}, nil)
+
+		s.handler.UnlockAccount(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.resetMocks()
+	})
+}
+
+func (s *AuthControllerSuite) TestAccountLocks() {
+	s.Run("List", func() {
+		locks := []*domain.AccountLock{{Identifier: "jane", UserID: "1", Failures: 10, LockedUntil: time.Now().Add(time.Hour)}}
+		s.mockLoginAttemptUsecase.On("ListLocks").Return(locks, nil)
+		c, w := s.createTestRequest(http.MethodGet, "/locks", nil, nil)
+
+		s.handler.ListAccountLocks(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response struct {
+			Locks []dto.AccountLockResponse `json:"locks"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Len(response.Locks, 1)
+		s.Equal("jane", response.Locks[0].Identifier)
+		s.resetMocks()
+	})
+
+	s.Run("LiftNotFound", func() {
+		s.mockLoginAttemptUsecase.On("UnlockIdentifier", "jane").Return(domain.ErrAccountLockNotFound)
+		c, w := s.createTestRequest(http.MethodDelete, "/locks/jane", nil, nil)
+		c.Params = gin.Params{{Key: "identifier", Value: "jane"}}
+
+		s.handler.LiftAccountLock(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+		s.resetMocks()
+	})
+}
@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	utils "g6/blog-api/Utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// the binding cookie ties a link to the browser that asked for it
+const (
+	magicLinkCookie     = "magic_link_binding"
+	magicLinkCookiePath = "/api/auth/magic-link"
+	magicLinkCookieAge  = 3600 // seconds, longer than any link lives
+)
+
+// RequestMagicLink emails a login link. The answer is the same whether or not the email has an account.
+func (ac *AuthController) RequestMagicLink(c *gin.Context) {
+	var req dto.MagicLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid email"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	binding, err := ac.MagicLinkUsecase.RequestLink(req.Email, c.ClientIP())
+	if err != nil {
+		if err == domain.ErrMagicLinkRateLimited {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to send login link"})
+		return
+	}
+
+	ac.setMagicLinkCookie(c, binding, magicLinkCookieAge)
+	c.JSON(http.StatusAccepted, gin.H{"message": "If an account exists for this email, a login link has been sent"})
+}
+
+// VerifyMagicLink logs in with the token from the emailed link
+func (ac *AuthController) VerifyMagicLink(c *gin.Context) {
+	var req dto.MagicLinkVerifyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	binding, _ := c.Cookie(magicLinkCookie)
+	user, err := ac.MagicLinkUsecase.Redeem(req.Token, binding)
+	if err != nil {
+		if err == domain.ErrMagicLinkInvalid {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to verify login link"})
+		return
+	}
+	ac.setMagicLinkCookie(c, "", -1)
+
+	if ac.requireSecondFactor(c, user) {
+		return
+	}
+
+	response, ok := ac.issueSession(c, user, "magic_link")
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK,
+		gin.H{
+			"message": "Login successful",
+			"user":    dto.ToUserResponse(*user),
+			"tokens": dto.LoginResponse{
+				AccessToken:  response.AccessToken,
+				RefreshToken: response.RefreshToken,
+			}})
+}
+
+func (ac *AuthController) setMagicLinkCookie(c *gin.Context, binding string, maxAge int) {
+	utils.SetCookie(c, utils.CookieOptions{
+		Name:     magicLinkCookie,
+		Value:    binding,
+		MaxAge:   maxAge,
+		Path:     magicLinkCookiePath,
+		Secure:   ac.Cookies.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"encoding/json"
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *AuthControllerSuite) TestRequestMagicLink() {
+	s.Run("SetsBindingCookie", func() {
+		s.mockMagicLinkUsecase.On("RequestLink", "jane@example.com", mock.Anything).Return("binding-1", nil)
+		c, w := s.createTestRequest(http.MethodPost, "/magic-link", dto.MagicLinkRequest{Email: "jane@example.com"}, nil)
+
+		s.handler.RequestMagicLink(c)
+
+		s.Equal(http.StatusAccepted, w.Code)
+		cookie := w.Result().Cookies()[0]
+		s.Equal(magicLinkCookie, cookie.Name)
+		s.Equal("binding-1", cookie.Value)
+		s.Equal(magicLinkCookiePath, cookie.Path)
+		s.True(cookie.HttpOnly)
+		s.resetMocks()
+	})
+
+	s.Run("InvalidEmail", func() {
+		c, w := s.createTestRequest(http.MethodPost, "/magic-link", dto.MagicLinkRequest{Email: "not-an-email"}, nil)
+
+		s.handler.RequestMagicLink(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("RateLimited", func() {
+		s.mockMagicLinkUsecase.On("RequestLink", "jane@example.com", mock.Anything).Return("", domain.ErrMagicLinkRateLimited)
+		c, w := s.createTestRequest(http.MethodPost, "/magic-link", dto.MagicLinkRequest{Email: "jane@example.com"}, nil)
+
+		s.handler.RequestMagicLink(c)
+
+		s.Equal(http.StatusTooManyRequests, w.Code)
+		s.Empty(w.Result().Cookies())
+		s.resetMocks()
+	})
+}
+
+func (s *AuthControllerSuite) TestVerifyMagicLink() {
+	user := &domain.User{ID: "1", Email: "jane@example.com", Role: domain.RoleUser}
+	bindingCookie := []*http.Cookie{{Name: magicLinkCookie, Value: "binding-1"}}
+	tokenResponse := domain.RefreshTokenResponse{
+		AccessToken:           "access-token",
+		RefreshToken:          "refresh-token",
+		AccessTokenExpiresAt:  time.Now().Add(time.Hour),
+		RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+	}
+
+	s.Run("Success", func() {
+		s.mockMagicLinkUsecase.On("Redeem", "link-token", "binding-1").Return(user, nil)
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(false, nil)
+		s.mockTwoFactorUsecase.On("IsRequired", user.Role).Return(false, nil)
+		s.mockAuthService.On("GenerateTokens", *user).Return(tokenResponse, nil)
+		s.mockRefreshTokenUsecase.On("Save", mock.Anything).Return(nil)
+		c, w := s.createTestRequest(http.MethodPost, "/magic-link/verify", dto.MagicLinkVerifyRequest{Token: "link-token"}, bindingCookie)
+
+		s.handler.VerifyMagicLink(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response gin.H
+		json.Unmarshal(w.Body.Bytes(), &response)
+		tokens := response["tokens"].(map[string]any)
+		s.Equal(tokenResponse.AccessToken, tokens["access_token"])
+		s.resetMocks()
+	})
+
+	s.Run("OtherBrowser", func() {
+		s.mockMagicLinkUsecase.On("Redeem", "link-token", "").Return(nil, domain.ErrMagicLinkInvalid)
+		c, w := s.createTestRequest(http.MethodPost, "/magic-link/verify", dto.MagicLinkVerifyRequest{Token: "link-token"}, nil)
+
+		s.handler.VerifyMagicLink(c)
+
+		s.Equal(http.StatusUnauthorized, w.Code)
+		s.mockAuthService.AssertNotCalled(s.T(), "GenerateTokens", mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("MissingToken", func() {
+		c, w := s.createTestRequest(http.MethodPost, "/magic-link/verify", dto.MagicLinkVerifyRequest{}, bindingCookie)
+
+		s.handler.VerifyMagicLink(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("TwoFactorChallenge", func() {
+		s.mockMagicLinkUsecase.On("Redeem", "link-token", "binding-1").Return(user, nil)
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(true, nil)
+		s.mockAuthService.On("GeneratePreAuthToken", *user).Return("pre-auth-token", time.Now(), nil)
+		c, w := s.createTestRequest(http.MethodPost, "/magic-link/verify", dto.MagicLinkVerifyRequest{Token: "link-token"}, bindingCookie)
+
+		s.handler.VerifyMagicLink(c)
+
+		s.Equal(http.StatusAccepted, w.Code)
+		s.mockAuthService.AssertNotCalled(s.T(), "GenerateTokens", mock.Anything)
+		s.resetMocks()
+	})
+}
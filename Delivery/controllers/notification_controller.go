@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationController struct {
+	Notifications domain.INotificationUsecase
+}
+
+func NewNotificationController(notifications domain.INotificationUsecase) *NotificationController {
+	return &NotificationController{Notifications: notifications}
+}
+
+// ListNotifications lists the logged in user's notifications newest first, ?unread=true only the unread ones
+func (nc *NotificationController) ListNotifications(c *gin.Context) {
+	var query dto.NotificationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query"})
+		return
+	}
+	if err := validate.Struct(query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filter := query.ToFilter(c.GetString("user_id"))
+	notifications, total, err := nc.Notifications.List(filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load notifications"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"notifications": dto.ToNotificationResponses(notifications),
+		"total":         total,
+		"page":          max(filter.Page, 1),
+	})
+}
+
+func (nc *NotificationController) UnreadCount(c *gin.Context) {
+	count, err := nc.Notifications.UnreadCount(c.GetString("user_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to count notifications"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"unread": count})
+}
+
+func (nc *NotificationController) MarkRead(c *gin.Context) {
+	err := nc.Notifications.MarkRead(c.GetString("user_id"), c.Param("id"))
+	if err == domain.ErrNotificationNotFound {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Notification not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark notification as read"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Notification marked as read"})
+}
+
+func (nc *NotificationController) MarkAllRead(c *gin.Context) {
+	marked, err := nc.Notifications.MarkAllRead(c.GetString("user_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark notifications as read"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"marked": marked})
+}
+
+// GetPreferences tells for every notification type whether the user gets it
+func (nc *NotificationController) GetPreferences(c *gin.Context) {
+	preferences, err := nc.Notifications.Preferences(c.GetString("user_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load notification preferences"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"preferences": preferences})
+}
+
+func (nc *NotificationController) UpdatePreferences(c *gin.Context) {
+	var req dto.NotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	preferences, err := nc.Notifications.UpdatePreferences(c.GetString("user_id"), req)
+	if err == domain.ErrUnknownNotificationType {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "types": domain.NotificationTypes})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update notification preferences"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"preferences": preferences})
+}
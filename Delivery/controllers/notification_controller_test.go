@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+// NotificationControllerSuite defines the test suite for NotificationController
+type NotificationControllerSuite struct {
+	suite.Suite
+	mockNotifications *domain_mocks.MockINotificationUsecase
+	handler           *NotificationController
+}
+
+func (s *NotificationControllerSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.mockNotifications = domain_mocks.NewMockINotificationUsecase(s.T())
+	s.handler = NewNotificationController(s.mockNotifications)
+}
+
+func TestNotificationControllerSuite(t *testing.T) {
+	suite.Run(t, new(NotificationControllerSuite))
+}
+
+func (s *NotificationControllerSuite) TestListNotifications() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		notifications := []*domain.Notification{{ID: "n1", RecipientID: "1", ActorID: "2", Type: domain.NotificationPostLike, TargetType: "post", TargetID: "p1", PostID: "p1", CreatedAt: time.Now()}}
+		s.mockNotifications.On("List", domain.NotificationFilter{RecipientID: "1", UnreadOnly: true, Page: 2}).Return(notifications, int64(21), nil)
+
+		c, w := newTestContext(http.MethodGet, "/notifications?unread=true&page=2", "", "1")
+		s.handler.ListNotifications(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response struct {
+			Notifications []map[string]any `json:"notifications"`
+			Total         int64            `json:"total"`
+			Page          int              `json:"page"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal(int64(21), response.Total)
+		s.Equal(2, response.Page)
+		s.Len(response.Notifications, 1)
+		s.Equal("post_like", response.Notifications[0]["type"])
+		s.NotContains(response.Notifications[0], "read_at")
+	})
+
+	s.Run("Failure", func() {
+		s.SetupTest()
+		s.mockNotifications.On("List", domain.NotificationFilter{RecipientID: "1"}).Return(nil, int64(0), errors.New("db error"))
+
+		c, w := newTestContext(http.MethodGet, "/notifications", "", "1")
+		s.handler.ListNotifications(c)
+
+		s.Equal(http.StatusInternalServerError, w.Code)
+	})
+}
+
+func (s *NotificationControllerSuite) TestUnreadCount() {
+	s.mockNotifications.On("UnreadCount", "1").Return(int64(3), nil)
+
+	c, w := newTestContext(http.MethodGet, "/notifications/unread-count", "", "1")
+	s.handler.UnreadCount(c)
+
+	s.Equal(http.StatusOK, w.Code)
+	s.JSONEq(`{"unread":3}`, w.Body.String())
+}
+
+func (s *NotificationControllerSuite) TestMarkRead() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockNotifications.On("MarkRead", "1", "n1").Return(nil)
+
+		c, w := newTestContext(http.MethodPost, "/notifications/n1/read", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: "n1"}}
+		s.handler.MarkRead(c)
+
+		s.Equal(http.StatusOK, w.Code)
+	})
+
+	s.Run("NotFound", func() {
+		s.SetupTest()
+		s.mockNotifications.On("MarkRead", "1", "n1").Return(domain.ErrNotificationNotFound)
+
+		c, w := newTestContext(http.MethodPost, "/notifications/n1/read", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: "n1"}}
+		s.handler.MarkRead(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+	})
+}
+
+func (s *NotificationControllerSuite) TestMarkAllRead() {
+	s.mockNotifications.On("MarkAllRead", "1").Return(int64(5), nil)
+
+	c, w := newTestContext(http.MethodPost, "/notifications/read-all", "", "1")
+	s.handler.MarkAllRead(c)
+
+	s.Equal(http.StatusOK, w.Code)
+	s.JSONEq(`{"marked":5}`, w.Body.String())
+}
+
+func (s *NotificationControllerSuite) TestPreferences() {
+	s.Run("Get", func() {
+		s.SetupTest()
+		s.mockNotifications.On("Preferences", "1").Return(map[domain.NotificationType]bool{domain.NotificationPostComment: true, domain.NotificationPostLike: false}, nil)
+
+		c, w := newTestContext(http.MethodGet, "/notifications/preferences", "", "1")
+		s.handler.GetPreferences(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.JSONEq(`{"preferences":{"post_comment":true,"post_like":false}}`, w.Body.String())
+	})
+
+	s.Run("Update", func() {
+		s.SetupTest()
+		updated := map[domain.NotificationType]bool{domain.NotificationPostComment: true, domain.NotificationPostLike: false}
+		s.mockNotifications.On("UpdatePreferences", "1", map[domain.NotificationType]bool{domain.NotificationPostLike: false}).Return(updated, nil)
+
+		c, w := newTestContext(http.MethodPut, "/notifications/preferences", `{"post_like":false}`, "1")
+		s.handler.UpdatePreferences(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.JSONEq(`{"preferences":{"post_comment":true,"post_like":false}}`, w.Body.String())
+	})
+
+	s.Run("UnknownType", func() {
+		s.SetupTest()
+		s.mockNotifications.On("UpdatePreferences", "1", map[domain.NotificationType]bool{"reply": false}).Return(nil, domain.ErrUnknownNotificationType)
+
+		c, w := newTestContext(http.MethodPut, "/notifications/preferences", `{"reply":false}`, "1")
+		s.handler.UpdatePreferences(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("InvalidBody", func() {
+		s.SetupTest()
+
+		c, w := newTestContext(http.MethodPut, "/notifications/preferences", `{"post_like":"no"}`, "1")
+		s.handler.UpdatePreferences(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,226 @@
+package controllers
+
+import (
+	"crypto/subtle"
+	"errors"
+	dto "g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	utils "g6/blog-api/Utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// the state is also kept in a cookie, so a callback only works in the browser that started the login
+const (
+	oidcStateCookie     = "oidc_state"
+	oidcStateCookiePath = "/api/auth"
+	oidcStateMaxAge     = 600 // seconds
+)
+
+func (ac *AuthController) ListOIDCProviders(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"providers": ac.OIDCUsecase.Providers()})
+}
+
+// OIDCLogin redirects the browser to the provider's login page
+func (ac *AuthController) OIDCLogin(c *gin.Context) {
+	ac.oidcLogin(c, c.Param("provider"))
+}
+
+// OIDCCallback finishes the login when the provider redirects back
+func (ac *AuthController) OIDCCallback(c *gin.Context) {
+	ac.oidcCallback(c, c.Param("provider"))
+}
+
+// GoogleLogin and GoogleCallback keep the original Google URLs, Google is an ordinary OIDC provider
+func (ac *AuthController) GoogleLogin(c *gin.Context) {
+	ac.oidcLogin(c, "google")
+}
+
+func (ac *AuthController) GoogleCallback(c *gin.Context) {
+	ac.oidcCallback(c, "google")
+}
+
+func (ac *AuthController) oidcLogin(c *gin.Context, provider string) {
+	authURL, state, err := ac.OIDCUsecase.Begin(provider)
+	if err != nil {
+		c.JSON(oidcErrorStatus(err), gin.H{"error": oidcErrorMessage(err)})
+		return
+	}
+
+	// Lax, the cookie has to come along on the cross-site redirect back from the provider
+	ac.setOIDCStateCookie(c, state, oidcStateMaxAge)
+	c.Redirect(http.StatusTemporaryRedirect, authURL)
+}
+
+func (ac *AuthController) oidcCallback(c *gin.Context, provider string) {
+	state := c.Query("state")
+	cookieState, cookieErr := c.Cookie(oidcStateCookie)
+	ac.setOIDCStateCookie(c, "", -1)
+
+	if reason := c.Query("error"); reason != "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Login was cancelled or refused by the provider", "reason": reason})
+		return
+	}
+	if cookieErr != nil || state == "" || subtle.ConstantTimeCompare([]byte(cookieState), []byte(state)) != 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": domain.ErrOIDCInvalidState.Error()})
+		return
+	}
+
+	result, err := ac.OIDCUsecase.Complete(provider, state, c.Query("code"))
+	if err != nil {
+		c.JSON(oidcErrorStatus(err), gin.H{"error": oidcErrorMessage(err)})
+		return
+	}
+
+	// the user started this from their account, they are already logged in
+	if result.LinkURL != "" {
+		ac.setOIDCStateCookie(c, result.LinkState, oidcStateMaxAge)
+		c.Redirect(http.StatusTemporaryRedirect, result.LinkURL)
+		return
+	}
+	if result.Linked != nil {
+		c.JSON(http.StatusOK, gin.H{
+			"message":  "Provider linked",
+			"identity": dto.ToLinkedIdentityResponse(result.Linked),
+		})
+		return
+	}
+	if result.Unlinked != "" {
+		c.JSON(http.StatusOK, gin.H{"message": "Provider unlinked", "provider": result.Unlinked})
+		return
+	}
+
+	user := result.User
+	if ac.requireSecondFactor(c, user) {
+		return
+	}
+
+	response, ok := ac.issueSession(c, user, "oidc "+provider)
+	if !ok {
+		return
+	}
+
+	status, message := http.StatusOK, "Login successful"
+	if result.Created {
+		status, message = http.StatusCreated, "User registered successfully"
+	}
+	c.JSON(status,
+		gin.H{
+			"message":  message,
+			"provider": provider,
+			"user":     dto.ToUserResponse(*user),
+			"tokens": dto.LoginResponse{
+				AccessToken:  response.AccessToken,
+				RefreshToken: response.RefreshToken,
+			}})
+}
+
+// ListLoginMethods shows whether the user has a password and which providers are linked
+func (ac *AuthController) ListLoginMethods(c *gin.Context) {
+	methods, err := ac.OIDCUsecase.LoginMethods(c.GetString("user_id"))
+	if err != nil {
+		c.JSON(oidcErrorStatus(err), gin.H{"error": oidcErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToLoginMethodsResponse(methods))
+}
+
+// LinkIdentity starts linking a provider. It answers with the provider URL instead of
+// redirecting, the client sends the password with a POST and then navigates there.
+// With confirm_with the URL is the one of that provider, its callback continues at the provider to link.
+func (ac *AuthController) LinkIdentity(c *gin.Context) {
+	var req dto.LinkIdentityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	authURL, state, err := ac.OIDCUsecase.BeginLink(c.GetString("user_id"), c.Param("provider"), req.Password, req.ConfirmWith)
+	if err != nil {
+		c.JSON(oidcErrorStatus(err), gin.H{"error": oidcErrorMessage(err)})
+		return
+	}
+	ac.setOIDCStateCookie(c, state, oidcStateMaxAge)
+	c.JSON(http.StatusOK, gin.H{"authorization_url": authURL})
+}
+
+// UnlinkIdentity unlinks a provider after the password is confirmed. With confirm_with it answers
+// with the URL of that provider instead, the provider is unlinked when the login comes back.
+func (ac *AuthController) UnlinkIdentity(c *gin.Context) {
+	var req dto.UnlinkIdentityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.ConfirmWith != "" {
+		authURL, state, err := ac.OIDCUsecase.BeginUnlink(c.GetString("user_id"), c.Param("provider"), req.ConfirmWith)
+		if err != nil {
+			c.JSON(oidcErrorStatus(err), gin.H{"error": oidcErrorMessage(err)})
+			return
+		}
+		ac.setOIDCStateCookie(c, state, oidcStateMaxAge)
+		c.JSON(http.StatusOK, gin.H{"authorization_url": authURL})
+		return
+	}
+
+	if err := ac.OIDCUsecase.Unlink(c.GetString("user_id"), c.Param("provider"), req.Password); err != nil {
+		c.JSON(oidcErrorStatus(err), gin.H{"error": oidcErrorMessage(err)})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Provider unlinked"})
+}
+
+func (ac *AuthController) setOIDCStateCookie(c *gin.Context, state string, maxAge int) {
+	utils.SetCookie(c, utils.CookieOptions{
+		Name:     oidcStateCookie,
+		Value:    state,
+		MaxAge:   maxAge,
+		Path:     oidcStateCookiePath,
+		Secure:   ac.Cookies.Secure,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func oidcErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrOIDCProviderNotFound), errors.Is(err, domain.ErrIdentityNotFound), errors.Is(err, domain.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrOIDCInvalidState):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrOIDCEmailNotVerified), errors.Is(err, domain.ErrPasswordRequired), errors.Is(err, domain.ErrProviderConfirmation):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrOIDCLoginFailed), errors.Is(err, domain.ErrInvalidPassword):
+		return http.StatusUnauthorized
+	case errors.Is(err, domain.ErrOIDCAccountExists), errors.Is(err, domain.ErrIdentityAlreadyLinked), errors.Is(err, domain.ErrLastLoginMethod):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+var oidcErrors = []error{
+	domain.ErrOIDCProviderNotFound, domain.ErrOIDCInvalidState, domain.ErrOIDCEmailNotVerified, domain.ErrOIDCLoginFailed,
+	domain.ErrOIDCAccountExists, domain.ErrIdentityNotFound, domain.ErrIdentityAlreadyLinked, domain.ErrLastLoginMethod,
+	domain.ErrPasswordRequired, domain.ErrProviderConfirmation, domain.ErrInvalidPassword, domain.ErrUserNotFound,
+}
+
+// provider errors can carry token or endpoint details, the client only gets the generic message
+func oidcErrorMessage(err error) string {
+	for _, known := range oidcErrors {
+		if errors.Is(err, known) {
+			return known.Error()
+		}
+	}
+	return "Login with the provider failed"
+}
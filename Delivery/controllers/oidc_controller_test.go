@@ -0,0 +1,313 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+)
+
+func (s *AuthControllerSuite) TestOIDCLogin() {
+	s.Run("RedirectsWithStateCookie", func() {
+		s.mockOIDCUsecase.On("Begin", "keycloak").Return("https://idp.example.com/authorize?state=state-1", "state-1", nil)
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/keycloak/login", nil, nil)
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.OIDCLogin(c)
+
+		s.Equal(http.StatusTemporaryRedirect, w.Code)
+		s.Equal("https://idp.example.com/authorize?state=state-1", w.Header().Get("Location"))
+		cookie := w.Result().Cookies()[0]
+		s.Equal(oidcStateCookie, cookie.Name)
+		s.Equal("state-1", cookie.Value)
+		s.True(cookie.HttpOnly)
+		s.Equal(http.SameSiteLaxMode, cookie.SameSite)
+		s.resetMocks()
+	})
+
+	s.Run("UnknownProvider", func() {
+		s.mockOIDCUsecase.On("Begin", "nope").Return("", "", domain.ErrOIDCProviderNotFound)
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/nope/login", nil, nil)
+		c.Params = gin.Params{{Key: "provider", Value: "nope"}}
+
+		s.handler.OIDCLogin(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+		s.resetMocks()
+	})
+}
+
+func (s *AuthControllerSuite) TestOIDCCallback() {
+	user := &domain.User{ID: "1", Email: "jane@example.com", Role: domain.RoleUser}
+	stateCookie := []*http.Cookie{{Name: oidcStateCookie, Value: "state-1"}}
+	tokenResponse := domain.RefreshTokenResponse{
+		AccessToken:           "access-token",
+		RefreshToken:          "refresh-token",
+		AccessTokenExpiresAt:  time.Now().Add(time.Hour),
+		RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+	}
+
+	s.Run("RegistersUser", func() {
+		s.mockOIDCUsecase.On("Complete", "keycloak", "state-1", "code-1").Return(&domain.OIDCLoginResult{User: user, Created: true}, nil)
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(false, nil)
+		s.mockTwoFactorUsecase.On("IsRequired", user.Role).Return(false, nil)
+		s.mockAuthService.On("GenerateTokens", *user).Return(tokenResponse, nil)
+		s.mockRefreshTokenUsecase.On("Save", mock.Anything).Return(nil)
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/keycloak/callback?state=state-1&code=code-1", nil, stateCookie)
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.OIDCCallback(c)
+
+		s.Equal(http.StatusCreated, w.Code)
+		var response gin.H
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("keycloak", response["provider"])
+		tokens := response["tokens"].(map[string]any)
+		s.Equal(tokenResponse.AccessToken, tokens["access_token"])
+		s.resetMocks()
+	})
+
+	s.Run("GoogleAlias", func() {
+		s.mockOIDCUsecase.On("Complete", "google", "state-1", "code-1").Return(&domain.OIDCLoginResult{User: user}, nil)
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(false, nil)
+		s.mockTwoFactorUsecase.On("IsRequired", user.Role).Return(false, nil)
+		s.mockAuthService.On("GenerateTokens", *user).Return(tokenResponse, nil)
+		s.mockRefreshTokenUsecase.On("Save", mock.Anything).Return(nil)
+		c, w := s.createTestRequest(http.MethodGet, "/google/callback?state=state-1&code=code-1", nil, stateCookie)
+
+		s.handler.GoogleCallback(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("TwoFactorChallenge", func() {
+		s.mockOIDCUsecase.On("Complete", "keycloak", "state-1", "code-1").Return(&domain.OIDCLoginResult{User: user}, nil)
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(true, nil)
+		s.mockAuthService.On("GeneratePreAuthToken", *user).Return("pre-auth-token", time.Now(), nil)
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/keycloak/callback?state=state-1&code=code-1", nil, stateCookie)
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.OIDCCallback(c)
+
+		s.Equal(http.StatusAccepted, w.Code)
+		s.mockAuthService.AssertNotCalled(s.T(), "GenerateTokens", mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("LinkCallbackKeepsSession", func() {
+		linked := &domain.LinkedIdentity{Provider: "keycloak", Email: "jane@example.com", CreatedAt: time.Now()}
+		s.mockOIDCUsecase.On("Complete", "keycloak", "state-1", "code-1").Return(&domain.OIDCLoginResult{User: user, Linked: linked}, nil)
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/keycloak/callback?state=state-1&code=code-1", nil, stateCookie)
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.OIDCCallback(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), "Provider linked")
+		s.mockAuthService.AssertNotCalled(s.T(), "GenerateTokens", mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("LinkConfirmedContinuesAtProvider", func() {
+		s.mockOIDCUsecase.On("Complete", "keycloak", "state-1", "code-1").Return(&domain.OIDCLoginResult{
+			User: user, LinkURL: "https://accounts.example.com/authorize?state=state-2", LinkState: "state-2",
+		}, nil)
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/keycloak/callback?state=state-1&code=code-1", nil, stateCookie)
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.OIDCCallback(c)
+
+		s.Equal(http.StatusTemporaryRedirect, w.Code)
+		s.Equal("https://accounts.example.com/authorize?state=state-2", w.Header().Get("Location"))
+		// the used state is cleared first, the browser keeps the last cookie set
+		cookies := w.Result().Cookies()
+		s.Equal(oidcStateCookie, cookies[len(cookies)-1].Name)
+		s.Equal("state-2", cookies[len(cookies)-1].Value)
+		s.mockAuthService.AssertNotCalled(s.T(), "GenerateTokens", mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("EmailBelongsToAnotherAccount", func() {
+		s.mockOIDCUsecase.On("Complete", "keycloak", "state-1", "code-1").Return(nil, domain.ErrOIDCAccountExists)
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/keycloak/callback?state=state-1&code=code-1", nil, stateCookie)
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.OIDCCallback(c)
+
+		s.Equal(http.StatusConflict, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("StateCookieMismatch", func() {
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/keycloak/callback?state=other&code=code-1", nil, stateCookie)
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.OIDCCallback(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.mockOIDCUsecase.AssertNotCalled(s.T(), "Complete", mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("MissingStateCookie", func() {
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/keycloak/callback?state=state-1&code=code-1", nil, nil)
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.OIDCCallback(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("ProviderError", func() {
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/keycloak/callback?state=state-1&error=access_denied", nil, stateCookie)
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.OIDCCallback(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("VerificationFailedHidesDetails", func() {
+		s.mockOIDCUsecase.On("Complete", "keycloak", "state-1", "code-1").Return(nil, fmt.Errorf("%w: invalid id token: nonce mismatch", domain.ErrOIDCLoginFailed))
+		c, w := s.createTestRequest(http.MethodGet, "/oidc/keycloak/callback?state=state-1&code=code-1", nil, stateCookie)
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.OIDCCallback(c)
+
+		s.Equal(http.StatusUnauthorized, w.Code)
+		s.JSONEq(`{"error":"`+domain.ErrOIDCLoginFailed.Error()+`"}`, w.Body.String())
+		s.resetMocks()
+	})
+}
+
+func (s *AuthControllerSuite) TestLinkIdentity() {
+	s.Run("Success", func() {
+		s.mockOIDCUsecase.On("BeginLink", "1", "keycloak", "password123", "").Return("https://idp.example.com/authorize", "state-1", nil)
+		c, w := s.createTestRequest(http.MethodPost, "/identities/keycloak/link", dto.LinkIdentityRequest{Password: "password123"}, nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.LinkIdentity(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), "https://idp.example.com/authorize")
+		s.Equal(oidcStateCookie, w.Result().Cookies()[0].Name)
+		s.resetMocks()
+	})
+
+	s.Run("ConfirmWithProvider", func() {
+		s.mockOIDCUsecase.On("BeginLink", "1", "keycloak", "", "google").Return("https://accounts.example.com/authorize?state=s1", "s1", nil)
+		c, w := s.createTestRequest(http.MethodPost, "/identities/keycloak/link", dto.LinkIdentityRequest{ConfirmWith: "google"}, nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.LinkIdentity(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), "https://accounts.example.com/authorize?state=s1")
+		s.Equal("s1", findCookie(w.Result().Cookies(), oidcStateCookie).Value)
+		s.resetMocks()
+	})
+
+	s.Run("MissingPassword", func() {
+		c, w := s.createTestRequest(http.MethodPost, "/identities/keycloak/link", dto.LinkIdentityRequest{}, nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.LinkIdentity(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("WrongPassword", func() {
+		s.mockOIDCUsecase.On("BeginLink", "1", "keycloak", "wrong", "").Return("", "", domain.ErrInvalidPassword)
+		c, w := s.createTestRequest(http.MethodPost, "/identities/keycloak/link", dto.LinkIdentityRequest{Password: "wrong"}, nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.LinkIdentity(c)
+
+		s.Equal(http.StatusUnauthorized, w.Code)
+		s.resetMocks()
+	})
+}
+
+func (s *AuthControllerSuite) TestUnlinkIdentity() {
+	s.Run("Success", func() {
+		s.mockOIDCUsecase.On("Unlink", "1", "keycloak", "password123").Return(nil)
+		c, w := s.createTestRequest(http.MethodDelete, "/identities/keycloak", dto.UnlinkIdentityRequest{Password: "password123"}, nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.UnlinkIdentity(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("LastLoginMethod", func() {
+		s.mockOIDCUsecase.On("Unlink", "1", "keycloak", "password123").Return(domain.ErrLastLoginMethod)
+		c, w := s.createTestRequest(http.MethodDelete, "/identities/keycloak", dto.UnlinkIdentityRequest{Password: "password123"}, nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.UnlinkIdentity(c)
+
+		s.Equal(http.StatusConflict, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("ConfirmWithProvider", func() {
+		s.mockOIDCUsecase.On("BeginUnlink", "1", "keycloak", "google").Return("https://accounts.example.com/authorize?state=s1", "s1", nil)
+		c, w := s.createTestRequest(http.MethodDelete, "/identities/keycloak", dto.UnlinkIdentityRequest{ConfirmWith: "google"}, nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.UnlinkIdentity(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), "authorization_url")
+		s.Equal("s1", findCookie(w.Result().Cookies(), oidcStateCookie).Value)
+		s.mockOIDCUsecase.AssertNotCalled(s.T(), "Unlink", mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("NeitherPasswordNorProvider", func() {
+		c, w := s.createTestRequest(http.MethodDelete, "/identities/keycloak", dto.UnlinkIdentityRequest{}, nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "provider", Value: "keycloak"}}
+
+		s.handler.UnlinkIdentity(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.resetMocks()
+	})
+}
+
+func (s *AuthControllerSuite) TestListLoginMethods() {
+	s.mockOIDCUsecase.On("LoginMethods", "1").Return(&domain.LoginMethods{
+		HasPassword: true,
+		Identities:  []*domain.LinkedIdentity{{Provider: "keycloak", Email: "jane@example.com"}},
+	}, nil)
+	c, w := s.createTestRequest(http.MethodGet, "/identities", nil, nil)
+	c.Set("user_id", "1")
+
+	s.handler.ListLoginMethods(c)
+
+	s.Equal(http.StatusOK, w.Code)
+	var response dto.LoginMethodsResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	s.True(response.HasPassword)
+	s.Equal("keycloak", response.Identities[0].Provider)
+	s.Nil(response.Identities[0].LastLoginAt)
+	s.resetMocks()
+}
@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PersonalAccessTokenController struct {
+	TokenUsecase         domain.IPersonalAccessTokenUsecase
+	SecurityEventUsecase domain.ISecurityEventUsecase
+}
+
+func NewPersonalAccessTokenController(tokenUsecase domain.IPersonalAccessTokenUsecase, securityEventUsecase domain.ISecurityEventUsecase) *PersonalAccessTokenController {
+	return &PersonalAccessTokenController{TokenUsecase: tokenUsecase, SecurityEventUsecase: securityEventUsecase}
+}
+
+// CreateToken issues a personal access token for the logged in user
+func (tc *PersonalAccessTokenController) CreateToken(c *gin.Context) {
+	var req dto.CreatePersonalAccessTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	token, err := tc.TokenUsecase.Create(
+		c.GetString("user_id"),
+		req.Name,
+		dto.ToDomainTokenScopes(req.Scopes),
+		time.Duration(req.ExpiresInDays)*24*time.Hour,
+	)
+	if err != nil {
+		c.JSON(personalAccessTokenErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Token created. Copy it now, it will not be shown again",
+		"token": dto.CreatedPersonalAccessTokenResponse{
+			PersonalAccessTokenResponse: dto.ToPersonalAccessTokenResponse(token),
+			Token:                       token.Token,
+		},
+	})
+}
+
+// ListTokens returns the personal access tokens of the logged in user, without their values
+func (tc *PersonalAccessTokenController) ListTokens(c *gin.Context) {
+	tokens, err := tc.TokenUsecase.List(c.GetString("user_id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load tokens"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"tokens": dto.ToPersonalAccessTokenResponseList(tokens)})
+}
+
+// RevokeToken deletes one of the logged in user's tokens, it stops working immediately
+func (tc *PersonalAccessTokenController) RevokeToken(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if err := tc.TokenUsecase.Revoke(userID, c.Param("id")); err != nil {
+		c.JSON(personalAccessTokenErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	recordSecurityEvent(c, tc.SecurityEventUsecase, domain.SecurityEvent{
+		Type:    domain.SecurityEventTokenRevoked,
+		UserID:  userID,
+		Outcome: domain.SecurityEventSuccess,
+		Details: "personal access token " + c.Param("id"),
+	})
+	c.JSON(http.StatusOK, gin.H{"message": "Token revoked"})
+}
+
+func personalAccessTokenErrorStatus(err error) int {
+	switch err {
+	case domain.ErrInvalidInput, domain.ErrInvalidTokenScope:
+		return http.StatusBadRequest
+	case domain.ErrTokenScopeNotAllowed:
+		return http.StatusForbidden
+	case domain.ErrAccessTokenNotFound, domain.ErrUserNotFound:
+		return http.StatusNotFound
+	case domain.ErrAccessTokenLimit:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// PersonalAccessTokenControllerSuite defines the test suite for PersonalAccessTokenController
+type PersonalAccessTokenControllerSuite struct {
+	suite.Suite
+	mockTokenUsecase         *domain_mocks.MockIPersonalAccessTokenUsecase
+	mockSecurityEventUsecase *domain_mocks.MockISecurityEventUsecase
+	handler                  *PersonalAccessTokenController
+}
+
+func (s *PersonalAccessTokenControllerSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.mockTokenUsecase = domain_mocks.NewMockIPersonalAccessTokenUsecase(s.T())
+	s.mockSecurityEventUsecase = domain_mocks.NewMockISecurityEventUsecase(s.T())
+	s.handler = NewPersonalAccessTokenController(s.mockTokenUsecase, s.mockSecurityEventUsecase)
+	s.resetMocks()
+}
+
+func TestPersonalAccessTokenControllerSuite(t *testing.T) {
+	suite.Run(t, new(PersonalAccessTokenControllerSuite))
+}
+
+func (s *PersonalAccessTokenControllerSuite) TestCreateToken() {
+	s.Run("ReturnsTokenOnce", func() {
+		created := &domain.PersonalAccessToken{
+			ID:        "t1",
+			Name:      "ci",
+			Token:     domain.PersonalAccessTokenPrefix + "secret",
+			Scopes:    []domain.TokenScope{domain.ScopeReadPosts},
+			ExpiresAt: time.Now().Add(30 * 24 * time.Hour),
+		}
+		s.mockTokenUsecase.On("Create", "1", "ci", []domain.TokenScope{domain.ScopeReadPosts}, 30*24*time.Hour).Return(created, nil)
+
+		c, w := s.createTestRequest(http.MethodPost, "/users/tokens", gin.H{"name": "ci", "scopes": []string{"read:posts"}, "expires_in_days": 30})
+		c.Set("user_id", "1")
+
+		s.handler.CreateToken(c)
+
+		s.Equal(http.StatusCreated, w.Code)
+		var response struct {
+			Token struct {
+				ID         string     `json:"id"`
+				Token      string     `json:"token"`
+				LastUsedAt *time.Time `json:"last_used_at"`
+			} `json:"token"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("t1", response.Token.ID)
+		s.Equal(created.Token, response.Token.Token)
+		s.Nil(response.Token.LastUsedAt)
+		s.resetMocks()
+	})
+
+	s.Run("UnknownScope", func() {
+		c, w := s.createTestRequest(http.MethodPost, "/users/tokens", gin.H{"name": "ci", "scopes": []string{"delete:everything"}, "expires_in_days": 30})
+		c.Set("user_id", "1")
+
+		s.handler.CreateToken(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.mockTokenUsecase.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("AdminScopeForbidden", func() {
+		s.mockTokenUsecase.On("Create", "1", "ci", []domain.TokenScope{domain.ScopeAdmin}, 24*time.Hour).Return(nil, domain.ErrTokenScopeNotAllowed)
+
+		c, w := s.createTestRequest(http.MethodPost, "/users/tokens", gin.H{"name": "ci", "scopes": []string{"admin"}, "expires_in_days": 1})
+		c.Set("user_id", "1")
+
+		s.handler.CreateToken(c)
+
+		s.Equal(http.StatusForbidden, w.Code)
+		s.resetMocks()
+	})
+}
+
+func (s *PersonalAccessTokenControllerSuite) TestListTokens() {
+	s.Run("DoesNotExposeHashes", func() {
+		s.mockTokenUsecase.On("List", "1").Return([]*domain.PersonalAccessToken{{ID: "t1", Name: "ci", TokenHash: "hash"}}, nil)
+
+		c, w := s.createTestRequest(http.MethodGet, "/users/tokens", nil)
+		c.Set("user_id", "1")
+
+		s.handler.ListTokens(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.NotContains(w.Body.String(), "hash")
+		s.resetMocks()
+	})
+}
+
+func (s *PersonalAccessTokenControllerSuite) TestRevokeToken() {
+	s.Run("Success", func() {
+		s.mockTokenUsecase.On("Revoke", "1", "t1").Return(nil)
+
+		c, w := s.createTestRequest(http.MethodDelete, "/users/tokens/t1", nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "id", Value: "t1"}}
+
+		s.handler.RevokeToken(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("NotFound", func() {
+		s.mockTokenUsecase.On("Revoke", "1", "t2").Return(domain.ErrAccessTokenNotFound)
+
+		c, w := s.createTestRequest(http.MethodDelete, "/users/tokens/t2", nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "id", Value: "t2"}}
+
+		s.handler.RevokeToken(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+		s.resetMocks()
+	})
+}
+
+func (s *PersonalAccessTokenControllerSuite) createTestRequest(method, url string, body interface{}) (*gin.Context, *httptest.ResponseRecorder) {
+	var requestBody *bytes.Reader
+	if body == nil {
+		requestBody = bytes.NewReader(nil)
+	} else {
+		jsonBody, _ := json.Marshal(body)
+		requestBody = bytes.NewReader(jsonBody)
+	}
+
+	req := httptest.NewRequest(method, url, requestBody)
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	return c, w
+}
+
+func (s *PersonalAccessTokenControllerSuite) resetMocks() {
+	s.mockTokenUsecase.ExpectedCalls = nil
+	s.mockTokenUsecase.Calls = nil
+	s.mockSecurityEventUsecase.ExpectedCalls = nil
+	s.mockSecurityEventUsecase.Calls = nil
+	// the audit log is written on most paths, tests that care assert the recorded events
+	s.mockSecurityEventUsecase.On("Record", mock.Anything).Return(nil).Maybe()
+}
@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// recordSecurityEvent appends to the audit log with the caller's IP and user agent. The actor
+// defaults to the logged in user. A failed write never fails the request it describes.
+func recordSecurityEvent(c *gin.Context, events domain.ISecurityEventUsecase, event domain.SecurityEvent) {
+	if event.ActorID == "" {
+		event.ActorID = c.GetString("user_id")
+	}
+	event.IP = c.ClientIP()
+	event.UserAgent = c.Request.UserAgent()
+	_ = events.Record(&event)
+}
+
+// securityEventOutcome maps the error of the audited action to its outcome
+func securityEventOutcome(err error) domain.SecurityEventOutcome {
+	if err != nil {
+		return domain.SecurityEventFailure
+	}
+	return domain.SecurityEventSuccess
+}
+
+// ListSecurityEvents searches the audit log of all users
+func (ac *AuthController) ListSecurityEvents(c *gin.Context) {
+	var query dto.SecurityEventQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query"})
+		return
+	}
+	if err := validate.Struct(query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filter := query.ToFilter()
+	events, total, err := ac.SecurityEventUsecase.Search(filter)
+	if err != nil {
+		if err == domain.ErrInvalidInput {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "from must be before to"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load security events"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"events": dto.ToSecurityEventResponses(events),
+		"total":  total,
+		"page":   max(filter.Page, 1),
+	})
+}
+
+// RecentActivity lists the security events of the logged in user's account
+func (ac *AuthController) RecentActivity(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+
+	events, total, err := ac.SecurityEventUsecase.RecentActivity(c.GetString("user_id"), page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load recent activity"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"events": dto.ToSecurityEventResponses(events),
+		"total":  total,
+		"page":   max(page, 1),
+	})
+}
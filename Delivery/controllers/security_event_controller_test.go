@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestListSecurityEvents tests the ListSecurityEvents method
+func (s *AuthControllerSuite) TestListSecurityEvents() {
+	s.Run("Success", func() {
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		filter := domain.SecurityEventFilter{
+			UserID:  "1",
+			Types:   []domain.SecurityEventType{domain.SecurityEventLogin, domain.SecurityEventRoleChange},
+			Outcome: domain.SecurityEventFailure,
+			From:    from,
+			Page:    2,
+		}
+		events := []*domain.SecurityEvent{{ID: "e1", Type: domain.SecurityEventLogin, UserID: "1", Outcome: domain.SecurityEventFailure, IP: "127.0.0.1"}}
+		s.mockSecurityEventUsecase.On("Search", filter).Return(events, int64(21), nil)
+
+		c, w := s.createTestRequest(http.MethodGet, "/security-events?user_id=1&type=login,role_change&outcome=failure&from=2024-01-01T00:00:00Z&page=2", nil, nil)
+		s.handler.ListSecurityEvents(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response struct {
+			Events []struct {
+				ID      string `json:"id"`
+				Type    string `json:"type"`
+				Outcome string `json:"outcome"`
+			} `json:"events"`
+			Total int `json:"total"`
+			Page  int `json:"page"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Len(response.Events, 1)
+		s.Equal("login", response.Events[0].Type)
+		s.Equal("failure", response.Events[0].Outcome)
+		s.Equal(21, response.Total)
+		s.Equal(2, response.Page)
+		s.resetMocks()
+	})
+
+	s.Run("InvalidOutcome", func() {
+		c, w := s.createTestRequest(http.MethodGet, "/security-events?outcome=maybe", nil, nil)
+		s.handler.ListSecurityEvents(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.mockSecurityEventUsecase.AssertNotCalled(s.T(), "Search", mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("InvalidTime", func() {
+		c, w := s.createTestRequest(http.MethodGet, "/security-events?from=yesterday", nil, nil)
+		s.handler.ListSecurityEvents(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("FromAfterTo", func() {
+		s.mockSecurityEventUsecase.On("Search", mock.Anything).Return(nil, int64(0), domain.ErrInvalidInput)
+
+		c, w := s.createTestRequest(http.MethodGet, "/security-events?from=2024-02-01T00:00:00Z&to=2024-01-01T00:00:00Z", nil, nil)
+		s.handler.ListSecurityEvents(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		var response gin.H
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("from must be before to", response["error"])
+		s.resetMocks()
+	})
+
+	s.Run("UsecaseError", func() {
+		s.mockSecurityEventUsecase.On("Search", mock.Anything).Return(nil, int64(0), errors.New("db error"))
+
+		c, w := s.createTestRequest(http.MethodGet, "/security-events", nil, nil)
+		s.handler.ListSecurityEvents(c)
+
+		s.Equal(http.StatusInternalServerError, w.Code)
+		s.resetMocks()
+	})
+}
+
+// TestRecentActivity tests the RecentActivity method
+func (s *AuthControllerSuite) TestRecentActivity() {
+	s.Run("Success", func() {
+		events := []*domain.SecurityEvent{{ID: "e1", Type: domain.SecurityEventPasswordChange, UserID: "1", ActorID: "1", Outcome: domain.SecurityEventSuccess}}
+		s.mockSecurityEventUsecase.On("RecentActivity", "1", 1, 20).Return(events, int64(1), nil)
+
+		c, w := s.createTestRequest(http.MethodGet, "/activity", nil, nil)
+		c.Set("user_id", "1")
+		s.handler.RecentActivity(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response struct {
+			Events []struct {
+				Type string `json:"type"`
+			} `json:"events"`
+			Total int `json:"total"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Len(response.Events, 1)
+		s.Equal("password_change", response.Events[0].Type)
+		s.Equal(1, response.Total)
+		s.resetMocks()
+	})
+
+	s.Run("UsecaseError", func() {
+		s.mockSecurityEventUsecase.On("RecentActivity", "1", 3, 5).Return(nil, int64(0), errors.New("db error"))
+
+		c, w := s.createTestRequest(http.MethodGet, "/activity?page=3&page_size=5", nil, nil)
+		c.Set("user_id", "1")
+		s.handler.RecentActivity(c)
+
+		s.Equal(http.StatusInternalServerError, w.Code)
+		s.resetMocks()
+	})
+}
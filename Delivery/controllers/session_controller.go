@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"fmt"
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	utils "g6/blog-api/Utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// currentSessionID finds the session (token family) behind the refresh token cookie of this request.
+// It returns an empty string when the cookie is missing or belongs to someone else.
+func (ac *AuthController) currentSessionID(c *gin.Context, userID string) string {
+	refreshToken, err := utils.GetCookie(c, "refresh_token")
+	if err != nil {
+		return ""
+	}
+	tokenDoc, err := ac.RefreshTokenUsecase.FindByToken(refreshToken)
+	if err != nil || tokenDoc == nil || tokenDoc.UserID != userID {
+		return ""
+	}
+	return tokenDoc.FamilyID
+}
+
+// ListSessions returns the active sessions of the logged in user
+func (ac *AuthController) ListSessions(c *gin.Context) {
+	userID := c.GetString("user_id")
+	sessions, err := ac.RefreshTokenUsecase.ListSessions(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load sessions"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"sessions": dto.ToSessionResponseList(sessions, ac.currentSessionID(c, userID)),
+	})
+}
+
+// RevokeSession logs out one device of the logged in user
+func (ac *AuthController) RevokeSession(c *gin.Context) {
+	userID := c.GetString("user_id")
+	sessionID := c.Param("id")
+
+	if err := ac.RefreshTokenUsecase.RevokeSession(userID, sessionID); err != nil {
+		if err == domain.ErrSessionNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke session"})
+		return
+	}
+	recordSecurityEvent(c, ac.SecurityEventUsecase, domain.SecurityEvent{
+		Type:    domain.SecurityEventTokenRevoked,
+		UserID:  userID,
+		Outcome: domain.SecurityEventSuccess,
+		Details: "session " + sessionID,
+	})
+
+	// revoking the session we are on is a logout
+	if sessionID == ac.currentSessionID(c, userID) {
+		ac.clearSessionCookies(c)
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Session revoked"})
+}
+
+// RevokeOtherSessions logs the user out everywhere except on the current device
+func (ac *AuthController) RevokeOtherSessions(c *gin.Context) {
+	userID := c.GetString("user_id")
+	currentSessionID := ac.currentSessionID(c, userID)
+	if currentSessionID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "You are not logged in or your session has expired"})
+		return
+	}
+
+	revoked, err := ac.RefreshTokenUsecase.RevokeOtherSessions(userID, currentSessionID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke sessions"})
+		return
+	}
+	recordSecurityEvent(c, ac.SecurityEventUsecase, domain.SecurityEvent{
+		Type:    domain.SecurityEventTokenRevoked,
+		UserID:  userID,
+		Outcome: domain.SecurityEventSuccess,
+		Details: fmt.Sprintf("%d other sessions", revoked),
+	})
+	c.JSON(http.StatusOK, gin.H{"message": "Logged out from all other sessions", "revoked": revoked})
+}
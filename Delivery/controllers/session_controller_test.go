@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"encoding/json"
+	domain "g6/blog-api/Domain"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestListSessions tests the ListSessions method
+func (s *AuthControllerSuite) TestListSessions() {
+	s.Run("MarksCurrentSession", func() {
+		current := &domain.RefreshToken{FamilyID: "laptop", Token: "laptop-token", UserID: "1", UserAgent: "Firefox", ExpiresAt: time.Now().Add(time.Hour)}
+		other := &domain.RefreshToken{FamilyID: "phone", Token: "phone-token", UserID: "1", UserAgent: "Safari", ExpiresAt: time.Now().Add(time.Hour)}
+		s.mockRefreshTokenUsecase.On("ListSessions", "1").Return([]*domain.RefreshToken{current, other}, nil)
+		s.mockRefreshTokenUsecase.On("FindByToken", "laptop-token").Return(current, nil)
+
+		cookie := []*http.Cookie{{Name: "refresh_token", Value: "laptop-token"}}
+		c, w := s.createTestRequest(http.MethodGet, "/sessions", nil, cookie)
+		c.Set("user_id", "1")
+
+		s.handler.ListSessions(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response struct {
+			Sessions []struct {
+				ID        string `json:"id"`
+				UserAgent string `json:"user_agent"`
+				Current   bool   `json:"current"`
+			} `json:"sessions"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Len(response.Sessions, 2)
+		s.True(response.Sessions[0].Current)
+		s.False(response.Sessions[1].Current)
+		s.resetMocks()
+	})
+}
+
+// TestRevokeSession tests the RevokeSession method
+func (s *AuthControllerSuite) TestRevokeSession() {
+	s.Run("Success", func() {
+		s.mockRefreshTokenUsecase.On("RevokeSession", "1", "phone").Return(nil)
+
+		c, w := s.createTestRequest(http.MethodDelete, "/sessions/phone", nil, nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "id", Value: "phone"}}
+
+		s.handler.RevokeSession(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.resetMocks()
+	})
+
+	s.Run("NotFound", func() {
+		s.mockRefreshTokenUsecase.On("RevokeSession", "1", "someone-elses").Return(domain.ErrSessionNotFound)
+
+		c, w := s.createTestRequest(http.MethodDelete, "/sessions/someone-elses", nil, nil)
+		c.Set("user_id", "1")
+		c.Params = gin.Params{{Key: "id", Value: "someone-elses"}}
+
+		s.handler.RevokeSession(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+		s.resetMocks()
+	})
+}
+
+// TestRevokeOtherSessions tests the RevokeOtherSessions method
+func (s *AuthControllerSuite) TestRevokeOtherSessions() {
+	s.Run("Success", func() {
+		current := &domain.RefreshToken{FamilyID: "laptop", Token: "laptop-token", UserID: "1"}
+		s.mockRefreshTokenUsecase.On("FindByToken", "laptop-token").Return(current, nil)
+		s.mockRefreshTokenUsecase.On("RevokeOtherSessions", "1", "laptop").Return(int64(3), nil)
+
+		cookie := []*http.Cookie{{Name: "refresh_token", Value: "laptop-token"}}
+		c, w := s.createTestRequest(http.MethodPost, "/sessions/revoke-others", nil, cookie)
+		c.Set("user_id", "1")
+
+		s.handler.RevokeOtherSessions(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response gin.H
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal(float64(3), response["revoked"])
+		s.resetMocks()
+	})
+
+	s.Run("NoCurrentSession", func() {
+		c, w := s.createTestRequest(http.MethodPost, "/sessions/revoke-others", nil, nil)
+		c.Set("user_id", "1")
+
+		s.handler.RevokeOtherSessions(c)
+
+		s.Equal(http.StatusUnauthorized, w.Code)
+		s.resetMocks()
+	})
+}
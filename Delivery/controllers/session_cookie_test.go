@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/security"
+	utils "g6/blog-api/Utils"
+	"net/http"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func findCookie(cookies []*http.Cookie, name string) *http.Cookie {
+	for _, cookie := range cookies {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func (s *AuthControllerSuite) TestSessionCookies() {
+	hashedPassword, _ := security.HashPassword("password123")
+	user := &domain.User{ID: "1", Email: "test@example.com", Password: string(hashedPassword)}
+	loginRequest := dto.LoginRequest{Identifier: "test@example.com", Password: "password123"}
+	tokenResponse := domain.RefreshTokenResponse{
+		AccessToken:           "access-token",
+		RefreshToken:          "refresh-token",
+		AccessTokenExpiresAt:  time.Now().Add(time.Hour),
+		RefreshTokenExpiresAt: time.Now().Add(24 * time.Hour),
+	}
+	login := func() []*http.Cookie {
+		s.mockLoginAttemptUsecase.On("Check", loginRequest.Identifier, mock.Anything).Return(time.Duration(0), nil)
+		s.mockLoginAttemptUsecase.On("RecordSuccess", loginRequest.Identifier).Return(nil)
+		s.mockUserUsecase.On("FindByUsernameOrEmail", mock.Anything, loginRequest.Identifier).Return(user, nil)
+		s.mockTwoFactorUsecase.On("IsEnabled", user.ID).Return(false, nil)
+		s.mockTwoFactorUsecase.On("IsRequired", user.Role).Return(false, nil)
+		s.mockAuthService.On("GenerateTokens", *user).Return(tokenResponse, nil)
+		s.mockRefreshTokenUsecase.On("Save", mock.Anything).Return(nil)
+		c, w := s.createTestRequest(http.MethodPost, "/login", loginRequest, nil)
+
+		s.handler.LoginRequest(c)
+
+		s.Require().Equal(http.StatusOK, w.Code)
+		csrf := findCookie(w.Result().Cookies(), utils.CSRFCookieName)
+		s.Require().NotNil(csrf)
+		s.Equal(csrf.Value, w.Header().Get(utils.CSRFHeaderName))
+		return w.Result().Cookies()
+	}
+
+	s.Run("DefaultsAndCSRFToken", func() {
+		cookies := login()
+
+		access := findCookie(cookies, "access_token")
+		s.True(access.HttpOnly)
+		s.False(access.Secure)
+		s.Equal(http.SameSiteStrictMode, access.SameSite)
+		csrf := findCookie(cookies, utils.CSRFCookieName)
+		s.NotEmpty(csrf.Value)
+		s.False(csrf.HttpOnly, "the page has to read the CSRF token")
+		s.resetMocks()
+	})
+
+	s.Run("ConfiguredAttributes", func() {
+		s.handler.Cookies = utils.CookieSettings{Secure: true, Domain: "example.com", SameSite: http.SameSiteNoneMode}
+		defer func() { s.handler.Cookies = utils.CookieSettings{} }()
+
+		for _, cookie := range login() {
+			s.True(cookie.Secure, cookie.Name)
+			s.Equal("example.com", cookie.Domain, cookie.Name)
+			s.Equal(http.SameSiteNoneMode, cookie.SameSite, cookie.Name)
+		}
+		s.resetMocks()
+	})
+
+	s.Run("LogoutClearsOnSamePath", func() {
+		tokenDoc := &domain.RefreshToken{Token: "refresh-token", UserID: "1", ExpiresAt: time.Now().Add(time.Hour)}
+		s.mockRefreshTokenUsecase.On("FindByToken", "refresh-token").Return(tokenDoc, nil)
+		s.mockRefreshTokenUsecase.On("RevokedToken", tokenDoc).Return(nil)
+		c, w := s.createTestRequest(http.MethodPost, "/logout", nil, []*http.Cookie{{Name: "refresh_token", Value: "refresh-token"}})
+
+		s.handler.LogoutRequest(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		for _, name := range []string{"refresh_token", "access_token", utils.CSRFCookieName} {
+			cookie := findCookie(w.Result().Cookies(), name)
+			s.Require().NotNil(cookie, name)
+			s.Equal("/", cookie.Path)
+			s.Less(cookie.MaxAge, 0)
+		}
+		s.resetMocks()
+	})
+}
@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"fmt"
+	domain "g6/blog-api/Domain"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultStreamHeartbeat keeps proxies from closing idle streams
+const defaultStreamHeartbeat = 25 * time.Second
+
+type StreamController struct {
+	Streams   domain.IStreamUsecase
+	Heartbeat time.Duration
+}
+
+func NewStreamController(streams domain.IStreamUsecase) *StreamController {
+	return &StreamController{Streams: streams, Heartbeat: defaultStreamHeartbeat}
+}
+
+// StreamNotifications sends the logged in user's new notifications as server-sent events
+func (sc *StreamController) StreamNotifications(c *gin.Context) {
+	sc.stream(c, domain.NotificationTopic(c.GetString("user_id")))
+}
+
+// StreamPost sends the new, edited and deleted comments of a post and its reaction counts as
+// server-sent events
+func (sc *StreamController) StreamPost(c *gin.Context) {
+	if validate.Var(c.Param("id"), "mongodb") != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+	sc.stream(c, domain.PostTopic(c.Param("id")))
+}
+
+// stream writes the topic's events until the client leaves. A client that reconnects sends the
+// last event ID it got in the Last-Event-ID header, or in ?last_event_id on a new connection.
+func (sc *StreamController) stream(c *gin.Context, topic string) {
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("last_event_id")
+	}
+	ctx := c.Request.Context()
+	events, err := sc.Streams.Subscribe(ctx, topic, lastEventID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open stream"})
+		return
+	}
+
+	// the server's write timeout is meant for ordinary requests
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	fmt.Fprint(c.Writer, "retry: 3000\n\n")
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(sc.Heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				// fell behind, the client reconnects and catches up
+				return
+			}
+			if event.ID != "" {
+				fmt.Fprintf(c.Writer, "id: %s\n", event.ID)
+			}
+			fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", event.Type, event.Data)
+		case <-heartbeat.C:
+			fmt.Fprint(c.Writer, ": keep-alive\n\n")
+		}
+		c.Writer.Flush()
+	}
+}
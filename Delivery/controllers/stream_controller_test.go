@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// StreamControllerSuite defines the test suite for StreamController
+type StreamControllerSuite struct {
+	suite.Suite
+	mockStreams *domain_mocks.MockIStreamUsecase
+	handler     *StreamController
+}
+
+func (s *StreamControllerSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.mockStreams = domain_mocks.NewMockIStreamUsecase(s.T())
+	s.handler = NewStreamController(s.mockStreams)
+}
+
+func TestStreamControllerSuite(t *testing.T) {
+	suite.Run(t, new(StreamControllerSuite))
+}
+
+func (s *StreamControllerSuite) TestStreamNotifications() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockStreams.On("Subscribe", mock.Anything, "user:1:notifications", "").Return(s.events(
+			domain.StreamEvent{ID: "1-0", Type: domain.StreamEventNotification, Data: json.RawMessage(`{"id":"n1"}`)},
+		), nil)
+
+		c, w := newTestContext(http.MethodGet, "/notifications/stream", "", "1")
+		s.handler.StreamNotifications(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Equal("text/event-stream", w.Header().Get("Content-Type"))
+		s.Equal("retry: 3000\n\nid: 1-0\nevent: notification\ndata: {\"id\":\"n1\"}\n\n", w.Body.String())
+	})
+
+	s.Run("Failure", func() {
+		s.SetupTest()
+		s.mockStreams.On("Subscribe", mock.Anything, "user:1:notifications", "").Return(nil, errors.New("redis down"))
+
+		c, w := newTestContext(http.MethodGet, "/notifications/stream", "", "1")
+		s.handler.StreamNotifications(c)
+
+		s.Equal(http.StatusInternalServerError, w.Code)
+	})
+}
+
+func (s *StreamControllerSuite) TestStreamPost() {
+	postID := "507f1f77bcf86cd799439011"
+
+	s.Run("ResumesFromHeader", func() {
+		s.SetupTest()
+		s.mockStreams.On("Subscribe", mock.Anything, "post:"+postID, "5-0").Return(s.events(
+			domain.StreamEvent{ID: "6-0", Type: domain.StreamEventCommentDeleted, Data: json.RawMessage(`{"id":"c1"}`)},
+		), nil)
+
+		c, w := newTestContext(http.MethodGet, "/blogs/"+postID+"/stream?last_event_id=1-0", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: postID}}
+		c.Request.Header.Set("Last-Event-ID", "5-0")
+		s.handler.StreamPost(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), "id: 6-0\nevent: comment_deleted\n")
+	})
+
+	s.Run("ResumesFromQuery", func() {
+		s.SetupTest()
+		s.mockStreams.On("Subscribe", mock.Anything, "post:"+postID, "1-0").Return(s.events(
+			domain.StreamEvent{Type: domain.StreamEventReset, Data: json.RawMessage(`{}`)},
+		), nil)
+
+		c, w := newTestContext(http.MethodGet, "/blogs/"+postID+"/stream?last_event_id=1-0", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: postID}}
+		s.handler.StreamPost(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), "event: reset\ndata: {}\n\n")
+		s.NotContains(w.Body.String(), "id: ")
+	})
+
+	s.Run("InvalidID", func() {
+		s.SetupTest()
+
+		c, w := newTestContext(http.MethodGet, "/blogs/nope/stream", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: "nope"}}
+		s.handler.StreamPost(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func (s *StreamControllerSuite) TestHeartbeat() {
+	s.handler.Heartbeat = time.Millisecond
+	events := make(chan domain.StreamEvent)
+	s.mockStreams.On("Subscribe", mock.Anything, "user:1:notifications", "").Return((<-chan domain.StreamEvent)(events), nil)
+	time.AfterFunc(50*time.Millisecond, func() { close(events) })
+
+	c, w := newTestContext(http.MethodGet, "/notifications/stream", "", "1")
+	s.handler.StreamNotifications(c)
+
+	s.Contains(w.Body.String(), ": keep-alive\n\n")
+}
+
+// events returns a stream that sends the events and then closes
+func (s *StreamControllerSuite) events(events ...domain.StreamEvent) <-chan domain.StreamEvent {
+	stream := make(chan domain.StreamEvent, len(events))
+	for _, event := range events {
+		stream <- event
+	}
+	close(stream)
+	return stream
+}
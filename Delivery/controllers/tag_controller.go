@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TagController struct {
+	Tags domain.ITagUsecase
+}
+
+func NewTagController(tags domain.ITagUsecase) *TagController {
+	return &TagController{Tags: tags}
+}
+
+// Autocomplete suggests the tags whose name or a synonym starts with q, most used first
+func (tc *TagController) Autocomplete(c *gin.Context) {
+	query, ok := bindTagQuery(c)
+	if !ok {
+		return
+	}
+	tags, err := tc.Tags.Autocomplete(query.Prefix, query.Limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load tags"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"tags": dto.ToTagResponses(tags)})
+}
+
+// Popular lists the tags on the most posts
+func (tc *TagController) Popular(c *gin.Context) {
+	query, ok := bindTagQuery(c)
+	if !ok {
+		return
+	}
+	tags, err := tc.Tags.Popular(query.Limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load tags"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"tags": dto.ToTagResponses(tags)})
+}
+
+// GetTag finds a tag by its slug or a synonym
+func (tc *TagController) GetTag(c *gin.Context) {
+	tag, err := tc.Tags.Get(c.Param("slug"))
+	if err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToTagResponse(tag))
+}
+
+func (tc *TagController) CreateTag(c *gin.Context) {
+	var req dto.TagRequest
+	if !bindTagRequest(c, &req) {
+		return
+	}
+	created, err := tc.Tags.Create(req.ToDomain())
+	if err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, dto.ToTagResponse(created))
+}
+
+// UpdateTag replaces the description and synonyms of a tag, the name is changed with RenameTag
+func (tc *TagController) UpdateTag(c *gin.Context) {
+	var req dto.TagRequest
+	if !bindTagRequest(c, &req) {
+		return
+	}
+	updated, err := tc.Tags.Update(c.Param("slug"), req.ToDomain())
+	if err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToTagResponse(updated))
+}
+
+// RenameTag renames a tag and retags its posts, the old slug stays a synonym
+func (tc *TagController) RenameTag(c *gin.Context) {
+	var req dto.TagRenameRequest
+	if !bindTagRequest(c, &req) {
+		return
+	}
+	renamed, err := tc.Tags.Rename(c.Param("slug"), req.Name)
+	if err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToTagResponse(renamed))
+}
+
+// MergeTag folds a tag into another one, its posts are retagged and it becomes a synonym
+func (tc *TagController) MergeTag(c *gin.Context) {
+	var req dto.TagMergeRequest
+	if !bindTagRequest(c, &req) {
+		return
+	}
+	merged, err := tc.Tags.Merge(c.Param("slug"), req.Into)
+	if err != nil {
+		c.JSON(tagErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToTagResponse(merged))
+}
+
+func bindTagQuery(c *gin.Context) (dto.TagQuery, bool) {
+	var query dto.TagQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query"})
+		return query, false
+	}
+	if err := validate.Struct(query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return query, false
+	}
+	return query, true
+}
+
+func bindTagRequest(c *gin.Context, req any) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return false
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	return true
+}
+
+func tagErrorStatus(err error) int {
+	switch err {
+	case domain.ErrTagNotFound:
+		return http.StatusNotFound
+	case domain.ErrTagConflict:
+		return http.StatusConflict
+	case domain.ErrInvalidTagName, domain.ErrTagMergeSelf:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// TagControllerSuite defines the test suite for TagController
+type TagControllerSuite struct {
+	suite.Suite
+	mockTags *domain_mocks.MockITagUsecase
+	handler  *TagController
+}
+
+func (s *TagControllerSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.mockTags = domain_mocks.NewMockITagUsecase(s.T())
+	s.handler = NewTagController(s.mockTags)
+}
+
+func TestTagControllerSuite(t *testing.T) {
+	suite.Run(t, new(TagControllerSuite))
+}
+
+func (s *TagControllerSuite) TestAutocomplete() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockTags.On("Autocomplete", "go", 5).Return([]*domain.Tag{{Name: "Go", Slug: "go", UsageCount: 3}}, nil)
+
+		c, w := newTestContext(http.MethodGet, "/tags/autocomplete?q=go&limit=5", "", "1")
+		s.handler.Autocomplete(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response struct {
+			Tags []map[string]any `json:"tags"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Len(response.Tags, 1)
+		s.Equal("go", response.Tags[0]["slug"])
+		s.Equal([]any{}, response.Tags[0]["synonyms"])
+	})
+
+	s.Run("LimitTooHigh", func() {
+		s.SetupTest()
+
+		c, w := newTestContext(http.MethodGet, "/tags/autocomplete?q=go&limit=1000", "", "1")
+		s.handler.Autocomplete(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func (s *TagControllerSuite) TestPopular() {
+	s.mockTags.On("Popular", 0).Return(nil, errors.New("db error"))
+
+	c, w := newTestContext(http.MethodGet, "/tags/popular", "", "1")
+	s.handler.Popular(c)
+
+	s.Equal(http.StatusInternalServerError, w.Code)
+}
+
+func (s *TagControllerSuite) TestCreateTag() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockTags.On("Create", &domain.Tag{Name: "Go", Synonyms: []string{"golang"}}).Return(&domain.Tag{Name: "Go", Slug: "go", Synonyms: []string{"golang"}}, nil)
+
+		c, w := newTestContext(http.MethodPost, "/tags", `{"name":"Go","synonyms":["golang"]}`, "1")
+		s.handler.CreateTag(c)
+
+		s.Equal(http.StatusCreated, w.Code)
+	})
+
+	s.Run("Conflict", func() {
+		s.SetupTest()
+		s.mockTags.On("Create", mock.Anything).Return(nil, domain.ErrTagConflict)
+
+		c, w := newTestContext(http.MethodPost, "/tags", `{"name":"Go"}`, "1")
+		s.handler.CreateTag(c)
+
+		s.Equal(http.StatusConflict, w.Code)
+	})
+}
+
+func (s *TagControllerSuite) TestRenameTag() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockTags.On("Rename", "golang", "Go").Return(&domain.Tag{Name: "Go", Slug: "go", Synonyms: []string{"golang"}, UsageCount: 7}, nil)
+
+		c, w := newTestContext(http.MethodPost, "/tags/golang/rename", `{"name":"Go"}`, "1")
+		c.Params = gin.Params{{Key: "slug", Value: "golang"}}
+		s.handler.RenameTag(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), `"usage_count":7`)
+	})
+
+	s.Run("MissingName", func() {
+		s.SetupTest()
+
+		c, w := newTestContext(http.MethodPost, "/tags/golang/rename", `{}`, "1")
+		c.Params = gin.Params{{Key: "slug", Value: "golang"}}
+		s.handler.RenameTag(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func (s *TagControllerSuite) TestMergeTag() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockTags.On("Merge", "golang", "go").Return(&domain.Tag{Name: "Go", Slug: "go", Synonyms: []string{"golang"}}, nil)
+
+		c, w := newTestContext(http.MethodPost, "/tags/golang/merge", `{"into":"go"}`, "1")
+		c.Params = gin.Params{{Key: "slug", Value: "golang"}}
+		s.handler.MergeTag(c)
+
+		s.Equal(http.StatusOK, w.Code)
+	})
+
+	s.Run("Self", func() {
+		s.SetupTest()
+		s.mockTags.On("Merge", "go", "go").Return(nil, domain.ErrTagMergeSelf)
+
+		c, w := newTestContext(http.MethodPost, "/tags/go/merge", `{"into":"go"}`, "1")
+		c.Params = gin.Params{{Key: "slug", Value: "go"}}
+		s.handler.MergeTag(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("NotFound", func() {
+		s.SetupTest()
+		s.mockTags.On("Merge", "golang", "go").Return(nil, domain.ErrTagNotFound)
+
+		c, w := newTestContext(http.MethodPost, "/tags/golang/merge", `{"into":"go"}`, "1")
+		c.Params = gin.Params{{Key: "slug", Value: "golang"}}
+		s.handler.MergeTag(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+	})
+}
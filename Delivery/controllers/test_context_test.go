@@ -0,0 +1,23 @@
+package controllers
+
+import (
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newTestContext makes a request of the logged in user, a body is sent as JSON and no user ID
+// makes an anonymous request
+func newTestContext(method, url, body, userID string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, url, strings.NewReader(body))
+	if body != "" {
+		c.Request.Header.Set("Content-Type", "application/json")
+	}
+	if userID != "" {
+		c.Set("user_id", userID)
+	}
+	return c, w
+}
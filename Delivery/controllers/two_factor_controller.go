@@ -0,0 +1,331 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requireSecondFactor answers the login with a pre-auth challenge when the user has
+// two-factor enabled, or when the policy requires it for the user's role.
+// It returns true when the response was written and the login must stop here.
+func (ac *AuthController) requireSecondFactor(c *gin.Context, user *domain.User) bool {
+	enabled, err := ac.TwoFactorUsecase.IsEnabled(user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check two-factor status"})
+		return true
+	}
+
+	setupRequired := false
+	if !enabled {
+		setupRequired, err = ac.TwoFactorUsecase.IsRequired(user.Role)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check two-factor policy"})
+			return true
+		}
+	}
+	if !enabled && !setupRequired {
+		return false
+	}
+
+	preAuthToken, expiresAt, err := ac.AuthService.GeneratePreAuthToken(*user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		return true
+	}
+
+	message := "Two-factor authentication required"
+	if setupRequired {
+		message = "Two-factor authentication must be set up before logging in"
+	}
+	c.JSON(http.StatusAccepted, dto.TwoFactorChallengeResponse{
+		Message:                message,
+		TwoFactorRequired:      enabled,
+		TwoFactorSetupRequired: setupRequired,
+		PreAuthToken:           preAuthToken,
+		ExpiresAt:              expiresAt,
+	})
+	return true
+}
+
+// preAuthUser resolves the user behind a pre-auth token, writing a 401 when it is not valid
+func (ac *AuthController) preAuthUser(c *gin.Context, preAuthToken string) (*domain.User, bool) {
+	claims, err := ac.AuthService.ValidatePreAuthToken(preAuthToken)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired pre-auth token"})
+		return nil, false
+	}
+	userID, _ := claims["sub"].(string)
+	user, err := ac.UserUsecase.FindUserByID(userID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired pre-auth token"})
+		return nil, false
+	}
+	// once too many wrong codes locked the user, no pre-auth token issued before goes on
+	if retryAfter, err := ac.LoginAttemptUsecase.CheckUser(user.ID); err == domain.ErrAccountLocked {
+		refuseLogin(c, err, retryAfter)
+		return nil, false
+	}
+	return user, true
+}
+
+// secondFactorFailed counts a wrong two-factor code of the user. When that locks the user
+// it answers 423 like a locked login and returns true.
+func (ac *AuthController) secondFactorFailed(c *gin.Context, userID string) bool {
+	if ac.LoginAttemptUsecase.RecordSecondFactorFailure(userID, c.ClientIP()) != domain.ErrAccountLocked {
+		return false
+	}
+	retryAfter, _ := ac.LoginAttemptUsecase.CheckUser(userID)
+	refuseLogin(c, domain.ErrAccountLocked, retryAfter)
+	return true
+}
+
+// VerifyTwoFactorLogin completes a two-step login with a TOTP or recovery code
+func (ac *AuthController) VerifyTwoFactorLogin(c *gin.Context) {
+	var req dto.TwoFactorLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, ok := ac.preAuthUser(c, req.PreAuthToken)
+	if !ok {
+		return
+	}
+
+	if err := ac.TwoFactorUsecase.Verify(user.ID, req.Code); err != nil {
+		recordSecurityEvent(c, ac.SecurityEventUsecase, domain.SecurityEvent{
+			Type:    domain.SecurityEventLogin,
+			UserID:  user.ID,
+			Outcome: domain.SecurityEventFailure,
+			Details: "method two_factor",
+		})
+		if err == domain.ErrTwoFactorInvalidCode && ac.secondFactorFailed(c, user.ID) {
+			return
+		}
+		c.JSON(http.StatusUnauthorized, gin.H{"error": domain.ErrTwoFactorInvalidCode.Error()})
+		return
+	}
+	_ = ac.LoginAttemptUsecase.RecordSecondFactorSuccess(user.ID)
+
+	response, ok := ac.issueSession(c, user, "two_factor")
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK,
+		gin.H{
+			"message": "Login successful",
+			"user":    dto.ToUserResponse(*user),
+			"tokens": dto.LoginResponse{
+				AccessToken:  response.AccessToken,
+				RefreshToken: response.RefreshToken,
+			}})
+}
+
+// TwoFactorSetup starts enrollment for a user the policy forced to set up two-factor at login
+func (ac *AuthController) TwoFactorSetup(c *gin.Context) {
+	var req dto.TwoFactorSetupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, ok := ac.preAuthUser(c, req.PreAuthToken)
+	if !ok {
+		return
+	}
+	ac.enroll(c, user.ID)
+}
+
+// TwoFactorSetupConfirm confirms a forced enrollment and logs the user in
+func (ac *AuthController) TwoFactorSetupConfirm(c *gin.Context) {
+	var req dto.TwoFactorLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, ok := ac.preAuthUser(c, req.PreAuthToken)
+	if !ok {
+		return
+	}
+
+	recoveryCodes, err := ac.TwoFactorUsecase.Confirm(user.ID, req.Code)
+	if err != nil {
+		if err == domain.ErrTwoFactorInvalidCode && ac.secondFactorFailed(c, user.ID) {
+			return
+		}
+		c.JSON(twoFactorErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	_ = ac.LoginAttemptUsecase.RecordSecondFactorSuccess(user.ID)
+
+	response, ok := ac.issueSession(c, user, "two_factor_setup")
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK,
+		gin.H{
+			"message":        "Two-factor authentication enabled, login successful",
+			"user":           dto.ToUserResponse(*user),
+			"recovery_codes": recoveryCodes,
+			"tokens": dto.LoginResponse{
+				AccessToken:  response.AccessToken,
+				RefreshToken: response.RefreshToken,
+			}})
+}
+
+// EnrollTwoFactor starts enrollment for the logged in user
+func (ac *AuthController) EnrollTwoFactor(c *gin.Context) {
+	ac.enroll(c, c.GetString("user_id"))
+}
+
+func (ac *AuthController) enroll(c *gin.Context, userID string) {
+	enrollment, err := ac.TwoFactorUsecase.Enroll(userID)
+	if err != nil {
+		c.JSON(twoFactorErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Scan the QR code with your authenticator app, then confirm with a code",
+		"enrollment": dto.ToTwoFactorEnrollmentResponse(enrollment),
+	})
+}
+
+// ConfirmTwoFactor enables two-factor for the logged in user and returns the recovery codes
+func (ac *AuthController) ConfirmTwoFactor(c *gin.Context) {
+	var req dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	recoveryCodes, err := ac.TwoFactorUsecase.Confirm(c.GetString("user_id"), req.Code)
+	if err != nil {
+		c.JSON(twoFactorErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":        "Two-factor authentication enabled. Store these recovery codes somewhere safe",
+		"recovery_codes": recoveryCodes,
+	})
+}
+
+func (ac *AuthController) DisableTwoFactor(c *gin.Context) {
+	var req dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// users whose role requires two-factor cannot turn it off
+	required, err := ac.TwoFactorUsecase.IsRequired(domain.UserRole(c.GetString("role")))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check two-factor policy"})
+		return
+	}
+	if required {
+		c.JSON(http.StatusForbidden, gin.H{"error": domain.ErrTwoFactorRequired.Error()})
+		return
+	}
+
+	if err := ac.TwoFactorUsecase.Disable(c.GetString("user_id"), req.Code); err != nil {
+		if err == domain.ErrTwoFactorInvalidCode && ac.secondFactorFailed(c, c.GetString("user_id")) {
+			return
+		}
+		c.JSON(twoFactorErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Two-factor authentication disabled"})
+}
+
+func (ac *AuthController) RegenerateRecoveryCodes(c *gin.Context) {
+	var req dto.TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	recoveryCodes, err := ac.TwoFactorUsecase.RegenerateRecoveryCodes(c.GetString("user_id"), req.Code)
+	if err != nil {
+		if err == domain.ErrTwoFactorInvalidCode && ac.secondFactorFailed(c, c.GetString("user_id")) {
+			return
+		}
+		c.JSON(twoFactorErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":        "Recovery codes regenerated, the previous codes no longer work",
+		"recovery_codes": recoveryCodes,
+	})
+}
+
+func (ac *AuthController) GetTwoFactorPolicy(c *gin.Context) {
+	policy, err := ac.TwoFactorUsecase.GetPolicy()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load two-factor policy"})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToTwoFactorPolicyResponse(policy))
+}
+
+func (ac *AuthController) UpdateTwoFactorPolicy(c *gin.Context) {
+	var req dto.TwoFactorPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy := dto.ToDomainTwoFactorPolicy(req)
+	policy.UpdatedBy = c.GetString("user_id")
+	if err := ac.TwoFactorUsecase.UpdatePolicy(policy); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToTwoFactorPolicyResponse(policy))
+}
+
+func twoFactorErrorStatus(err error) int {
+	switch err {
+	case domain.ErrTwoFactorInvalidCode:
+		return http.StatusUnauthorized
+	case domain.ErrTwoFactorNotFound, domain.ErrUserNotFound:
+		return http.StatusNotFound
+	case domain.ErrTwoFactorAlreadyEnabled, domain.ErrTwoFactorNotEnabled:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
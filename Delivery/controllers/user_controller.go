@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"io"
 	"net/http"
 
@@ -15,11 +16,12 @@ import (
 var validate = validator.New()
 
 type UserController struct {
-	uc domain.IUserUsecase
+	uc             domain.IUserUsecase
+	securityEvents domain.ISecurityEventUsecase
 }
 
-func NewUserController(uc domain.IUserUsecase) *UserController {
-	return &UserController{uc: uc}
+func NewUserController(uc domain.IUserUsecase, securityEvents domain.ISecurityEventUsecase) *UserController {
+	return &UserController{uc: uc, securityEvents: securityEvents}
 }
 
 func (ctrl *UserController) UpdateProfile(c *gin.Context) {
@@ -73,6 +75,7 @@ func (ctrl *UserController) UpdateProfile(c *gin.Context) {
 		AvatarData: avatarData,
 		FirstName:  req.FirstName,
 		LastName:   req.LastName,
+		Language:   req.Language,
 	}
 
 	updatedUser, err := ctrl.uc.UpdateProfile(userID.(string), update, fileName)
@@ -108,9 +111,28 @@ func (ctrl *UserController) ChangePassword(c *gin.Context) {
 		return
 	}
 
-	if err := ctrl.uc.ChangePassword(userID, req.OldPassword, req.NewPassword); err != nil {
+	err := ctrl.uc.ChangePassword(userID, req.OldPassword, req.NewPassword)
+	recordSecurityEvent(c, ctrl.securityEvents, domain.SecurityEvent{
+		Type:    domain.SecurityEventPasswordChange,
+		UserID:  userID,
+		Outcome: securityEventOutcome(err),
+	})
+	if err != nil {
+		if passwordRefused(c, err) {
+			return
+		}
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "Password changed successfully"})
 }
+
+// passwordRefused answers with every failed rule when err comes from the password policy
+func passwordRefused(c *gin.Context, err error) bool {
+	var policyErr *domain.PasswordPolicyError
+	if !errors.As(err, &policyErr) {
+		return false
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": "Password does not meet the password policy", "violations": policyErr.Violations})
+	return true
+}
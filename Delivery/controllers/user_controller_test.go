@@ -22,18 +22,22 @@ import (
 // UserControllerSuite defines the test suite for UserController
 type UserControllerSuite struct {
 	suite.Suite
-	mockUserUsecase *domain_mocks.MockIUserUsecase
-	handler         *UserController
-	validate        *validator.Validate
+	mockUserUsecase          *domain_mocks.MockIUserUsecase
+	mockSecurityEventUsecase *domain_mocks.MockISecurityEventUsecase
+	handler                  *UserController
+	validate                 *validator.Validate
 }
 
 // SetupTest initializes the mocks and handler before each test
 func (s *UserControllerSuite) SetupTest() {
 	s.mockUserUsecase = domain_mocks.NewMockIUserUsecase(s.T())
+	s.mockSecurityEventUsecase = domain_mocks.NewMockISecurityEventUsecase(s.T())
 	s.handler = &UserController{
-		uc: s.mockUserUsecase,
+		uc:             s.mockUserUsecase,
+		securityEvents: s.mockSecurityEventUsecase,
 	}
 	s.validate = validator.New()
+	s.resetMocks()
 }
 
 // TestUserControllerSuite runs the test suite
@@ -486,9 +490,45 @@ func (s *UserControllerSuite) TestChangePassword() {
 		s.Equal("invalid old password", response["error"])
 		s.resetMocks()
 	})
+
+	s.Run("PasswordPolicyViolation", func() {
+		userID := "1"
+		changePasswordRequest := dto.ChangePasswordRequest{
+			OldPassword: "oldpassword123",
+			NewPassword: "newpassword",
+		}
+
+		s.mockUserUsecase.On("ChangePassword", userID, changePasswordRequest.OldPassword, changePasswordRequest.NewPassword).Return(&domain.PasswordPolicyError{Violations: []domain.PasswordViolation{
+			{Rule: domain.PasswordRuleUppercase, Message: "must contain an uppercase letter"},
+		}})
+
+		body, _ := json.Marshal(changePasswordRequest)
+		req := httptest.NewRequest(http.MethodPost, "/change-password", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+		c.Set("user_id", userID)
+
+		s.handler.ChangePassword(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+		var response struct {
+			Error      string                     `json:"error"`
+			Violations []domain.PasswordViolation `json:"violations"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("Password does not meet the password policy", response.Error)
+		s.Equal([]domain.PasswordViolation{{Rule: domain.PasswordRuleUppercase, Message: "must contain an uppercase letter"}}, response.Violations)
+		s.resetMocks()
+	})
 }
 
 func (s *UserControllerSuite) resetMocks() {
 	s.mockUserUsecase.ExpectedCalls = nil
 	s.mockUserUsecase.Calls = nil
+	s.mockSecurityEventUsecase.ExpectedCalls = nil
+	s.mockSecurityEventUsecase.Calls = nil
+	// the audit log is written on most paths, tests that care assert the recorded events
+	s.mockSecurityEventUsecase.On("Record", mock.Anything).Return(nil).Maybe()
 }
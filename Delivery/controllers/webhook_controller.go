@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"g6/blog-api/Delivery/dto"
+	domain "g6/blog-api/Domain"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookController struct {
+	Webhooks domain.IWebhookUsecase
+}
+
+func NewWebhookController(webhooks domain.IWebhookUsecase) *WebhookController {
+	return &WebhookController{Webhooks: webhooks}
+}
+
+// ListWebhooks lists every webhook, without their secrets
+func (wc *WebhookController) ListWebhooks(c *gin.Context) {
+	webhooks, err := wc.Webhooks.List()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load webhooks"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"webhooks": dto.ToWebhookResponses(webhooks)})
+}
+
+// CreateWebhook subscribes a URL to events, the response holds the signing secret
+func (wc *WebhookController) CreateWebhook(c *gin.Context) {
+	req, ok := bindWebhookRequest(c)
+	if !ok {
+		return
+	}
+	webhook := req.ToDomain()
+	webhook.CreatedBy = c.GetString("user_id")
+
+	created, err := wc.Webhooks.Create(webhook)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Webhook created. Keep the secret to verify the signatures of its deliveries",
+		"webhook": dto.CreatedWebhookResponse{
+			WebhookResponse: dto.ToWebhookResponse(created),
+			Secret:          created.Secret,
+		},
+	})
+}
+
+func (wc *WebhookController) GetWebhook(c *gin.Context) {
+	webhook, err := wc.Webhooks.FindByID(c.Param("id"))
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToWebhookResponse(webhook))
+}
+
+// UpdateWebhook replaces a webhook's URL, events and state, and its secret when one is given
+func (wc *WebhookController) UpdateWebhook(c *gin.Context) {
+	req, ok := bindWebhookRequest(c)
+	if !ok {
+		return
+	}
+	updated, err := wc.Webhooks.Update(c.Param("id"), req.ToDomain())
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToWebhookResponse(updated))
+}
+
+// DeleteWebhook removes a webhook with its delivery log, queued deliveries are not sent
+func (wc *WebhookController) DeleteWebhook(c *gin.Context) {
+	if err := wc.Webhooks.Delete(c.Param("id")); err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Webhook deleted"})
+}
+
+// SendTestEvent sends a webhook.test event right away and returns how the receiver answered
+func (wc *WebhookController) SendTestEvent(c *gin.Context) {
+	delivery, err := wc.Webhooks.SendTest(c.Param("id"))
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToWebhookDeliveryDetailResponse(delivery))
+}
+
+// ListDeliveries pages through a webhook's delivery log newest first, filtered by status and event
+func (wc *WebhookController) ListDeliveries(c *gin.Context) {
+	var query dto.WebhookDeliveryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query"})
+		return
+	}
+	if err := validate.Struct(query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filter := query.ToFilter(c.Param("id"))
+	deliveries, total, err := wc.Webhooks.Deliveries(filter)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"deliveries": dto.ToWebhookDeliveryResponses(deliveries),
+		"total":      total,
+		"page":       max(filter.Page, 1),
+	})
+}
+
+// GetDelivery shows one delivery with its payload and attempts
+func (wc *WebhookController) GetDelivery(c *gin.Context) {
+	delivery, err := wc.Webhooks.FindDelivery(c.Param("id"), c.Param("delivery_id"))
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, dto.ToWebhookDeliveryDetailResponse(delivery))
+}
+
+func bindWebhookRequest(c *gin.Context) (dto.WebhookRequest, bool) {
+	var req dto.WebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return req, false
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return req, false
+	}
+	return req, true
+}
+
+func webhookErrorStatus(err error) int {
+	switch err {
+	case domain.ErrWebhookNotFound, domain.ErrWebhookDeliveryNotFound:
+		return http.StatusNotFound
+	case domain.ErrInvalidWebhookURL, domain.ErrUnknownWebhookEvent:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// WebhookControllerSuite defines the test suite for WebhookController
+type WebhookControllerSuite struct {
+	suite.Suite
+	mockWebhooks *domain_mocks.MockIWebhookUsecase
+	handler      *WebhookController
+}
+
+func (s *WebhookControllerSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.mockWebhooks = domain_mocks.NewMockIWebhookUsecase(s.T())
+	s.handler = NewWebhookController(s.mockWebhooks)
+}
+
+func TestWebhookControllerSuite(t *testing.T) {
+	suite.Run(t, new(WebhookControllerSuite))
+}
+
+func (s *WebhookControllerSuite) TestCreateWebhook() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("Create", &domain.Webhook{
+			URL:       "https://hooks.example.com/blog",
+			Events:    []domain.WebhookEventType{domain.WebhookPostPublished, domain.WebhookCommentCreated},
+			Active:    true,
+			CreatedBy: "1",
+		}).Return(&domain.Webhook{ID: "w1", URL: "https://hooks.example.com/blog", Secret: "whsec_abc", Active: true}, nil)
+
+		c, w := newTestContext(http.MethodPost, "/webhooks", `{"url":"https://hooks.example.com/blog","events":["post.published","comment.created"]}`, "1")
+		s.handler.CreateWebhook(c)
+
+		s.Equal(http.StatusCreated, w.Code)
+		var response struct {
+			Webhook map[string]any `json:"webhook"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("w1", response.Webhook["id"])
+		s.Equal("whsec_abc", response.Webhook["secret"])
+	})
+
+	s.Run("UnknownEvent", func() {
+		s.SetupTest()
+
+		c, w := newTestContext(http.MethodPost, "/webhooks", `{"url":"https://hooks.example.com","events":["user.deleted"]}`, "1")
+		s.handler.CreateWebhook(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	s.Run("InvalidURL", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("Create", mock.Anything).Return(nil, domain.ErrInvalidWebhookURL)
+
+		c, w := newTestContext(http.MethodPost, "/webhooks", `{"url":"ftp://files.example.com","events":["post.updated"]}`, "1")
+		s.handler.CreateWebhook(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func (s *WebhookControllerSuite) TestListWebhooks() {
+	s.mockWebhooks.On("List").Return([]*domain.Webhook{{ID: "w1", URL: "https://hooks.example.com", Secret: "whsec_abc", Events: []domain.WebhookEventType{domain.WebhookPostDeleted}}}, nil)
+
+	c, w := newTestContext(http.MethodGet, "/webhooks", "", "1")
+	s.handler.ListWebhooks(c)
+
+	s.Equal(http.StatusOK, w.Code)
+	s.NotContains(w.Body.String(), "whsec_abc")
+	s.Contains(w.Body.String(), `"events":["post.deleted"]`)
+}
+
+func (s *WebhookControllerSuite) TestUpdateWebhook() {
+	s.Run("Disable", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("Update", "w1", &domain.Webhook{
+			URL:    "https://hooks.example.com",
+			Events: []domain.WebhookEventType{domain.WebhookPostUpdated},
+		}).Return(&domain.Webhook{ID: "w1"}, nil)
+
+		c, w := newTestContext(http.MethodPut, "/webhooks/w1", `{"url":"https://hooks.example.com","events":["post.updated"],"active":false}`, "1")
+		c.Params = gin.Params{{Key: "id", Value: "w1"}}
+		s.handler.UpdateWebhook(c)
+
+		s.Equal(http.StatusOK, w.Code)
+	})
+
+	s.Run("NotFound", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("Update", "w1", mock.Anything).Return(nil, domain.ErrWebhookNotFound)
+
+		c, w := newTestContext(http.MethodPut, "/webhooks/w1", `{"url":"https://hooks.example.com","events":["post.updated"]}`, "1")
+		c.Params = gin.Params{{Key: "id", Value: "w1"}}
+		s.handler.UpdateWebhook(c)
+
+		s.Equal(http.StatusNotFound, w.Code)
+	})
+}
+
+func (s *WebhookControllerSuite) TestDeleteWebhook() {
+	s.mockWebhooks.On("Delete", "w1").Return(nil)
+
+	c, w := newTestContext(http.MethodDelete, "/webhooks/w1", "", "1")
+	c.Params = gin.Params{{Key: "id", Value: "w1"}}
+	s.handler.DeleteWebhook(c)
+
+	s.Equal(http.StatusOK, w.Code)
+}
+
+func (s *WebhookControllerSuite) TestSendTestEvent() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("SendTest", "w1").Return(&domain.WebhookDelivery{
+			ID:        "d1",
+			WebhookID: "w1",
+			Event:     domain.WebhookTest,
+			Payload:   `{"type":"webhook.test"}`,
+			Status:    domain.WebhookDeliveryDead,
+			Attempts:  []domain.WebhookAttempt{{At: time.Now(), StatusCode: 401, Error: "unexpected response 401 Unauthorized", Duration: 12 * time.Millisecond}},
+		}, nil)
+
+		c, w := newTestContext(http.MethodPost, "/webhooks/w1/test", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: "w1"}}
+		s.handler.SendTestEvent(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		var response struct {
+			Status   string `json:"status"`
+			Attempts []struct {
+				StatusCode int   `json:"status_code"`
+				DurationMs int64 `json:"duration_ms"`
+			} `json:"attempts"`
+			Payload map[string]any `json:"payload"`
+		}
+		json.Unmarshal(w.Body.Bytes(), &response)
+		s.Equal("dead", response.Status)
+		s.Equal(401, response.Attempts[0].StatusCode)
+		s.Equal(int64(12), response.Attempts[0].DurationMs)
+		s.Equal("webhook.test", response.Payload["type"])
+	})
+
+	s.Run("Failure", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("SendTest", "w1").Return(nil, errors.New("db error"))
+
+		c, w := newTestContext(http.MethodPost, "/webhooks/w1/test", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: "w1"}}
+		s.handler.SendTestEvent(c)
+
+		s.Equal(http.StatusInternalServerError, w.Code)
+	})
+}
+
+func (s *WebhookControllerSuite) TestListDeliveries() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		deliveries := []*domain.WebhookDelivery{{ID: "d1", WebhookID: "w1", Payload: `{"secret":"no"}`, Status: domain.WebhookDeliveryDelivered}}
+		s.mockWebhooks.On("Deliveries", domain.WebhookDeliveryFilter{WebhookID: "w1", Status: domain.WebhookDeliveryDelivered, Page: 2}).Return(deliveries, int64(21), nil)
+
+		c, w := newTestContext(http.MethodGet, "/webhooks/w1/deliveries?status=delivered&page=2", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: "w1"}}
+		s.handler.ListDeliveries(c)
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Contains(w.Body.String(), `"total":21`)
+		s.NotContains(w.Body.String(), "payload")
+	})
+
+	s.Run("InvalidStatus", func() {
+		s.SetupTest()
+
+		c, w := newTestContext(http.MethodGet, "/webhooks/w1/deliveries?status=lost", "", "1")
+		c.Params = gin.Params{{Key: "id", Value: "w1"}}
+		s.handler.ListDeliveries(c)
+
+		s.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func (s *WebhookControllerSuite) TestGetDelivery() {
+	s.mockWebhooks.On("FindDelivery", "w1", "d1").Return(nil, domain.ErrWebhookDeliveryNotFound)
+
+	c, w := newTestContext(http.MethodGet, "/webhooks/w1/deliveries/d1", "", "1")
+	c.Params = gin.Params{{Key: "id", Value: "w1"}, {Key: "delivery_id", Value: "d1"}}
+	s.handler.GetDelivery(c)
+
+	s.Equal(http.StatusNotFound, w.Code)
+}
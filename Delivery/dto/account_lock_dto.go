@@ -0,0 +1,35 @@
+package dto
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+// UnlockAccountRequest carries the token from the unlock email
+type UnlockAccountRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type AccountLockResponse struct {
+	Identifier  string    `json:"identifier"`
+	UserID      string    `json:"user_id,omitempty"`
+	Failures    int64     `json:"failures"`
+	IP          string    `json:"ip"`
+	LockedAt    time.Time `json:"locked_at"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+func ToAccountLockResponses(locks []*domain.AccountLock) []AccountLockResponse {
+	responses := make([]AccountLockResponse, 0, len(locks))
+	for _, lock := range locks {
+		responses = append(responses, AccountLockResponse{
+			Identifier:  lock.Identifier,
+			UserID:      lock.UserID,
+			Failures:    lock.Failures,
+			IP:          lock.IP,
+			LockedAt:    lock.LockedAt,
+			LockedUntil: lock.LockedUntil,
+		})
+	}
+	return responses
+}
@@ -6,9 +6,10 @@ import (
 )
 
 type BlogPostRequest struct {
-	Title   string   `json:"title" binding:"required"`
-	Content string   `json:"content" binding:"required"`
-	Tags    []string `json:"tags"`
+	Title    string   `json:"title" binding:"required"`
+	Content  string   `json:"content" binding:"required"`
+	Tags     []string `json:"tags"`
+	Category string   `json:"category" binding:"required"` // ID or slug of the primary category
 }
 
 type BlogPostResponse struct {
@@ -18,6 +19,7 @@ type BlogPostResponse struct {
 	AuthorID        string    `json:"author_id"`
 	AuthorName      string    `json:"author_name"` // for easy access to author's name: first_name + last_name
 	Tags            []string  `json:"tags,omitempty"`
+	CategoryID      string    `json:"category_id,omitempty"`
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 	Likes           int       `json:"likes"`
@@ -68,6 +70,7 @@ func (b *BlogPostRequest) ToDomain() *domain.BlogPost {
 		Title:           b.Title,
 		Content:         b.Content,
 		Tags:            b.Tags,
+		CategoryID:      b.Category,
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
 		Likes:           0,
@@ -85,6 +88,7 @@ func (b *BlogPostResponse) Parse(blog *domain.BlogPost) {
 	b.AuthorID = blog.AuthorID
 	b.AuthorName = blog.AuthorName
 	b.Tags = blog.Tags
+	b.CategoryID = blog.CategoryID
 	b.CreatedAt = blog.CreatedAt
 	b.UpdatedAt = blog.UpdatedAt
 	b.Likes = blog.Likes
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+// CategoryRequest creates or replaces a category, Parent is the ID or slug of the category it is
+// under and empty for a top level category
+type CategoryRequest struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	Description string `json:"description" validate:"max=500"`
+	Parent      string `json:"parent" validate:"max=100"`
+}
+
+func (r CategoryRequest) ToDomain() *domain.Category {
+	return &domain.Category{
+		Name:        r.Name,
+		Description: r.Description,
+		ParentID:    r.Parent,
+	}
+}
+
+type CategoryResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description,omitempty"`
+	ParentID    string    `json:"parent_id,omitempty"`
+	Ancestors   []string  `json:"ancestors"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CategoryNodeResponse is a category of the tree with the categories under it
+type CategoryNodeResponse struct {
+	CategoryResponse
+	Children []CategoryNodeResponse `json:"children"`
+}
+
+func ToCategoryResponse(category *domain.Category) CategoryResponse {
+	ancestors := category.Ancestors
+	if ancestors == nil {
+		ancestors = []string{}
+	}
+	return CategoryResponse{
+		ID:          category.ID,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		ParentID:    category.ParentID,
+		Ancestors:   ancestors,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+	}
+}
+
+func ToCategoryTreeResponse(nodes []*domain.CategoryNode) []CategoryNodeResponse {
+	responses := make([]CategoryNodeResponse, 0, len(nodes))
+	for _, node := range nodes {
+		responses = append(responses, CategoryNodeResponse{
+			CategoryResponse: ToCategoryResponse(node.Category),
+			Children:         ToCategoryTreeResponse(node.Children),
+		})
+	}
+	return responses
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+// DigestSubscriptionRequest replaces the user's digest settings, authors are user IDs
+type DigestSubscriptionRequest struct {
+	Frequency string   `json:"frequency" validate:"required,oneof=off daily weekly"`
+	Authors   []string `json:"authors" validate:"max=100,dive,mongodb"`
+	Tags      []string `json:"tags" validate:"max=100,dive,min=1,max=50"`
+}
+
+func (r DigestSubscriptionRequest) ToDomain(userID string) *domain.DigestSubscription {
+	return &domain.DigestSubscription{
+		UserID:    userID,
+		Frequency: domain.DigestFrequency(r.Frequency),
+		Authors:   r.Authors,
+		Tags:      r.Tags,
+	}
+}
+
+type DigestSubscriptionResponse struct {
+	Frequency  string     `json:"frequency"`
+	Authors    []string   `json:"authors"`
+	Tags       []string   `json:"tags"`
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+	NextSendAt *time.Time `json:"next_send_at,omitempty"`
+}
+
+func ToDigestSubscriptionResponse(subscription *domain.DigestSubscription) DigestSubscriptionResponse {
+	response := DigestSubscriptionResponse{
+		Frequency: string(subscription.Frequency),
+		Authors:   subscription.Authors,
+		Tags:      subscription.Tags,
+	}
+	if response.Authors == nil {
+		response.Authors = []string{}
+	}
+	if response.Tags == nil {
+		response.Tags = []string{}
+	}
+	if !subscription.LastSentAt.IsZero() {
+		response.LastSentAt = &subscription.LastSentAt
+	}
+	if subscription.Frequency != domain.DigestOff && !subscription.NextSendAt.IsZero() {
+		response.NextSendAt = &subscription.NextSendAt
+	}
+	return response
+}
@@ -0,0 +1,66 @@
+package dto
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+type OutboxEmailQuery struct {
+	Status   string `form:"status" validate:"omitempty,oneof=pending sending sent dead"`
+	To       string `form:"to"`
+	Page     int    `form:"page" validate:"min=0"`
+	PageSize int    `form:"page_size" validate:"min=0"`
+}
+
+func (q OutboxEmailQuery) ToFilter() domain.OutboxEmailFilter {
+	return domain.OutboxEmailFilter{
+		Status:   domain.OutboxEmailStatus(q.Status),
+		To:       q.To,
+		Page:     q.Page,
+		PageSize: q.PageSize,
+	}
+}
+
+// OutboxEmailResponse leaves out the body and template data, they may hold codes and tokens
+type OutboxEmailResponse struct {
+	ID            string     `json:"id"`
+	To            string     `json:"to"`
+	Subject       string     `json:"subject,omitempty"`
+	Template      string     `json:"template,omitempty"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func ToOutboxEmailResponse(email *domain.OutboxEmail) OutboxEmailResponse {
+	response := OutboxEmailResponse{
+		ID:        email.ID,
+		To:        email.To,
+		Subject:   email.Subject,
+		Template:  email.Template,
+		Status:    string(email.Status),
+		Attempts:  email.Attempts,
+		LastError: email.LastError,
+		CreatedAt: email.CreatedAt,
+		UpdatedAt: email.UpdatedAt,
+	}
+	if email.Status == domain.OutboxEmailPending || email.Status == domain.OutboxEmailSending {
+		response.NextAttemptAt = &email.NextAttemptAt
+	}
+	if !email.SentAt.IsZero() {
+		response.SentAt = &email.SentAt
+	}
+	return response
+}
+
+func ToOutboxEmailResponses(emails []*domain.OutboxEmail) []OutboxEmailResponse {
+	responses := make([]OutboxEmailResponse, 0, len(emails))
+	for _, email := range emails {
+		responses = append(responses, ToOutboxEmailResponse(email))
+	}
+	return responses
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+// LinkIdentityRequest re-confirms the user with the password, or for accounts without one
+// with a login at ConfirmWith, a provider that is already linked
+type LinkIdentityRequest struct {
+	Password    string `json:"password" validate:"required_without=ConfirmWith"`
+	ConfirmWith string `json:"confirm_with"`
+}
+
+// UnlinkIdentityRequest re-confirms the user with the password, or for accounts without one
+// with a login at ConfirmWith, another linked provider
+type UnlinkIdentityRequest struct {
+	Password    string `json:"password" validate:"required_without=ConfirmWith"`
+	ConfirmWith string `json:"confirm_with"`
+}
+
+type LinkedIdentityResponse struct {
+	Provider    string     `json:"provider"`
+	Email       string     `json:"email"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+}
+
+type LoginMethodsResponse struct {
+	HasPassword bool                     `json:"has_password"`
+	Identities  []LinkedIdentityResponse `json:"identities"`
+}
+
+func ToLinkedIdentityResponse(identity *domain.LinkedIdentity) LinkedIdentityResponse {
+	var lastLoginAt *time.Time
+	if !identity.LastLoginAt.IsZero() {
+		lastLoginAt = &identity.LastLoginAt
+	}
+	return LinkedIdentityResponse{
+		Provider:    identity.Provider,
+		Email:       identity.Email,
+		CreatedAt:   identity.CreatedAt,
+		LastLoginAt: lastLoginAt,
+	}
+}
+
+func ToLoginMethodsResponse(methods *domain.LoginMethods) LoginMethodsResponse {
+	identities := make([]LinkedIdentityResponse, 0, len(methods.Identities))
+	for _, identity := range methods.Identities {
+		identities = append(identities, ToLinkedIdentityResponse(identity))
+	}
+	return LoginMethodsResponse{
+		HasPassword: methods.HasPassword,
+		Identities:  identities,
+	}
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+type NotificationQuery struct {
+	Unread   bool `form:"unread"`
+	Page     int  `form:"page" validate:"min=0"`
+	PageSize int  `form:"page_size" validate:"min=0"`
+}
+
+func (q NotificationQuery) ToFilter(recipientID string) domain.NotificationFilter {
+	return domain.NotificationFilter{
+		RecipientID: recipientID,
+		UnreadOnly:  q.Unread,
+		Page:        q.Page,
+		PageSize:    q.PageSize,
+	}
+}
+
+type NotificationResponse struct {
+	ID         string     `json:"id"`
+	Type       string     `json:"type"`
+	ActorID    string     `json:"actor_id"`
+	TargetType string     `json:"target_type"`
+	TargetID   string     `json:"target_id"`
+	PostID     string     `json:"post_id,omitempty"`
+	Read       bool       `json:"read"`
+	ReadAt     *time.Time `json:"read_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+func ToNotificationResponses(notifications []*domain.Notification) []NotificationResponse {
+	responses := make([]NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		response := NotificationResponse{
+			ID:         notification.ID,
+			Type:       string(notification.Type),
+			ActorID:    notification.ActorID,
+			TargetType: notification.TargetType,
+			TargetID:   notification.TargetID,
+			PostID:     notification.PostID,
+			Read:       notification.Read,
+			CreatedAt:  notification.CreatedAt,
+		}
+		if !notification.ReadAt.IsZero() {
+			response.ReadAt = &notification.ReadAt
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+// NotificationPreferencesRequest turns notification types on or off, types left out keep their setting
+type NotificationPreferencesRequest map[domain.NotificationType]bool
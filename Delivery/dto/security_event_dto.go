@@ -0,0 +1,73 @@
+package dto
+
+import (
+	domain "g6/blog-api/Domain"
+	"strings"
+	"time"
+)
+
+// SecurityEventQuery filters the audit log, from and to are RFC 3339 times and type may be
+// repeated or comma separated
+type SecurityEventQuery struct {
+	UserID   string    `form:"user_id"`
+	ActorID  string    `form:"actor_id"`
+	Types    []string  `form:"type"`
+	Outcome  string    `form:"outcome" validate:"omitempty,oneof=success failure"`
+	IP       string    `form:"ip"`
+	From     time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To       time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Page     int       `form:"page" validate:"min=0"`
+	PageSize int       `form:"page_size" validate:"min=0"`
+}
+
+func (q SecurityEventQuery) ToFilter() domain.SecurityEventFilter {
+	var types []domain.SecurityEventType
+	for _, value := range q.Types {
+		for _, t := range strings.Split(value, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				types = append(types, domain.SecurityEventType(t))
+			}
+		}
+	}
+	return domain.SecurityEventFilter{
+		UserID:   q.UserID,
+		ActorID:  q.ActorID,
+		Types:    types,
+		Outcome:  domain.SecurityEventOutcome(q.Outcome),
+		IP:       q.IP,
+		From:     q.From,
+		To:       q.To,
+		Page:     q.Page,
+		PageSize: q.PageSize,
+	}
+}
+
+type SecurityEventResponse struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	ActorID   string    `json:"actor_id,omitempty"`
+	UserID    string    `json:"user_id,omitempty"`
+	Outcome   string    `json:"outcome,omitempty"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	Details   string    `json:"details,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ToSecurityEventResponses(events []*domain.SecurityEvent) []SecurityEventResponse {
+	responses := make([]SecurityEventResponse, 0, len(events))
+	for _, event := range events {
+		responses = append(responses, SecurityEventResponse{
+			ID:        event.ID,
+			Type:      string(event.Type),
+			ActorID:   event.ActorID,
+			UserID:    event.UserID,
+			Outcome:   string(event.Outcome),
+			IP:        event.IP,
+			UserAgent: event.UserAgent,
+			Details:   event.Details,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+// TagQuery is the prefix and size of an autocomplete or popular tags list
+type TagQuery struct {
+	Prefix string `form:"q" validate:"max=50"`
+	Limit  int    `form:"limit" validate:"min=0,max=100"`
+}
+
+// TagRequest creates a tag, or replaces the description and synonyms of one. Synonyms are names
+// that stand for the tag, they are stored as slugs.
+type TagRequest struct {
+	Name        string   `json:"name" validate:"max=50"`
+	Description string   `json:"description" validate:"max=500"`
+	Synonyms    []string `json:"synonyms" validate:"max=50,dive,min=1,max=50"`
+}
+
+func (r TagRequest) ToDomain() *domain.Tag {
+	return &domain.Tag{
+		Name:        r.Name,
+		Description: r.Description,
+		Synonyms:    r.Synonyms,
+	}
+}
+
+type TagRenameRequest struct {
+	Name string `json:"name" validate:"required,max=50"`
+}
+
+// TagMergeRequest names the tag the merged tag becomes a synonym of
+type TagMergeRequest struct {
+	Into string `json:"into" validate:"required,max=50"`
+}
+
+type TagResponse struct {
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description,omitempty"`
+	Synonyms    []string  `json:"synonyms"`
+	UsageCount  int       `json:"usage_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func ToTagResponse(tag *domain.Tag) TagResponse {
+	synonyms := tag.Synonyms
+	if synonyms == nil {
+		synonyms = []string{}
+	}
+	return TagResponse{
+		Name:        tag.Name,
+		Slug:        tag.Slug,
+		Description: tag.Description,
+		Synonyms:    synonyms,
+		UsageCount:  tag.UsageCount,
+		CreatedAt:   tag.CreatedAt,
+		UpdatedAt:   tag.UpdatedAt,
+	}
+}
+
+func ToTagResponses(tags []*domain.Tag) []TagResponse {
+	responses := make([]TagResponse, 0, len(tags))
+	for _, tag := range tags {
+		responses = append(responses, ToTagResponse(tag))
+	}
+	return responses
+}
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type TwoFactorLoginRequest struct {
+	PreAuthToken string `json:"pre_auth_token" validate:"required"`
+	Code         string `json:"code" validate:"required"`
+}
+
+type TwoFactorSetupRequest struct {
+	PreAuthToken string `json:"pre_auth_token" validate:"required"`
+}
+
+type TwoFactorEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+type TwoFactorChallengeResponse struct {
+	Message                string    `json:"message"`
+	TwoFactorRequired      bool      `json:"two_factor_required"`
+	TwoFactorSetupRequired bool      `json:"two_factor_setup_required"`
+	PreAuthToken           string    `json:"pre_auth_token"`
+	ExpiresAt              time.Time `json:"expires_at"`
+}
+
+type TwoFactorPolicyRequest struct {
+	RequiredRoles []string `json:"required_roles" validate:"dive,oneof=admin user superadmin"`
+}
+
+type TwoFactorPolicyResponse struct {
+	RequiredRoles []string  `json:"required_roles"`
+	UpdatedBy     string    `json:"updated_by,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+}
+
+func ToTwoFactorEnrollmentResponse(enrollment *domain.TwoFactorEnrollment) TwoFactorEnrollmentResponse {
+	return TwoFactorEnrollmentResponse{
+		Secret:     enrollment.Secret,
+		OTPAuthURI: enrollment.OTPAuthURI,
+	}
+}
+
+func ToTwoFactorPolicyResponse(policy *domain.TwoFactorPolicy) TwoFactorPolicyResponse {
+	roles := make([]string, 0, len(policy.RequiredRoles))
+	for _, role := range policy.RequiredRoles {
+		roles = append(roles, string(role))
+	}
+	return TwoFactorPolicyResponse{
+		RequiredRoles: roles,
+		UpdatedBy:     policy.UpdatedBy,
+		UpdatedAt:     policy.UpdatedAt,
+	}
+}
+
+func ToDomainTwoFactorPolicy(req TwoFactorPolicyRequest) *domain.TwoFactorPolicy {
+	roles := make([]domain.UserRole, 0, len(req.RequiredRoles))
+	for _, role := range req.RequiredRoles {
+		roles = append(roles, domain.UserRole(role))
+	}
+	return &domain.TwoFactorPolicy{RequiredRoles: roles}
+}
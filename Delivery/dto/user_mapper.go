@@ -9,7 +9,7 @@ type UserRequest struct {
 	ID         string    `json:"id" validate:"omitempty"`
 	Username   string    `json:"username" validate:"required,min=3,max=50"`
 	Email      string    `json:"email" validate:"required,email"`
-	Password   string    `json:"password" validate:"required,min=6,max=100"`
+	Password   string    `json:"password" validate:"required"` // length and content are up to the password policy
 	FirstName  string    `json:"first_name" validate:"required,alpha,min=2,max=50"`
 	LastName   string    `json:"last_name" validate:"required,alpha,min=2,max=50"`
 	Role       string    `json:"role"`
@@ -19,6 +19,7 @@ type UserRequest struct {
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 	Provider   string    `json:"provider" validate:"required,oneof=manual google"`
+	Language   string    `json:"language" validate:"omitempty,bcp47_language_tag"`
 }
 
 type UserResponse struct {
@@ -31,6 +32,7 @@ type UserResponse struct {
 	Bio        string    `json:"bio"`
 	AvatarURL  string    `json:"avatar_url"`
 	IsVerified bool      `json:"is_verified"`
+	Language   string    `json:"language,omitempty"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 }
@@ -50,6 +52,7 @@ func ToDomainUser(req UserRequest) domain.User {
 		CreatedAt:  req.CreatedAt,
 		UpdatedAt:  req.UpdatedAt,
 		Provider:   req.Provider,
+		Language:   req.Language,
 	}
 }
 
@@ -64,6 +67,7 @@ func ToUserResponse(user domain.User) UserResponse {
 		Bio:        user.Bio,
 		IsVerified: user.IsVerified,
 		AvatarURL:  user.AvatarURL,
+		Language:   user.Language,
 		CreatedAt:  user.CreatedAt,
 		UpdatedAt:  user.UpdatedAt,
 	}
@@ -84,10 +88,11 @@ type UserUpdateProfileRequest struct {
 	Bio       string `form:"bio" validate:"omitempty,max=500"`
 	FirstName string `form:"first_name" validate:"omitempty,alpha,min=2,max=50"`
 	LastName  string `form:"last_name" validate:"omitempty,alpha,min=2,max=50"`
+	Language  string `form:"language" validate:"omitempty,bcp47_language_tag"`
 }
 
 // change password request
 type ChangePasswordRequest struct {
 	OldPassword string `json:"old_password" validate:"required,min=6,max=100"`
-	NewPassword string `json:"new_password" validate:"required,min=6,max=100"`
+	NewPassword string `json:"new_password" validate:"required"`
 }
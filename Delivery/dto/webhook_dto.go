@@ -0,0 +1,157 @@
+package dto
+
+import (
+	"encoding/json"
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+// WebhookRequest creates or replaces a webhook, Active defaults to true. An empty secret is
+// generated on creation and kept on update.
+type WebhookRequest struct {
+	URL         string   `json:"url" validate:"required,url,max=2048"`
+	Secret      string   `json:"secret" validate:"omitempty,min=16,max=256"`
+	Events      []string `json:"events" validate:"required,min=1,dive,oneof=post.published post.updated post.deleted comment.created"`
+	Active      *bool    `json:"active"`
+	Description string   `json:"description" validate:"max=200"`
+}
+
+func (r WebhookRequest) ToDomain() *domain.Webhook {
+	events := make([]domain.WebhookEventType, 0, len(r.Events))
+	for _, event := range r.Events {
+		events = append(events, domain.WebhookEventType(event))
+	}
+	return &domain.Webhook{
+		URL:         r.URL,
+		Secret:      r.Secret,
+		Events:      events,
+		Active:      r.Active == nil || *r.Active,
+		Description: r.Description,
+	}
+}
+
+// WebhookResponse leaves out the secret, it is only shown when the webhook is created
+type WebhookResponse struct {
+	ID          string    `json:"id"`
+	URL         string    `json:"url"`
+	Events      []string  `json:"events"`
+	Active      bool      `json:"active"`
+	Description string    `json:"description,omitempty"`
+	CreatedBy   string    `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreatedWebhookResponse includes the signing secret
+type CreatedWebhookResponse struct {
+	WebhookResponse
+	Secret string `json:"secret"`
+}
+
+func ToWebhookResponse(webhook *domain.Webhook) WebhookResponse {
+	events := make([]string, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+	return WebhookResponse{
+		ID:          webhook.ID,
+		URL:         webhook.URL,
+		Events:      events,
+		Active:      webhook.Active,
+		Description: webhook.Description,
+		CreatedBy:   webhook.CreatedBy,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+func ToWebhookResponses(webhooks []*domain.Webhook) []WebhookResponse {
+	responses := make([]WebhookResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		responses = append(responses, ToWebhookResponse(webhook))
+	}
+	return responses
+}
+
+type WebhookDeliveryQuery struct {
+	Status   string `form:"status" validate:"omitempty,oneof=pending sending delivered dead"`
+	Event    string `form:"event" validate:"omitempty,oneof=post.published post.updated post.deleted comment.created webhook.test"`
+	Page     int    `form:"page" validate:"min=0"`
+	PageSize int    `form:"page_size" validate:"min=0"`
+}
+
+func (q WebhookDeliveryQuery) ToFilter(webhookID string) domain.WebhookDeliveryFilter {
+	return domain.WebhookDeliveryFilter{
+		WebhookID: webhookID,
+		Status:    domain.WebhookDeliveryStatus(q.Status),
+		Event:     domain.WebhookEventType(q.Event),
+		Page:      q.Page,
+		PageSize:  q.PageSize,
+	}
+}
+
+type WebhookAttemptResponse struct {
+	At         time.Time `json:"at"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+// WebhookDeliveryResponse is one entry of the delivery log, with the response code of every
+// attempt. The payload is only included for a single delivery.
+type WebhookDeliveryResponse struct {
+	ID            string                   `json:"id"`
+	WebhookID     string                   `json:"webhook_id"`
+	EventID       string                   `json:"event_id"`
+	Event         string                   `json:"event"`
+	Status        string                   `json:"status"`
+	Attempts      []WebhookAttemptResponse `json:"attempts"`
+	NextAttemptAt *time.Time               `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time               `json:"delivered_at,omitempty"`
+	Payload       json.RawMessage          `json:"payload,omitempty"`
+	CreatedAt     time.Time                `json:"created_at"`
+	UpdatedAt     time.Time                `json:"updated_at"`
+}
+
+func ToWebhookDeliveryResponse(delivery *domain.WebhookDelivery) WebhookDeliveryResponse {
+	attempts := make([]WebhookAttemptResponse, 0, len(delivery.Attempts))
+	for _, attempt := range delivery.Attempts {
+		attempts = append(attempts, WebhookAttemptResponse{
+			At:         attempt.At,
+			StatusCode: attempt.StatusCode,
+			Error:      attempt.Error,
+			DurationMs: attempt.Duration.Milliseconds(),
+		})
+	}
+	response := WebhookDeliveryResponse{
+		ID:        delivery.ID,
+		WebhookID: delivery.WebhookID,
+		EventID:   delivery.EventID,
+		Event:     string(delivery.Event),
+		Status:    string(delivery.Status),
+		Attempts:  attempts,
+		CreatedAt: delivery.CreatedAt,
+		UpdatedAt: delivery.UpdatedAt,
+	}
+	if delivery.Status == domain.WebhookDeliveryPending || delivery.Status == domain.WebhookDeliverySending {
+		response.NextAttemptAt = &delivery.NextAttemptAt
+	}
+	if !delivery.DeliveredAt.IsZero() {
+		response.DeliveredAt = &delivery.DeliveredAt
+	}
+	return response
+}
+
+func ToWebhookDeliveryDetailResponse(delivery *domain.WebhookDelivery) WebhookDeliveryResponse {
+	response := ToWebhookDeliveryResponse(delivery)
+	response.Payload = json.RawMessage(delivery.Payload)
+	return response
+}
+
+func ToWebhookDeliveryResponses(deliveries []*domain.WebhookDelivery) []WebhookDeliveryResponse {
+	responses := make([]WebhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		responses = append(responses, ToWebhookDeliveryResponse(delivery))
+	}
+	return responses
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"g6/blog-api/Delivery/bootstrap"
 	"g6/blog-api/Delivery/routers"
+	"g6/blog-api/Infrastructure/database/mongo"
 	"log"
 	"net/http"
 	"os"
@@ -33,6 +34,24 @@ func close_server(srv *http.Server) {
 	log.Println("Server exiting")
 }
 
+// runCommand executes an admin command given on the command line
+func runCommand(name string, env *bootstrap.Env, db mongo.Database, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	switch name {
+	case "rotate-keys":
+		// the new key signs from now on; running servers pick it up on their next key reload
+		key, err := routers.NewKeySet(env, db).Rotate(ctx)
+		if err != nil {
+			log.Fatalf("rotate-keys: %v", err)
+		}
+		fmt.Printf("✅ New %s signing key %s is active, previous keys are retired\n", key.Algorithm, key.Kid)
+	default:
+		log.Fatalf("unknown command %q, available commands: rotate-keys", name)
+	}
+}
+
 func main() {
 	app := bootstrap.App(".env")
 	env := app.Env
@@ -44,6 +63,12 @@ func main() {
 
 	timeout := time.Duration(env.CtxTSeconds) * time.Second
 
+	// admin commands run instead of the server, e.g. `go run Delivery/main.go rotate-keys`
+	if len(os.Args) > 1 {
+		runCommand(os.Args[1], env, db, timeout)
+		return
+	}
+
 	router := gin.Default()
 	routers.Setup(env, timeout, db, router)
 
@@ -3,13 +3,15 @@ package routers
 import (
 	"g6/blog-api/Delivery/bootstrap"
 	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
 	repositories "g6/blog-api/Repositories"
 	usercase "g6/blog-api/Usecases"
+	utils "g6/blog-api/Utils"
 	"time"
 
-	"g6/blog-api/Infrastructure/email"
 	"g6/blog-api/Infrastructure/middleware"
-	"g6/blog-api/Infrastructure/security"
+	"g6/blog-api/Infrastructure/oauth"
+	"g6/blog-api/Infrastructure/redis"
 	"g6/blog-api/Infrastructure/storage"
 
 	"g6/blog-api/Infrastructure/database/mongo"
@@ -17,30 +19,13 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func NewAuthRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database) {
+func NewAuthRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, policy domain.IPolicy, passwordPolicy domain.IPasswordPolicy, emailService domain.IEmailService, limiter *middleware.RateLimiter, events domain.IEventBus) {
 	// context time out
 	ctxTimeout := time.Duration(env.CtxTSeconds) * time.Second
 
-	// jwt services
-	authService := security.NewJWTService(
-		env.ATS,
-		env.RTS,
-		env.AccTEMinutes,
-		env.RefTEHours,
-	)
-
 	// user repository
 	userRepo := repositories.NewUserRepository(db, env.UserCollection)
 
-	// email service
-	emailService := email.NewGomailEmailService(
-		env.SMTPHost,
-		env.SMTPPort,
-		env.SMTPFrom,
-		env.SMTPUsername,
-		env.SMTPPassword,
-	)
-
 	// reset password repository
 	resetPasswordRepo := repositories.NewPasswordResetRepository(db, env.PasswordResetCollection)
 
@@ -49,12 +34,13 @@ func NewAuthRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database)
 		resetPasswordRepo,
 		userRepo,
 		emailService,
+		passwordPolicy,
 		time.Duration(env.PasswordResetExpiry)*time.Minute,
 	)
 
 	// otp usecase and otp repository
 	otpRepo := repositories.NewOTPRepository(db, env.OtpCollection)
-	otpUsecase := usercase.NewOTPUsecase(otpRepo, emailService, ctxTimeout, time.Duration(env.OtpExpireMinutes)*time.Minute, env.OtpMaximumAttempts, env.SecretSalt)
+	otpUsecase := usercase.NewOTPUsecase(otpRepo, emailService, ctxTimeout, NewOTPPolicies(env), env.SecretSalt)
 
 	// storage services
 	imageKitStorageService := storage.NewImageKitStorage(
@@ -63,34 +49,158 @@ func NewAuthRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database)
 		env.ImageKitEndpoint,
 	)
 
+	// audit log of logins, password and role changes, OTP checks and revoked tokens
+	securityEventRepo := repositories.NewSecurityEventRepository(db, env.SecurityEventCollection)
+	securityEventUsecase := usercase.NewSecurityEventUsecase(securityEventRepo, ctxTimeout)
+
+	// refresh token usecase, replayed refresh tokens are recorded as security events
+	refreshTokenUsecase := usercase.NewRefreshTokenUsecase(
+		repositories.NewRefreshTokenRepository(db, env.RefreshTokenCollection),
+		securityEventRepo,
+	)
+
+	// two-factor usecase and repository
+	twoFactorRepo := repositories.NewTwoFactorRepository(db, env.TwoFactorCollection, env.TwoFactorPolicyCollection)
+	twoFactorUsecase := usercase.NewTwoFactorUsecase(
+		twoFactorRepo,
+		userRepo,
+		env.TwoFactorIssuer,
+		env.TwoFactorSecretKey,
+		usercase.ParseRoles(env.TwoFactorRequiredRoles),
+		ctxTimeout,
+	)
+
+	// OpenID Connect providers from configuration, google is one of them when configured
+	oidcProviders := make([]oauth.ProviderConfig, 0, len(env.OIDCProviders))
+	for _, provider := range env.OIDCProviders {
+		oidcProviders = append(oidcProviders, oauth.ProviderConfig{
+			Name:         provider.Name,
+			Issuer:       provider.Issuer,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			Scopes:       provider.Scopes,
+			TrustEmail:   provider.TrustEmail,
+		})
+	}
+	oidcUsecase := usercase.NewOIDCUsecase(
+		oauth.NewOIDCProviders(oidcProviders, nil),
+		repositories.NewOIDCStateRepository(db, env.OIDCStateCollection),
+		repositories.NewLinkedIdentityRepository(db, env.LinkedIdentityCollection),
+		userRepo,
+		ctxTimeout,
+	)
+
+	// passwordless login links, valid for MAGIC_LINK_EXPIRE_MINUTES
+	magicLinkExpiry := time.Duration(env.MagicLinkExpireMinutes) * time.Minute
+	if magicLinkExpiry <= 0 {
+		magicLinkExpiry = 15 * time.Minute
+	}
+	magicLinkUsecase := usercase.NewMagicLinkUsecase(
+		repositories.NewMagicLinkRepository(db, env.MagicLinkCollection),
+		userRepo,
+		emailService,
+		env.MagicLinkURL,
+		magicLinkExpiry,
+		ctxTimeout,
+	)
+
+	// failed login counters and locks live in Redis, thresholds come from LOGIN_* settings
+	loginAttemptUsecase := usercase.NewLoginAttemptUsecase(
+		redis.NewRedisClient(env, &redis.RedisService{}),
+		userRepo,
+		securityEventRepo,
+		emailService,
+		usercase.LoginAttemptLimits{
+			MaxFailures:   int64(env.LoginMaxFailures),
+			IPMaxFailures: int64(env.LoginIPMaxFailures),
+			Window:        time.Duration(env.LoginFailureWindowMinutes) * time.Minute,
+			Lockout:       time.Duration(env.LoginLockoutMinutes) * time.Minute,
+			DelayAfter:    int64(env.LoginDelayAfterFailures),
+			BaseDelay:     time.Duration(env.LoginDelayBaseSeconds) * time.Second,
+			MaxDelay:      time.Duration(env.LoginDelayMaxSeconds) * time.Second,
+
+			SecondFactorMaxFailures: int64(env.LoginSecondFactorMaxFailures),
+		},
+		env.LoginUnlockURL,
+		ctxTimeout,
+	)
+
 	authController := controllers.AuthController{
-		UserUsecase:          usercase.NewUserUsecase(userRepo, imageKitStorageService, ctxTimeout),
+		UserUsecase:          usercase.NewUserUsecase(userRepo, imageKitStorageService, policy, passwordPolicy, events, ctxTimeout),
 		OTP:                  otpUsecase,
 		AuthService:          authService,
-		RefreshTokenUsecase:  usercase.NewRefreshTokenUsecase(repositories.NewRefreshTokenRepository(db, env.RefreshTokenCollection)),
+		RefreshTokenUsecase:  refreshTokenUsecase,
 		PasswordResetUsecase: passwordResetUsecase,
+		TwoFactorUsecase:     twoFactorUsecase,
+		OIDCUsecase:          oidcUsecase,
+		MagicLinkUsecase:     magicLinkUsecase,
+		LoginAttemptUsecase:  loginAttemptUsecase,
+		SecurityEventUsecase: securityEventUsecase,
+		Cookies: utils.CookieSettings{
+			Secure:   env.CookieSecure,
+			Domain:   env.CookieDomain,
+			SameSite: utils.ParseSameSite(env.CookieSameSite),
+		},
 		Env:                  env,
 	}
 
+	// every route that checks a secret or sends an email gets its own count, per IP or per logged-in user
+	limitAuth := limiter.Limit("auth")
+	// logout and refresh run on the refresh_token cookie alone, so they check the CSRF token themselves
+	csrfRefreshCookie := middleware.CSRFMiddleware("refresh_token")
+
 	auth := api.Group("/auth/")
 	{
-		auth.POST("/register", authController.RegisterRequest)
-		auth.POST("/login", authController.LoginRequest)
-		auth.POST("/logout", authController.LogoutRequest)
-		auth.POST("/forgot-password", authController.ForgotPasswordRequest)
-		auth.POST("/reset-password", authController.ResetPasswordRequest)
-		auth.POST("/refresh", authController.RefreshToken)
-
+		auth.POST("/register", limitAuth, authController.RegisterRequest)
+		auth.POST("/login", limitAuth, authController.LoginRequest)
+		auth.POST("/logout", csrfRefreshCookie, authController.LogoutRequest)
+		auth.POST("/forgot-password", limitAuth, authController.ForgotPasswordRequest)
+		auth.POST("/reset-password", limitAuth, authController.ResetPasswordRequest)
+		auth.POST("/refresh", csrfRefreshCookie, authController.RefreshToken)
+		auth.POST("/magic-link", limitAuth, authController.RequestMagicLink)
+		auth.POST("/magic-link/verify", limitAuth, authController.VerifyMagicLink)
+		auth.POST("/unlock", limitAuth, authController.UnlockAccount)
+
+		auth.GET("/oidc/providers", authController.ListOIDCProviders)
+		auth.GET("/oidc/:provider/login", authController.OIDCLogin)
+		auth.GET("/oidc/:provider/callback", authController.OIDCCallback)
 		auth.GET("/google/login", authController.GoogleLogin)
 		auth.GET("/google/callback", authController.GoogleCallback)
 
+		// second step of login, authenticated by the pre-auth token
+		auth.POST("/2fa/verify", limitAuth, authController.VerifyTwoFactorLogin)
+		auth.POST("/2fa/setup", limitAuth, authController.TwoFactorSetup)
+		auth.POST("/2fa/setup/confirm", limitAuth, authController.TwoFactorSetupConfirm)
+
 	}
 	authHead := auth
-	authHead.Use(middleware.AuthMiddleware(*env))
+	// account management needs a real login, personal access tokens are refused
+	authHead.Use(middleware.AuthMiddleware(authService, tokenUsecase), middleware.SessionOnly())
 	{
-		authHead.POST("/verify-email", authController.VerifyEmailRequest)
-		authHead.POST("/resend-otp", authController.ResendOTPRequest)
-		authHead.PATCH("/verify-otp", authController.VerifyOTPRequest)
-		authHead.PATCH("/change-role", authController.ChangeRoleRequest)
+		authHead.POST("/verify-email", limitAuth, authController.VerifyEmailRequest)
+		authHead.POST("/resend-otp", limitAuth, authController.ResendOTPRequest)
+		authHead.PATCH("/verify-otp", limitAuth, authController.VerifyOTPRequest)
+		authHead.PATCH("/change-role", middleware.RequirePermission(policy, domain.PermUserPromote), authController.ChangeRoleRequest)
+
+		authHead.GET("/sessions", authController.ListSessions)
+		authHead.DELETE("/sessions/:id", authController.RevokeSession)
+		authHead.POST("/sessions/revoke-others", authController.RevokeOtherSessions)
+		authHead.GET("/activity", authController.RecentActivity)
+		authHead.GET("/security-events", middleware.RequirePermission(policy, domain.PermSecurityAuditRead), authController.ListSecurityEvents)
+
+		authHead.GET("/identities", authController.ListLoginMethods)
+		authHead.POST("/identities/:provider/link", authController.LinkIdentity)
+		authHead.DELETE("/identities/:provider", authController.UnlinkIdentity)
+
+		authHead.GET("/locks", middleware.RequirePermission(policy, domain.PermAccountLockManage), authController.ListAccountLocks)
+		authHead.DELETE("/locks/:identifier", middleware.RequirePermission(policy, domain.PermAccountLockManage), authController.LiftAccountLock)
+
+		authHead.POST("/2fa/enroll", authController.EnrollTwoFactor)
+		authHead.POST("/2fa/confirm", authController.ConfirmTwoFactor)
+		authHead.POST("/2fa/disable", authController.DisableTwoFactor)
+		authHead.POST("/2fa/recovery-codes", authController.RegenerateRecoveryCodes)
+		authHead.GET("/2fa/policy", middleware.RequirePermission(policy, domain.PermSecurityPolicyManage), authController.GetTwoFactorPolicy)
+		authHead.PUT("/2fa/policy", middleware.RequirePermission(policy, domain.PermSecurityPolicyManage), authController.UpdateTwoFactorPolicy)
 	}
 }
\ No newline at end of file
@@ -3,6 +3,7 @@ package routers
 import (
 	"g6/blog-api/Delivery/bootstrap"
 	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
 	"g6/blog-api/Infrastructure/ai"
 	"g6/blog-api/Infrastructure/database/mongo"
 	"g6/blog-api/Infrastructure/middleware"
@@ -12,7 +13,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func NewBlogAIRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database) {
+func NewBlogAIRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, policy domain.IPolicy, limiter *middleware.RateLimiter) {
 	blog_ai_controller := controllers.BlogAIController{
 		BlogAIUsecase: usecases.NewBlogAIUsecase(
 			ai.GeminiConfig{
@@ -26,6 +27,6 @@ func NewBlogAIRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database
 
 	ai := api.Group("/ai/blog")
 	{
-		ai.POST("/generate", middleware.AuthMiddleware(*env), blog_ai_controller.GenerateBlogContent) // Generate blog content from keywords
+		ai.POST("/generate", middleware.AuthMiddleware(authService, tokenUsecase), middleware.RequirePermission(policy, domain.PermPostCreate), limiter.Limit("ai"), blog_ai_controller.GenerateBlogContent) // Generate blog content from keywords
 	}
 }
@@ -3,6 +3,7 @@ package routers
 import (
 	"g6/blog-api/Delivery/bootstrap"
 	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
 	"g6/blog-api/Infrastructure/database/mongo"
 	"g6/blog-api/Infrastructure/middleware"
 	"g6/blog-api/Infrastructure/redis"
@@ -13,35 +14,38 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func NewBlogCommentRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database) {
+func NewBlogCommentRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, policy domain.IPolicy, limiter *middleware.RateLimiter, events domain.IEventBus) {
+	collections := &mongo.Collections{
+		BlogPosts:         env.BlogPostCollection,
+		BlogComments:      env.BlogCommentCollection,
+		BlogUserReactions: env.BlogUserReactionCollection,
+	}
 	comment_controller := controllers.BlogCommentController{
 		BlogCommentUsecase: usecases.NewBlogCommentUsecase(
-			repository.NewBlogCommentRepository(
-				db,
-				&mongo.Collections{
-					BlogPosts:         env.BlogPostCollection,
-					BlogComments:      env.BlogCommentCollection,
-					BlogUserReactions: env.BlogUserReactionCollection,
-				},
-			),
+			repository.NewBlogCommentRepository(db, collections),
 			redis.NewRedisClient(env, &redis.RedisService{}),
+			policy,
+			events,
 			time.Duration(env.CtxTSeconds)*time.Second,
 		),
 		Env: env,
 	}
 
 	// Routes for managing comments on a specific blog
+	requireAuth := middleware.AuthMiddleware(authService, tokenUsecase)
+	createComments := middleware.RequirePermission(policy, domain.PermCommentCreate)
 	blog_comments := api.Group("/blogs/:id/comments")
 	{
-		blog_comments.POST("/", middleware.AuthMiddleware(*env), middleware.VerifiedUserOnly(), comment_controller.CreateComment) // Create a comment for a blog
-		blog_comments.GET("/", comment_controller.GetCommentsByBlogID)                                                            // Get all comments for a blog
+		blog_comments.POST("/", requireAuth, createComments, middleware.VerifiedUserOnly(), limiter.Limit("comments"), comment_controller.CreateComment) // Create a comment for a blog
+		blog_comments.GET("/", comment_controller.GetCommentsByBlogID)                                                                                   // Get all comments for a blog
 	}
 
 	// General comment routes (independent of blog)
+	// editing and deleting depend on who wrote the comment, the usecase checks those with the policy
 	comments := api.Group("/comments")
 	{
-		comments.GET("/:id", comment_controller.GetCommentByID)                                  // Get comment by ID
-		comments.PUT("/:id", middleware.VerifiedUserOnly(), comment_controller.UpdateComment)    // Update a comment by ID
-		comments.DELETE("/:id", middleware.VerifiedUserOnly(), comment_controller.DeleteComment) // Delete a comment by ID
+		comments.GET("/:id", comment_controller.GetCommentByID)                                               // Get comment by ID
+		comments.PUT("/:id", requireAuth, middleware.VerifiedUserOnly(), comment_controller.UpdateComment)    // Update a comment by ID
+		comments.DELETE("/:id", requireAuth, middleware.VerifiedUserOnly(), comment_controller.DeleteComment) // Delete a comment by ID
 	}
 }
@@ -3,6 +3,7 @@ package routers
 import (
 	"g6/blog-api/Delivery/bootstrap"
 	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
 	"g6/blog-api/Infrastructure/database/mongo"
 	"g6/blog-api/Infrastructure/middleware"
 	"g6/blog-api/Infrastructure/redis"
@@ -13,7 +14,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func NewBlogRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database) {
+func NewBlogRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, policy domain.IPolicy, events domain.IEventBus, tags domain.ITagUsecase, categories domain.ICategoryUsecase) {
 	blogGroup := api.Group("/blogs")
 
 	blog_post_controller := controllers.BlogPostController{
@@ -24,15 +25,22 @@ func NewBlogRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database)
 				BlogUserReactions: env.BlogUserReactionCollection,
 			}),
 			redis.NewRedisClient(env, &redis.RedisService{}),
+			policy,
+			events,
+			tags,
+			categories,
 			time.Duration(env.CtxTSeconds)*time.Second),
 		Env: env,
 	}
 
 	// Routes for managing blog posts
-	blogGroup.Use(middleware.AuthMiddleware(*env))
-	blogGroup.GET("/", blog_post_controller.GetBlogPosts)                                    // Get all blogs with optional filters
-	blogGroup.GET("/:id", blog_post_controller.GetBlogPostByID)                              // Get a single blog by ID
-	blogGroup.POST("/", middleware.VerifiedUserOnly(), blog_post_controller.CreateBlog)      // Create a new blog
-	blogGroup.PUT("/:id", middleware.VerifiedUserOnly(), blog_post_controller.UpdateBlog)    // Update an existing blog
-	blogGroup.DELETE("/:id", middleware.VerifiedUserOnly(), blog_post_controller.DeleteBlog) // Delete a blog by ID
+	blogGroup.Use(middleware.AuthMiddleware(authService, tokenUsecase))
+	// updating and deleting depend on who owns the post, the usecase checks those with the policy
+	readPosts := middleware.RequirePermission(policy, domain.PermPostRead)
+	createPosts := middleware.RequirePermission(policy, domain.PermPostCreate)
+	blogGroup.GET("/", readPosts, blog_post_controller.GetBlogPosts)                                 // Get all blogs with optional filters
+	blogGroup.GET("/:id", readPosts, blog_post_controller.GetBlogPostByID)                           // Get a single blog by ID
+	blogGroup.POST("/", createPosts, middleware.VerifiedUserOnly(), blog_post_controller.CreateBlog) // Create a new blog
+	blogGroup.PUT("/:id", middleware.VerifiedUserOnly(), blog_post_controller.UpdateBlog)            // Update an existing blog
+	blogGroup.DELETE("/:id", middleware.VerifiedUserOnly(), blog_post_controller.DeleteBlog)         // Delete a blog by ID
 }
@@ -3,6 +3,7 @@ package routers
 import (
 	"g6/blog-api/Delivery/bootstrap"
 	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
 	"g6/blog-api/Infrastructure/database/mongo"
 	"g6/blog-api/Infrastructure/middleware"
 	repository "g6/blog-api/Repositories/blog"
@@ -12,17 +13,20 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func NewBlogUserReactionRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database) {
-	blogUserReactionGroup := api.Group("/blog/reactions", middleware.AuthMiddleware(*env))
+func NewBlogUserReactionRoutes(env *bootstrap.Env, api *gin.RouterGroup, db mongo.Database, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, policy domain.IPolicy, events domain.IEventBus) {
+	blogUserReactionGroup := api.Group("/blog/reactions", middleware.AuthMiddleware(authService, tokenUsecase), middleware.SessionOnly())
 
 	// Initialize the blog user reaction repository, usecase, and controller
+	collections := &mongo.Collections{
+		BlogPosts:         env.BlogPostCollection,
+		BlogComments:      env.BlogCommentCollection,
+		BlogUserReactions: env.BlogUserReactionCollection,
+	}
 	blog_user_reaction_controller := controllers.BlogReactionController{
 		BlogUserReactionUsecase: usecases.NewBlogUserReactionUsecase(
-			repository.NewUserReactionRepo(db, &mongo.Collections{
-			BlogPosts:         env.BlogPostCollection,
-			BlogComments:      env.BlogCommentCollection,
-			BlogUserReactions: env.BlogUserReactionCollection,
-		}), time.Duration(env.CtxTSeconds)*time.Second),
+			repository.NewUserReactionRepo(db, collections),
+			events,
+			time.Duration(env.CtxTSeconds)*time.Second),
 		Env: env,
 	}
 
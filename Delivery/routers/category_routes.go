@@ -0,0 +1,28 @@
+package routers
+
+import (
+	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewCategoryRoutes(group *gin.RouterGroup, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, policy domain.IPolicy, categories domain.ICategoryUsecase) {
+	categoryController := controllers.NewCategoryController(categories)
+
+	// anyone may browse the tree, to navigate posts and to pick the category of a post
+	categoryGroup := group.Group("/categories")
+	categoryGroup.GET("", categoryController.GetTree)
+	categoryGroup.GET("/:id", categoryController.GetCategory)
+
+	// editors of the tree are admins
+	manage := categoryGroup.Group("",
+		middleware.AuthMiddleware(authService, tokenUsecase),
+		middleware.SessionOnly(),
+		middleware.RequirePermission(policy, domain.PermCategoryManage),
+	)
+	manage.POST("", categoryController.CreateCategory)
+	manage.PUT("/:id", categoryController.UpdateCategory)
+	manage.DELETE("/:id", categoryController.DeleteCategory)
+}
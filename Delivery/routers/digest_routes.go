@@ -0,0 +1,26 @@
+package routers
+
+import (
+	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewDigestRoutes(group *gin.RouterGroup, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, digests domain.IDigestUsecase) {
+	digestController := controllers.NewDigestController(digests)
+
+	digestGroup := group.Group("/digests")
+	// the link in a digest unsubscribes with one click, the signed token stands in for a login.
+	// POST is for mail clients that unsubscribe on the user's behalf.
+	digestGroup.GET("/unsubscribe", digestController.Unsubscribe)
+	digestGroup.POST("/unsubscribe", digestController.Unsubscribe)
+
+	settings := digestGroup.Group("/settings",
+		middleware.AuthMiddleware(authService, tokenUsecase),
+		middleware.SessionOnly(),
+	)
+	settings.GET("", digestController.GetSubscription)
+	settings.PUT("", digestController.UpdateSubscription)
+}
@@ -0,0 +1,30 @@
+package routers
+
+import (
+	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewEmailRoutes(group *gin.RouterGroup, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, policy domain.IPolicy, templates domain.IEmailTemplates, outbox domain.IEmailOutboxUsecase) {
+	emailTemplateController := controllers.NewEmailTemplateController(templates)
+	emailOutboxController := controllers.NewEmailOutboxController(outbox)
+
+	emails := group.Group("/emails",
+		middleware.AuthMiddleware(authService, tokenUsecase),
+		middleware.SessionOnly(),
+	)
+
+	// admins preview the transactional emails in every language
+	previewTemplates := middleware.RequirePermission(policy, domain.PermEmailTemplatePreview)
+	emails.GET("/templates", previewTemplates, emailTemplateController.ListTemplates)
+	emails.GET("/templates/:name/preview", previewTemplates, emailTemplateController.PreviewTemplate)
+
+	// and follow the delivery of queued emails
+	manageOutbox := middleware.RequirePermission(policy, domain.PermEmailOutboxManage)
+	emails.GET("/outbox", manageOutbox, emailOutboxController.ListOutbox)
+	emails.GET("/outbox/:id", manageOutbox, emailOutboxController.GetOutboxEmail)
+	emails.POST("/outbox/:id/retry", manageOutbox, emailOutboxController.RetryOutboxEmail)
+}
@@ -0,0 +1,25 @@
+package routers
+
+import (
+	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewNotificationRoutes(group *gin.RouterGroup, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, notifications domain.INotificationUsecase) {
+	notificationController := controllers.NewNotificationController(notifications)
+
+	// every user reads and manages only their own notifications
+	notificationGroup := group.Group("/notifications",
+		middleware.AuthMiddleware(authService, tokenUsecase),
+		middleware.SessionOnly(),
+	)
+	notificationGroup.GET("", notificationController.ListNotifications)
+	notificationGroup.GET("/unread-count", notificationController.UnreadCount)
+	notificationGroup.POST("/read-all", notificationController.MarkAllRead)
+	notificationGroup.POST("/:id/read", notificationController.MarkRead)
+	notificationGroup.GET("/preferences", notificationController.GetPreferences)
+	notificationGroup.PUT("/preferences", notificationController.UpdatePreferences)
+}
@@ -1,24 +1,464 @@
 package routers
 
 import (
+	"context"
 	"g6/blog-api/Delivery/bootstrap"
+	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
 	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/email"
+	"g6/blog-api/Infrastructure/middleware"
+	"g6/blog-api/Infrastructure/redis"
+	"g6/blog-api/Infrastructure/security"
+	repositories "g6/blog-api/Repositories"
+	repository "g6/blog-api/Repositories/blog"
+	usecases "g6/blog-api/Usecases"
+	"log"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func Setup(env *bootstrap.Env, timeout time.Duration, db mongo.Database, router *gin.Engine) {
 	router.GET("/", func(ctx *gin.Context) { ctx.Redirect(http.StatusPermanentRedirect, "/api") })
 
+	// every password hashed from here on uses the configured algorithm
+	security.SetPasswordHashing(NewPasswordHashing(env))
+
+	// signing keys shared by every route group, tokens are verified through them
+	keySet := NewKeySet(env, db)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := keySet.Load(ctx); err != nil {
+		log.Fatalf("failed to load signing keys: %v", err)
+	}
+	authService := security.NewJWTService(
+		keySet,
+		env.AccTEMinutes,
+		env.RefTEHours,
+		env.PreAuthTokenExpireMinutes,
+	)
+
+	jwksController := controllers.NewJWKSController(keySet)
+	router.GET("/.well-known/jwks.json", jwksController.GetJWKS)
+
+	// role to permission mapping used by every route group and usecase
+	policy := security.NewPolicy(security.DefaultRolePermissions)
+
+	// personal access tokens are accepted by the auth middleware next to the JWTs
+	tokenUsecase := usecases.NewPersonalAccessTokenUsecase(
+		repositories.NewPersonalAccessTokenRepository(db, env.PersonalAccessTokenCollection),
+		repositories.NewUserRepository(db, env.UserCollection),
+		policy,
+		timeout,
+	)
+
+	// request rate limits, the route groups pick the policies that fit their routes
+	limiter := NewRateLimiter(env)
+
+	// password rules checked on registration, password change and reset
+	passwordPolicy := NewPasswordPolicy(env, db)
+
+	// transactional emails, rendered from the templates in the recipient's language
+	emailTemplates := NewEmailTemplates(env)
+	// they are queued in the outbox and sent by the worker, requests never wait for the mail server
+	emailOutbox := NewEmailOutbox(env, db, timeout, NewEmailTransport(env, db, emailTemplates))
+	go usecases.RunEmailOutboxWorker(context.Background(), emailOutbox, emailOutboxPollInterval(env))
+
+	// live streams of notifications, comments and reactions, fanned out to every server through Redis
+	streams := usecases.NewStreamUsecase(
+		redis.NewRedisClient(env, &redis.RedisService{}),
+		usecases.StreamSettings{
+			Retain:    int64(env.StreamRetainEvents),
+			RetainFor: time.Duration(env.StreamRetainHours) * time.Hour,
+		},
+		timeout,
+	)
+	go usecases.RunStreamListener(context.Background(), streams, 5*time.Second)
+
+	// in-app notifications, raised by the comment and reaction event subscribers
+	notifications := usecases.NewNotificationUsecase(
+		repositories.NewNotificationRepository(db, env.NotificationCollection, env.NotificationPreferenceCollection),
+		redis.NewRedisClient(env, &redis.RedisService{}),
+		streams,
+		timeout,
+	)
+
+	// outbound webhooks told about posts and comments, queued and sent by the worker with retries
+	webhooks := NewWebhooks(env, db, timeout)
+	go usecases.RunWebhookWorker(context.Background(), webhooks, webhookPollInterval(env))
+
+	// changes that span documents, the domain events and tag renames and merges, commit together
+	transactor := mongo.NewTransactor(db.Client(), env.DBTransactions)
+
+	// canonical tags, posts are tagged with them and searched by them and their synonyms
+	tags := NewTags(env, db, transactor, timeout)
+
+	// the category tree posts are filed under
+	categories := NewCategories(env, db, transactor, timeout)
+
+	// domain events, recorded with the change they are about and handed to the subscribers below
+	events := NewEvents(env, db, transactor, timeout)
+	usecases.NewBlogEventHandlers(
+		repository.NewBlogPostRepo(db, &mongo.Collections{
+			BlogPosts:         env.BlogPostCollection,
+			BlogComments:      env.BlogCommentCollection,
+			BlogUserReactions: env.BlogUserReactionCollection,
+		}),
+		redis.NewRedisClient(env, &redis.RedisService{}),
+		notifications,
+		streams,
+		webhooks,
+		tags,
+	).Register(events)
+	go usecases.RunEventDispatcher(context.Background(), events, eventPollInterval(env))
+
+	// daily and weekly digests of the posts users follow, queued in the email outbox
+	digests := NewDigests(env, db, timeout, emailOutbox, tags, events)
+	go usecases.RunDigestScheduler(context.Background(), digests, digestPollInterval(env))
+
 	api := router.Group("/api")
+	// no route has authenticated the request yet, so the global limit is per IP; the per-user
+	// policies are used after the auth middleware of the routes they guard
+	api.Use(limiter.Limit("global"))
 	{
-		NewAuthRoutes(env, api, db)
-		NewUserRoutes(env, api, db)
-		NewBlogRoutes(env, api, db)
-		NewBlogCommentRoutes(env, api, db)
-		NewBlogUserReactionRoutes(env, api, db)
-		NewBlogAIRoutes(env, api, db)
+		NewAuthRoutes(env, api, db, authService, tokenUsecase, policy, passwordPolicy, emailOutbox, limiter, events)
+		NewUserRoutes(env, api, db, authService, tokenUsecase, policy, passwordPolicy, events)
+		NewBlogRoutes(env, api, db, authService, tokenUsecase, policy, events, tags, categories)
+		NewBlogCommentRoutes(env, api, db, authService, tokenUsecase, policy, limiter, events)
+		NewBlogUserReactionRoutes(env, api, db, authService, tokenUsecase, policy, events)
+		NewBlogAIRoutes(env, api, db, authService, tokenUsecase, policy, limiter)
+		NewEmailRoutes(api, authService, tokenUsecase, policy, emailTemplates, emailOutbox)
+		NewNotificationRoutes(api, authService, tokenUsecase, notifications)
+		NewStreamRoutes(api, authService, tokenUsecase, streams)
+		NewWebhookRoutes(api, authService, tokenUsecase, policy, webhooks)
+		NewDigestRoutes(api, authService, tokenUsecase, digests)
+		NewTagRoutes(api, authService, tokenUsecase, policy, tags)
+		NewCategoryRoutes(api, authService, tokenUsecase, policy, categories)
+	}
+}
+
+// NewRateLimiter builds the rate limit policies from configuration, a bad spec stops the server
+func NewRateLimiter(env *bootstrap.Env) *middleware.RateLimiter {
+	specs := []struct {
+		name, spec, fallback string
+		perRoute             bool
+	}{
+		{"global", env.RateLimitGlobal, "600/1m", false},
+		{"auth", env.RateLimitAuth, "10/15m", true},
+		{"ai", env.RateLimitAI, "20/1h", true},
+		{"comments", env.RateLimitComments, "30/10m", true},
+	}
+	policies := []middleware.RateLimitPolicy{}
+	for _, s := range specs {
+		policy, err := middleware.ParseRateLimitPolicy(s.name, s.spec, s.fallback, s.perRoute)
+		if err != nil {
+			log.Fatalf("invalid rate limit configuration: %v", err)
+		}
+		if policy != nil {
+			policies = append(policies, *policy)
+		}
+	}
+	return middleware.NewRateLimiter(redis.NewRedisClient(env, &redis.RedisService{}), policies...)
+}
+
+// NewPasswordPolicy builds the password rules from configuration, an unreadable breach list stops the server.
+// The list stays open for the life of the server, checks read it on demand.
+func NewPasswordPolicy(env *bootstrap.Env, db mongo.Database) domain.IPasswordPolicy {
+	var breached domain.IBreachedPasswordChecker
+	if env.PasswordBreachedFile != "" {
+		list, err := security.OpenBreachedPasswords(env.PasswordBreachedFile)
+		if err != nil {
+			log.Fatalf("failed to open breached passwords: %v", err)
+		}
+		breached = list
+	}
+	return usecases.NewPasswordPolicy(
+		usecases.PasswordRules{
+			MinLength:        env.PasswordMinLength,
+			MaxLength:        env.PasswordMaxLength,
+			RequireUppercase: env.PasswordRequireUppercase,
+			RequireLowercase: env.PasswordRequireLowercase,
+			RequireDigit:     env.PasswordRequireDigit,
+			RequireSymbol:    env.PasswordRequireSymbol,
+			History:          env.PasswordHistory,
+		},
+		repositories.NewPasswordHistoryRepository(db, env.PasswordHistoryCollection),
+		breached,
+	)
+}
+
+// NewEmailTemplates parses the email templates, a broken template stops the server
+func NewEmailTemplates(env *bootstrap.Env) domain.IEmailTemplates {
+	templateFiles := email.EmbeddedTemplates()
+	if env.EmailTemplatesDir != "" {
+		templateFiles = os.DirFS(env.EmailTemplatesDir)
+	}
+	locale := env.EmailDefaultLocale
+	if locale == "" {
+		locale = "en"
+	}
+	templates, err := email.NewTemplates(templateFiles, locale)
+	if err != nil {
+		log.Fatalf("failed to load email templates: %v", err)
+	}
+	return templates
+}
+
+// NewEmailTransport picks how queued emails are delivered, the file transport writes them to
+// EMAIL_FILE_DIR for local development. An unknown transport stops the server.
+func NewEmailTransport(env *bootstrap.Env, db mongo.Database, templates domain.IEmailTemplates) domain.IEmailService {
+	users := repositories.NewUserRepository(db, env.UserCollection)
+	switch strings.ToLower(env.EmailTransport) {
+	case "", "smtp":
+		return email.NewGomailEmailService(
+			env.SMTPHost,
+			env.SMTPPort,
+			env.SMTPFrom,
+			env.SMTPUsername,
+			env.SMTPPassword,
+			templates,
+			users,
+		)
+	case "file":
+		dir := env.EmailFileDir
+		if dir == "" {
+			dir = "tmp/emails"
+		}
+		transport, err := email.NewFileEmailService(dir, env.SMTPFrom, templates, users)
+		if err != nil {
+			log.Fatalf("failed to create the email directory: %v", err)
+		}
+		return transport
+	default:
+		log.Fatalf("unknown EMAIL_TRANSPORT %q, use smtp or file", env.EmailTransport)
+		return nil
+	}
+}
+
+// NewEmailOutbox queues emails for the transport, unset retry settings take their default
+func NewEmailOutbox(env *bootstrap.Env, db mongo.Database, timeout time.Duration, transport domain.IEmailService) domain.IEmailOutboxUsecase {
+	collection := env.EmailOutboxCollection
+	if collection == "" {
+		collection = "email_outbox"
+	}
+	return usecases.NewEmailOutboxUsecase(
+		repositories.NewEmailOutboxRepository(db, collection),
+		transport,
+		usecases.EmailOutboxSettings{
+			MaxAttempts: env.EmailOutboxMaxAttempts,
+			RetryBase:   time.Duration(env.EmailOutboxRetryBaseSeconds) * time.Second,
+			RetryMax:    time.Duration(env.EmailOutboxRetryMaxMinutes) * time.Minute,
+		},
+		timeout,
+	)
+}
+
+// emailOutboxPollInterval is how often the worker looks for due retries, new emails wake it up
+func emailOutboxPollInterval(env *bootstrap.Env) time.Duration {
+	if env.EmailOutboxPollSeconds > 0 {
+		return time.Duration(env.EmailOutboxPollSeconds) * time.Second
+	}
+	return 10 * time.Second
+}
+
+// NewWebhooks delivers the blog's events to the subscribed webhooks, unset retry settings take
+// their default
+func NewWebhooks(env *bootstrap.Env, db mongo.Database, timeout time.Duration) domain.IWebhookUsecase {
+	collection := env.WebhookCollection
+	if collection == "" {
+		collection = "webhooks"
+	}
+	deliveryCollection := env.WebhookDeliveryCollection
+	if deliveryCollection == "" {
+		deliveryCollection = "webhook_deliveries"
+	}
+	return usecases.NewWebhookUsecase(
+		repositories.NewWebhookRepository(db, collection),
+		repositories.NewWebhookDeliveryRepository(db, deliveryCollection),
+		nil,
+		usecases.WebhookSettings{
+			MaxAttempts:    env.WebhookMaxAttempts,
+			RetryBase:      time.Duration(env.WebhookRetryBaseSeconds) * time.Second,
+			RetryMax:       time.Duration(env.WebhookRetryMaxMinutes) * time.Minute,
+			RequestTimeout: time.Duration(env.WebhookTimeoutSeconds) * time.Second,
+		},
+		timeout,
+	)
+}
+
+// webhookPollInterval is how often the worker looks for due retries, new deliveries wake it up
+func webhookPollInterval(env *bootstrap.Env) time.Duration {
+	if env.WebhookPollSeconds > 0 {
+		return time.Duration(env.WebhookPollSeconds) * time.Second
+	}
+	return 10 * time.Second
+}
+
+// NewTags builds the tag taxonomy, renames and merges retag the posts in a transaction of transactor
+func NewTags(env *bootstrap.Env, db mongo.Database, transactor domain.ITransactor, timeout time.Duration) domain.ITagUsecase {
+	collection := env.TagCollection
+	if collection == "" {
+		collection = "tags"
+	}
+	return usecases.NewTagUsecase(
+		repositories.NewTagRepository(db, collection),
+		repository.NewBlogPostRepo(db, &mongo.Collections{BlogPosts: env.BlogPostCollection}),
+		transactor,
+		timeout,
+	)
+}
+
+// NewCategories builds the category tree, moving a category updates its subcategories in a
+// transaction of transactor
+func NewCategories(env *bootstrap.Env, db mongo.Database, transactor domain.ITransactor, timeout time.Duration) domain.ICategoryUsecase {
+	collection := env.CategoryCollection
+	if collection == "" {
+		collection = "categories"
+	}
+	return usecases.NewCategoryUsecase(
+		repositories.NewCategoryRepository(db, collection),
+		repository.NewBlogPostRepo(db, &mongo.Collections{BlogPosts: env.BlogPostCollection}),
+		transactor,
+		timeout,
+	)
+}
+
+// NewEvents builds the event bus, unset retry settings take their default
+func NewEvents(env *bootstrap.Env, db mongo.Database, transactor domain.ITransactor, timeout time.Duration) domain.IEventBus {
+	collection := env.EventOutboxCollection
+	if collection == "" {
+		collection = "domain_events"
+	}
+	return usecases.NewEventBus(
+		repositories.NewEventOutboxRepository(db, collection),
+		transactor,
+		usecases.EventBusSettings{
+			MaxAttempts: env.EventMaxAttempts,
+			RetryBase:   time.Duration(env.EventRetryBaseSeconds) * time.Second,
+			RetryMax:    time.Duration(env.EventRetryMaxMinutes) * time.Minute,
+		},
+		timeout,
+	)
+}
+
+// eventPollInterval is how often the dispatcher looks for due retries, committed events wake it up
+func eventPollInterval(env *bootstrap.Env) time.Duration {
+	if env.EventPollSeconds > 0 {
+		return time.Duration(env.EventPollSeconds) * time.Second
+	}
+	return 5 * time.Second
+}
+
+// NewDigests builds the digest scheduler, without a secret to sign the unsubscribe links the
+// server stops
+func NewDigests(env *bootstrap.Env, db mongo.Database, timeout time.Duration, emailService domain.IEmailService, tags domain.ITagUsecase, events domain.IEventBus) domain.IDigestUsecase {
+	if env.DigestSecret == "" {
+		log.Fatalf("DIGEST_SECRET is required to sign the unsubscribe links of digests")
+	}
+	collection := env.DigestCollection
+	if collection == "" {
+		collection = "digest_subscriptions"
+	}
+	return usecases.NewDigestUsecase(
+		repositories.NewDigestRepository(db, collection),
+		repository.NewBlogPostRepo(db, &mongo.Collections{BlogPosts: env.BlogPostCollection}),
+		tags,
+		repositories.NewUserRepository(db, env.UserCollection),
+		emailService,
+		events,
+		usecases.DigestSettings{
+			Secret:         env.DigestSecret,
+			UnsubscribeURL: env.DigestUnsubscribeURL,
+			PostURL:        env.DigestPostURL,
+			MaxPosts:       env.DigestMaxPosts,
+		},
+		timeout,
+	)
+}
+
+// digestPollInterval is how often the scheduler looks for due digests
+func digestPollInterval(env *bootstrap.Env) time.Duration {
+	if env.DigestPollMinutes > 0 {
+		return time.Duration(env.DigestPollMinutes) * time.Minute
+	}
+	return 5 * time.Minute
+}
+
+// NewOTPPolicies builds the OTP policy of each purpose, OTP_<PURPOSE>_* settings override
+// OTP_EXPIRE_MINUTES, OTP_MAXIMUM_ATTEMPTS and OTP_MAXIMUM_FAILED_ATTEMPTS
+func NewOTPPolicies(env *bootstrap.Env) map[domain.OTPPurpose]usecases.OTPPolicy {
+	base := otpPolicy(usecases.DefaultOTPPolicy, env.OtpExpireMinutes, env.OtpMaximumAttempts, env.OtpMaximumFailedAttempts)
+	return map[domain.OTPPurpose]usecases.OTPPolicy{
+		domain.OTPPurposeEmailVerification: otpPolicy(base, env.OtpEmailVerificationExpireMinutes, env.OtpEmailVerificationMaximumAttempts, env.OtpEmailVerificationMaximumFailedAttempts),
+		domain.OTPPurposeLogin:             otpPolicy(base, env.OtpLoginExpireMinutes, env.OtpLoginMaximumAttempts, env.OtpLoginMaximumFailedAttempts),
+		domain.OTPPurposeSensitiveAction:   otpPolicy(base, env.OtpSensitiveActionExpireMinutes, env.OtpSensitiveActionMaximumAttempts, env.OtpSensitiveActionMaximumFailedAttempts),
+	}
+}
+
+// otpPolicy overrides the set values of base
+func otpPolicy(base usecases.OTPPolicy, expireMinutes, maxAttempts, maxFailedAttempts int) usecases.OTPPolicy {
+	if expireMinutes > 0 {
+		base.Expiration = time.Duration(expireMinutes) * time.Minute
+	}
+	if maxAttempts > 0 {
+		base.MaxAttempts = maxAttempts
+	}
+	if maxFailedAttempts > 0 {
+		base.MaxFailedAttempts = maxFailedAttempts
+	}
+	return base
+}
+
+// NewPasswordHashing picks the configured hasher for new hashes, the other algorithm still verifies
+// existing hashes until they are upgraded. An unknown algorithm stops the server.
+func NewPasswordHashing(env *bootstrap.Env) *security.PasswordHashing {
+	argon2id := security.DefaultArgon2idHasher
+	if env.PasswordArgon2MemoryKB > 0 {
+		argon2id.Memory = uint32(env.PasswordArgon2MemoryKB)
+	}
+	if env.PasswordArgon2Iterations > 0 {
+		argon2id.Iterations = uint32(env.PasswordArgon2Iterations)
+	}
+	if env.PasswordArgon2Parallelism > 0 {
+		argon2id.Parallelism = uint8(min(env.PasswordArgon2Parallelism, 255))
+	}
+	bcryptHasher := security.BcryptHasher{Cost: bcrypt.DefaultCost}
+	if env.PasswordBcryptCost > 0 {
+		bcryptHasher.Cost = min(max(env.PasswordBcryptCost, bcrypt.MinCost), bcrypt.MaxCost)
+	}
+
+	switch strings.ToLower(env.PasswordHashAlgorithm) {
+	case "", "argon2id":
+		return security.NewPasswordHashing(argon2id, bcryptHasher)
+	case "bcrypt":
+		return security.NewPasswordHashing(bcryptHasher, argon2id)
+	default:
+		log.Fatalf("unknown PASSWORD_HASH_ALGORITHM %q, use argon2id or bcrypt", env.PasswordHashAlgorithm)
+		return nil
+	}
+}
+
+// NewKeySet builds the JWT key set from configuration, it is also used by the rotate-keys command
+func NewKeySet(env *bootstrap.Env, db mongo.Database) *security.KeySet {
+	algorithm := domain.SigningAlgorithm(env.JWTSigningAlgorithm)
+	if algorithm == "" {
+		algorithm = domain.SigningAlgorithmRS256
+	}
+	// retired keys must outlive the refresh tokens they signed
+	retention := time.Duration(env.JWTKeyRetentionHours) * time.Hour
+	if retention <= 0 {
+		retention = time.Duration(env.RefTEHours) * time.Hour
 	}
+	return security.NewKeySet(
+		repositories.NewSigningKeyRepository(db, env.SigningKeyCollection),
+		env.JWTKeyEncryptionSecret,
+		algorithm,
+		retention,
+		time.Duration(env.CtxTSeconds)*time.Second,
+	)
 }
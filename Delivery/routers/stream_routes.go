@@ -0,0 +1,23 @@
+package routers
+
+import (
+	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewStreamRoutes serves the live streams as server-sent events, for EventSource clients
+func NewStreamRoutes(group *gin.RouterGroup, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, streams domain.IStreamUsecase) {
+	streamController := controllers.NewStreamController(streams)
+
+	// a user's notifications, only to that user
+	group.GET("/notifications/stream",
+		middleware.AuthMiddleware(authService, tokenUsecase),
+		middleware.SessionOnly(),
+		streamController.StreamNotifications,
+	)
+	// a post's comments and reactions are public, like the comments themselves
+	group.GET("/blogs/:id/stream", streamController.StreamPost)
+}
@@ -0,0 +1,30 @@
+package routers
+
+import (
+	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewTagRoutes(group *gin.RouterGroup, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, policy domain.IPolicy, tags domain.ITagUsecase) {
+	tagController := controllers.NewTagController(tags)
+
+	// anyone may look tags up, for the tag field of the editor and tag clouds
+	tagGroup := group.Group("/tags")
+	tagGroup.GET("/autocomplete", tagController.Autocomplete)
+	tagGroup.GET("/popular", tagController.Popular)
+	tagGroup.GET("/:slug", tagController.GetTag)
+
+	// admins curate the taxonomy, renames and merges rewrite the tags of existing posts
+	manage := tagGroup.Group("",
+		middleware.AuthMiddleware(authService, tokenUsecase),
+		middleware.SessionOnly(),
+		middleware.RequirePermission(policy, domain.PermTagManage),
+	)
+	manage.POST("", tagController.CreateTag)
+	manage.PUT("/:slug", tagController.UpdateTag)
+	manage.POST("/:slug/rename", tagController.RenameTag)
+	manage.POST("/:slug/merge", tagController.MergeTag)
+}
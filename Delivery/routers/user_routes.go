@@ -3,6 +3,7 @@ package routers
 import (
 	"g6/blog-api/Delivery/bootstrap"
 	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
 	repositories "g6/blog-api/Repositories"
 	usecases "g6/blog-api/Usecases"
 	"time"
@@ -14,7 +15,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func NewUserRoutes(env *bootstrap.Env, group *gin.RouterGroup, db mongo.Database) {
+func NewUserRoutes(env *bootstrap.Env, group *gin.RouterGroup, db mongo.Database, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, policy domain.IPolicy, passwordPolicy domain.IPasswordPolicy, events domain.IEventBus) {
 	// context time out
 	ctxTimeout := time.Duration(env.CtxTSeconds) * time.Second
 
@@ -26,10 +27,18 @@ func NewUserRoutes(env *bootstrap.Env, group *gin.RouterGroup, db mongo.Database
 	)
 	// repositories and usecases
 	userRepo := repositories.NewUserRepository(db, env.UserCollection)
-	userUsecase := usecases.NewUserUsecase(userRepo, imageKitStorageService, ctxTimeout)
-	userController := controllers.NewUserController(userUsecase)
+	userUsecase := usecases.NewUserUsecase(userRepo, imageKitStorageService, policy, passwordPolicy, events, ctxTimeout)
+	securityEventUsecase := usecases.NewSecurityEventUsecase(repositories.NewSecurityEventRepository(db, env.SecurityEventCollection), ctxTimeout)
+	userController := controllers.NewUserController(userUsecase, securityEventUsecase)
+	tokenController := controllers.NewPersonalAccessTokenController(tokenUsecase, securityEventUsecase)
 
-	group.PATCH("/users/update-profile", middleware.AuthMiddleware(*env), userController.UpdateProfile)
-	group.PATCH("/users/change-password", middleware.AuthMiddleware(*env), userController.ChangePassword)
+	// profile and token management need a real login, personal access tokens are refused
+	users := group.Group("/users", middleware.AuthMiddleware(authService, tokenUsecase), middleware.SessionOnly())
+	users.PATCH("/update-profile", userController.UpdateProfile)
+	users.PATCH("/change-password", userController.ChangePassword)
+
+	users.GET("/tokens", tokenController.ListTokens)
+	users.POST("/tokens", tokenController.CreateToken)
+	users.DELETE("/tokens/:id", tokenController.RevokeToken)
 
 }
@@ -0,0 +1,28 @@
+package routers
+
+import (
+	"g6/blog-api/Delivery/controllers"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NewWebhookRoutes(group *gin.RouterGroup, authService domain.IAuthService, tokenUsecase domain.IPersonalAccessTokenUsecase, policy domain.IPolicy, webhooks domain.IWebhookUsecase) {
+	webhookController := controllers.NewWebhookController(webhooks)
+
+	// admins subscribe integrations to the blog's events and follow their deliveries
+	manage := group.Group("/webhooks",
+		middleware.AuthMiddleware(authService, tokenUsecase),
+		middleware.SessionOnly(),
+		middleware.RequirePermission(policy, domain.PermWebhookManage),
+	)
+	manage.GET("", webhookController.ListWebhooks)
+	manage.POST("", webhookController.CreateWebhook)
+	manage.GET("/:id", webhookController.GetWebhook)
+	manage.PUT("/:id", webhookController.UpdateWebhook)
+	manage.DELETE("/:id", webhookController.DeleteWebhook)
+	manage.POST("/:id/test", webhookController.SendTestEvent)
+	manage.GET("/:id/deliveries", webhookController.ListDeliveries)
+	manage.GET("/:id/deliveries/:delivery_id", webhookController.GetDelivery)
+}
@@ -12,6 +12,7 @@ type BlogPost struct {
 	AuthorID        string
 	AuthorName      string // for easy access to author's name: first_name + last_name
 	Tags            []string
+	CategoryID      string // the primary category, required on new posts
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	Likes           int
@@ -52,13 +53,20 @@ const (
 )
 
 type BlogPostFilter struct {
-	Page       int
-	PageSize   int
-	Recency    Recency
-	Tags       []string
-	AuthorName string
-	Title      string
-	Popular    bool // indicates if the filter is for most popular blogs
+	Page         int
+	PageSize     int
+	Recency      Recency
+	Tags         []string
+	AuthorName   string
+	Title        string
+	Popular      bool      // indicates if the filter is for most popular blogs
+	AuthorIDs    []string  // posts written by any of these users
+	CreatedAfter time.Time // posts created after this time, ignored when zero
+	// Category is the ID or slug of the category of the posts, IncludeSubcategories takes in
+	// the posts of the categories under it. The usecase resolves them into CategoryIDs.
+	Category             string
+	IncludeSubcategories bool
+	CategoryIDs          []string
 }
 
 // Repository Interfaces provide an abstraction layer for data access operations related to blogs, comments, and user reactions.
@@ -72,6 +80,13 @@ type BlogPostRepository interface {
 	IncrementViewCount(ctx context.Context, id string) (*BlogPost, *DomainError)
 	UpdateCommentCount(ctx context.Context, id string, increment bool) (*BlogPost, *DomainError)
 	UpdateReactionCount(ctx context.Context, is_like bool, id string, increment bool) (*BlogPost, *DomainError)
+	// RefreshCounts recounts the likes, dislikes and comments of the post and its popularity score
+	RefreshCounts(ctx context.Context, id string) (*BlogPost, *DomainError)
+	UpdateAuthorName(ctx context.Context, authorID string, name string) *DomainError
+	// ReplaceTags tags the posts with any of the from tags with to instead
+	ReplaceTags(ctx context.Context, from []string, to string) *DomainError
+	CountByTag(ctx context.Context, tag string) (int, *DomainError)
+	CountByCategory(ctx context.Context, categoryID string) (int, *DomainError)
 
 	//... more methods can be added based on the usecases
 }
@@ -88,6 +103,7 @@ type BlogUserReactionRepository interface {
 	Create(ctx context.Context, reaction *BlogUserReaction) (*BlogUserReaction, *DomainError)
 	Delete(ctx context.Context, id string) *DomainError
 	GetUserReaction(ctx context.Context, blogID, userID string) (*BlogUserReaction, *DomainError)
+	GetReactionByID(ctx context.Context, id string) (*BlogUserReaction, *DomainError)
 }
 
 // Usecase Interfaces define the business logic for handling blogs, comments, and user reactions.
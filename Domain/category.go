@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Category is a node of the category tree editors file posts under. Ancestors are the IDs of
+// the categories above it, the root first, so a subtree is found in one query.
+type Category struct {
+	ID          string
+	Name        string
+	Slug        string // unique across the tree, made like a tag slug
+	Description string
+	ParentID    string // empty for a top level category
+	Ancestors   []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CategoryNode is a category with the categories under it
+type CategoryNode struct {
+	*Category
+	Children []*CategoryNode
+}
+
+type ICategoryUsecase interface {
+	// Tree returns the top level categories with their subcategories, by name
+	Tree() ([]*CategoryNode, error)
+	// Get finds a category by its ID or slug
+	Get(ref string) (*Category, error)
+	Create(category *Category) (*Category, error)
+	// Update renames, describes and moves a category, its subcategories move along
+	Update(ref string, category *Category) (*Category, error)
+	// Delete removes a category without subcategories or posts
+	Delete(ref string) error
+	// Resolve returns the ID of the category with the ID or slug ref, followed by the IDs of
+	// its subcategories at any depth when withDescendants is set
+	Resolve(ctx context.Context, ref string, withDescendants bool) ([]string, error)
+}
+
+type ICategoryRepository interface {
+	Create(ctx context.Context, category *Category) error // ErrCategoryConflict when the slug is taken
+	FindByID(ctx context.Context, id string) (*Category, error)
+	FindBySlug(ctx context.Context, slug string) (*Category, error)
+	List(ctx context.Context) ([]*Category, error)
+	// Descendants finds the categories with id among their ancestors
+	Descendants(ctx context.Context, id string) ([]*Category, error)
+	Update(ctx context.Context, category *Category) error
+	Delete(ctx context.Context, id string) error
+}
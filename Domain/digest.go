@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type DigestFrequency string
+
+const (
+	DigestOff    DigestFrequency = "off"
+	DigestDaily  DigestFrequency = "daily"
+	DigestWeekly DigestFrequency = "weekly"
+)
+
+// Period is the time between two digests, zero when they are turned off
+func (f DigestFrequency) Period() time.Duration {
+	switch f {
+	case DigestDaily:
+		return 24 * time.Hour
+	case DigestWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// DigestSubscription is how often a user gets a digest and what it is about, the new posts of
+// the followed authors (user IDs) and tags. A digest covers the posts created since LastSentAt
+// and goes out at NextSendAt.
+type DigestSubscription struct {
+	UserID     string
+	Frequency  DigestFrequency
+	Authors    []string
+	Tags       []string
+	LastSentAt time.Time
+	NextSendAt time.Time
+	UpdatedAt  time.Time
+}
+
+type IDigestUsecase interface {
+	// Subscription returns the user's settings, digests are off for a user who never set them
+	Subscription(userID string) (*DigestSubscription, error)
+	// UpdateSubscription replaces the user's frequency and follows. Turning digests on starts
+	// the first one from now.
+	UpdateSubscription(subscription *DigestSubscription) (*DigestSubscription, error)
+	// UnsubscribeToken signs the user ID for the one-click unsubscribe link of their digests
+	UnsubscribeToken(userID string) string
+	// Unsubscribe turns off the digests of the user the token was made for
+	Unsubscribe(token string) error
+	// SendDue sends the digests that are due and returns how many subscriptions it went through
+	SendDue(ctx context.Context) (int, error)
+}
+
+type IDigestRepository interface {
+	// FindByUserID returns ErrNotFound when the user never set up digests
+	FindByUserID(ctx context.Context, userID string) (*DigestSubscription, error)
+	Save(ctx context.Context, subscription *DigestSubscription) error
+	// ClaimNext takes the first subscription that is due, moving its NextSendAt to leaseUntil so
+	// no other worker sends it in the meantime
+	ClaimNext(ctx context.Context, now, leaseUntil time.Time) (*DigestSubscription, error)
+	// MarkSent records a digest and when the next one is due
+	MarkSent(ctx context.Context, userID string, sentAt, nextSendAt time.Time) error
+}
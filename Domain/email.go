@@ -2,8 +2,41 @@ package domain
 
 import "context"
 
+// the transactional email templates
+const (
+	EmailTemplateOTP           = "otp"
+	EmailTemplatePasswordReset = "password_reset"
+	EmailTemplateMagicLink     = "magic_link"
+	EmailTemplateAccountLocked = "account_locked"
+	EmailTemplateDigest        = "digest"
+)
+
+// EmailTemplateData is what a template is rendered with. Its "Locale" picks the translation,
+// without one the recipient's preferred language is used.
+type EmailTemplateData map[string]any
+
+// RenderedEmail is a template rendered for one locale, Text is the plain text alternative of HTML
+type RenderedEmail struct {
+	Locale  string
+	Subject string
+	HTML    string
+	Text    string
+}
+
 type IEmailService interface {
 	SendEmail(ctx context.Context, to, subject, body string) error
+	// SendTemplate renders the named template in the recipient's language and sends it
+	// as HTML with a plain text alternative
+	SendTemplate(ctx context.Context, to, templateName string, data EmailTemplateData) error
+}
+
+type IEmailTemplates interface {
+	// Render renders the template in the closest available locale, falling back to the default one
+	Render(name, locale string, data EmailTemplateData) (*RenderedEmail, error)
+	// Preview renders the template with made-up data
+	Preview(name, locale string) (*RenderedEmail, error)
+	Names() []string
+	Locales() []string
 }
 
 type IPasswordResetUsecase interface {
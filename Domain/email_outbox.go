@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type OutboxEmailStatus string
+
+const (
+	OutboxEmailPending OutboxEmailStatus = "pending"
+	OutboxEmailSending OutboxEmailStatus = "sending"
+	OutboxEmailSent    OutboxEmailStatus = "sent"
+	OutboxEmailDead    OutboxEmailStatus = "dead" // gave up after the last attempt
+)
+
+// OutboxEmail is an email waiting to be sent, either an HTML Body with its Subject or a Template
+// with its Data. Body and Data are cleared once the email is sent, they may hold codes and tokens.
+type OutboxEmail struct {
+	ID            string
+	To            string
+	Subject       string
+	Body          string
+	Template      string
+	Data          EmailTemplateData
+	Status        OutboxEmailStatus
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time // for a sending email, when its claim runs out
+	SentAt        time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// OutboxEmailFilter narrows the outbox, zero fields match everything
+type OutboxEmailFilter struct {
+	Status   OutboxEmailStatus
+	To       string
+	Page     int
+	PageSize int
+}
+
+// IEmailOutboxUsecase queues emails instead of sending them within the request, a worker
+// sends them with retries
+type IEmailOutboxUsecase interface {
+	IEmailService
+	// ProcessDue sends the emails that are due and returns how many it attempted
+	ProcessDue(ctx context.Context) (int, error)
+	// Wake is signalled whenever an email is queued
+	Wake() <-chan struct{}
+	List(filter OutboxEmailFilter) ([]*OutboxEmail, int64, error)
+	FindByID(id string) (*OutboxEmail, error)
+	// Retry queues a dead email again, with a fresh set of attempts
+	Retry(id string) (*OutboxEmail, error)
+}
+
+type IEmailOutboxRepository interface {
+	Enqueue(ctx context.Context, email *OutboxEmail) error
+	// ClaimNext marks the longest due email as sending until leaseUntil and returns it, or nil
+	// when none is due. Emails whose claim ran out, because a worker died, are due again.
+	ClaimNext(ctx context.Context, now, leaseUntil time.Time) (*OutboxEmail, error)
+	Update(ctx context.Context, email *OutboxEmail) error
+	FindByID(ctx context.Context, id string) (*OutboxEmail, error)
+	Find(ctx context.Context, filter OutboxEmailFilter) ([]*OutboxEmail, int64, error)
+}
@@ -13,6 +13,7 @@ var (
 	ErrTokenExpired      = errors.New("token expired")
 	ErrInvalidInput      = errors.New("invalid input")
 	ErrUnauthorized      = errors.New("User not authenticated or authorized")
+	ErrForbidden         = errors.New("you are not allowed to perform this action")
 	ErrInvalidFile       = errors.New("invalid file format")
 	ErrOTPNotFound       = errors.New("OTP not found")
 	ErrOTPExpired        = errors.New("OTP expired")
@@ -21,4 +22,67 @@ var (
 	ErrOTPInvalidCode    = errors.New("invalid OTP code")
 	ErrOTPInvalid        = errors.New("invalid OTP")
 	ErrOTPFailedToDelete = errors.New("failed to delete OTP")
+	ErrOTPUnknownPurpose = errors.New("unknown OTP purpose")
+
+	ErrEmailTemplateNotFound = errors.New("email template not found")
+	ErrOutboxEmailNotFound   = errors.New("outbox email not found")
+	ErrOutboxEmailNotDead    = errors.New("only dead emails can be retried")
+
+	ErrNotificationNotFound    = errors.New("notification not found")
+	ErrUnknownNotificationType = errors.New("unknown notification type")
+
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrInvalidWebhookURL       = errors.New("webhook URL must be an absolute http or https URL")
+	ErrUnknownWebhookEvent     = errors.New("unknown webhook event")
+
+	ErrTagNotFound    = errors.New("tag not found")
+	ErrTagConflict    = errors.New("the slug or a synonym is already used by another tag")
+	ErrInvalidTagName = errors.New("a tag needs a letter or digit")
+	ErrTagMergeSelf   = errors.New("a tag cannot be merged into itself")
+
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrCategoryConflict    = errors.New("the slug is already used by another category")
+	ErrInvalidCategoryName = errors.New("a category needs a letter or digit")
+	ErrCategoryCycle       = errors.New("a category cannot be moved under itself or one of its subcategories")
+	ErrCategoryInUse       = errors.New("the category still has subcategories or posts")
+
+	ErrUnknownDigestFrequency  = errors.New("unknown digest frequency")
+	ErrInvalidUnsubscribeToken = errors.New("invalid unsubscribe link")
+
+	ErrTwoFactorNotFound       = errors.New("two-factor authentication is not set up")
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorInvalidCode    = errors.New("invalid two-factor code")
+	ErrTwoFactorRequired       = errors.New("two-factor authentication is required for this role")
+
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+
+	ErrAccessTokenNotFound  = errors.New("personal access token not found")
+	ErrAccessTokenExpired   = errors.New("personal access token expired")
+	ErrAccessTokenLimit     = errors.New("personal access token limit reached")
+	ErrInvalidTokenScope    = errors.New("invalid token scope")
+	ErrTokenScopeNotAllowed = errors.New("admin scope requires an admin account")
+
+	ErrOIDCProviderNotFound = errors.New("unknown login provider")
+	ErrOIDCInvalidState     = errors.New("invalid or expired login state")
+	ErrOIDCEmailNotVerified = errors.New("the provider did not verify this email address")
+	ErrOIDCLoginFailed      = errors.New("login with the provider failed")
+	ErrOIDCAccountExists    = errors.New("an account with this email already exists, log in and link the provider from your account")
+
+	ErrIdentityNotFound      = errors.New("provider is not linked to this account")
+	ErrIdentityAlreadyLinked = errors.New("provider account is already linked")
+	ErrLastLoginMethod       = errors.New("cannot unlink the last way to log in")
+	ErrPasswordRequired      = errors.New("set a password before changing linked providers")
+	ErrProviderConfirmation  = errors.New("confirm with another linked provider to unlink this one")
+	ErrInvalidPassword       = errors.New("invalid password")
+
+	ErrMagicLinkInvalid     = errors.New("invalid or expired login link, open it in the browser you requested it from")
+	ErrMagicLinkRateLimited = errors.New("too many login links requested, try again later")
+
+	ErrAccountLocked       = errors.New("too many failed logins, the account is temporarily locked")
+	ErrLoginThrottled      = errors.New("too many failed logins, try again later")
+	ErrUnlockTokenInvalid  = errors.New("invalid or expired unlock link")
+	ErrAccountLockNotFound = errors.New("no lock found for this identifier")
 )
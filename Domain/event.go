@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// DomainEventType is something that happened to the blog, the subscribers of the type are
+// told about it after the change is committed
+type DomainEventType string
+
+const (
+	EventPostCreated     DomainEventType = "post.created"
+	EventPostUpdated     DomainEventType = "post.updated"
+	EventPostDeleted     DomainEventType = "post.deleted"
+	EventCommentCreated  DomainEventType = "comment.created"
+	EventCommentUpdated  DomainEventType = "comment.updated"
+	EventCommentDeleted  DomainEventType = "comment.deleted"
+	EventReactionChanged DomainEventType = "reaction.changed"
+	EventUserUpdated     DomainEventType = "user.updated"
+	EventAuthorFollowed  DomainEventType = "author.followed"
+)
+
+type DomainEventStatus string
+
+const (
+	DomainEventPending     DomainEventStatus = "pending"
+	DomainEventDispatching DomainEventStatus = "dispatching" // claimed by a dispatcher until NextAttemptAt
+	DomainEventDone        DomainEventStatus = "done"
+	DomainEventDead        DomainEventStatus = "dead" // a subscriber kept failing
+)
+
+// DomainEvent is an event in the outbox. Payload is the JSON of its data, Delivered names the
+// subscribers that handled it so a retry only runs the ones that failed.
+type DomainEvent struct {
+	ID            string
+	Type          DomainEventType
+	AggregateID   string // the post, comment, reaction or user the event is about
+	Payload       string
+	Status        DomainEventStatus
+	Delivered     []string
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Decode reads the event's data into v
+func (e *DomainEvent) Decode(v any) error {
+	return json.Unmarshal([]byte(e.Payload), v)
+}
+
+// PostEventData is the post after the change, or as it was for post.deleted. PreviousTags are
+// the tags before a post.updated.
+type PostEventData struct {
+	ID           string    `json:"id"`
+	Title        string    `json:"title"`
+	Content      string    `json:"content"`
+	AuthorID     string    `json:"author_id"`
+	AuthorName   string    `json:"author_name"`
+	Tags         []string  `json:"tags"`
+	PreviousTags []string  `json:"previous_tags,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// CommentEventData is the comment after the change, or as it was for comment.deleted
+type CommentEventData struct {
+	ID        string    `json:"id"`
+	BlogID    string    `json:"blog_id"`
+	AuthorID  string    `json:"author_id"`
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ReactionEventData is a reaction that was added, changed or, when Removed, taken back
+type ReactionEventData struct {
+	ID      string `json:"id"`
+	BlogID  string `json:"blog_id"`
+	UserID  string `json:"user_id"`
+	IsLike  bool   `json:"is_like"`
+	Removed bool   `json:"removed"`
+}
+
+type UserEventData struct {
+	ID        string `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+// FollowEventData is a reader who started following an author's posts in their digest
+type FollowEventData struct {
+	FollowerID string `json:"follower_id"`
+	AuthorID   string `json:"author_id"`
+}
+
+// DomainEventHandler handles an event at least once, a handler that fails is retried with the
+// same event so it has to be safe to run again
+type DomainEventHandler func(ctx context.Context, event *DomainEvent) error
+
+type IEventBus interface {
+	// Subscribe runs the handler for every event of the type. The name identifies the
+	// subscriber among those of the type and is recorded once it handled an event.
+	Subscribe(eventType DomainEventType, name string, handler DomainEventHandler)
+	// Transaction runs fn in a database transaction, the events fn publishes with the context it
+	// is given are stored with its changes or not at all
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// Publish stores the event in the outbox, the dispatcher hands it to the subscribers
+	Publish(ctx context.Context, eventType DomainEventType, aggregateID string, data any) error
+	// ProcessDue dispatches the events that are due and returns how many it attempted
+	ProcessDue(ctx context.Context) (int, error)
+	// Wake is signalled whenever events are committed
+	Wake() <-chan struct{}
+}
+
+type IEventOutboxRepository interface {
+	Append(ctx context.Context, event *DomainEvent) error
+	// ClaimNext takes the oldest due event, it is leased to the caller until leaseUntil
+	ClaimNext(ctx context.Context, now, leaseUntil time.Time) (*DomainEvent, error)
+	Update(ctx context.Context, event *DomainEvent) error
+}
+
+type ITransactor interface {
+	// WithTransaction runs fn in a transaction, the repositories take part in it through the
+	// context fn is given. fn may run more than once when the transaction is retried.
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// LinkedIdentity connects a user to an account at an OpenID Connect provider.
+// The provider's subject identifies the account, the email is only kept for display.
+type LinkedIdentity struct {
+	ID          string
+	UserID      string
+	Provider    string
+	Subject     string
+	Email       string
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+}
+
+// LoginMethods lists the ways a user can log in
+type LoginMethods struct {
+	HasPassword bool
+	Identities  []*LinkedIdentity
+}
+
+type ILinkedIdentityRepository interface {
+	Create(ctx context.Context, identity *LinkedIdentity) error
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*LinkedIdentity, error)
+	FindByUserID(ctx context.Context, userID string) ([]*LinkedIdentity, error)
+	// Delete is scoped to the owner, so users cannot unlink each other's identities
+	Delete(ctx context.Context, userID, provider string) error
+	UpdateLastLogin(ctx context.Context, id string, at time.Time) error
+}
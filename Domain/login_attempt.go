@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// AccountLock is a login identifier locked after too many failed logins.
+// Locks are kept per identifier, so unknown usernames are locked like real ones.
+type AccountLock struct {
+	Identifier  string    `json:"identifier"`
+	UserID      string    `json:"user_id,omitempty"`
+	Failures    int64     `json:"failures"`
+	IP          string    `json:"ip"` // where the failure that locked it came from
+	LockedAt    time.Time `json:"locked_at"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+type ILoginAttemptUsecase interface {
+	// Check is called before the password is verified. It fails with ErrAccountLocked or
+	// ErrLoginThrottled and how long the client has to wait.
+	Check(identifier, ip string) (retryAfter time.Duration, err error)
+	// RecordFailure counts a failed login, delays the next attempts and locks the identifier at the limit
+	RecordFailure(identifier, ip string) error
+	// RecordSuccess forgets the failures of the identifier, the IP keeps its count
+	RecordSuccess(identifier string) error
+	// CheckUser fails with ErrAccountLocked while a login identifier of the user is locked
+	CheckUser(userID string) (retryAfter time.Duration, err error)
+	// RecordSecondFactorFailure counts a wrong two-factor code of the user and locks the user at the limit
+	RecordSecondFactorFailure(userID, ip string) error
+	// RecordSecondFactorSuccess forgets the wrong two-factor codes of the user
+	RecordSecondFactorSuccess(userID string) error
+	// Unlock lifts a lock with the token from the unlock email
+	Unlock(token string) error
+	ListLocks() ([]*AccountLock, error)
+	UnlockIdentifier(identifier string) error
+}
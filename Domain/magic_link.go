@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MagicLink is a single-use login link sent by email. Only hashes are stored: of the token in the
+// link and of the binding cookie given to the browser that asked for it.
+type MagicLink struct {
+	ID          string
+	Email       string
+	UserID      string // empty when no user has the email, the request is still counted for rate limiting
+	TokenHash   string
+	BindingHash string
+	IP          string
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+type IMagicLinkUsecase interface {
+	// RequestLink emails a login link when the address belongs to a user and returns the browser binding.
+	// Unknown addresses get a binding too, so the response does not reveal which emails have accounts.
+	RequestLink(email, ip string) (binding string, err error)
+	// Redeem logs in with the token from the link, from the browser holding the binding
+	Redeem(token, binding string) (*User, error)
+}
+
+type IMagicLinkRepository interface {
+	Create(ctx context.Context, link *MagicLink) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*MagicLink, error)
+	// Consume deletes the link, it fails when another redemption got there first
+	Consume(ctx context.Context, id string) error
+	CountByEmailSince(ctx context.Context, email string, since time.Time) (int64, error)
+	CountByIPSince(ctx context.Context, ip string, since time.Time) (int64, error)
+}
@@ -38,6 +38,142 @@ func (_m *MockBlogPostRepository) EXPECT() *MockBlogPostRepository_Expecter {
 	return &MockBlogPostRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountByCategory provides a mock function for the type MockBlogPostRepository
+func (_mock *MockBlogPostRepository) CountByCategory(ctx context.Context, categoryID string) (int, *domain.DomainError) {
+	ret := _mock.Called(ctx, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByCategory")
+	}
+
+	var r0 int
+	var r1 *domain.DomainError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int, *domain.DomainError)); ok {
+		return returnFunc(ctx, categoryID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = returnFunc(ctx, categoryID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *domain.DomainError); ok {
+		r1 = returnFunc(ctx, categoryID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.DomainError)
+		}
+	}
+	return r0, r1
+}
+
+// MockBlogPostRepository_CountByCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByCategory'
+type MockBlogPostRepository_CountByCategory_Call struct {
+	*mock.Call
+}
+
+// CountByCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID string
+func (_e *MockBlogPostRepository_Expecter) CountByCategory(ctx interface{}, categoryID interface{}) *MockBlogPostRepository_CountByCategory_Call {
+	return &MockBlogPostRepository_CountByCategory_Call{Call: _e.mock.On("CountByCategory", ctx, categoryID)}
+}
+
+func (_c *MockBlogPostRepository_CountByCategory_Call) Run(run func(ctx context.Context, categoryID string)) *MockBlogPostRepository_CountByCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlogPostRepository_CountByCategory_Call) Return(n int, domainError *domain.DomainError) *MockBlogPostRepository_CountByCategory_Call {
+	_c.Call.Return(n, domainError)
+	return _c
+}
+
+func (_c *MockBlogPostRepository_CountByCategory_Call) RunAndReturn(run func(ctx context.Context, categoryID string) (int, *domain.DomainError)) *MockBlogPostRepository_CountByCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByTag provides a mock function for the type MockBlogPostRepository
+func (_mock *MockBlogPostRepository) CountByTag(ctx context.Context, tag string) (int, *domain.DomainError) {
+	ret := _mock.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByTag")
+	}
+
+	var r0 int
+	var r1 *domain.DomainError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int, *domain.DomainError)); ok {
+		return returnFunc(ctx, tag)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = returnFunc(ctx, tag)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *domain.DomainError); ok {
+		r1 = returnFunc(ctx, tag)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.DomainError)
+		}
+	}
+	return r0, r1
+}
+
+// MockBlogPostRepository_CountByTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByTag'
+type MockBlogPostRepository_CountByTag_Call struct {
+	*mock.Call
+}
+
+// CountByTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+func (_e *MockBlogPostRepository_Expecter) CountByTag(ctx interface{}, tag interface{}) *MockBlogPostRepository_CountByTag_Call {
+	return &MockBlogPostRepository_CountByTag_Call{Call: _e.mock.On("CountByTag", ctx, tag)}
+}
+
+func (_c *MockBlogPostRepository_CountByTag_Call) Run(run func(ctx context.Context, tag string)) *MockBlogPostRepository_CountByTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlogPostRepository_CountByTag_Call) Return(n int, domainError *domain.DomainError) *MockBlogPostRepository_CountByTag_Call {
+	_c.Call.Return(n, domainError)
+	return _c
+}
+
+func (_c *MockBlogPostRepository_CountByTag_Call) RunAndReturn(run func(ctx context.Context, tag string) (int, *domain.DomainError)) *MockBlogPostRepository_CountByTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockBlogPostRepository
 func (_mock *MockBlogPostRepository) Create(ctx context.Context, blog *domain.BlogPost) (*domain.BlogPost, *domain.DomainError) {
 	ret := _mock.Called(ctx, blog)
@@ -385,6 +521,76 @@ func (_c *MockBlogPostRepository_IncrementViewCount_Call) RunAndReturn(run func(
 	return _c
 }
 
+// RefreshCounts provides a mock function for the type MockBlogPostRepository
+func (_mock *MockBlogPostRepository) RefreshCounts(ctx context.Context, id string) (*domain.BlogPost, *domain.DomainError) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshCounts")
+	}
+
+	var r0 *domain.BlogPost
+	var r1 *domain.DomainError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.BlogPost, *domain.DomainError)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.BlogPost); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BlogPost)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *domain.DomainError); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.DomainError)
+		}
+	}
+	return r0, r1
+}
+
+// MockBlogPostRepository_RefreshCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshCounts'
+type MockBlogPostRepository_RefreshCounts_Call struct {
+	*mock.Call
+}
+
+// RefreshCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockBlogPostRepository_Expecter) RefreshCounts(ctx interface{}, id interface{}) *MockBlogPostRepository_RefreshCounts_Call {
+	return &MockBlogPostRepository_RefreshCounts_Call{Call: _e.mock.On("RefreshCounts", ctx, id)}
+}
+
+func (_c *MockBlogPostRepository_RefreshCounts_Call) Run(run func(ctx context.Context, id string)) *MockBlogPostRepository_RefreshCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlogPostRepository_RefreshCounts_Call) Return(blogPost *domain.BlogPost, domainError *domain.DomainError) *MockBlogPostRepository_RefreshCounts_Call {
+	_c.Call.Return(blogPost, domainError)
+	return _c
+}
+
+func (_c *MockBlogPostRepository_RefreshCounts_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.BlogPost, *domain.DomainError)) *MockBlogPostRepository_RefreshCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshPopularityScore provides a mock function for the type MockBlogPostRepository
 func (_mock *MockBlogPostRepository) RefreshPopularityScore(ctx context.Context, id string) (*domain.BlogPost, *domain.DomainError) {
 	ret := _mock.Called(ctx, id)
@@ -455,6 +661,71 @@ func (_c *MockBlogPostRepository_RefreshPopularityScore_Call) RunAndReturn(run f
 	return _c
 }
 
+// ReplaceTags provides a mock function for the type MockBlogPostRepository
+func (_mock *MockBlogPostRepository) ReplaceTags(ctx context.Context, from []string, to string) *domain.DomainError {
+	ret := _mock.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceTags")
+	}
+
+	var r0 *domain.DomainError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, string) *domain.DomainError); ok {
+		r0 = returnFunc(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DomainError)
+		}
+	}
+	return r0
+}
+
+// MockBlogPostRepository_ReplaceTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceTags'
+type MockBlogPostRepository_ReplaceTags_Call struct {
+	*mock.Call
+}
+
+// ReplaceTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from []string
+//   - to string
+func (_e *MockBlogPostRepository_Expecter) ReplaceTags(ctx interface{}, from interface{}, to interface{}) *MockBlogPostRepository_ReplaceTags_Call {
+	return &MockBlogPostRepository_ReplaceTags_Call{Call: _e.mock.On("ReplaceTags", ctx, from, to)}
+}
+
+func (_c *MockBlogPostRepository_ReplaceTags_Call) Run(run func(ctx context.Context, from []string, to string)) *MockBlogPostRepository_ReplaceTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlogPostRepository_ReplaceTags_Call) Return(domainError *domain.DomainError) *MockBlogPostRepository_ReplaceTags_Call {
+	_c.Call.Return(domainError)
+	return _c
+}
+
+func (_c *MockBlogPostRepository_ReplaceTags_Call) RunAndReturn(run func(ctx context.Context, from []string, to string) *domain.DomainError) *MockBlogPostRepository_ReplaceTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockBlogPostRepository
 func (_mock *MockBlogPostRepository) Update(ctx context.Context, id string, blog domain.BlogPost) (*domain.BlogPost, *domain.DomainError) {
 	ret := _mock.Called(ctx, id, blog)
@@ -531,6 +802,71 @@ func (_c *MockBlogPostRepository_Update_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// UpdateAuthorName provides a mock function for the type MockBlogPostRepository
+func (_mock *MockBlogPostRepository) UpdateAuthorName(ctx context.Context, authorID string, name string) *domain.DomainError {
+	ret := _mock.Called(ctx, authorID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAuthorName")
+	}
+
+	var r0 *domain.DomainError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.DomainError); ok {
+		r0 = returnFunc(ctx, authorID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DomainError)
+		}
+	}
+	return r0
+}
+
+// MockBlogPostRepository_UpdateAuthorName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAuthorName'
+type MockBlogPostRepository_UpdateAuthorName_Call struct {
+	*mock.Call
+}
+
+// UpdateAuthorName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - authorID string
+//   - name string
+func (_e *MockBlogPostRepository_Expecter) UpdateAuthorName(ctx interface{}, authorID interface{}, name interface{}) *MockBlogPostRepository_UpdateAuthorName_Call {
+	return &MockBlogPostRepository_UpdateAuthorName_Call{Call: _e.mock.On("UpdateAuthorName", ctx, authorID, name)}
+}
+
+func (_c *MockBlogPostRepository_UpdateAuthorName_Call) Run(run func(ctx context.Context, authorID string, name string)) *MockBlogPostRepository_UpdateAuthorName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlogPostRepository_UpdateAuthorName_Call) Return(domainError *domain.DomainError) *MockBlogPostRepository_UpdateAuthorName_Call {
+	_c.Call.Return(domainError)
+	return _c
+}
+
+func (_c *MockBlogPostRepository_UpdateAuthorName_Call) RunAndReturn(run func(ctx context.Context, authorID string, name string) *domain.DomainError) *MockBlogPostRepository_UpdateAuthorName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCommentCount provides a mock function for the type MockBlogPostRepository
 func (_mock *MockBlogPostRepository) UpdateCommentCount(ctx context.Context, id string, increment bool) (*domain.BlogPost, *domain.DomainError) {
 	ret := _mock.Called(ctx, id, increment)
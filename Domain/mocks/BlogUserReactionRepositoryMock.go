@@ -167,6 +167,76 @@ func (_c *MockBlogUserReactionRepository_Delete_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// GetReactionByID provides a mock function for the type MockBlogUserReactionRepository
+func (_mock *MockBlogUserReactionRepository) GetReactionByID(ctx context.Context, id string) (*domain.BlogUserReaction, *domain.DomainError) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReactionByID")
+	}
+
+	var r0 *domain.BlogUserReaction
+	var r1 *domain.DomainError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.BlogUserReaction, *domain.DomainError)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.BlogUserReaction); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BlogUserReaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *domain.DomainError); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.DomainError)
+		}
+	}
+	return r0, r1
+}
+
+// MockBlogUserReactionRepository_GetReactionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReactionByID'
+type MockBlogUserReactionRepository_GetReactionByID_Call struct {
+	*mock.Call
+}
+
+// GetReactionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockBlogUserReactionRepository_Expecter) GetReactionByID(ctx interface{}, id interface{}) *MockBlogUserReactionRepository_GetReactionByID_Call {
+	return &MockBlogUserReactionRepository_GetReactionByID_Call{Call: _e.mock.On("GetReactionByID", ctx, id)}
+}
+
+func (_c *MockBlogUserReactionRepository_GetReactionByID_Call) Run(run func(ctx context.Context, id string)) *MockBlogUserReactionRepository_GetReactionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBlogUserReactionRepository_GetReactionByID_Call) Return(blogUserReaction *domain.BlogUserReaction, domainError *domain.DomainError) *MockBlogUserReactionRepository_GetReactionByID_Call {
+	_c.Call.Return(blogUserReaction, domainError)
+	return _c
+}
+
+func (_c *MockBlogUserReactionRepository_GetReactionByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.BlogUserReaction, *domain.DomainError)) *MockBlogUserReactionRepository_GetReactionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserReaction provides a mock function for the type MockBlogUserReactionRepository
 func (_mock *MockBlogUserReactionRepository) GetUserReaction(ctx context.Context, blogID string, userID string) (*domain.BlogUserReaction, *domain.DomainError) {
 	ret := _mock.Called(ctx, blogID, userID)
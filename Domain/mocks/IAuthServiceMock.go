@@ -6,6 +6,7 @@ package domain_mocks
 
 import (
 	"g6/blog-api/Domain"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	mock "github.com/stretchr/testify/mock"
@@ -38,6 +39,72 @@ func (_m *MockIAuthService) EXPECT() *MockIAuthService_Expecter {
 	return &MockIAuthService_Expecter{mock: &_m.Mock}
 }
 
+// GeneratePreAuthToken provides a mock function for the type MockIAuthService
+func (_mock *MockIAuthService) GeneratePreAuthToken(user domain.User) (string, time.Time, error) {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GeneratePreAuthToken")
+	}
+
+	var r0 string
+	var r1 time.Time
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(domain.User) (string, time.Time, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(domain.User) string); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(domain.User) time.Time); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+	if returnFunc, ok := ret.Get(2).(func(domain.User) error); ok {
+		r2 = returnFunc(user)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIAuthService_GeneratePreAuthToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GeneratePreAuthToken'
+type MockIAuthService_GeneratePreAuthToken_Call struct {
+	*mock.Call
+}
+
+// GeneratePreAuthToken is a helper method to define mock.On call
+//   - user domain.User
+func (_e *MockIAuthService_Expecter) GeneratePreAuthToken(user interface{}) *MockIAuthService_GeneratePreAuthToken_Call {
+	return &MockIAuthService_GeneratePreAuthToken_Call{Call: _e.mock.On("GeneratePreAuthToken", user)}
+}
+
+func (_c *MockIAuthService_GeneratePreAuthToken_Call) Run(run func(user domain.User)) *MockIAuthService_GeneratePreAuthToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.User
+		if args[0] != nil {
+			arg0 = args[0].(domain.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIAuthService_GeneratePreAuthToken_Call) Return(s string, time1 time.Time, err error) *MockIAuthService_GeneratePreAuthToken_Call {
+	_c.Call.Return(s, time1, err)
+	return _c
+}
+
+func (_c *MockIAuthService_GeneratePreAuthToken_Call) RunAndReturn(run func(user domain.User) (string, time.Time, error)) *MockIAuthService_GeneratePreAuthToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateTokens provides a mock function for the type MockIAuthService
 func (_mock *MockIAuthService) GenerateTokens(user domain.User) (domain.RefreshTokenResponse, error) {
 	ret := _mock.Called(user)
@@ -98,6 +165,68 @@ func (_c *MockIAuthService_GenerateTokens_Call) RunAndReturn(run func(user domai
 	return _c
 }
 
+// ValidatePreAuthToken provides a mock function for the type MockIAuthService
+func (_mock *MockIAuthService) ValidatePreAuthToken(token string) (jwt.MapClaims, error) {
+	ret := _mock.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidatePreAuthToken")
+	}
+
+	var r0 jwt.MapClaims
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (jwt.MapClaims, error)); ok {
+		return returnFunc(token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) jwt.MapClaims); ok {
+		r0 = returnFunc(token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(jwt.MapClaims)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIAuthService_ValidatePreAuthToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidatePreAuthToken'
+type MockIAuthService_ValidatePreAuthToken_Call struct {
+	*mock.Call
+}
+
+// ValidatePreAuthToken is a helper method to define mock.On call
+//   - token string
+func (_e *MockIAuthService_Expecter) ValidatePreAuthToken(token interface{}) *MockIAuthService_ValidatePreAuthToken_Call {
+	return &MockIAuthService_ValidatePreAuthToken_Call{Call: _e.mock.On("ValidatePreAuthToken", token)}
+}
+
+func (_c *MockIAuthService_ValidatePreAuthToken_Call) Run(run func(token string)) *MockIAuthService_ValidatePreAuthToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIAuthService_ValidatePreAuthToken_Call) Return(mapClaims jwt.MapClaims, err error) *MockIAuthService_ValidatePreAuthToken_Call {
+	_c.Call.Return(mapClaims, err)
+	return _c
+}
+
+func (_c *MockIAuthService_ValidatePreAuthToken_Call) RunAndReturn(run func(token string) (jwt.MapClaims, error)) *MockIAuthService_ValidatePreAuthToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateRefreshToken provides a mock function for the type MockIAuthService
 func (_mock *MockIAuthService) ValidateRefreshToken(token string) (jwt.MapClaims, error) {
 	ret := _mock.Called(token)
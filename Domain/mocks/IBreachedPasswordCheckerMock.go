@@ -0,0 +1,96 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIBreachedPasswordChecker creates a new instance of MockIBreachedPasswordChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIBreachedPasswordChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIBreachedPasswordChecker {
+	mock := &MockIBreachedPasswordChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIBreachedPasswordChecker is an autogenerated mock type for the IBreachedPasswordChecker type
+type MockIBreachedPasswordChecker struct {
+	mock.Mock
+}
+
+type MockIBreachedPasswordChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIBreachedPasswordChecker) EXPECT() *MockIBreachedPasswordChecker_Expecter {
+	return &MockIBreachedPasswordChecker_Expecter{mock: &_m.Mock}
+}
+
+// IsBreached provides a mock function for the type MockIBreachedPasswordChecker
+func (_mock *MockIBreachedPasswordChecker) IsBreached(password string) (bool, error) {
+	ret := _mock.Called(password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBreached")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return returnFunc(password)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = returnFunc(password)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(password)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIBreachedPasswordChecker_IsBreached_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBreached'
+type MockIBreachedPasswordChecker_IsBreached_Call struct {
+	*mock.Call
+}
+
+// IsBreached is a helper method to define mock.On call
+//   - password string
+func (_e *MockIBreachedPasswordChecker_Expecter) IsBreached(password interface{}) *MockIBreachedPasswordChecker_IsBreached_Call {
+	return &MockIBreachedPasswordChecker_IsBreached_Call{Call: _e.mock.On("IsBreached", password)}
+}
+
+func (_c *MockIBreachedPasswordChecker_IsBreached_Call) Run(run func(password string)) *MockIBreachedPasswordChecker_IsBreached_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIBreachedPasswordChecker_IsBreached_Call) Return(b bool, err error) *MockIBreachedPasswordChecker_IsBreached_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIBreachedPasswordChecker_IsBreached_Call) RunAndReturn(run func(password string) (bool, error)) *MockIBreachedPasswordChecker_IsBreached_Call {
+	_c.Call.Return(run)
+	return _c
+}
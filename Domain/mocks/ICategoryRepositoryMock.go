@@ -0,0 +1,476 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockICategoryRepository creates a new instance of MockICategoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockICategoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockICategoryRepository {
+	mock := &MockICategoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockICategoryRepository is an autogenerated mock type for the ICategoryRepository type
+type MockICategoryRepository struct {
+	mock.Mock
+}
+
+type MockICategoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockICategoryRepository) EXPECT() *MockICategoryRepository_Expecter {
+	return &MockICategoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockICategoryRepository
+func (_mock *MockICategoryRepository) Create(ctx context.Context, category *domain.Category) error {
+	ret := _mock.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Category) error); ok {
+		r0 = returnFunc(ctx, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockICategoryRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockICategoryRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *domain.Category
+func (_e *MockICategoryRepository_Expecter) Create(ctx interface{}, category interface{}) *MockICategoryRepository_Create_Call {
+	return &MockICategoryRepository_Create_Call{Call: _e.mock.On("Create", ctx, category)}
+}
+
+func (_c *MockICategoryRepository_Create_Call) Run(run func(ctx context.Context, category *domain.Category)) *MockICategoryRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Category
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryRepository_Create_Call) Return(err error) *MockICategoryRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockICategoryRepository_Create_Call) RunAndReturn(run func(ctx context.Context, category *domain.Category) error) *MockICategoryRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockICategoryRepository
+func (_mock *MockICategoryRepository) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockICategoryRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockICategoryRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockICategoryRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockICategoryRepository_Delete_Call {
+	return &MockICategoryRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockICategoryRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockICategoryRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryRepository_Delete_Call) Return(err error) *MockICategoryRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockICategoryRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockICategoryRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Descendants provides a mock function for the type MockICategoryRepository
+func (_mock *MockICategoryRepository) Descendants(ctx context.Context, id string) ([]*domain.Category, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Descendants")
+	}
+
+	var r0 []*domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.Category, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.Category); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICategoryRepository_Descendants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Descendants'
+type MockICategoryRepository_Descendants_Call struct {
+	*mock.Call
+}
+
+// Descendants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockICategoryRepository_Expecter) Descendants(ctx interface{}, id interface{}) *MockICategoryRepository_Descendants_Call {
+	return &MockICategoryRepository_Descendants_Call{Call: _e.mock.On("Descendants", ctx, id)}
+}
+
+func (_c *MockICategoryRepository_Descendants_Call) Run(run func(ctx context.Context, id string)) *MockICategoryRepository_Descendants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryRepository_Descendants_Call) Return(categorys []*domain.Category, err error) *MockICategoryRepository_Descendants_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *MockICategoryRepository_Descendants_Call) RunAndReturn(run func(ctx context.Context, id string) ([]*domain.Category, error)) *MockICategoryRepository_Descendants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockICategoryRepository
+func (_mock *MockICategoryRepository) FindByID(ctx context.Context, id string) (*domain.Category, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Category, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Category); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICategoryRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockICategoryRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockICategoryRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockICategoryRepository_FindByID_Call {
+	return &MockICategoryRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockICategoryRepository_FindByID_Call) Run(run func(ctx context.Context, id string)) *MockICategoryRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryRepository_FindByID_Call) Return(category *domain.Category, err error) *MockICategoryRepository_FindByID_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockICategoryRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Category, error)) *MockICategoryRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBySlug provides a mock function for the type MockICategoryRepository
+func (_mock *MockICategoryRepository) FindBySlug(ctx context.Context, slug string) (*domain.Category, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySlug")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Category, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Category); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICategoryRepository_FindBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySlug'
+type MockICategoryRepository_FindBySlug_Call struct {
+	*mock.Call
+}
+
+// FindBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockICategoryRepository_Expecter) FindBySlug(ctx interface{}, slug interface{}) *MockICategoryRepository_FindBySlug_Call {
+	return &MockICategoryRepository_FindBySlug_Call{Call: _e.mock.On("FindBySlug", ctx, slug)}
+}
+
+func (_c *MockICategoryRepository_FindBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockICategoryRepository_FindBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryRepository_FindBySlug_Call) Return(category *domain.Category, err error) *MockICategoryRepository_FindBySlug_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockICategoryRepository_FindBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.Category, error)) *MockICategoryRepository_FindBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockICategoryRepository
+func (_mock *MockICategoryRepository) List(ctx context.Context) ([]*domain.Category, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Category, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Category); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICategoryRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockICategoryRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockICategoryRepository_Expecter) List(ctx interface{}) *MockICategoryRepository_List_Call {
+	return &MockICategoryRepository_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *MockICategoryRepository_List_Call) Run(run func(ctx context.Context)) *MockICategoryRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryRepository_List_Call) Return(categorys []*domain.Category, err error) *MockICategoryRepository_List_Call {
+	_c.Call.Return(categorys, err)
+	return _c
+}
+
+func (_c *MockICategoryRepository_List_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Category, error)) *MockICategoryRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockICategoryRepository
+func (_mock *MockICategoryRepository) Update(ctx context.Context, category *domain.Category) error {
+	ret := _mock.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Category) error); ok {
+		r0 = returnFunc(ctx, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockICategoryRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockICategoryRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *domain.Category
+func (_e *MockICategoryRepository_Expecter) Update(ctx interface{}, category interface{}) *MockICategoryRepository_Update_Call {
+	return &MockICategoryRepository_Update_Call{Call: _e.mock.On("Update", ctx, category)}
+}
+
+func (_c *MockICategoryRepository_Update_Call) Run(run func(ctx context.Context, category *domain.Category)) *MockICategoryRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Category
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryRepository_Update_Call) Return(err error) *MockICategoryRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockICategoryRepository_Update_Call) RunAndReturn(run func(ctx context.Context, category *domain.Category) error) *MockICategoryRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,411 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockICategoryUsecase creates a new instance of MockICategoryUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockICategoryUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockICategoryUsecase {
+	mock := &MockICategoryUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockICategoryUsecase is an autogenerated mock type for the ICategoryUsecase type
+type MockICategoryUsecase struct {
+	mock.Mock
+}
+
+type MockICategoryUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockICategoryUsecase) EXPECT() *MockICategoryUsecase_Expecter {
+	return &MockICategoryUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockICategoryUsecase
+func (_mock *MockICategoryUsecase) Create(category *domain.Category) (*domain.Category, error) {
+	ret := _mock.Called(category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.Category) (*domain.Category, error)); ok {
+		return returnFunc(category)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*domain.Category) *domain.Category); ok {
+		r0 = returnFunc(category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*domain.Category) error); ok {
+		r1 = returnFunc(category)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICategoryUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockICategoryUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - category *domain.Category
+func (_e *MockICategoryUsecase_Expecter) Create(category interface{}) *MockICategoryUsecase_Create_Call {
+	return &MockICategoryUsecase_Create_Call{Call: _e.mock.On("Create", category)}
+}
+
+func (_c *MockICategoryUsecase_Create_Call) Run(run func(category *domain.Category)) *MockICategoryUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.Category
+		if args[0] != nil {
+			arg0 = args[0].(*domain.Category)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Create_Call) Return(category1 *domain.Category, err error) *MockICategoryUsecase_Create_Call {
+	_c.Call.Return(category1, err)
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Create_Call) RunAndReturn(run func(category *domain.Category) (*domain.Category, error)) *MockICategoryUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockICategoryUsecase
+func (_mock *MockICategoryUsecase) Delete(ref string) error {
+	ret := _mock.Called(ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(ref)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockICategoryUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockICategoryUsecase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ref string
+func (_e *MockICategoryUsecase_Expecter) Delete(ref interface{}) *MockICategoryUsecase_Delete_Call {
+	return &MockICategoryUsecase_Delete_Call{Call: _e.mock.On("Delete", ref)}
+}
+
+func (_c *MockICategoryUsecase_Delete_Call) Run(run func(ref string)) *MockICategoryUsecase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Delete_Call) Return(err error) *MockICategoryUsecase_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Delete_Call) RunAndReturn(run func(ref string) error) *MockICategoryUsecase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockICategoryUsecase
+func (_mock *MockICategoryUsecase) Get(ref string) (*domain.Category, error) {
+	ret := _mock.Called(ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.Category, error)); ok {
+		return returnFunc(ref)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.Category); ok {
+		r0 = returnFunc(ref)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(ref)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICategoryUsecase_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockICategoryUsecase_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ref string
+func (_e *MockICategoryUsecase_Expecter) Get(ref interface{}) *MockICategoryUsecase_Get_Call {
+	return &MockICategoryUsecase_Get_Call{Call: _e.mock.On("Get", ref)}
+}
+
+func (_c *MockICategoryUsecase_Get_Call) Run(run func(ref string)) *MockICategoryUsecase_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Get_Call) Return(category *domain.Category, err error) *MockICategoryUsecase_Get_Call {
+	_c.Call.Return(category, err)
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Get_Call) RunAndReturn(run func(ref string) (*domain.Category, error)) *MockICategoryUsecase_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function for the type MockICategoryUsecase
+func (_mock *MockICategoryUsecase) Resolve(ctx context.Context, ref string, withDescendants bool) ([]string, error) {
+	ret := _mock.Called(ctx, ref, withDescendants)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) ([]string, error)); ok {
+		return returnFunc(ctx, ref, withDescendants)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) []string); ok {
+		r0 = returnFunc(ctx, ref, withDescendants)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = returnFunc(ctx, ref, withDescendants)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICategoryUsecase_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type MockICategoryUsecase_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ref string
+//   - withDescendants bool
+func (_e *MockICategoryUsecase_Expecter) Resolve(ctx interface{}, ref interface{}, withDescendants interface{}) *MockICategoryUsecase_Resolve_Call {
+	return &MockICategoryUsecase_Resolve_Call{Call: _e.mock.On("Resolve", ctx, ref, withDescendants)}
+}
+
+func (_c *MockICategoryUsecase_Resolve_Call) Run(run func(ctx context.Context, ref string, withDescendants bool)) *MockICategoryUsecase_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Resolve_Call) Return(strings []string, err error) *MockICategoryUsecase_Resolve_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Resolve_Call) RunAndReturn(run func(ctx context.Context, ref string, withDescendants bool) ([]string, error)) *MockICategoryUsecase_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tree provides a mock function for the type MockICategoryUsecase
+func (_mock *MockICategoryUsecase) Tree() ([]*domain.CategoryNode, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tree")
+	}
+
+	var r0 []*domain.CategoryNode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*domain.CategoryNode, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*domain.CategoryNode); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.CategoryNode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICategoryUsecase_Tree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tree'
+type MockICategoryUsecase_Tree_Call struct {
+	*mock.Call
+}
+
+// Tree is a helper method to define mock.On call
+func (_e *MockICategoryUsecase_Expecter) Tree() *MockICategoryUsecase_Tree_Call {
+	return &MockICategoryUsecase_Tree_Call{Call: _e.mock.On("Tree")}
+}
+
+func (_c *MockICategoryUsecase_Tree_Call) Run(run func()) *MockICategoryUsecase_Tree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Tree_Call) Return(categoryNodes []*domain.CategoryNode, err error) *MockICategoryUsecase_Tree_Call {
+	_c.Call.Return(categoryNodes, err)
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Tree_Call) RunAndReturn(run func() ([]*domain.CategoryNode, error)) *MockICategoryUsecase_Tree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockICategoryUsecase
+func (_mock *MockICategoryUsecase) Update(ref string, category *domain.Category) (*domain.Category, error) {
+	ret := _mock.Called(ref, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, *domain.Category) (*domain.Category, error)); ok {
+		return returnFunc(ref, category)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, *domain.Category) *domain.Category); ok {
+		r0 = returnFunc(ref, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, *domain.Category) error); ok {
+		r1 = returnFunc(ref, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICategoryUsecase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockICategoryUsecase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ref string
+//   - category *domain.Category
+func (_e *MockICategoryUsecase_Expecter) Update(ref interface{}, category interface{}) *MockICategoryUsecase_Update_Call {
+	return &MockICategoryUsecase_Update_Call{Call: _e.mock.On("Update", ref, category)}
+}
+
+func (_c *MockICategoryUsecase_Update_Call) Run(run func(ref string, category *domain.Category)) *MockICategoryUsecase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 *domain.Category
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Category)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Update_Call) Return(category1 *domain.Category, err error) *MockICategoryUsecase_Update_Call {
+	_c.Call.Return(category1, err)
+	return _c
+}
+
+func (_c *MockICategoryUsecase_Update_Call) RunAndReturn(run func(ref string, category *domain.Category) (*domain.Category, error)) *MockICategoryUsecase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,308 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIDigestRepository creates a new instance of MockIDigestRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIDigestRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIDigestRepository {
+	mock := &MockIDigestRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIDigestRepository is an autogenerated mock type for the IDigestRepository type
+type MockIDigestRepository struct {
+	mock.Mock
+}
+
+type MockIDigestRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIDigestRepository) EXPECT() *MockIDigestRepository_Expecter {
+	return &MockIDigestRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimNext provides a mock function for the type MockIDigestRepository
+func (_mock *MockIDigestRepository) ClaimNext(ctx context.Context, now time.Time, leaseUntil time.Time) (*domain.DigestSubscription, error) {
+	ret := _mock.Called(ctx, now, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimNext")
+	}
+
+	var r0 *domain.DigestSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) (*domain.DigestSubscription, error)); ok {
+		return returnFunc(ctx, now, leaseUntil)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) *domain.DigestSubscription); ok {
+		r0 = returnFunc(ctx, now, leaseUntil)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DigestSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, now, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDigestRepository_ClaimNext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimNext'
+type MockIDigestRepository_ClaimNext_Call struct {
+	*mock.Call
+}
+
+// ClaimNext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+func (_e *MockIDigestRepository_Expecter) ClaimNext(ctx interface{}, now interface{}, leaseUntil interface{}) *MockIDigestRepository_ClaimNext_Call {
+	return &MockIDigestRepository_ClaimNext_Call{Call: _e.mock.On("ClaimNext", ctx, now, leaseUntil)}
+}
+
+func (_c *MockIDigestRepository_ClaimNext_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time)) *MockIDigestRepository_ClaimNext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDigestRepository_ClaimNext_Call) Return(digestSubscription *domain.DigestSubscription, err error) *MockIDigestRepository_ClaimNext_Call {
+	_c.Call.Return(digestSubscription, err)
+	return _c
+}
+
+func (_c *MockIDigestRepository_ClaimNext_Call) RunAndReturn(run func(ctx context.Context, now time.Time, leaseUntil time.Time) (*domain.DigestSubscription, error)) *MockIDigestRepository_ClaimNext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function for the type MockIDigestRepository
+func (_mock *MockIDigestRepository) FindByUserID(ctx context.Context, userID string) (*domain.DigestSubscription, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 *domain.DigestSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.DigestSubscription, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.DigestSubscription); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DigestSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDigestRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type MockIDigestRepository_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockIDigestRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}) *MockIDigestRepository_FindByUserID_Call {
+	return &MockIDigestRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID)}
+}
+
+func (_c *MockIDigestRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockIDigestRepository_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDigestRepository_FindByUserID_Call) Return(digestSubscription *domain.DigestSubscription, err error) *MockIDigestRepository_FindByUserID_Call {
+	_c.Call.Return(digestSubscription, err)
+	return _c
+}
+
+func (_c *MockIDigestRepository_FindByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.DigestSubscription, error)) *MockIDigestRepository_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkSent provides a mock function for the type MockIDigestRepository
+func (_mock *MockIDigestRepository) MarkSent(ctx context.Context, userID string, sentAt time.Time, nextSendAt time.Time) error {
+	ret := _mock.Called(ctx, userID, sentAt, nextSendAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkSent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r0 = returnFunc(ctx, userID, sentAt, nextSendAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIDigestRepository_MarkSent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSent'
+type MockIDigestRepository_MarkSent_Call struct {
+	*mock.Call
+}
+
+// MarkSent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - sentAt time.Time
+//   - nextSendAt time.Time
+func (_e *MockIDigestRepository_Expecter) MarkSent(ctx interface{}, userID interface{}, sentAt interface{}, nextSendAt interface{}) *MockIDigestRepository_MarkSent_Call {
+	return &MockIDigestRepository_MarkSent_Call{Call: _e.mock.On("MarkSent", ctx, userID, sentAt, nextSendAt)}
+}
+
+func (_c *MockIDigestRepository_MarkSent_Call) Run(run func(ctx context.Context, userID string, sentAt time.Time, nextSendAt time.Time)) *MockIDigestRepository_MarkSent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDigestRepository_MarkSent_Call) Return(err error) *MockIDigestRepository_MarkSent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIDigestRepository_MarkSent_Call) RunAndReturn(run func(ctx context.Context, userID string, sentAt time.Time, nextSendAt time.Time) error) *MockIDigestRepository_MarkSent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockIDigestRepository
+func (_mock *MockIDigestRepository) Save(ctx context.Context, subscription *domain.DigestSubscription) error {
+	ret := _mock.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DigestSubscription) error); ok {
+		r0 = returnFunc(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIDigestRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockIDigestRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription *domain.DigestSubscription
+func (_e *MockIDigestRepository_Expecter) Save(ctx interface{}, subscription interface{}) *MockIDigestRepository_Save_Call {
+	return &MockIDigestRepository_Save_Call{Call: _e.mock.On("Save", ctx, subscription)}
+}
+
+func (_c *MockIDigestRepository_Save_Call) Run(run func(ctx context.Context, subscription *domain.DigestSubscription)) *MockIDigestRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.DigestSubscription
+		if args[1] != nil {
+			arg1 = args[1].(*domain.DigestSubscription)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDigestRepository_Save_Call) Return(err error) *MockIDigestRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIDigestRepository_Save_Call) RunAndReturn(run func(ctx context.Context, subscription *domain.DigestSubscription) error) *MockIDigestRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,325 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIDigestUsecase creates a new instance of MockIDigestUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIDigestUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIDigestUsecase {
+	mock := &MockIDigestUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIDigestUsecase is an autogenerated mock type for the IDigestUsecase type
+type MockIDigestUsecase struct {
+	mock.Mock
+}
+
+type MockIDigestUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIDigestUsecase) EXPECT() *MockIDigestUsecase_Expecter {
+	return &MockIDigestUsecase_Expecter{mock: &_m.Mock}
+}
+
+// SendDue provides a mock function for the type MockIDigestUsecase
+func (_mock *MockIDigestUsecase) SendDue(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDue")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDigestUsecase_SendDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDue'
+type MockIDigestUsecase_SendDue_Call struct {
+	*mock.Call
+}
+
+// SendDue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIDigestUsecase_Expecter) SendDue(ctx interface{}) *MockIDigestUsecase_SendDue_Call {
+	return &MockIDigestUsecase_SendDue_Call{Call: _e.mock.On("SendDue", ctx)}
+}
+
+func (_c *MockIDigestUsecase_SendDue_Call) Run(run func(ctx context.Context)) *MockIDigestUsecase_SendDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDigestUsecase_SendDue_Call) Return(n int, err error) *MockIDigestUsecase_SendDue_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIDigestUsecase_SendDue_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockIDigestUsecase_SendDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscription provides a mock function for the type MockIDigestUsecase
+func (_mock *MockIDigestUsecase) Subscription(userID string) (*domain.DigestSubscription, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscription")
+	}
+
+	var r0 *domain.DigestSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.DigestSubscription, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.DigestSubscription); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DigestSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDigestUsecase_Subscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscription'
+type MockIDigestUsecase_Subscription_Call struct {
+	*mock.Call
+}
+
+// Subscription is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIDigestUsecase_Expecter) Subscription(userID interface{}) *MockIDigestUsecase_Subscription_Call {
+	return &MockIDigestUsecase_Subscription_Call{Call: _e.mock.On("Subscription", userID)}
+}
+
+func (_c *MockIDigestUsecase_Subscription_Call) Run(run func(userID string)) *MockIDigestUsecase_Subscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDigestUsecase_Subscription_Call) Return(digestSubscription *domain.DigestSubscription, err error) *MockIDigestUsecase_Subscription_Call {
+	_c.Call.Return(digestSubscription, err)
+	return _c
+}
+
+func (_c *MockIDigestUsecase_Subscription_Call) RunAndReturn(run func(userID string) (*domain.DigestSubscription, error)) *MockIDigestUsecase_Subscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unsubscribe provides a mock function for the type MockIDigestUsecase
+func (_mock *MockIDigestUsecase) Unsubscribe(token string) error {
+	ret := _mock.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unsubscribe")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIDigestUsecase_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type MockIDigestUsecase_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - token string
+func (_e *MockIDigestUsecase_Expecter) Unsubscribe(token interface{}) *MockIDigestUsecase_Unsubscribe_Call {
+	return &MockIDigestUsecase_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", token)}
+}
+
+func (_c *MockIDigestUsecase_Unsubscribe_Call) Run(run func(token string)) *MockIDigestUsecase_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDigestUsecase_Unsubscribe_Call) Return(err error) *MockIDigestUsecase_Unsubscribe_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIDigestUsecase_Unsubscribe_Call) RunAndReturn(run func(token string) error) *MockIDigestUsecase_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribeToken provides a mock function for the type MockIDigestUsecase
+func (_mock *MockIDigestUsecase) UnsubscribeToken(userID string) string {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeToken")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockIDigestUsecase_UnsubscribeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeToken'
+type MockIDigestUsecase_UnsubscribeToken_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeToken is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIDigestUsecase_Expecter) UnsubscribeToken(userID interface{}) *MockIDigestUsecase_UnsubscribeToken_Call {
+	return &MockIDigestUsecase_UnsubscribeToken_Call{Call: _e.mock.On("UnsubscribeToken", userID)}
+}
+
+func (_c *MockIDigestUsecase_UnsubscribeToken_Call) Run(run func(userID string)) *MockIDigestUsecase_UnsubscribeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDigestUsecase_UnsubscribeToken_Call) Return(s string) *MockIDigestUsecase_UnsubscribeToken_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockIDigestUsecase_UnsubscribeToken_Call) RunAndReturn(run func(userID string) string) *MockIDigestUsecase_UnsubscribeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSubscription provides a mock function for the type MockIDigestUsecase
+func (_mock *MockIDigestUsecase) UpdateSubscription(subscription *domain.DigestSubscription) (*domain.DigestSubscription, error) {
+	ret := _mock.Called(subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubscription")
+	}
+
+	var r0 *domain.DigestSubscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.DigestSubscription) (*domain.DigestSubscription, error)); ok {
+		return returnFunc(subscription)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*domain.DigestSubscription) *domain.DigestSubscription); ok {
+		r0 = returnFunc(subscription)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DigestSubscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*domain.DigestSubscription) error); ok {
+		r1 = returnFunc(subscription)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIDigestUsecase_UpdateSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubscription'
+type MockIDigestUsecase_UpdateSubscription_Call struct {
+	*mock.Call
+}
+
+// UpdateSubscription is a helper method to define mock.On call
+//   - subscription *domain.DigestSubscription
+func (_e *MockIDigestUsecase_Expecter) UpdateSubscription(subscription interface{}) *MockIDigestUsecase_UpdateSubscription_Call {
+	return &MockIDigestUsecase_UpdateSubscription_Call{Call: _e.mock.On("UpdateSubscription", subscription)}
+}
+
+func (_c *MockIDigestUsecase_UpdateSubscription_Call) Run(run func(subscription *domain.DigestSubscription)) *MockIDigestUsecase_UpdateSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.DigestSubscription
+		if args[0] != nil {
+			arg0 = args[0].(*domain.DigestSubscription)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIDigestUsecase_UpdateSubscription_Call) Return(digestSubscription *domain.DigestSubscription, err error) *MockIDigestUsecase_UpdateSubscription_Call {
+	_c.Call.Return(digestSubscription, err)
+	return _c
+}
+
+func (_c *MockIDigestUsecase_UpdateSubscription_Call) RunAndReturn(run func(subscription *domain.DigestSubscription) (*domain.DigestSubscription, error)) *MockIDigestUsecase_UpdateSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,370 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIEmailOutboxRepository creates a new instance of MockIEmailOutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIEmailOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIEmailOutboxRepository {
+	mock := &MockIEmailOutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIEmailOutboxRepository is an autogenerated mock type for the IEmailOutboxRepository type
+type MockIEmailOutboxRepository struct {
+	mock.Mock
+}
+
+type MockIEmailOutboxRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIEmailOutboxRepository) EXPECT() *MockIEmailOutboxRepository_Expecter {
+	return &MockIEmailOutboxRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimNext provides a mock function for the type MockIEmailOutboxRepository
+func (_mock *MockIEmailOutboxRepository) ClaimNext(ctx context.Context, now time.Time, leaseUntil time.Time) (*domain.OutboxEmail, error) {
+	ret := _mock.Called(ctx, now, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimNext")
+	}
+
+	var r0 *domain.OutboxEmail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) (*domain.OutboxEmail, error)); ok {
+		return returnFunc(ctx, now, leaseUntil)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) *domain.OutboxEmail); ok {
+		r0 = returnFunc(ctx, now, leaseUntil)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OutboxEmail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, now, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEmailOutboxRepository_ClaimNext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimNext'
+type MockIEmailOutboxRepository_ClaimNext_Call struct {
+	*mock.Call
+}
+
+// ClaimNext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+func (_e *MockIEmailOutboxRepository_Expecter) ClaimNext(ctx interface{}, now interface{}, leaseUntil interface{}) *MockIEmailOutboxRepository_ClaimNext_Call {
+	return &MockIEmailOutboxRepository_ClaimNext_Call{Call: _e.mock.On("ClaimNext", ctx, now, leaseUntil)}
+}
+
+func (_c *MockIEmailOutboxRepository_ClaimNext_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time)) *MockIEmailOutboxRepository_ClaimNext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxRepository_ClaimNext_Call) Return(outboxEmail *domain.OutboxEmail, err error) *MockIEmailOutboxRepository_ClaimNext_Call {
+	_c.Call.Return(outboxEmail, err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxRepository_ClaimNext_Call) RunAndReturn(run func(ctx context.Context, now time.Time, leaseUntil time.Time) (*domain.OutboxEmail, error)) *MockIEmailOutboxRepository_ClaimNext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enqueue provides a mock function for the type MockIEmailOutboxRepository
+func (_mock *MockIEmailOutboxRepository) Enqueue(ctx context.Context, email *domain.OutboxEmail) error {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.OutboxEmail) error); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIEmailOutboxRepository_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockIEmailOutboxRepository_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email *domain.OutboxEmail
+func (_e *MockIEmailOutboxRepository_Expecter) Enqueue(ctx interface{}, email interface{}) *MockIEmailOutboxRepository_Enqueue_Call {
+	return &MockIEmailOutboxRepository_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, email)}
+}
+
+func (_c *MockIEmailOutboxRepository_Enqueue_Call) Run(run func(ctx context.Context, email *domain.OutboxEmail)) *MockIEmailOutboxRepository_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.OutboxEmail
+		if args[1] != nil {
+			arg1 = args[1].(*domain.OutboxEmail)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxRepository_Enqueue_Call) Return(err error) *MockIEmailOutboxRepository_Enqueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxRepository_Enqueue_Call) RunAndReturn(run func(ctx context.Context, email *domain.OutboxEmail) error) *MockIEmailOutboxRepository_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockIEmailOutboxRepository
+func (_mock *MockIEmailOutboxRepository) Find(ctx context.Context, filter domain.OutboxEmailFilter) ([]*domain.OutboxEmail, int64, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 []*domain.OutboxEmail
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.OutboxEmailFilter) ([]*domain.OutboxEmail, int64, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.OutboxEmailFilter) []*domain.OutboxEmail); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.OutboxEmail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.OutboxEmailFilter) int64); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.OutboxEmailFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIEmailOutboxRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockIEmailOutboxRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.OutboxEmailFilter
+func (_e *MockIEmailOutboxRepository_Expecter) Find(ctx interface{}, filter interface{}) *MockIEmailOutboxRepository_Find_Call {
+	return &MockIEmailOutboxRepository_Find_Call{Call: _e.mock.On("Find", ctx, filter)}
+}
+
+func (_c *MockIEmailOutboxRepository_Find_Call) Run(run func(ctx context.Context, filter domain.OutboxEmailFilter)) *MockIEmailOutboxRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.OutboxEmailFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.OutboxEmailFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxRepository_Find_Call) Return(outboxEmails []*domain.OutboxEmail, n int64, err error) *MockIEmailOutboxRepository_Find_Call {
+	_c.Call.Return(outboxEmails, n, err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxRepository_Find_Call) RunAndReturn(run func(ctx context.Context, filter domain.OutboxEmailFilter) ([]*domain.OutboxEmail, int64, error)) *MockIEmailOutboxRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockIEmailOutboxRepository
+func (_mock *MockIEmailOutboxRepository) FindByID(ctx context.Context, id string) (*domain.OutboxEmail, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.OutboxEmail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.OutboxEmail, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.OutboxEmail); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OutboxEmail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEmailOutboxRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockIEmailOutboxRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIEmailOutboxRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockIEmailOutboxRepository_FindByID_Call {
+	return &MockIEmailOutboxRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockIEmailOutboxRepository_FindByID_Call) Run(run func(ctx context.Context, id string)) *MockIEmailOutboxRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxRepository_FindByID_Call) Return(outboxEmail *domain.OutboxEmail, err error) *MockIEmailOutboxRepository_FindByID_Call {
+	_c.Call.Return(outboxEmail, err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.OutboxEmail, error)) *MockIEmailOutboxRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIEmailOutboxRepository
+func (_mock *MockIEmailOutboxRepository) Update(ctx context.Context, email *domain.OutboxEmail) error {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.OutboxEmail) error); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIEmailOutboxRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIEmailOutboxRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email *domain.OutboxEmail
+func (_e *MockIEmailOutboxRepository_Expecter) Update(ctx interface{}, email interface{}) *MockIEmailOutboxRepository_Update_Call {
+	return &MockIEmailOutboxRepository_Update_Call{Call: _e.mock.On("Update", ctx, email)}
+}
+
+func (_c *MockIEmailOutboxRepository_Update_Call) Run(run func(ctx context.Context, email *domain.OutboxEmail)) *MockIEmailOutboxRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.OutboxEmail
+		if args[1] != nil {
+			arg1 = args[1].(*domain.OutboxEmail)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxRepository_Update_Call) Return(err error) *MockIEmailOutboxRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxRepository_Update_Call) RunAndReturn(run func(ctx context.Context, email *domain.OutboxEmail) error) *MockIEmailOutboxRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,475 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIEmailOutboxUsecase creates a new instance of MockIEmailOutboxUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIEmailOutboxUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIEmailOutboxUsecase {
+	mock := &MockIEmailOutboxUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIEmailOutboxUsecase is an autogenerated mock type for the IEmailOutboxUsecase type
+type MockIEmailOutboxUsecase struct {
+	mock.Mock
+}
+
+type MockIEmailOutboxUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIEmailOutboxUsecase) EXPECT() *MockIEmailOutboxUsecase_Expecter {
+	return &MockIEmailOutboxUsecase_Expecter{mock: &_m.Mock}
+}
+
+// FindByID provides a mock function for the type MockIEmailOutboxUsecase
+func (_mock *MockIEmailOutboxUsecase) FindByID(id string) (*domain.OutboxEmail, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.OutboxEmail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.OutboxEmail, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.OutboxEmail); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OutboxEmail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEmailOutboxUsecase_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockIEmailOutboxUsecase_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - id string
+func (_e *MockIEmailOutboxUsecase_Expecter) FindByID(id interface{}) *MockIEmailOutboxUsecase_FindByID_Call {
+	return &MockIEmailOutboxUsecase_FindByID_Call{Call: _e.mock.On("FindByID", id)}
+}
+
+func (_c *MockIEmailOutboxUsecase_FindByID_Call) Run(run func(id string)) *MockIEmailOutboxUsecase_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_FindByID_Call) Return(outboxEmail *domain.OutboxEmail, err error) *MockIEmailOutboxUsecase_FindByID_Call {
+	_c.Call.Return(outboxEmail, err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_FindByID_Call) RunAndReturn(run func(id string) (*domain.OutboxEmail, error)) *MockIEmailOutboxUsecase_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockIEmailOutboxUsecase
+func (_mock *MockIEmailOutboxUsecase) List(filter domain.OutboxEmailFilter) ([]*domain.OutboxEmail, int64, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.OutboxEmail
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(domain.OutboxEmailFilter) ([]*domain.OutboxEmail, int64, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(domain.OutboxEmailFilter) []*domain.OutboxEmail); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.OutboxEmail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(domain.OutboxEmailFilter) int64); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(domain.OutboxEmailFilter) error); ok {
+		r2 = returnFunc(filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIEmailOutboxUsecase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockIEmailOutboxUsecase_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - filter domain.OutboxEmailFilter
+func (_e *MockIEmailOutboxUsecase_Expecter) List(filter interface{}) *MockIEmailOutboxUsecase_List_Call {
+	return &MockIEmailOutboxUsecase_List_Call{Call: _e.mock.On("List", filter)}
+}
+
+func (_c *MockIEmailOutboxUsecase_List_Call) Run(run func(filter domain.OutboxEmailFilter)) *MockIEmailOutboxUsecase_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.OutboxEmailFilter
+		if args[0] != nil {
+			arg0 = args[0].(domain.OutboxEmailFilter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_List_Call) Return(outboxEmails []*domain.OutboxEmail, n int64, err error) *MockIEmailOutboxUsecase_List_Call {
+	_c.Call.Return(outboxEmails, n, err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_List_Call) RunAndReturn(run func(filter domain.OutboxEmailFilter) ([]*domain.OutboxEmail, int64, error)) *MockIEmailOutboxUsecase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessDue provides a mock function for the type MockIEmailOutboxUsecase
+func (_mock *MockIEmailOutboxUsecase) ProcessDue(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessDue")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEmailOutboxUsecase_ProcessDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessDue'
+type MockIEmailOutboxUsecase_ProcessDue_Call struct {
+	*mock.Call
+}
+
+// ProcessDue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIEmailOutboxUsecase_Expecter) ProcessDue(ctx interface{}) *MockIEmailOutboxUsecase_ProcessDue_Call {
+	return &MockIEmailOutboxUsecase_ProcessDue_Call{Call: _e.mock.On("ProcessDue", ctx)}
+}
+
+func (_c *MockIEmailOutboxUsecase_ProcessDue_Call) Run(run func(ctx context.Context)) *MockIEmailOutboxUsecase_ProcessDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_ProcessDue_Call) Return(n int, err error) *MockIEmailOutboxUsecase_ProcessDue_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_ProcessDue_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockIEmailOutboxUsecase_ProcessDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Retry provides a mock function for the type MockIEmailOutboxUsecase
+func (_mock *MockIEmailOutboxUsecase) Retry(id string) (*domain.OutboxEmail, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retry")
+	}
+
+	var r0 *domain.OutboxEmail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.OutboxEmail, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.OutboxEmail); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OutboxEmail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEmailOutboxUsecase_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type MockIEmailOutboxUsecase_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - id string
+func (_e *MockIEmailOutboxUsecase_Expecter) Retry(id interface{}) *MockIEmailOutboxUsecase_Retry_Call {
+	return &MockIEmailOutboxUsecase_Retry_Call{Call: _e.mock.On("Retry", id)}
+}
+
+func (_c *MockIEmailOutboxUsecase_Retry_Call) Run(run func(id string)) *MockIEmailOutboxUsecase_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_Retry_Call) Return(outboxEmail *domain.OutboxEmail, err error) *MockIEmailOutboxUsecase_Retry_Call {
+	_c.Call.Return(outboxEmail, err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_Retry_Call) RunAndReturn(run func(id string) (*domain.OutboxEmail, error)) *MockIEmailOutboxUsecase_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendEmail provides a mock function for the type MockIEmailOutboxUsecase
+func (_mock *MockIEmailOutboxUsecase) SendEmail(ctx context.Context, to string, subject string, body string) error {
+	ret := _mock.Called(ctx, to, subject, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, to, subject, body)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIEmailOutboxUsecase_SendEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendEmail'
+type MockIEmailOutboxUsecase_SendEmail_Call struct {
+	*mock.Call
+}
+
+// SendEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - to string
+//   - subject string
+//   - body string
+func (_e *MockIEmailOutboxUsecase_Expecter) SendEmail(ctx interface{}, to interface{}, subject interface{}, body interface{}) *MockIEmailOutboxUsecase_SendEmail_Call {
+	return &MockIEmailOutboxUsecase_SendEmail_Call{Call: _e.mock.On("SendEmail", ctx, to, subject, body)}
+}
+
+func (_c *MockIEmailOutboxUsecase_SendEmail_Call) Run(run func(ctx context.Context, to string, subject string, body string)) *MockIEmailOutboxUsecase_SendEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_SendEmail_Call) Return(err error) *MockIEmailOutboxUsecase_SendEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_SendEmail_Call) RunAndReturn(run func(ctx context.Context, to string, subject string, body string) error) *MockIEmailOutboxUsecase_SendEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendTemplate provides a mock function for the type MockIEmailOutboxUsecase
+func (_mock *MockIEmailOutboxUsecase) SendTemplate(ctx context.Context, to string, templateName string, data domain.EmailTemplateData) error {
+	ret := _mock.Called(ctx, to, templateName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendTemplate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.EmailTemplateData) error); ok {
+		r0 = returnFunc(ctx, to, templateName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIEmailOutboxUsecase_SendTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendTemplate'
+type MockIEmailOutboxUsecase_SendTemplate_Call struct {
+	*mock.Call
+}
+
+// SendTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - to string
+//   - templateName string
+//   - data domain.EmailTemplateData
+func (_e *MockIEmailOutboxUsecase_Expecter) SendTemplate(ctx interface{}, to interface{}, templateName interface{}, data interface{}) *MockIEmailOutboxUsecase_SendTemplate_Call {
+	return &MockIEmailOutboxUsecase_SendTemplate_Call{Call: _e.mock.On("SendTemplate", ctx, to, templateName, data)}
+}
+
+func (_c *MockIEmailOutboxUsecase_SendTemplate_Call) Run(run func(ctx context.Context, to string, templateName string, data domain.EmailTemplateData)) *MockIEmailOutboxUsecase_SendTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.EmailTemplateData
+		if args[3] != nil {
+			arg3 = args[3].(domain.EmailTemplateData)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_SendTemplate_Call) Return(err error) *MockIEmailOutboxUsecase_SendTemplate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_SendTemplate_Call) RunAndReturn(run func(ctx context.Context, to string, templateName string, data domain.EmailTemplateData) error) *MockIEmailOutboxUsecase_SendTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wake provides a mock function for the type MockIEmailOutboxUsecase
+func (_mock *MockIEmailOutboxUsecase) Wake() <-chan struct{} {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wake")
+	}
+
+	var r0 <-chan struct{}
+	if returnFunc, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+	return r0
+}
+
+// MockIEmailOutboxUsecase_Wake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wake'
+type MockIEmailOutboxUsecase_Wake_Call struct {
+	*mock.Call
+}
+
+// Wake is a helper method to define mock.On call
+func (_e *MockIEmailOutboxUsecase_Expecter) Wake() *MockIEmailOutboxUsecase_Wake_Call {
+	return &MockIEmailOutboxUsecase_Wake_Call{Call: _e.mock.On("Wake")}
+}
+
+func (_c *MockIEmailOutboxUsecase_Wake_Call) Run(run func()) *MockIEmailOutboxUsecase_Wake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_Wake_Call) Return(valCh <-chan struct{}) *MockIEmailOutboxUsecase_Wake_Call {
+	_c.Call.Return(valCh)
+	return _c
+}
+
+func (_c *MockIEmailOutboxUsecase_Wake_Call) RunAndReturn(run func() <-chan struct{}) *MockIEmailOutboxUsecase_Wake_Call {
+	_c.Call.Return(run)
+	return _c
+}
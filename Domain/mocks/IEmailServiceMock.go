@@ -6,6 +6,7 @@ package domain_mocks
 
 import (
 	"context"
+	"g6/blog-api/Domain"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -105,3 +106,72 @@ func (_c *MockIEmailService_SendEmail_Call) RunAndReturn(run func(ctx context.Co
 	_c.Call.Return(run)
 	return _c
 }
+
+// SendTemplate provides a mock function for the type MockIEmailService
+func (_mock *MockIEmailService) SendTemplate(ctx context.Context, to string, templateName string, data domain.EmailTemplateData) error {
+	ret := _mock.Called(ctx, to, templateName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendTemplate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.EmailTemplateData) error); ok {
+		r0 = returnFunc(ctx, to, templateName, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIEmailService_SendTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendTemplate'
+type MockIEmailService_SendTemplate_Call struct {
+	*mock.Call
+}
+
+// SendTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - to string
+//   - templateName string
+//   - data domain.EmailTemplateData
+func (_e *MockIEmailService_Expecter) SendTemplate(ctx interface{}, to interface{}, templateName interface{}, data interface{}) *MockIEmailService_SendTemplate_Call {
+	return &MockIEmailService_SendTemplate_Call{Call: _e.mock.On("SendTemplate", ctx, to, templateName, data)}
+}
+
+func (_c *MockIEmailService_SendTemplate_Call) Run(run func(ctx context.Context, to string, templateName string, data domain.EmailTemplateData)) *MockIEmailService_SendTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.EmailTemplateData
+		if args[3] != nil {
+			arg3 = args[3].(domain.EmailTemplateData)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailService_SendTemplate_Call) Return(err error) *MockIEmailService_SendTemplate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIEmailService_SendTemplate_Call) RunAndReturn(run func(ctx context.Context, to string, templateName string, data domain.EmailTemplateData) error) *MockIEmailService_SendTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
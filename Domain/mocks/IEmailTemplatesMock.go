@@ -0,0 +1,272 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIEmailTemplates creates a new instance of MockIEmailTemplates. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIEmailTemplates(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIEmailTemplates {
+	mock := &MockIEmailTemplates{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIEmailTemplates is an autogenerated mock type for the IEmailTemplates type
+type MockIEmailTemplates struct {
+	mock.Mock
+}
+
+type MockIEmailTemplates_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIEmailTemplates) EXPECT() *MockIEmailTemplates_Expecter {
+	return &MockIEmailTemplates_Expecter{mock: &_m.Mock}
+}
+
+// Locales provides a mock function for the type MockIEmailTemplates
+func (_mock *MockIEmailTemplates) Locales() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Locales")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockIEmailTemplates_Locales_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Locales'
+type MockIEmailTemplates_Locales_Call struct {
+	*mock.Call
+}
+
+// Locales is a helper method to define mock.On call
+func (_e *MockIEmailTemplates_Expecter) Locales() *MockIEmailTemplates_Locales_Call {
+	return &MockIEmailTemplates_Locales_Call{Call: _e.mock.On("Locales")}
+}
+
+func (_c *MockIEmailTemplates_Locales_Call) Run(run func()) *MockIEmailTemplates_Locales_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIEmailTemplates_Locales_Call) Return(strings []string) *MockIEmailTemplates_Locales_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockIEmailTemplates_Locales_Call) RunAndReturn(run func() []string) *MockIEmailTemplates_Locales_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Names provides a mock function for the type MockIEmailTemplates
+func (_mock *MockIEmailTemplates) Names() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Names")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockIEmailTemplates_Names_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Names'
+type MockIEmailTemplates_Names_Call struct {
+	*mock.Call
+}
+
+// Names is a helper method to define mock.On call
+func (_e *MockIEmailTemplates_Expecter) Names() *MockIEmailTemplates_Names_Call {
+	return &MockIEmailTemplates_Names_Call{Call: _e.mock.On("Names")}
+}
+
+func (_c *MockIEmailTemplates_Names_Call) Run(run func()) *MockIEmailTemplates_Names_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIEmailTemplates_Names_Call) Return(strings []string) *MockIEmailTemplates_Names_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockIEmailTemplates_Names_Call) RunAndReturn(run func() []string) *MockIEmailTemplates_Names_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Preview provides a mock function for the type MockIEmailTemplates
+func (_mock *MockIEmailTemplates) Preview(name string, locale string) (*domain.RenderedEmail, error) {
+	ret := _mock.Called(name, locale)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Preview")
+	}
+
+	var r0 *domain.RenderedEmail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*domain.RenderedEmail, error)); ok {
+		return returnFunc(name, locale)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *domain.RenderedEmail); ok {
+		r0 = returnFunc(name, locale)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RenderedEmail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(name, locale)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEmailTemplates_Preview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preview'
+type MockIEmailTemplates_Preview_Call struct {
+	*mock.Call
+}
+
+// Preview is a helper method to define mock.On call
+//   - name string
+//   - locale string
+func (_e *MockIEmailTemplates_Expecter) Preview(name interface{}, locale interface{}) *MockIEmailTemplates_Preview_Call {
+	return &MockIEmailTemplates_Preview_Call{Call: _e.mock.On("Preview", name, locale)}
+}
+
+func (_c *MockIEmailTemplates_Preview_Call) Run(run func(name string, locale string)) *MockIEmailTemplates_Preview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailTemplates_Preview_Call) Return(renderedEmail *domain.RenderedEmail, err error) *MockIEmailTemplates_Preview_Call {
+	_c.Call.Return(renderedEmail, err)
+	return _c
+}
+
+func (_c *MockIEmailTemplates_Preview_Call) RunAndReturn(run func(name string, locale string) (*domain.RenderedEmail, error)) *MockIEmailTemplates_Preview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Render provides a mock function for the type MockIEmailTemplates
+func (_mock *MockIEmailTemplates) Render(name string, locale string, data domain.EmailTemplateData) (*domain.RenderedEmail, error) {
+	ret := _mock.Called(name, locale, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Render")
+	}
+
+	var r0 *domain.RenderedEmail
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, domain.EmailTemplateData) (*domain.RenderedEmail, error)); ok {
+		return returnFunc(name, locale, data)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, domain.EmailTemplateData) *domain.RenderedEmail); ok {
+		r0 = returnFunc(name, locale, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RenderedEmail)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, domain.EmailTemplateData) error); ok {
+		r1 = returnFunc(name, locale, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEmailTemplates_Render_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Render'
+type MockIEmailTemplates_Render_Call struct {
+	*mock.Call
+}
+
+// Render is a helper method to define mock.On call
+//   - name string
+//   - locale string
+//   - data domain.EmailTemplateData
+func (_e *MockIEmailTemplates_Expecter) Render(name interface{}, locale interface{}, data interface{}) *MockIEmailTemplates_Render_Call {
+	return &MockIEmailTemplates_Render_Call{Call: _e.mock.On("Render", name, locale, data)}
+}
+
+func (_c *MockIEmailTemplates_Render_Call) Run(run func(name string, locale string, data domain.EmailTemplateData)) *MockIEmailTemplates_Render_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.EmailTemplateData
+		if args[2] != nil {
+			arg2 = args[2].(domain.EmailTemplateData)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEmailTemplates_Render_Call) Return(renderedEmail *domain.RenderedEmail, err error) *MockIEmailTemplates_Render_Call {
+	_c.Call.Return(renderedEmail, err)
+	return _c
+}
+
+func (_c *MockIEmailTemplates_Render_Call) RunAndReturn(run func(name string, locale string, data domain.EmailTemplateData) (*domain.RenderedEmail, error)) *MockIEmailTemplates_Render_Call {
+	_c.Call.Return(run)
+	return _c
+}
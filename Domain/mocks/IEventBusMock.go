@@ -0,0 +1,323 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIEventBus creates a new instance of MockIEventBus. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIEventBus(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIEventBus {
+	mock := &MockIEventBus{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIEventBus is an autogenerated mock type for the IEventBus type
+type MockIEventBus struct {
+	mock.Mock
+}
+
+type MockIEventBus_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIEventBus) EXPECT() *MockIEventBus_Expecter {
+	return &MockIEventBus_Expecter{mock: &_m.Mock}
+}
+
+// ProcessDue provides a mock function for the type MockIEventBus
+func (_mock *MockIEventBus) ProcessDue(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessDue")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEventBus_ProcessDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessDue'
+type MockIEventBus_ProcessDue_Call struct {
+	*mock.Call
+}
+
+// ProcessDue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIEventBus_Expecter) ProcessDue(ctx interface{}) *MockIEventBus_ProcessDue_Call {
+	return &MockIEventBus_ProcessDue_Call{Call: _e.mock.On("ProcessDue", ctx)}
+}
+
+func (_c *MockIEventBus_ProcessDue_Call) Run(run func(ctx context.Context)) *MockIEventBus_ProcessDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEventBus_ProcessDue_Call) Return(n int, err error) *MockIEventBus_ProcessDue_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIEventBus_ProcessDue_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockIEventBus_ProcessDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type MockIEventBus
+func (_mock *MockIEventBus) Publish(ctx context.Context, eventType domain.DomainEventType, aggregateID string, data any) error {
+	ret := _mock.Called(ctx, eventType, aggregateID, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DomainEventType, string, any) error); ok {
+		r0 = returnFunc(ctx, eventType, aggregateID, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIEventBus_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockIEventBus_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventType domain.DomainEventType
+//   - aggregateID string
+//   - data any
+func (_e *MockIEventBus_Expecter) Publish(ctx interface{}, eventType interface{}, aggregateID interface{}, data interface{}) *MockIEventBus_Publish_Call {
+	return &MockIEventBus_Publish_Call{Call: _e.mock.On("Publish", ctx, eventType, aggregateID, data)}
+}
+
+func (_c *MockIEventBus_Publish_Call) Run(run func(ctx context.Context, eventType domain.DomainEventType, aggregateID string, data any)) *MockIEventBus_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DomainEventType
+		if args[1] != nil {
+			arg1 = args[1].(domain.DomainEventType)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 any
+		if args[3] != nil {
+			arg3 = args[3].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEventBus_Publish_Call) Return(err error) *MockIEventBus_Publish_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIEventBus_Publish_Call) RunAndReturn(run func(ctx context.Context, eventType domain.DomainEventType, aggregateID string, data any) error) *MockIEventBus_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockIEventBus
+func (_mock *MockIEventBus) Subscribe(eventType domain.DomainEventType, name string, handler domain.DomainEventHandler) {
+	_mock.Called(eventType, name, handler)
+	return
+}
+
+// MockIEventBus_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockIEventBus_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - eventType domain.DomainEventType
+//   - name string
+//   - handler domain.DomainEventHandler
+func (_e *MockIEventBus_Expecter) Subscribe(eventType interface{}, name interface{}, handler interface{}) *MockIEventBus_Subscribe_Call {
+	return &MockIEventBus_Subscribe_Call{Call: _e.mock.On("Subscribe", eventType, name, handler)}
+}
+
+func (_c *MockIEventBus_Subscribe_Call) Run(run func(eventType domain.DomainEventType, name string, handler domain.DomainEventHandler)) *MockIEventBus_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.DomainEventType
+		if args[0] != nil {
+			arg0 = args[0].(domain.DomainEventType)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.DomainEventHandler
+		if args[2] != nil {
+			arg2 = args[2].(domain.DomainEventHandler)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEventBus_Subscribe_Call) Return() *MockIEventBus_Subscribe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIEventBus_Subscribe_Call) RunAndReturn(run func(eventType domain.DomainEventType, name string, handler domain.DomainEventHandler)) *MockIEventBus_Subscribe_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Transaction provides a mock function for the type MockIEventBus
+func (_mock *MockIEventBus) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	ret := _mock.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transaction")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) error); ok {
+		r0 = returnFunc(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIEventBus_Transaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transaction'
+type MockIEventBus_Transaction_Call struct {
+	*mock.Call
+}
+
+// Transaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(ctx context.Context) error
+func (_e *MockIEventBus_Expecter) Transaction(ctx interface{}, fn interface{}) *MockIEventBus_Transaction_Call {
+	return &MockIEventBus_Transaction_Call{Call: _e.mock.On("Transaction", ctx, fn)}
+}
+
+func (_c *MockIEventBus_Transaction_Call) Run(run func(ctx context.Context, fn func(ctx context.Context) error)) *MockIEventBus_Transaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(ctx context.Context) error
+		if args[1] != nil {
+			arg1 = args[1].(func(ctx context.Context) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEventBus_Transaction_Call) Return(err error) *MockIEventBus_Transaction_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIEventBus_Transaction_Call) RunAndReturn(run func(ctx context.Context, fn func(ctx context.Context) error) error) *MockIEventBus_Transaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wake provides a mock function for the type MockIEventBus
+func (_mock *MockIEventBus) Wake() <-chan struct{} {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wake")
+	}
+
+	var r0 <-chan struct{}
+	if returnFunc, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+	return r0
+}
+
+// MockIEventBus_Wake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wake'
+type MockIEventBus_Wake_Call struct {
+	*mock.Call
+}
+
+// Wake is a helper method to define mock.On call
+func (_e *MockIEventBus_Expecter) Wake() *MockIEventBus_Wake_Call {
+	return &MockIEventBus_Wake_Call{Call: _e.mock.On("Wake")}
+}
+
+func (_c *MockIEventBus_Wake_Call) Run(run func()) *MockIEventBus_Wake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIEventBus_Wake_Call) Return(valCh <-chan struct{}) *MockIEventBus_Wake_Call {
+	_c.Call.Return(valCh)
+	return _c
+}
+
+func (_c *MockIEventBus_Wake_Call) RunAndReturn(run func() <-chan struct{}) *MockIEventBus_Wake_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,228 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIEventOutboxRepository creates a new instance of MockIEventOutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIEventOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIEventOutboxRepository {
+	mock := &MockIEventOutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIEventOutboxRepository is an autogenerated mock type for the IEventOutboxRepository type
+type MockIEventOutboxRepository struct {
+	mock.Mock
+}
+
+type MockIEventOutboxRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIEventOutboxRepository) EXPECT() *MockIEventOutboxRepository_Expecter {
+	return &MockIEventOutboxRepository_Expecter{mock: &_m.Mock}
+}
+
+// Append provides a mock function for the type MockIEventOutboxRepository
+func (_mock *MockIEventOutboxRepository) Append(ctx context.Context, event *domain.DomainEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Append")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DomainEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIEventOutboxRepository_Append_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Append'
+type MockIEventOutboxRepository_Append_Call struct {
+	*mock.Call
+}
+
+// Append is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *domain.DomainEvent
+func (_e *MockIEventOutboxRepository_Expecter) Append(ctx interface{}, event interface{}) *MockIEventOutboxRepository_Append_Call {
+	return &MockIEventOutboxRepository_Append_Call{Call: _e.mock.On("Append", ctx, event)}
+}
+
+func (_c *MockIEventOutboxRepository_Append_Call) Run(run func(ctx context.Context, event *domain.DomainEvent)) *MockIEventOutboxRepository_Append_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.DomainEvent
+		if args[1] != nil {
+			arg1 = args[1].(*domain.DomainEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEventOutboxRepository_Append_Call) Return(err error) *MockIEventOutboxRepository_Append_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIEventOutboxRepository_Append_Call) RunAndReturn(run func(ctx context.Context, event *domain.DomainEvent) error) *MockIEventOutboxRepository_Append_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimNext provides a mock function for the type MockIEventOutboxRepository
+func (_mock *MockIEventOutboxRepository) ClaimNext(ctx context.Context, now time.Time, leaseUntil time.Time) (*domain.DomainEvent, error) {
+	ret := _mock.Called(ctx, now, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimNext")
+	}
+
+	var r0 *domain.DomainEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) (*domain.DomainEvent, error)); ok {
+		return returnFunc(ctx, now, leaseUntil)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) *domain.DomainEvent); ok {
+		r0 = returnFunc(ctx, now, leaseUntil)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DomainEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, now, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIEventOutboxRepository_ClaimNext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimNext'
+type MockIEventOutboxRepository_ClaimNext_Call struct {
+	*mock.Call
+}
+
+// ClaimNext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+func (_e *MockIEventOutboxRepository_Expecter) ClaimNext(ctx interface{}, now interface{}, leaseUntil interface{}) *MockIEventOutboxRepository_ClaimNext_Call {
+	return &MockIEventOutboxRepository_ClaimNext_Call{Call: _e.mock.On("ClaimNext", ctx, now, leaseUntil)}
+}
+
+func (_c *MockIEventOutboxRepository_ClaimNext_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time)) *MockIEventOutboxRepository_ClaimNext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEventOutboxRepository_ClaimNext_Call) Return(domainEvent *domain.DomainEvent, err error) *MockIEventOutboxRepository_ClaimNext_Call {
+	_c.Call.Return(domainEvent, err)
+	return _c
+}
+
+func (_c *MockIEventOutboxRepository_ClaimNext_Call) RunAndReturn(run func(ctx context.Context, now time.Time, leaseUntil time.Time) (*domain.DomainEvent, error)) *MockIEventOutboxRepository_ClaimNext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIEventOutboxRepository
+func (_mock *MockIEventOutboxRepository) Update(ctx context.Context, event *domain.DomainEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.DomainEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIEventOutboxRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIEventOutboxRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *domain.DomainEvent
+func (_e *MockIEventOutboxRepository_Expecter) Update(ctx interface{}, event interface{}) *MockIEventOutboxRepository_Update_Call {
+	return &MockIEventOutboxRepository_Update_Call{Call: _e.mock.On("Update", ctx, event)}
+}
+
+func (_c *MockIEventOutboxRepository_Update_Call) Run(run func(ctx context.Context, event *domain.DomainEvent)) *MockIEventOutboxRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.DomainEvent
+		if args[1] != nil {
+			arg1 = args[1].(*domain.DomainEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIEventOutboxRepository_Update_Call) Return(err error) *MockIEventOutboxRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIEventOutboxRepository_Update_Call) RunAndReturn(run func(ctx context.Context, event *domain.DomainEvent) error) *MockIEventOutboxRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIJWKSProvider creates a new instance of MockIJWKSProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIJWKSProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIJWKSProvider {
+	mock := &MockIJWKSProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIJWKSProvider is an autogenerated mock type for the IJWKSProvider type
+type MockIJWKSProvider struct {
+	mock.Mock
+}
+
+type MockIJWKSProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIJWKSProvider) EXPECT() *MockIJWKSProvider_Expecter {
+	return &MockIJWKSProvider_Expecter{mock: &_m.Mock}
+}
+
+// JWKS provides a mock function for the type MockIJWKSProvider
+func (_mock *MockIJWKSProvider) JWKS(ctx context.Context) (*domain.JSONWebKeySet, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JWKS")
+	}
+
+	var r0 *domain.JSONWebKeySet
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*domain.JSONWebKeySet, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *domain.JSONWebKeySet); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.JSONWebKeySet)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIJWKSProvider_JWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JWKS'
+type MockIJWKSProvider_JWKS_Call struct {
+	*mock.Call
+}
+
+// JWKS is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIJWKSProvider_Expecter) JWKS(ctx interface{}) *MockIJWKSProvider_JWKS_Call {
+	return &MockIJWKSProvider_JWKS_Call{Call: _e.mock.On("JWKS", ctx)}
+}
+
+func (_c *MockIJWKSProvider_JWKS_Call) Run(run func(ctx context.Context)) *MockIJWKSProvider_JWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIJWKSProvider_JWKS_Call) Return(jSONWebKeySet *domain.JSONWebKeySet, err error) *MockIJWKSProvider_JWKS_Call {
+	_c.Call.Return(jSONWebKeySet, err)
+	return _c
+}
+
+func (_c *MockIJWKSProvider_JWKS_Call) RunAndReturn(run func(ctx context.Context) (*domain.JSONWebKeySet, error)) *MockIJWKSProvider_JWKS_Call {
+	_c.Call.Return(run)
+	return _c
+}
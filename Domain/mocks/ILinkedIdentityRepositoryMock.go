@@ -0,0 +1,365 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockILinkedIdentityRepository creates a new instance of MockILinkedIdentityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockILinkedIdentityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockILinkedIdentityRepository {
+	mock := &MockILinkedIdentityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockILinkedIdentityRepository is an autogenerated mock type for the ILinkedIdentityRepository type
+type MockILinkedIdentityRepository struct {
+	mock.Mock
+}
+
+type MockILinkedIdentityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockILinkedIdentityRepository) EXPECT() *MockILinkedIdentityRepository_Expecter {
+	return &MockILinkedIdentityRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockILinkedIdentityRepository
+func (_mock *MockILinkedIdentityRepository) Create(ctx context.Context, identity *domain.LinkedIdentity) error {
+	ret := _mock.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.LinkedIdentity) error); ok {
+		r0 = returnFunc(ctx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockILinkedIdentityRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockILinkedIdentityRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity *domain.LinkedIdentity
+func (_e *MockILinkedIdentityRepository_Expecter) Create(ctx interface{}, identity interface{}) *MockILinkedIdentityRepository_Create_Call {
+	return &MockILinkedIdentityRepository_Create_Call{Call: _e.mock.On("Create", ctx, identity)}
+}
+
+func (_c *MockILinkedIdentityRepository_Create_Call) Run(run func(ctx context.Context, identity *domain.LinkedIdentity)) *MockILinkedIdentityRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.LinkedIdentity
+		if args[1] != nil {
+			arg1 = args[1].(*domain.LinkedIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILinkedIdentityRepository_Create_Call) Return(err error) *MockILinkedIdentityRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockILinkedIdentityRepository_Create_Call) RunAndReturn(run func(ctx context.Context, identity *domain.LinkedIdentity) error) *MockILinkedIdentityRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockILinkedIdentityRepository
+func (_mock *MockILinkedIdentityRepository) Delete(ctx context.Context, userID string, provider string) error {
+	ret := _mock.Called(ctx, userID, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, provider)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockILinkedIdentityRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockILinkedIdentityRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - provider string
+func (_e *MockILinkedIdentityRepository_Expecter) Delete(ctx interface{}, userID interface{}, provider interface{}) *MockILinkedIdentityRepository_Delete_Call {
+	return &MockILinkedIdentityRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, provider)}
+}
+
+func (_c *MockILinkedIdentityRepository_Delete_Call) Run(run func(ctx context.Context, userID string, provider string)) *MockILinkedIdentityRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILinkedIdentityRepository_Delete_Call) Return(err error) *MockILinkedIdentityRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockILinkedIdentityRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, userID string, provider string) error) *MockILinkedIdentityRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByProviderSubject provides a mock function for the type MockILinkedIdentityRepository
+func (_mock *MockILinkedIdentityRepository) FindByProviderSubject(ctx context.Context, provider string, subject string) (*domain.LinkedIdentity, error) {
+	ret := _mock.Called(ctx, provider, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByProviderSubject")
+	}
+
+	var r0 *domain.LinkedIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.LinkedIdentity, error)); ok {
+		return returnFunc(ctx, provider, subject)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.LinkedIdentity); ok {
+		r0 = returnFunc(ctx, provider, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LinkedIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILinkedIdentityRepository_FindByProviderSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByProviderSubject'
+type MockILinkedIdentityRepository_FindByProviderSubject_Call struct {
+	*mock.Call
+}
+
+// FindByProviderSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - subject string
+func (_e *MockILinkedIdentityRepository_Expecter) FindByProviderSubject(ctx interface{}, provider interface{}, subject interface{}) *MockILinkedIdentityRepository_FindByProviderSubject_Call {
+	return &MockILinkedIdentityRepository_FindByProviderSubject_Call{Call: _e.mock.On("FindByProviderSubject", ctx, provider, subject)}
+}
+
+func (_c *MockILinkedIdentityRepository_FindByProviderSubject_Call) Run(run func(ctx context.Context, provider string, subject string)) *MockILinkedIdentityRepository_FindByProviderSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILinkedIdentityRepository_FindByProviderSubject_Call) Return(linkedIdentity *domain.LinkedIdentity, err error) *MockILinkedIdentityRepository_FindByProviderSubject_Call {
+	_c.Call.Return(linkedIdentity, err)
+	return _c
+}
+
+func (_c *MockILinkedIdentityRepository_FindByProviderSubject_Call) RunAndReturn(run func(ctx context.Context, provider string, subject string) (*domain.LinkedIdentity, error)) *MockILinkedIdentityRepository_FindByProviderSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function for the type MockILinkedIdentityRepository
+func (_mock *MockILinkedIdentityRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.LinkedIdentity, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []*domain.LinkedIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.LinkedIdentity, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.LinkedIdentity); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.LinkedIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILinkedIdentityRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type MockILinkedIdentityRepository_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockILinkedIdentityRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}) *MockILinkedIdentityRepository_FindByUserID_Call {
+	return &MockILinkedIdentityRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID)}
+}
+
+func (_c *MockILinkedIdentityRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockILinkedIdentityRepository_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILinkedIdentityRepository_FindByUserID_Call) Return(linkedIdentitys []*domain.LinkedIdentity, err error) *MockILinkedIdentityRepository_FindByUserID_Call {
+	_c.Call.Return(linkedIdentitys, err)
+	return _c
+}
+
+func (_c *MockILinkedIdentityRepository_FindByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.LinkedIdentity, error)) *MockILinkedIdentityRepository_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastLogin provides a mock function for the type MockILinkedIdentityRepository
+func (_mock *MockILinkedIdentityRepository) UpdateLastLogin(ctx context.Context, id string, at time.Time) error {
+	ret := _mock.Called(ctx, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastLogin")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockILinkedIdentityRepository_UpdateLastLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastLogin'
+type MockILinkedIdentityRepository_UpdateLastLogin_Call struct {
+	*mock.Call
+}
+
+// UpdateLastLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - at time.Time
+func (_e *MockILinkedIdentityRepository_Expecter) UpdateLastLogin(ctx interface{}, id interface{}, at interface{}) *MockILinkedIdentityRepository_UpdateLastLogin_Call {
+	return &MockILinkedIdentityRepository_UpdateLastLogin_Call{Call: _e.mock.On("UpdateLastLogin", ctx, id, at)}
+}
+
+func (_c *MockILinkedIdentityRepository_UpdateLastLogin_Call) Run(run func(ctx context.Context, id string, at time.Time)) *MockILinkedIdentityRepository_UpdateLastLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILinkedIdentityRepository_UpdateLastLogin_Call) Return(err error) *MockILinkedIdentityRepository_UpdateLastLogin_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockILinkedIdentityRepository_UpdateLastLogin_Call) RunAndReturn(run func(ctx context.Context, id string, at time.Time) error) *MockILinkedIdentityRepository_UpdateLastLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,538 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockILoginAttemptUsecase creates a new instance of MockILoginAttemptUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockILoginAttemptUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockILoginAttemptUsecase {
+	mock := &MockILoginAttemptUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockILoginAttemptUsecase is an autogenerated mock type for the ILoginAttemptUsecase type
+type MockILoginAttemptUsecase struct {
+	mock.Mock
+}
+
+type MockILoginAttemptUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockILoginAttemptUsecase) EXPECT() *MockILoginAttemptUsecase_Expecter {
+	return &MockILoginAttemptUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function for the type MockILoginAttemptUsecase
+func (_mock *MockILoginAttemptUsecase) Check(identifier string, ip string) (time.Duration, error) {
+	ret := _mock.Called(identifier, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 time.Duration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (time.Duration, error)); ok {
+		return returnFunc(identifier, ip)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) time.Duration); ok {
+		r0 = returnFunc(identifier, ip)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(identifier, ip)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoginAttemptUsecase_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MockILoginAttemptUsecase_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - identifier string
+//   - ip string
+func (_e *MockILoginAttemptUsecase_Expecter) Check(identifier interface{}, ip interface{}) *MockILoginAttemptUsecase_Check_Call {
+	return &MockILoginAttemptUsecase_Check_Call{Call: _e.mock.On("Check", identifier, ip)}
+}
+
+func (_c *MockILoginAttemptUsecase_Check_Call) Run(run func(identifier string, ip string)) *MockILoginAttemptUsecase_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_Check_Call) Return(retryAfter time.Duration, err error) *MockILoginAttemptUsecase_Check_Call {
+	_c.Call.Return(retryAfter, err)
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_Check_Call) RunAndReturn(run func(identifier string, ip string) (time.Duration, error)) *MockILoginAttemptUsecase_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckUser provides a mock function for the type MockILoginAttemptUsecase
+func (_mock *MockILoginAttemptUsecase) CheckUser(userID string) (time.Duration, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckUser")
+	}
+
+	var r0 time.Duration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (time.Duration, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) time.Duration); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoginAttemptUsecase_CheckUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckUser'
+type MockILoginAttemptUsecase_CheckUser_Call struct {
+	*mock.Call
+}
+
+// CheckUser is a helper method to define mock.On call
+//   - userID string
+func (_e *MockILoginAttemptUsecase_Expecter) CheckUser(userID interface{}) *MockILoginAttemptUsecase_CheckUser_Call {
+	return &MockILoginAttemptUsecase_CheckUser_Call{Call: _e.mock.On("CheckUser", userID)}
+}
+
+func (_c *MockILoginAttemptUsecase_CheckUser_Call) Run(run func(userID string)) *MockILoginAttemptUsecase_CheckUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_CheckUser_Call) Return(retryAfter time.Duration, err error) *MockILoginAttemptUsecase_CheckUser_Call {
+	_c.Call.Return(retryAfter, err)
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_CheckUser_Call) RunAndReturn(run func(userID string) (time.Duration, error)) *MockILoginAttemptUsecase_CheckUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLocks provides a mock function for the type MockILoginAttemptUsecase
+func (_mock *MockILoginAttemptUsecase) ListLocks() ([]*domain.AccountLock, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLocks")
+	}
+
+	var r0 []*domain.AccountLock
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*domain.AccountLock, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*domain.AccountLock); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.AccountLock)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockILoginAttemptUsecase_ListLocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLocks'
+type MockILoginAttemptUsecase_ListLocks_Call struct {
+	*mock.Call
+}
+
+// ListLocks is a helper method to define mock.On call
+func (_e *MockILoginAttemptUsecase_Expecter) ListLocks() *MockILoginAttemptUsecase_ListLocks_Call {
+	return &MockILoginAttemptUsecase_ListLocks_Call{Call: _e.mock.On("ListLocks")}
+}
+
+func (_c *MockILoginAttemptUsecase_ListLocks_Call) Run(run func()) *MockILoginAttemptUsecase_ListLocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_ListLocks_Call) Return(accountLocks []*domain.AccountLock, err error) *MockILoginAttemptUsecase_ListLocks_Call {
+	_c.Call.Return(accountLocks, err)
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_ListLocks_Call) RunAndReturn(run func() ([]*domain.AccountLock, error)) *MockILoginAttemptUsecase_ListLocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailure provides a mock function for the type MockILoginAttemptUsecase
+func (_mock *MockILoginAttemptUsecase) RecordFailure(identifier string, ip string) error {
+	ret := _mock.Called(identifier, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailure")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(identifier, ip)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockILoginAttemptUsecase_RecordFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailure'
+type MockILoginAttemptUsecase_RecordFailure_Call struct {
+	*mock.Call
+}
+
+// RecordFailure is a helper method to define mock.On call
+//   - identifier string
+//   - ip string
+func (_e *MockILoginAttemptUsecase_Expecter) RecordFailure(identifier interface{}, ip interface{}) *MockILoginAttemptUsecase_RecordFailure_Call {
+	return &MockILoginAttemptUsecase_RecordFailure_Call{Call: _e.mock.On("RecordFailure", identifier, ip)}
+}
+
+func (_c *MockILoginAttemptUsecase_RecordFailure_Call) Run(run func(identifier string, ip string)) *MockILoginAttemptUsecase_RecordFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_RecordFailure_Call) Return(err error) *MockILoginAttemptUsecase_RecordFailure_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_RecordFailure_Call) RunAndReturn(run func(identifier string, ip string) error) *MockILoginAttemptUsecase_RecordFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSecondFactorFailure provides a mock function for the type MockILoginAttemptUsecase
+func (_mock *MockILoginAttemptUsecase) RecordSecondFactorFailure(userID string, ip string) error {
+	ret := _mock.Called(userID, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSecondFactorFailure")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(userID, ip)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockILoginAttemptUsecase_RecordSecondFactorFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSecondFactorFailure'
+type MockILoginAttemptUsecase_RecordSecondFactorFailure_Call struct {
+	*mock.Call
+}
+
+// RecordSecondFactorFailure is a helper method to define mock.On call
+//   - userID string
+//   - ip string
+func (_e *MockILoginAttemptUsecase_Expecter) RecordSecondFactorFailure(userID interface{}, ip interface{}) *MockILoginAttemptUsecase_RecordSecondFactorFailure_Call {
+	return &MockILoginAttemptUsecase_RecordSecondFactorFailure_Call{Call: _e.mock.On("RecordSecondFactorFailure", userID, ip)}
+}
+
+func (_c *MockILoginAttemptUsecase_RecordSecondFactorFailure_Call) Run(run func(userID string, ip string)) *MockILoginAttemptUsecase_RecordSecondFactorFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_RecordSecondFactorFailure_Call) Return(err error) *MockILoginAttemptUsecase_RecordSecondFactorFailure_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_RecordSecondFactorFailure_Call) RunAndReturn(run func(userID string, ip string) error) *MockILoginAttemptUsecase_RecordSecondFactorFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSecondFactorSuccess provides a mock function for the type MockILoginAttemptUsecase
+func (_mock *MockILoginAttemptUsecase) RecordSecondFactorSuccess(userID string) error {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSecondFactorSuccess")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockILoginAttemptUsecase_RecordSecondFactorSuccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSecondFactorSuccess'
+type MockILoginAttemptUsecase_RecordSecondFactorSuccess_Call struct {
+	*mock.Call
+}
+
+// RecordSecondFactorSuccess is a helper method to define mock.On call
+//   - userID string
+func (_e *MockILoginAttemptUsecase_Expecter) RecordSecondFactorSuccess(userID interface{}) *MockILoginAttemptUsecase_RecordSecondFactorSuccess_Call {
+	return &MockILoginAttemptUsecase_RecordSecondFactorSuccess_Call{Call: _e.mock.On("RecordSecondFactorSuccess", userID)}
+}
+
+func (_c *MockILoginAttemptUsecase_RecordSecondFactorSuccess_Call) Run(run func(userID string)) *MockILoginAttemptUsecase_RecordSecondFactorSuccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_RecordSecondFactorSuccess_Call) Return(err error) *MockILoginAttemptUsecase_RecordSecondFactorSuccess_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_RecordSecondFactorSuccess_Call) RunAndReturn(run func(userID string) error) *MockILoginAttemptUsecase_RecordSecondFactorSuccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSuccess provides a mock function for the type MockILoginAttemptUsecase
+func (_mock *MockILoginAttemptUsecase) RecordSuccess(identifier string) error {
+	ret := _mock.Called(identifier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSuccess")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(identifier)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockILoginAttemptUsecase_RecordSuccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSuccess'
+type MockILoginAttemptUsecase_RecordSuccess_Call struct {
+	*mock.Call
+}
+
+// RecordSuccess is a helper method to define mock.On call
+//   - identifier string
+func (_e *MockILoginAttemptUsecase_Expecter) RecordSuccess(identifier interface{}) *MockILoginAttemptUsecase_RecordSuccess_Call {
+	return &MockILoginAttemptUsecase_RecordSuccess_Call{Call: _e.mock.On("RecordSuccess", identifier)}
+}
+
+func (_c *MockILoginAttemptUsecase_RecordSuccess_Call) Run(run func(identifier string)) *MockILoginAttemptUsecase_RecordSuccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_RecordSuccess_Call) Return(err error) *MockILoginAttemptUsecase_RecordSuccess_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_RecordSuccess_Call) RunAndReturn(run func(identifier string) error) *MockILoginAttemptUsecase_RecordSuccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unlock provides a mock function for the type MockILoginAttemptUsecase
+func (_mock *MockILoginAttemptUsecase) Unlock(token string) error {
+	ret := _mock.Called(token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unlock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockILoginAttemptUsecase_Unlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlock'
+type MockILoginAttemptUsecase_Unlock_Call struct {
+	*mock.Call
+}
+
+// Unlock is a helper method to define mock.On call
+//   - token string
+func (_e *MockILoginAttemptUsecase_Expecter) Unlock(token interface{}) *MockILoginAttemptUsecase_Unlock_Call {
+	return &MockILoginAttemptUsecase_Unlock_Call{Call: _e.mock.On("Unlock", token)}
+}
+
+func (_c *MockILoginAttemptUsecase_Unlock_Call) Run(run func(token string)) *MockILoginAttemptUsecase_Unlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_Unlock_Call) Return(err error) *MockILoginAttemptUsecase_Unlock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_Unlock_Call) RunAndReturn(run func(token string) error) *MockILoginAttemptUsecase_Unlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlockIdentifier provides a mock function for the type MockILoginAttemptUsecase
+func (_mock *MockILoginAttemptUsecase) UnlockIdentifier(identifier string) error {
+	ret := _mock.Called(identifier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockIdentifier")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(identifier)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockILoginAttemptUsecase_UnlockIdentifier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockIdentifier'
+type MockILoginAttemptUsecase_UnlockIdentifier_Call struct {
+	*mock.Call
+}
+
+// UnlockIdentifier is a helper method to define mock.On call
+//   - identifier string
+func (_e *MockILoginAttemptUsecase_Expecter) UnlockIdentifier(identifier interface{}) *MockILoginAttemptUsecase_UnlockIdentifier_Call {
+	return &MockILoginAttemptUsecase_UnlockIdentifier_Call{Call: _e.mock.On("UnlockIdentifier", identifier)}
+}
+
+func (_c *MockILoginAttemptUsecase_UnlockIdentifier_Call) Run(run func(identifier string)) *MockILoginAttemptUsecase_UnlockIdentifier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_UnlockIdentifier_Call) Return(err error) *MockILoginAttemptUsecase_UnlockIdentifier_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockILoginAttemptUsecase_UnlockIdentifier_Call) RunAndReturn(run func(identifier string) error) *MockILoginAttemptUsecase_UnlockIdentifier_Call {
+	_c.Call.Return(run)
+	return _c
+}
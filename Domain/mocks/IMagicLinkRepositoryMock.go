@@ -0,0 +1,366 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIMagicLinkRepository creates a new instance of MockIMagicLinkRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIMagicLinkRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIMagicLinkRepository {
+	mock := &MockIMagicLinkRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIMagicLinkRepository is an autogenerated mock type for the IMagicLinkRepository type
+type MockIMagicLinkRepository struct {
+	mock.Mock
+}
+
+type MockIMagicLinkRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIMagicLinkRepository) EXPECT() *MockIMagicLinkRepository_Expecter {
+	return &MockIMagicLinkRepository_Expecter{mock: &_m.Mock}
+}
+
+// Consume provides a mock function for the type MockIMagicLinkRepository
+func (_mock *MockIMagicLinkRepository) Consume(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Consume")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIMagicLinkRepository_Consume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Consume'
+type MockIMagicLinkRepository_Consume_Call struct {
+	*mock.Call
+}
+
+// Consume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIMagicLinkRepository_Expecter) Consume(ctx interface{}, id interface{}) *MockIMagicLinkRepository_Consume_Call {
+	return &MockIMagicLinkRepository_Consume_Call{Call: _e.mock.On("Consume", ctx, id)}
+}
+
+func (_c *MockIMagicLinkRepository_Consume_Call) Run(run func(ctx context.Context, id string)) *MockIMagicLinkRepository_Consume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIMagicLinkRepository_Consume_Call) Return(err error) *MockIMagicLinkRepository_Consume_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIMagicLinkRepository_Consume_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockIMagicLinkRepository_Consume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByEmailSince provides a mock function for the type MockIMagicLinkRepository
+func (_mock *MockIMagicLinkRepository) CountByEmailSince(ctx context.Context, email string, since time.Time) (int64, error) {
+	ret := _mock.Called(ctx, email, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByEmailSince")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, email, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) int64); ok {
+		r0 = returnFunc(ctx, email, since)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, email, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIMagicLinkRepository_CountByEmailSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByEmailSince'
+type MockIMagicLinkRepository_CountByEmailSince_Call struct {
+	*mock.Call
+}
+
+// CountByEmailSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - since time.Time
+func (_e *MockIMagicLinkRepository_Expecter) CountByEmailSince(ctx interface{}, email interface{}, since interface{}) *MockIMagicLinkRepository_CountByEmailSince_Call {
+	return &MockIMagicLinkRepository_CountByEmailSince_Call{Call: _e.mock.On("CountByEmailSince", ctx, email, since)}
+}
+
+func (_c *MockIMagicLinkRepository_CountByEmailSince_Call) Run(run func(ctx context.Context, email string, since time.Time)) *MockIMagicLinkRepository_CountByEmailSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIMagicLinkRepository_CountByEmailSince_Call) Return(n int64, err error) *MockIMagicLinkRepository_CountByEmailSince_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIMagicLinkRepository_CountByEmailSince_Call) RunAndReturn(run func(ctx context.Context, email string, since time.Time) (int64, error)) *MockIMagicLinkRepository_CountByEmailSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByIPSince provides a mock function for the type MockIMagicLinkRepository
+func (_mock *MockIMagicLinkRepository) CountByIPSince(ctx context.Context, ip string, since time.Time) (int64, error) {
+	ret := _mock.Called(ctx, ip, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByIPSince")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, ip, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) int64); ok {
+		r0 = returnFunc(ctx, ip, since)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, ip, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIMagicLinkRepository_CountByIPSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByIPSince'
+type MockIMagicLinkRepository_CountByIPSince_Call struct {
+	*mock.Call
+}
+
+// CountByIPSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ip string
+//   - since time.Time
+func (_e *MockIMagicLinkRepository_Expecter) CountByIPSince(ctx interface{}, ip interface{}, since interface{}) *MockIMagicLinkRepository_CountByIPSince_Call {
+	return &MockIMagicLinkRepository_CountByIPSince_Call{Call: _e.mock.On("CountByIPSince", ctx, ip, since)}
+}
+
+func (_c *MockIMagicLinkRepository_CountByIPSince_Call) Run(run func(ctx context.Context, ip string, since time.Time)) *MockIMagicLinkRepository_CountByIPSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIMagicLinkRepository_CountByIPSince_Call) Return(n int64, err error) *MockIMagicLinkRepository_CountByIPSince_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIMagicLinkRepository_CountByIPSince_Call) RunAndReturn(run func(ctx context.Context, ip string, since time.Time) (int64, error)) *MockIMagicLinkRepository_CountByIPSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIMagicLinkRepository
+func (_mock *MockIMagicLinkRepository) Create(ctx context.Context, link *domain.MagicLink) error {
+	ret := _mock.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.MagicLink) error); ok {
+		r0 = returnFunc(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIMagicLinkRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIMagicLinkRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *domain.MagicLink
+func (_e *MockIMagicLinkRepository_Expecter) Create(ctx interface{}, link interface{}) *MockIMagicLinkRepository_Create_Call {
+	return &MockIMagicLinkRepository_Create_Call{Call: _e.mock.On("Create", ctx, link)}
+}
+
+func (_c *MockIMagicLinkRepository_Create_Call) Run(run func(ctx context.Context, link *domain.MagicLink)) *MockIMagicLinkRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.MagicLink
+		if args[1] != nil {
+			arg1 = args[1].(*domain.MagicLink)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIMagicLinkRepository_Create_Call) Return(err error) *MockIMagicLinkRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIMagicLinkRepository_Create_Call) RunAndReturn(run func(ctx context.Context, link *domain.MagicLink) error) *MockIMagicLinkRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTokenHash provides a mock function for the type MockIMagicLinkRepository
+func (_mock *MockIMagicLinkRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.MagicLink, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTokenHash")
+	}
+
+	var r0 *domain.MagicLink
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.MagicLink, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.MagicLink); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MagicLink)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIMagicLinkRepository_FindByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTokenHash'
+type MockIMagicLinkRepository_FindByTokenHash_Call struct {
+	*mock.Call
+}
+
+// FindByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockIMagicLinkRepository_Expecter) FindByTokenHash(ctx interface{}, tokenHash interface{}) *MockIMagicLinkRepository_FindByTokenHash_Call {
+	return &MockIMagicLinkRepository_FindByTokenHash_Call{Call: _e.mock.On("FindByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *MockIMagicLinkRepository_FindByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockIMagicLinkRepository_FindByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIMagicLinkRepository_FindByTokenHash_Call) Return(magicLink *domain.MagicLink, err error) *MockIMagicLinkRepository_FindByTokenHash_Call {
+	_c.Call.Return(magicLink, err)
+	return _c
+}
+
+func (_c *MockIMagicLinkRepository_FindByTokenHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*domain.MagicLink, error)) *MockIMagicLinkRepository_FindByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
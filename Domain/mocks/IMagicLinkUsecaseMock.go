@@ -0,0 +1,172 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIMagicLinkUsecase creates a new instance of MockIMagicLinkUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIMagicLinkUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIMagicLinkUsecase {
+	mock := &MockIMagicLinkUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIMagicLinkUsecase is an autogenerated mock type for the IMagicLinkUsecase type
+type MockIMagicLinkUsecase struct {
+	mock.Mock
+}
+
+type MockIMagicLinkUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIMagicLinkUsecase) EXPECT() *MockIMagicLinkUsecase_Expecter {
+	return &MockIMagicLinkUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Redeem provides a mock function for the type MockIMagicLinkUsecase
+func (_mock *MockIMagicLinkUsecase) Redeem(token string, binding string) (*domain.User, error) {
+	ret := _mock.Called(token, binding)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeem")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*domain.User, error)); ok {
+		return returnFunc(token, binding)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *domain.User); ok {
+		r0 = returnFunc(token, binding)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(token, binding)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIMagicLinkUsecase_Redeem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeem'
+type MockIMagicLinkUsecase_Redeem_Call struct {
+	*mock.Call
+}
+
+// Redeem is a helper method to define mock.On call
+//   - token string
+//   - binding string
+func (_e *MockIMagicLinkUsecase_Expecter) Redeem(token interface{}, binding interface{}) *MockIMagicLinkUsecase_Redeem_Call {
+	return &MockIMagicLinkUsecase_Redeem_Call{Call: _e.mock.On("Redeem", token, binding)}
+}
+
+func (_c *MockIMagicLinkUsecase_Redeem_Call) Run(run func(token string, binding string)) *MockIMagicLinkUsecase_Redeem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIMagicLinkUsecase_Redeem_Call) Return(user *domain.User, err error) *MockIMagicLinkUsecase_Redeem_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIMagicLinkUsecase_Redeem_Call) RunAndReturn(run func(token string, binding string) (*domain.User, error)) *MockIMagicLinkUsecase_Redeem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestLink provides a mock function for the type MockIMagicLinkUsecase
+func (_mock *MockIMagicLinkUsecase) RequestLink(email string, ip string) (string, error) {
+	ret := _mock.Called(email, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestLink")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return returnFunc(email, ip)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = returnFunc(email, ip)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(email, ip)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIMagicLinkUsecase_RequestLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestLink'
+type MockIMagicLinkUsecase_RequestLink_Call struct {
+	*mock.Call
+}
+
+// RequestLink is a helper method to define mock.On call
+//   - email string
+//   - ip string
+func (_e *MockIMagicLinkUsecase_Expecter) RequestLink(email interface{}, ip interface{}) *MockIMagicLinkUsecase_RequestLink_Call {
+	return &MockIMagicLinkUsecase_RequestLink_Call{Call: _e.mock.On("RequestLink", email, ip)}
+}
+
+func (_c *MockIMagicLinkUsecase_RequestLink_Call) Run(run func(email string, ip string)) *MockIMagicLinkUsecase_RequestLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIMagicLinkUsecase_RequestLink_Call) Return(binding string, err error) *MockIMagicLinkUsecase_RequestLink_Call {
+	_c.Call.Return(binding, err)
+	return _c
+}
+
+func (_c *MockIMagicLinkUsecase_RequestLink_Call) RunAndReturn(run func(email string, ip string) (string, error)) *MockIMagicLinkUsecase_RequestLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,503 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockINotificationRepository creates a new instance of MockINotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockINotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockINotificationRepository {
+	mock := &MockINotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockINotificationRepository is an autogenerated mock type for the INotificationRepository type
+type MockINotificationRepository struct {
+	mock.Mock
+}
+
+type MockINotificationRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockINotificationRepository) EXPECT() *MockINotificationRepository_Expecter {
+	return &MockINotificationRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountUnread provides a mock function for the type MockINotificationRepository
+func (_mock *MockINotificationRepository) CountUnread(ctx context.Context, recipientID string) (int64, error) {
+	ret := _mock.Called(ctx, recipientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnread")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, recipientID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, recipientID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, recipientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockINotificationRepository_CountUnread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnread'
+type MockINotificationRepository_CountUnread_Call struct {
+	*mock.Call
+}
+
+// CountUnread is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipientID string
+func (_e *MockINotificationRepository_Expecter) CountUnread(ctx interface{}, recipientID interface{}) *MockINotificationRepository_CountUnread_Call {
+	return &MockINotificationRepository_CountUnread_Call{Call: _e.mock.On("CountUnread", ctx, recipientID)}
+}
+
+func (_c *MockINotificationRepository_CountUnread_Call) Run(run func(ctx context.Context, recipientID string)) *MockINotificationRepository_CountUnread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationRepository_CountUnread_Call) Return(n int64, err error) *MockINotificationRepository_CountUnread_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockINotificationRepository_CountUnread_Call) RunAndReturn(run func(ctx context.Context, recipientID string) (int64, error)) *MockINotificationRepository_CountUnread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockINotificationRepository
+func (_mock *MockINotificationRepository) Create(ctx context.Context, notification *domain.Notification) error {
+	ret := _mock.Called(ctx, notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Notification) error); ok {
+		r0 = returnFunc(ctx, notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockINotificationRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockINotificationRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notification *domain.Notification
+func (_e *MockINotificationRepository_Expecter) Create(ctx interface{}, notification interface{}) *MockINotificationRepository_Create_Call {
+	return &MockINotificationRepository_Create_Call{Call: _e.mock.On("Create", ctx, notification)}
+}
+
+func (_c *MockINotificationRepository_Create_Call) Run(run func(ctx context.Context, notification *domain.Notification)) *MockINotificationRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Notification
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Notification)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationRepository_Create_Call) Return(err error) *MockINotificationRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockINotificationRepository_Create_Call) RunAndReturn(run func(ctx context.Context, notification *domain.Notification) error) *MockINotificationRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockINotificationRepository
+func (_mock *MockINotificationRepository) Find(ctx context.Context, filter domain.NotificationFilter) ([]*domain.Notification, int64, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 []*domain.Notification
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NotificationFilter) ([]*domain.Notification, int64, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.NotificationFilter) []*domain.Notification); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.NotificationFilter) int64); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.NotificationFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockINotificationRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockINotificationRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.NotificationFilter
+func (_e *MockINotificationRepository_Expecter) Find(ctx interface{}, filter interface{}) *MockINotificationRepository_Find_Call {
+	return &MockINotificationRepository_Find_Call{Call: _e.mock.On("Find", ctx, filter)}
+}
+
+func (_c *MockINotificationRepository_Find_Call) Run(run func(ctx context.Context, filter domain.NotificationFilter)) *MockINotificationRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.NotificationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.NotificationFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationRepository_Find_Call) Return(notifications []*domain.Notification, n int64, err error) *MockINotificationRepository_Find_Call {
+	_c.Call.Return(notifications, n, err)
+	return _c
+}
+
+func (_c *MockINotificationRepository_Find_Call) RunAndReturn(run func(ctx context.Context, filter domain.NotificationFilter) ([]*domain.Notification, int64, error)) *MockINotificationRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPreferences provides a mock function for the type MockINotificationRepository
+func (_mock *MockINotificationRepository) FindPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPreferences")
+	}
+
+	var r0 *domain.NotificationPreferences
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.NotificationPreferences, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.NotificationPreferences); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.NotificationPreferences)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockINotificationRepository_FindPreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPreferences'
+type MockINotificationRepository_FindPreferences_Call struct {
+	*mock.Call
+}
+
+// FindPreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockINotificationRepository_Expecter) FindPreferences(ctx interface{}, userID interface{}) *MockINotificationRepository_FindPreferences_Call {
+	return &MockINotificationRepository_FindPreferences_Call{Call: _e.mock.On("FindPreferences", ctx, userID)}
+}
+
+func (_c *MockINotificationRepository_FindPreferences_Call) Run(run func(ctx context.Context, userID string)) *MockINotificationRepository_FindPreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationRepository_FindPreferences_Call) Return(notificationPreferences *domain.NotificationPreferences, err error) *MockINotificationRepository_FindPreferences_Call {
+	_c.Call.Return(notificationPreferences, err)
+	return _c
+}
+
+func (_c *MockINotificationRepository_FindPreferences_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.NotificationPreferences, error)) *MockINotificationRepository_FindPreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllRead provides a mock function for the type MockINotificationRepository
+func (_mock *MockINotificationRepository) MarkAllRead(ctx context.Context, recipientID string, readAt time.Time) (int64, error) {
+	ret := _mock.Called(ctx, recipientID, readAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllRead")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, recipientID, readAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) int64); ok {
+		r0 = returnFunc(ctx, recipientID, readAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, recipientID, readAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockINotificationRepository_MarkAllRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllRead'
+type MockINotificationRepository_MarkAllRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipientID string
+//   - readAt time.Time
+func (_e *MockINotificationRepository_Expecter) MarkAllRead(ctx interface{}, recipientID interface{}, readAt interface{}) *MockINotificationRepository_MarkAllRead_Call {
+	return &MockINotificationRepository_MarkAllRead_Call{Call: _e.mock.On("MarkAllRead", ctx, recipientID, readAt)}
+}
+
+func (_c *MockINotificationRepository_MarkAllRead_Call) Run(run func(ctx context.Context, recipientID string, readAt time.Time)) *MockINotificationRepository_MarkAllRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationRepository_MarkAllRead_Call) Return(n int64, err error) *MockINotificationRepository_MarkAllRead_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockINotificationRepository_MarkAllRead_Call) RunAndReturn(run func(ctx context.Context, recipientID string, readAt time.Time) (int64, error)) *MockINotificationRepository_MarkAllRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function for the type MockINotificationRepository
+func (_mock *MockINotificationRepository) MarkRead(ctx context.Context, recipientID string, id string, readAt time.Time) error {
+	ret := _mock.Called(ctx, recipientID, id, readAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, recipientID, id, readAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockINotificationRepository_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type MockINotificationRepository_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recipientID string
+//   - id string
+//   - readAt time.Time
+func (_e *MockINotificationRepository_Expecter) MarkRead(ctx interface{}, recipientID interface{}, id interface{}, readAt interface{}) *MockINotificationRepository_MarkRead_Call {
+	return &MockINotificationRepository_MarkRead_Call{Call: _e.mock.On("MarkRead", ctx, recipientID, id, readAt)}
+}
+
+func (_c *MockINotificationRepository_MarkRead_Call) Run(run func(ctx context.Context, recipientID string, id string, readAt time.Time)) *MockINotificationRepository_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationRepository_MarkRead_Call) Return(err error) *MockINotificationRepository_MarkRead_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockINotificationRepository_MarkRead_Call) RunAndReturn(run func(ctx context.Context, recipientID string, id string, readAt time.Time) error) *MockINotificationRepository_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SavePreferences provides a mock function for the type MockINotificationRepository
+func (_mock *MockINotificationRepository) SavePreferences(ctx context.Context, preferences *domain.NotificationPreferences) error {
+	ret := _mock.Called(ctx, preferences)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePreferences")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.NotificationPreferences) error); ok {
+		r0 = returnFunc(ctx, preferences)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockINotificationRepository_SavePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePreferences'
+type MockINotificationRepository_SavePreferences_Call struct {
+	*mock.Call
+}
+
+// SavePreferences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - preferences *domain.NotificationPreferences
+func (_e *MockINotificationRepository_Expecter) SavePreferences(ctx interface{}, preferences interface{}) *MockINotificationRepository_SavePreferences_Call {
+	return &MockINotificationRepository_SavePreferences_Call{Call: _e.mock.On("SavePreferences", ctx, preferences)}
+}
+
+func (_c *MockINotificationRepository_SavePreferences_Call) Run(run func(ctx context.Context, preferences *domain.NotificationPreferences)) *MockINotificationRepository_SavePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.NotificationPreferences
+		if args[1] != nil {
+			arg1 = args[1].(*domain.NotificationPreferences)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationRepository_SavePreferences_Call) Return(err error) *MockINotificationRepository_SavePreferences_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockINotificationRepository_SavePreferences_Call) RunAndReturn(run func(ctx context.Context, preferences *domain.NotificationPreferences) error) *MockINotificationRepository_SavePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
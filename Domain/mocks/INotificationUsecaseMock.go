@@ -0,0 +1,464 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockINotificationUsecase creates a new instance of MockINotificationUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockINotificationUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockINotificationUsecase {
+	mock := &MockINotificationUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockINotificationUsecase is an autogenerated mock type for the INotificationUsecase type
+type MockINotificationUsecase struct {
+	mock.Mock
+}
+
+type MockINotificationUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockINotificationUsecase) EXPECT() *MockINotificationUsecase_Expecter {
+	return &MockINotificationUsecase_Expecter{mock: &_m.Mock}
+}
+
+// List provides a mock function for the type MockINotificationUsecase
+func (_mock *MockINotificationUsecase) List(filter domain.NotificationFilter) ([]*domain.Notification, int64, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.Notification
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(domain.NotificationFilter) ([]*domain.Notification, int64, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(domain.NotificationFilter) []*domain.Notification); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(domain.NotificationFilter) int64); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(domain.NotificationFilter) error); ok {
+		r2 = returnFunc(filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockINotificationUsecase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockINotificationUsecase_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - filter domain.NotificationFilter
+func (_e *MockINotificationUsecase_Expecter) List(filter interface{}) *MockINotificationUsecase_List_Call {
+	return &MockINotificationUsecase_List_Call{Call: _e.mock.On("List", filter)}
+}
+
+func (_c *MockINotificationUsecase_List_Call) Run(run func(filter domain.NotificationFilter)) *MockINotificationUsecase_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.NotificationFilter
+		if args[0] != nil {
+			arg0 = args[0].(domain.NotificationFilter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationUsecase_List_Call) Return(notifications []*domain.Notification, n int64, err error) *MockINotificationUsecase_List_Call {
+	_c.Call.Return(notifications, n, err)
+	return _c
+}
+
+func (_c *MockINotificationUsecase_List_Call) RunAndReturn(run func(filter domain.NotificationFilter) ([]*domain.Notification, int64, error)) *MockINotificationUsecase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllRead provides a mock function for the type MockINotificationUsecase
+func (_mock *MockINotificationUsecase) MarkAllRead(userID string) (int64, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllRead")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockINotificationUsecase_MarkAllRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllRead'
+type MockINotificationUsecase_MarkAllRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllRead is a helper method to define mock.On call
+//   - userID string
+func (_e *MockINotificationUsecase_Expecter) MarkAllRead(userID interface{}) *MockINotificationUsecase_MarkAllRead_Call {
+	return &MockINotificationUsecase_MarkAllRead_Call{Call: _e.mock.On("MarkAllRead", userID)}
+}
+
+func (_c *MockINotificationUsecase_MarkAllRead_Call) Run(run func(userID string)) *MockINotificationUsecase_MarkAllRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationUsecase_MarkAllRead_Call) Return(n int64, err error) *MockINotificationUsecase_MarkAllRead_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockINotificationUsecase_MarkAllRead_Call) RunAndReturn(run func(userID string) (int64, error)) *MockINotificationUsecase_MarkAllRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function for the type MockINotificationUsecase
+func (_mock *MockINotificationUsecase) MarkRead(userID string, id string) error {
+	ret := _mock.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockINotificationUsecase_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type MockINotificationUsecase_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - userID string
+//   - id string
+func (_e *MockINotificationUsecase_Expecter) MarkRead(userID interface{}, id interface{}) *MockINotificationUsecase_MarkRead_Call {
+	return &MockINotificationUsecase_MarkRead_Call{Call: _e.mock.On("MarkRead", userID, id)}
+}
+
+func (_c *MockINotificationUsecase_MarkRead_Call) Run(run func(userID string, id string)) *MockINotificationUsecase_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationUsecase_MarkRead_Call) Return(err error) *MockINotificationUsecase_MarkRead_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockINotificationUsecase_MarkRead_Call) RunAndReturn(run func(userID string, id string) error) *MockINotificationUsecase_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Notify provides a mock function for the type MockINotificationUsecase
+func (_mock *MockINotificationUsecase) Notify(notification *domain.Notification) error {
+	ret := _mock.Called(notification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.Notification) error); ok {
+		r0 = returnFunc(notification)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockINotificationUsecase_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type MockINotificationUsecase_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - notification *domain.Notification
+func (_e *MockINotificationUsecase_Expecter) Notify(notification interface{}) *MockINotificationUsecase_Notify_Call {
+	return &MockINotificationUsecase_Notify_Call{Call: _e.mock.On("Notify", notification)}
+}
+
+func (_c *MockINotificationUsecase_Notify_Call) Run(run func(notification *domain.Notification)) *MockINotificationUsecase_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.Notification
+		if args[0] != nil {
+			arg0 = args[0].(*domain.Notification)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationUsecase_Notify_Call) Return(err error) *MockINotificationUsecase_Notify_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockINotificationUsecase_Notify_Call) RunAndReturn(run func(notification *domain.Notification) error) *MockINotificationUsecase_Notify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Preferences provides a mock function for the type MockINotificationUsecase
+func (_mock *MockINotificationUsecase) Preferences(userID string) (map[domain.NotificationType]bool, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Preferences")
+	}
+
+	var r0 map[domain.NotificationType]bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (map[domain.NotificationType]bool, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) map[domain.NotificationType]bool); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[domain.NotificationType]bool)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockINotificationUsecase_Preferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preferences'
+type MockINotificationUsecase_Preferences_Call struct {
+	*mock.Call
+}
+
+// Preferences is a helper method to define mock.On call
+//   - userID string
+func (_e *MockINotificationUsecase_Expecter) Preferences(userID interface{}) *MockINotificationUsecase_Preferences_Call {
+	return &MockINotificationUsecase_Preferences_Call{Call: _e.mock.On("Preferences", userID)}
+}
+
+func (_c *MockINotificationUsecase_Preferences_Call) Run(run func(userID string)) *MockINotificationUsecase_Preferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationUsecase_Preferences_Call) Return(notificationTypeToBool map[domain.NotificationType]bool, err error) *MockINotificationUsecase_Preferences_Call {
+	_c.Call.Return(notificationTypeToBool, err)
+	return _c
+}
+
+func (_c *MockINotificationUsecase_Preferences_Call) RunAndReturn(run func(userID string) (map[domain.NotificationType]bool, error)) *MockINotificationUsecase_Preferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnreadCount provides a mock function for the type MockINotificationUsecase
+func (_mock *MockINotificationUsecase) UnreadCount(userID string) (int64, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnreadCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockINotificationUsecase_UnreadCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnreadCount'
+type MockINotificationUsecase_UnreadCount_Call struct {
+	*mock.Call
+}
+
+// UnreadCount is a helper method to define mock.On call
+//   - userID string
+func (_e *MockINotificationUsecase_Expecter) UnreadCount(userID interface{}) *MockINotificationUsecase_UnreadCount_Call {
+	return &MockINotificationUsecase_UnreadCount_Call{Call: _e.mock.On("UnreadCount", userID)}
+}
+
+func (_c *MockINotificationUsecase_UnreadCount_Call) Run(run func(userID string)) *MockINotificationUsecase_UnreadCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationUsecase_UnreadCount_Call) Return(n int64, err error) *MockINotificationUsecase_UnreadCount_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockINotificationUsecase_UnreadCount_Call) RunAndReturn(run func(userID string) (int64, error)) *MockINotificationUsecase_UnreadCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePreferences provides a mock function for the type MockINotificationUsecase
+func (_mock *MockINotificationUsecase) UpdatePreferences(userID string, enabled map[domain.NotificationType]bool) (map[domain.NotificationType]bool, error) {
+	ret := _mock.Called(userID, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreferences")
+	}
+
+	var r0 map[domain.NotificationType]bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, map[domain.NotificationType]bool) (map[domain.NotificationType]bool, error)); ok {
+		return returnFunc(userID, enabled)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, map[domain.NotificationType]bool) map[domain.NotificationType]bool); ok {
+		r0 = returnFunc(userID, enabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[domain.NotificationType]bool)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, map[domain.NotificationType]bool) error); ok {
+		r1 = returnFunc(userID, enabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockINotificationUsecase_UpdatePreferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePreferences'
+type MockINotificationUsecase_UpdatePreferences_Call struct {
+	*mock.Call
+}
+
+// UpdatePreferences is a helper method to define mock.On call
+//   - userID string
+//   - enabled map[domain.NotificationType]bool
+func (_e *MockINotificationUsecase_Expecter) UpdatePreferences(userID interface{}, enabled interface{}) *MockINotificationUsecase_UpdatePreferences_Call {
+	return &MockINotificationUsecase_UpdatePreferences_Call{Call: _e.mock.On("UpdatePreferences", userID, enabled)}
+}
+
+func (_c *MockINotificationUsecase_UpdatePreferences_Call) Run(run func(userID string, enabled map[domain.NotificationType]bool)) *MockINotificationUsecase_UpdatePreferences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 map[domain.NotificationType]bool
+		if args[1] != nil {
+			arg1 = args[1].(map[domain.NotificationType]bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockINotificationUsecase_UpdatePreferences_Call) Return(notificationTypeToBool map[domain.NotificationType]bool, err error) *MockINotificationUsecase_UpdatePreferences_Call {
+	_c.Call.Return(notificationTypeToBool, err)
+	return _c
+}
+
+func (_c *MockINotificationUsecase_UpdatePreferences_Call) RunAndReturn(run func(userID string, enabled map[domain.NotificationType]bool) (map[domain.NotificationType]bool, error)) *MockINotificationUsecase_UpdatePreferences_Call {
+	_c.Call.Return(run)
+	return _c
+}
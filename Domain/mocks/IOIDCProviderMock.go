@@ -0,0 +1,241 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIOIDCProvider creates a new instance of MockIOIDCProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIOIDCProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIOIDCProvider {
+	mock := &MockIOIDCProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIOIDCProvider is an autogenerated mock type for the IOIDCProvider type
+type MockIOIDCProvider struct {
+	mock.Mock
+}
+
+type MockIOIDCProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIOIDCProvider) EXPECT() *MockIOIDCProvider_Expecter {
+	return &MockIOIDCProvider_Expecter{mock: &_m.Mock}
+}
+
+// AuthCodeURL provides a mock function for the type MockIOIDCProvider
+func (_mock *MockIOIDCProvider) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	ret := _mock.Called(ctx, state, nonce, codeVerifier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthCodeURL")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return returnFunc(ctx, state, nonce, codeVerifier)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = returnFunc(ctx, state, nonce, codeVerifier)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, state, nonce, codeVerifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOIDCProvider_AuthCodeURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthCodeURL'
+type MockIOIDCProvider_AuthCodeURL_Call struct {
+	*mock.Call
+}
+
+// AuthCodeURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state string
+//   - nonce string
+//   - codeVerifier string
+func (_e *MockIOIDCProvider_Expecter) AuthCodeURL(ctx interface{}, state interface{}, nonce interface{}, codeVerifier interface{}) *MockIOIDCProvider_AuthCodeURL_Call {
+	return &MockIOIDCProvider_AuthCodeURL_Call{Call: _e.mock.On("AuthCodeURL", ctx, state, nonce, codeVerifier)}
+}
+
+func (_c *MockIOIDCProvider_AuthCodeURL_Call) Run(run func(ctx context.Context, state string, nonce string, codeVerifier string)) *MockIOIDCProvider_AuthCodeURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCProvider_AuthCodeURL_Call) Return(s string, err error) *MockIOIDCProvider_AuthCodeURL_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockIOIDCProvider_AuthCodeURL_Call) RunAndReturn(run func(ctx context.Context, state string, nonce string, codeVerifier string) (string, error)) *MockIOIDCProvider_AuthCodeURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exchange provides a mock function for the type MockIOIDCProvider
+func (_mock *MockIOIDCProvider) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*domain.OIDCIdentity, error) {
+	ret := _mock.Called(ctx, code, codeVerifier, nonce)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exchange")
+	}
+
+	var r0 *domain.OIDCIdentity
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.OIDCIdentity, error)); ok {
+		return returnFunc(ctx, code, codeVerifier, nonce)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.OIDCIdentity); ok {
+		r0 = returnFunc(ctx, code, codeVerifier, nonce)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OIDCIdentity)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, code, codeVerifier, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOIDCProvider_Exchange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exchange'
+type MockIOIDCProvider_Exchange_Call struct {
+	*mock.Call
+}
+
+// Exchange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - codeVerifier string
+//   - nonce string
+func (_e *MockIOIDCProvider_Expecter) Exchange(ctx interface{}, code interface{}, codeVerifier interface{}, nonce interface{}) *MockIOIDCProvider_Exchange_Call {
+	return &MockIOIDCProvider_Exchange_Call{Call: _e.mock.On("Exchange", ctx, code, codeVerifier, nonce)}
+}
+
+func (_c *MockIOIDCProvider_Exchange_Call) Run(run func(ctx context.Context, code string, codeVerifier string, nonce string)) *MockIOIDCProvider_Exchange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCProvider_Exchange_Call) Return(oIDCIdentity *domain.OIDCIdentity, err error) *MockIOIDCProvider_Exchange_Call {
+	_c.Call.Return(oIDCIdentity, err)
+	return _c
+}
+
+func (_c *MockIOIDCProvider_Exchange_Call) RunAndReturn(run func(ctx context.Context, code string, codeVerifier string, nonce string) (*domain.OIDCIdentity, error)) *MockIOIDCProvider_Exchange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Name provides a mock function for the type MockIOIDCProvider
+func (_mock *MockIOIDCProvider) Name() string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockIOIDCProvider_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type MockIOIDCProvider_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *MockIOIDCProvider_Expecter) Name() *MockIOIDCProvider_Name_Call {
+	return &MockIOIDCProvider_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *MockIOIDCProvider_Name_Call) Run(run func()) *MockIOIDCProvider_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIOIDCProvider_Name_Call) Return(s string) *MockIOIDCProvider_Name_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockIOIDCProvider_Name_Call) RunAndReturn(run func() string) *MockIOIDCProvider_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,164 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIOIDCStateRepository creates a new instance of MockIOIDCStateRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIOIDCStateRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIOIDCStateRepository {
+	mock := &MockIOIDCStateRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIOIDCStateRepository is an autogenerated mock type for the IOIDCStateRepository type
+type MockIOIDCStateRepository struct {
+	mock.Mock
+}
+
+type MockIOIDCStateRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIOIDCStateRepository) EXPECT() *MockIOIDCStateRepository_Expecter {
+	return &MockIOIDCStateRepository_Expecter{mock: &_m.Mock}
+}
+
+// Consume provides a mock function for the type MockIOIDCStateRepository
+func (_mock *MockIOIDCStateRepository) Consume(ctx context.Context, state string) (*domain.OIDCLoginState, error) {
+	ret := _mock.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Consume")
+	}
+
+	var r0 *domain.OIDCLoginState
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.OIDCLoginState, error)); ok {
+		return returnFunc(ctx, state)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.OIDCLoginState); ok {
+		r0 = returnFunc(ctx, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OIDCLoginState)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOIDCStateRepository_Consume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Consume'
+type MockIOIDCStateRepository_Consume_Call struct {
+	*mock.Call
+}
+
+// Consume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state string
+func (_e *MockIOIDCStateRepository_Expecter) Consume(ctx interface{}, state interface{}) *MockIOIDCStateRepository_Consume_Call {
+	return &MockIOIDCStateRepository_Consume_Call{Call: _e.mock.On("Consume", ctx, state)}
+}
+
+func (_c *MockIOIDCStateRepository_Consume_Call) Run(run func(ctx context.Context, state string)) *MockIOIDCStateRepository_Consume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCStateRepository_Consume_Call) Return(oIDCLoginState *domain.OIDCLoginState, err error) *MockIOIDCStateRepository_Consume_Call {
+	_c.Call.Return(oIDCLoginState, err)
+	return _c
+}
+
+func (_c *MockIOIDCStateRepository_Consume_Call) RunAndReturn(run func(ctx context.Context, state string) (*domain.OIDCLoginState, error)) *MockIOIDCStateRepository_Consume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockIOIDCStateRepository
+func (_mock *MockIOIDCStateRepository) Save(ctx context.Context, state *domain.OIDCLoginState) error {
+	ret := _mock.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.OIDCLoginState) error); ok {
+		r0 = returnFunc(ctx, state)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOIDCStateRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockIOIDCStateRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state *domain.OIDCLoginState
+func (_e *MockIOIDCStateRepository_Expecter) Save(ctx interface{}, state interface{}) *MockIOIDCStateRepository_Save_Call {
+	return &MockIOIDCStateRepository_Save_Call{Call: _e.mock.On("Save", ctx, state)}
+}
+
+func (_c *MockIOIDCStateRepository_Save_Call) Run(run func(ctx context.Context, state *domain.OIDCLoginState)) *MockIOIDCStateRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.OIDCLoginState
+		if args[1] != nil {
+			arg1 = args[1].(*domain.OIDCLoginState)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCStateRepository_Save_Call) Return(err error) *MockIOIDCStateRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOIDCStateRepository_Save_Call) RunAndReturn(run func(ctx context.Context, state *domain.OIDCLoginState) error) *MockIOIDCStateRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,562 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIOIDCUsecase creates a new instance of MockIOIDCUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIOIDCUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIOIDCUsecase {
+	mock := &MockIOIDCUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIOIDCUsecase is an autogenerated mock type for the IOIDCUsecase type
+type MockIOIDCUsecase struct {
+	mock.Mock
+}
+
+type MockIOIDCUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIOIDCUsecase) EXPECT() *MockIOIDCUsecase_Expecter {
+	return &MockIOIDCUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Begin provides a mock function for the type MockIOIDCUsecase
+func (_mock *MockIOIDCUsecase) Begin(provider string) (string, string, error) {
+	ret := _mock.Called(provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, string, error)); ok {
+		return returnFunc(provider)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(provider)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) string); ok {
+		r1 = returnFunc(provider)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
+		r2 = returnFunc(provider)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIOIDCUsecase_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type MockIOIDCUsecase_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - provider string
+func (_e *MockIOIDCUsecase_Expecter) Begin(provider interface{}) *MockIOIDCUsecase_Begin_Call {
+	return &MockIOIDCUsecase_Begin_Call{Call: _e.mock.On("Begin", provider)}
+}
+
+func (_c *MockIOIDCUsecase_Begin_Call) Run(run func(provider string)) *MockIOIDCUsecase_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_Begin_Call) Return(authURL string, state string, err error) *MockIOIDCUsecase_Begin_Call {
+	_c.Call.Return(authURL, state, err)
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_Begin_Call) RunAndReturn(run func(provider string) (string, string, error)) *MockIOIDCUsecase_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginLink provides a mock function for the type MockIOIDCUsecase
+func (_mock *MockIOIDCUsecase) BeginLink(userID string, provider string, password string, confirmWith string) (string, string, error) {
+	ret := _mock.Called(userID, provider, password, confirmWith)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginLink")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string) (string, string, error)); ok {
+		return returnFunc(userID, provider, password, confirmWith)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string) string); ok {
+		r0 = returnFunc(userID, provider, password, confirmWith)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string, string) string); ok {
+		r1 = returnFunc(userID, provider, password, confirmWith)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, string, string, string) error); ok {
+		r2 = returnFunc(userID, provider, password, confirmWith)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIOIDCUsecase_BeginLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginLink'
+type MockIOIDCUsecase_BeginLink_Call struct {
+	*mock.Call
+}
+
+// BeginLink is a helper method to define mock.On call
+//   - userID string
+//   - provider string
+//   - password string
+//   - confirmWith string
+func (_e *MockIOIDCUsecase_Expecter) BeginLink(userID interface{}, provider interface{}, password interface{}, confirmWith interface{}) *MockIOIDCUsecase_BeginLink_Call {
+	return &MockIOIDCUsecase_BeginLink_Call{Call: _e.mock.On("BeginLink", userID, provider, password, confirmWith)}
+}
+
+func (_c *MockIOIDCUsecase_BeginLink_Call) Run(run func(userID string, provider string, password string, confirmWith string)) *MockIOIDCUsecase_BeginLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_BeginLink_Call) Return(authURL string, state string, err error) *MockIOIDCUsecase_BeginLink_Call {
+	_c.Call.Return(authURL, state, err)
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_BeginLink_Call) RunAndReturn(run func(userID string, provider string, password string, confirmWith string) (string, string, error)) *MockIOIDCUsecase_BeginLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginUnlink provides a mock function for the type MockIOIDCUsecase
+func (_mock *MockIOIDCUsecase) BeginUnlink(userID string, provider string, confirmWith string) (string, string, error) {
+	ret := _mock.Called(userID, provider, confirmWith)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginUnlink")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) (string, string, error)); ok {
+		return returnFunc(userID, provider, confirmWith)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = returnFunc(userID, provider, confirmWith)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string) string); ok {
+		r1 = returnFunc(userID, provider, confirmWith)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, string, string) error); ok {
+		r2 = returnFunc(userID, provider, confirmWith)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIOIDCUsecase_BeginUnlink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginUnlink'
+type MockIOIDCUsecase_BeginUnlink_Call struct {
+	*mock.Call
+}
+
+// BeginUnlink is a helper method to define mock.On call
+//   - userID string
+//   - provider string
+//   - confirmWith string
+func (_e *MockIOIDCUsecase_Expecter) BeginUnlink(userID interface{}, provider interface{}, confirmWith interface{}) *MockIOIDCUsecase_BeginUnlink_Call {
+	return &MockIOIDCUsecase_BeginUnlink_Call{Call: _e.mock.On("BeginUnlink", userID, provider, confirmWith)}
+}
+
+func (_c *MockIOIDCUsecase_BeginUnlink_Call) Run(run func(userID string, provider string, confirmWith string)) *MockIOIDCUsecase_BeginUnlink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_BeginUnlink_Call) Return(authURL string, state string, err error) *MockIOIDCUsecase_BeginUnlink_Call {
+	_c.Call.Return(authURL, state, err)
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_BeginUnlink_Call) RunAndReturn(run func(userID string, provider string, confirmWith string) (string, string, error)) *MockIOIDCUsecase_BeginUnlink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Complete provides a mock function for the type MockIOIDCUsecase
+func (_mock *MockIOIDCUsecase) Complete(provider string, state string, code string) (*domain.OIDCLoginResult, error) {
+	ret := _mock.Called(provider, state, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 *domain.OIDCLoginResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) (*domain.OIDCLoginResult, error)); ok {
+		return returnFunc(provider, state, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) *domain.OIDCLoginResult); ok {
+		r0 = returnFunc(provider, state, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.OIDCLoginResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = returnFunc(provider, state, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOIDCUsecase_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockIOIDCUsecase_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - provider string
+//   - state string
+//   - code string
+func (_e *MockIOIDCUsecase_Expecter) Complete(provider interface{}, state interface{}, code interface{}) *MockIOIDCUsecase_Complete_Call {
+	return &MockIOIDCUsecase_Complete_Call{Call: _e.mock.On("Complete", provider, state, code)}
+}
+
+func (_c *MockIOIDCUsecase_Complete_Call) Run(run func(provider string, state string, code string)) *MockIOIDCUsecase_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_Complete_Call) Return(oIDCLoginResult *domain.OIDCLoginResult, err error) *MockIOIDCUsecase_Complete_Call {
+	_c.Call.Return(oIDCLoginResult, err)
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_Complete_Call) RunAndReturn(run func(provider string, state string, code string) (*domain.OIDCLoginResult, error)) *MockIOIDCUsecase_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasProvider provides a mock function for the type MockIOIDCUsecase
+func (_mock *MockIOIDCUsecase) HasProvider(name string) bool {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasProvider")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockIOIDCUsecase_HasProvider_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasProvider'
+type MockIOIDCUsecase_HasProvider_Call struct {
+	*mock.Call
+}
+
+// HasProvider is a helper method to define mock.On call
+//   - name string
+func (_e *MockIOIDCUsecase_Expecter) HasProvider(name interface{}) *MockIOIDCUsecase_HasProvider_Call {
+	return &MockIOIDCUsecase_HasProvider_Call{Call: _e.mock.On("HasProvider", name)}
+}
+
+func (_c *MockIOIDCUsecase_HasProvider_Call) Run(run func(name string)) *MockIOIDCUsecase_HasProvider_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_HasProvider_Call) Return(b bool) *MockIOIDCUsecase_HasProvider_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_HasProvider_Call) RunAndReturn(run func(name string) bool) *MockIOIDCUsecase_HasProvider_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginMethods provides a mock function for the type MockIOIDCUsecase
+func (_mock *MockIOIDCUsecase) LoginMethods(userID string) (*domain.LoginMethods, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginMethods")
+	}
+
+	var r0 *domain.LoginMethods
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.LoginMethods, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.LoginMethods); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginMethods)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIOIDCUsecase_LoginMethods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginMethods'
+type MockIOIDCUsecase_LoginMethods_Call struct {
+	*mock.Call
+}
+
+// LoginMethods is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIOIDCUsecase_Expecter) LoginMethods(userID interface{}) *MockIOIDCUsecase_LoginMethods_Call {
+	return &MockIOIDCUsecase_LoginMethods_Call{Call: _e.mock.On("LoginMethods", userID)}
+}
+
+func (_c *MockIOIDCUsecase_LoginMethods_Call) Run(run func(userID string)) *MockIOIDCUsecase_LoginMethods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_LoginMethods_Call) Return(loginMethods *domain.LoginMethods, err error) *MockIOIDCUsecase_LoginMethods_Call {
+	_c.Call.Return(loginMethods, err)
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_LoginMethods_Call) RunAndReturn(run func(userID string) (*domain.LoginMethods, error)) *MockIOIDCUsecase_LoginMethods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Providers provides a mock function for the type MockIOIDCUsecase
+func (_mock *MockIOIDCUsecase) Providers() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Providers")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockIOIDCUsecase_Providers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Providers'
+type MockIOIDCUsecase_Providers_Call struct {
+	*mock.Call
+}
+
+// Providers is a helper method to define mock.On call
+func (_e *MockIOIDCUsecase_Expecter) Providers() *MockIOIDCUsecase_Providers_Call {
+	return &MockIOIDCUsecase_Providers_Call{Call: _e.mock.On("Providers")}
+}
+
+func (_c *MockIOIDCUsecase_Providers_Call) Run(run func()) *MockIOIDCUsecase_Providers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_Providers_Call) Return(strings []string) *MockIOIDCUsecase_Providers_Call {
+	_c.Call.Return(strings)
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_Providers_Call) RunAndReturn(run func() []string) *MockIOIDCUsecase_Providers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unlink provides a mock function for the type MockIOIDCUsecase
+func (_mock *MockIOIDCUsecase) Unlink(userID string, provider string, password string) error {
+	ret := _mock.Called(userID, provider, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unlink")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = returnFunc(userID, provider, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIOIDCUsecase_Unlink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlink'
+type MockIOIDCUsecase_Unlink_Call struct {
+	*mock.Call
+}
+
+// Unlink is a helper method to define mock.On call
+//   - userID string
+//   - provider string
+//   - password string
+func (_e *MockIOIDCUsecase_Expecter) Unlink(userID interface{}, provider interface{}, password interface{}) *MockIOIDCUsecase_Unlink_Call {
+	return &MockIOIDCUsecase_Unlink_Call{Call: _e.mock.On("Unlink", userID, provider, password)}
+}
+
+func (_c *MockIOIDCUsecase_Unlink_Call) Run(run func(userID string, provider string, password string)) *MockIOIDCUsecase_Unlink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_Unlink_Call) Return(err error) *MockIOIDCUsecase_Unlink_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIOIDCUsecase_Unlink_Call) RunAndReturn(run func(userID string, provider string, password string) error) *MockIOIDCUsecase_Unlink_Call {
+	_c.Call.Return(run)
+	return _c
+}
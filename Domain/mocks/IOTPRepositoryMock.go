@@ -95,47 +95,48 @@ func (_c *MockIOTPRepository_DeleteOTPByID_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
-// FindOTPByEmail provides a mock function for the type MockIOTPRepository
-func (_mock *MockIOTPRepository) FindOTPByEmail(ctx context.Context, email string) (*domain.OTP, error) {
-	ret := _mock.Called(ctx, email)
+// FindOTPByEmailAndPurpose provides a mock function for the type MockIOTPRepository
+func (_mock *MockIOTPRepository) FindOTPByEmailAndPurpose(ctx context.Context, email string, purpose domain.OTPPurpose) (*domain.OTP, error) {
+	ret := _mock.Called(ctx, email, purpose)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindOTPByEmail")
+		panic("no return value specified for FindOTPByEmailAndPurpose")
 	}
 
 	var r0 *domain.OTP
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.OTP, error)); ok {
-		return returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.OTPPurpose) (*domain.OTP, error)); ok {
+		return returnFunc(ctx, email, purpose)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.OTP); ok {
-		r0 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.OTPPurpose) *domain.OTP); ok {
+		r0 = returnFunc(ctx, email, purpose)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.OTP)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.OTPPurpose) error); ok {
+		r1 = returnFunc(ctx, email, purpose)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIOTPRepository_FindOTPByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindOTPByEmail'
-type MockIOTPRepository_FindOTPByEmail_Call struct {
+// MockIOTPRepository_FindOTPByEmailAndPurpose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindOTPByEmailAndPurpose'
+type MockIOTPRepository_FindOTPByEmailAndPurpose_Call struct {
 	*mock.Call
 }
 
-// FindOTPByEmail is a helper method to define mock.On call
+// FindOTPByEmailAndPurpose is a helper method to define mock.On call
 //   - ctx context.Context
 //   - email string
-func (_e *MockIOTPRepository_Expecter) FindOTPByEmail(ctx interface{}, email interface{}) *MockIOTPRepository_FindOTPByEmail_Call {
-	return &MockIOTPRepository_FindOTPByEmail_Call{Call: _e.mock.On("FindOTPByEmail", ctx, email)}
+//   - purpose domain.OTPPurpose
+func (_e *MockIOTPRepository_Expecter) FindOTPByEmailAndPurpose(ctx interface{}, email interface{}, purpose interface{}) *MockIOTPRepository_FindOTPByEmailAndPurpose_Call {
+	return &MockIOTPRepository_FindOTPByEmailAndPurpose_Call{Call: _e.mock.On("FindOTPByEmailAndPurpose", ctx, email, purpose)}
 }
 
-func (_c *MockIOTPRepository_FindOTPByEmail_Call) Run(run func(ctx context.Context, email string)) *MockIOTPRepository_FindOTPByEmail_Call {
+func (_c *MockIOTPRepository_FindOTPByEmailAndPurpose_Call) Run(run func(ctx context.Context, email string, purpose domain.OTPPurpose)) *MockIOTPRepository_FindOTPByEmailAndPurpose_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -145,20 +146,25 @@ func (_c *MockIOTPRepository_FindOTPByEmail_Call) Run(run func(ctx context.Conte
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 domain.OTPPurpose
+		if args[2] != nil {
+			arg2 = args[2].(domain.OTPPurpose)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIOTPRepository_FindOTPByEmail_Call) Return(oTP *domain.OTP, err error) *MockIOTPRepository_FindOTPByEmail_Call {
+func (_c *MockIOTPRepository_FindOTPByEmailAndPurpose_Call) Return(oTP *domain.OTP, err error) *MockIOTPRepository_FindOTPByEmailAndPurpose_Call {
 	_c.Call.Return(oTP, err)
 	return _c
 }
 
-func (_c *MockIOTPRepository_FindOTPByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*domain.OTP, error)) *MockIOTPRepository_FindOTPByEmail_Call {
+func (_c *MockIOTPRepository_FindOTPByEmailAndPurpose_Call) RunAndReturn(run func(ctx context.Context, email string, purpose domain.OTPPurpose) (*domain.OTP, error)) *MockIOTPRepository_FindOTPByEmailAndPurpose_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -89,16 +89,16 @@ func (_c *MockIOTPUsecase_DeleteByID_Call) RunAndReturn(run func(id string) erro
 }
 
 // RequestOTP provides a mock function for the type MockIOTPUsecase
-func (_mock *MockIOTPUsecase) RequestOTP(email string) error {
-	ret := _mock.Called(email)
+func (_mock *MockIOTPUsecase) RequestOTP(email string, purpose domain.OTPPurpose) error {
+	ret := _mock.Called(email, purpose)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RequestOTP")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(email)
+	if returnFunc, ok := ret.Get(0).(func(string, domain.OTPPurpose) error); ok {
+		r0 = returnFunc(email, purpose)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -112,18 +112,24 @@ type MockIOTPUsecase_RequestOTP_Call struct {
 
 // RequestOTP is a helper method to define mock.On call
 //   - email string
-func (_e *MockIOTPUsecase_Expecter) RequestOTP(email interface{}) *MockIOTPUsecase_RequestOTP_Call {
-	return &MockIOTPUsecase_RequestOTP_Call{Call: _e.mock.On("RequestOTP", email)}
+//   - purpose domain.OTPPurpose
+func (_e *MockIOTPUsecase_Expecter) RequestOTP(email interface{}, purpose interface{}) *MockIOTPUsecase_RequestOTP_Call {
+	return &MockIOTPUsecase_RequestOTP_Call{Call: _e.mock.On("RequestOTP", email, purpose)}
 }
 
-func (_c *MockIOTPUsecase_RequestOTP_Call) Run(run func(email string)) *MockIOTPUsecase_RequestOTP_Call {
+func (_c *MockIOTPUsecase_RequestOTP_Call) Run(run func(email string, purpose domain.OTPPurpose)) *MockIOTPUsecase_RequestOTP_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 domain.OTPPurpose
+		if args[1] != nil {
+			arg1 = args[1].(domain.OTPPurpose)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -134,14 +140,14 @@ func (_c *MockIOTPUsecase_RequestOTP_Call) Return(err error) *MockIOTPUsecase_Re
 	return _c
 }
 
-func (_c *MockIOTPUsecase_RequestOTP_Call) RunAndReturn(run func(email string) error) *MockIOTPUsecase_RequestOTP_Call {
+func (_c *MockIOTPUsecase_RequestOTP_Call) RunAndReturn(run func(email string, purpose domain.OTPPurpose) error) *MockIOTPUsecase_RequestOTP_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // VerifyOTP provides a mock function for the type MockIOTPUsecase
-func (_mock *MockIOTPUsecase) VerifyOTP(email string, code string) (*domain.OTP, error) {
-	ret := _mock.Called(email, code)
+func (_mock *MockIOTPUsecase) VerifyOTP(email string, purpose domain.OTPPurpose, code string) (*domain.OTP, error) {
+	ret := _mock.Called(email, purpose, code)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyOTP")
@@ -149,18 +155,18 @@ func (_mock *MockIOTPUsecase) VerifyOTP(email string, code string) (*domain.OTP,
 
 	var r0 *domain.OTP
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) (*domain.OTP, error)); ok {
-		return returnFunc(email, code)
+	if returnFunc, ok := ret.Get(0).(func(string, domain.OTPPurpose, string) (*domain.OTP, error)); ok {
+		return returnFunc(email, purpose, code)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) *domain.OTP); ok {
-		r0 = returnFunc(email, code)
+	if returnFunc, ok := ret.Get(0).(func(string, domain.OTPPurpose, string) *domain.OTP); ok {
+		r0 = returnFunc(email, purpose, code)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.OTP)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(email, code)
+	if returnFunc, ok := ret.Get(1).(func(string, domain.OTPPurpose, string) error); ok {
+		r1 = returnFunc(email, purpose, code)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -174,24 +180,30 @@ type MockIOTPUsecase_VerifyOTP_Call struct {
 
 // VerifyOTP is a helper method to define mock.On call
 //   - email string
+//   - purpose domain.OTPPurpose
 //   - code string
-func (_e *MockIOTPUsecase_Expecter) VerifyOTP(email interface{}, code interface{}) *MockIOTPUsecase_VerifyOTP_Call {
-	return &MockIOTPUsecase_VerifyOTP_Call{Call: _e.mock.On("VerifyOTP", email, code)}
+func (_e *MockIOTPUsecase_Expecter) VerifyOTP(email interface{}, purpose interface{}, code interface{}) *MockIOTPUsecase_VerifyOTP_Call {
+	return &MockIOTPUsecase_VerifyOTP_Call{Call: _e.mock.On("VerifyOTP", email, purpose, code)}
 }
 
-func (_c *MockIOTPUsecase_VerifyOTP_Call) Run(run func(email string, code string)) *MockIOTPUsecase_VerifyOTP_Call {
+func (_c *MockIOTPUsecase_VerifyOTP_Call) Run(run func(email string, purpose domain.OTPPurpose, code string)) *MockIOTPUsecase_VerifyOTP_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
-		var arg1 string
+		var arg1 domain.OTPPurpose
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(domain.OTPPurpose)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -202,7 +214,7 @@ func (_c *MockIOTPUsecase_VerifyOTP_Call) Return(oTP *domain.OTP, err error) *Mo
 	return _c
 }
 
-func (_c *MockIOTPUsecase_VerifyOTP_Call) RunAndReturn(run func(email string, code string) (*domain.OTP, error)) *MockIOTPUsecase_VerifyOTP_Call {
+func (_c *MockIOTPUsecase_VerifyOTP_Call) RunAndReturn(run func(email string, purpose domain.OTPPurpose, code string) (*domain.OTP, error)) *MockIOTPUsecase_VerifyOTP_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,170 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIPasswordHistoryRepository creates a new instance of MockIPasswordHistoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIPasswordHistoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIPasswordHistoryRepository {
+	mock := &MockIPasswordHistoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIPasswordHistoryRepository is an autogenerated mock type for the IPasswordHistoryRepository type
+type MockIPasswordHistoryRepository struct {
+	mock.Mock
+}
+
+type MockIPasswordHistoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIPasswordHistoryRepository) EXPECT() *MockIPasswordHistoryRepository_Expecter {
+	return &MockIPasswordHistoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIPasswordHistoryRepository
+func (_mock *MockIPasswordHistoryRepository) Create(ctx context.Context, entry *domain.PasswordHistory) error {
+	ret := _mock.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PasswordHistory) error); ok {
+		r0 = returnFunc(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIPasswordHistoryRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIPasswordHistoryRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *domain.PasswordHistory
+func (_e *MockIPasswordHistoryRepository_Expecter) Create(ctx interface{}, entry interface{}) *MockIPasswordHistoryRepository_Create_Call {
+	return &MockIPasswordHistoryRepository_Create_Call{Call: _e.mock.On("Create", ctx, entry)}
+}
+
+func (_c *MockIPasswordHistoryRepository_Create_Call) Run(run func(ctx context.Context, entry *domain.PasswordHistory)) *MockIPasswordHistoryRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PasswordHistory
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PasswordHistory)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPasswordHistoryRepository_Create_Call) Return(err error) *MockIPasswordHistoryRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIPasswordHistoryRepository_Create_Call) RunAndReturn(run func(ctx context.Context, entry *domain.PasswordHistory) error) *MockIPasswordHistoryRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRecent provides a mock function for the type MockIPasswordHistoryRepository
+func (_mock *MockIPasswordHistoryRepository) FindRecent(ctx context.Context, userID string, limit int) ([]*domain.PasswordHistory, error) {
+	ret := _mock.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRecent")
+	}
+
+	var r0 []*domain.PasswordHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]*domain.PasswordHistory, error)); ok {
+		return returnFunc(ctx, userID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []*domain.PasswordHistory); ok {
+		r0 = returnFunc(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PasswordHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIPasswordHistoryRepository_FindRecent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRecent'
+type MockIPasswordHistoryRepository_FindRecent_Call struct {
+	*mock.Call
+}
+
+// FindRecent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - limit int
+func (_e *MockIPasswordHistoryRepository_Expecter) FindRecent(ctx interface{}, userID interface{}, limit interface{}) *MockIPasswordHistoryRepository_FindRecent_Call {
+	return &MockIPasswordHistoryRepository_FindRecent_Call{Call: _e.mock.On("FindRecent", ctx, userID, limit)}
+}
+
+func (_c *MockIPasswordHistoryRepository_FindRecent_Call) Run(run func(ctx context.Context, userID string, limit int)) *MockIPasswordHistoryRepository_FindRecent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPasswordHistoryRepository_FindRecent_Call) Return(passwordHistorys []*domain.PasswordHistory, err error) *MockIPasswordHistoryRepository_FindRecent_Call {
+	_c.Call.Return(passwordHistorys, err)
+	return _c
+}
+
+func (_c *MockIPasswordHistoryRepository_FindRecent_Call) RunAndReturn(run func(ctx context.Context, userID string, limit int) ([]*domain.PasswordHistory, error)) *MockIPasswordHistoryRepository_FindRecent_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,165 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIPasswordPolicy creates a new instance of MockIPasswordPolicy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIPasswordPolicy(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIPasswordPolicy {
+	mock := &MockIPasswordPolicy{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIPasswordPolicy is an autogenerated mock type for the IPasswordPolicy type
+type MockIPasswordPolicy struct {
+	mock.Mock
+}
+
+type MockIPasswordPolicy_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIPasswordPolicy) EXPECT() *MockIPasswordPolicy_Expecter {
+	return &MockIPasswordPolicy_Expecter{mock: &_m.Mock}
+}
+
+// Remember provides a mock function for the type MockIPasswordPolicy
+func (_mock *MockIPasswordPolicy) Remember(ctx context.Context, userID string, passwordHash string) error {
+	ret := _mock.Called(ctx, userID, passwordHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remember")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, passwordHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIPasswordPolicy_Remember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remember'
+type MockIPasswordPolicy_Remember_Call struct {
+	*mock.Call
+}
+
+// Remember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - passwordHash string
+func (_e *MockIPasswordPolicy_Expecter) Remember(ctx interface{}, userID interface{}, passwordHash interface{}) *MockIPasswordPolicy_Remember_Call {
+	return &MockIPasswordPolicy_Remember_Call{Call: _e.mock.On("Remember", ctx, userID, passwordHash)}
+}
+
+func (_c *MockIPasswordPolicy_Remember_Call) Run(run func(ctx context.Context, userID string, passwordHash string)) *MockIPasswordPolicy_Remember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPasswordPolicy_Remember_Call) Return(err error) *MockIPasswordPolicy_Remember_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIPasswordPolicy_Remember_Call) RunAndReturn(run func(ctx context.Context, userID string, passwordHash string) error) *MockIPasswordPolicy_Remember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Validate provides a mock function for the type MockIPasswordPolicy
+func (_mock *MockIPasswordPolicy) Validate(ctx context.Context, user *domain.User, password string) error {
+	ret := _mock.Called(ctx, user, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, string) error); ok {
+		r0 = returnFunc(ctx, user, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIPasswordPolicy_Validate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validate'
+type MockIPasswordPolicy_Validate_Call struct {
+	*mock.Call
+}
+
+// Validate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *domain.User
+//   - password string
+func (_e *MockIPasswordPolicy_Expecter) Validate(ctx interface{}, user interface{}, password interface{}) *MockIPasswordPolicy_Validate_Call {
+	return &MockIPasswordPolicy_Validate_Call{Call: _e.mock.On("Validate", ctx, user, password)}
+}
+
+func (_c *MockIPasswordPolicy_Validate_Call) Run(run func(ctx context.Context, user *domain.User, password string)) *MockIPasswordPolicy_Validate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.User
+		if args[1] != nil {
+			arg1 = args[1].(*domain.User)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPasswordPolicy_Validate_Call) Return(err error) *MockIPasswordPolicy_Validate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIPasswordPolicy_Validate_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, password string) error) *MockIPasswordPolicy_Validate_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,431 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIPersonalAccessTokenRepository creates a new instance of MockIPersonalAccessTokenRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIPersonalAccessTokenRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIPersonalAccessTokenRepository {
+	mock := &MockIPersonalAccessTokenRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIPersonalAccessTokenRepository is an autogenerated mock type for the IPersonalAccessTokenRepository type
+type MockIPersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+type MockIPersonalAccessTokenRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIPersonalAccessTokenRepository) EXPECT() *MockIPersonalAccessTokenRepository_Expecter {
+	return &MockIPersonalAccessTokenRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountByUserID provides a mock function for the type MockIPersonalAccessTokenRepository
+func (_mock *MockIPersonalAccessTokenRepository) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByUserID")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIPersonalAccessTokenRepository_CountByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByUserID'
+type MockIPersonalAccessTokenRepository_CountByUserID_Call struct {
+	*mock.Call
+}
+
+// CountByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockIPersonalAccessTokenRepository_Expecter) CountByUserID(ctx interface{}, userID interface{}) *MockIPersonalAccessTokenRepository_CountByUserID_Call {
+	return &MockIPersonalAccessTokenRepository_CountByUserID_Call{Call: _e.mock.On("CountByUserID", ctx, userID)}
+}
+
+func (_c *MockIPersonalAccessTokenRepository_CountByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockIPersonalAccessTokenRepository_CountByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_CountByUserID_Call) Return(n int64, err error) *MockIPersonalAccessTokenRepository_CountByUserID_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_CountByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) (int64, error)) *MockIPersonalAccessTokenRepository_CountByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIPersonalAccessTokenRepository
+func (_mock *MockIPersonalAccessTokenRepository) Create(ctx context.Context, token *domain.PersonalAccessToken) error {
+	ret := _mock.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.PersonalAccessToken) error); ok {
+		r0 = returnFunc(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIPersonalAccessTokenRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIPersonalAccessTokenRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token *domain.PersonalAccessToken
+func (_e *MockIPersonalAccessTokenRepository_Expecter) Create(ctx interface{}, token interface{}) *MockIPersonalAccessTokenRepository_Create_Call {
+	return &MockIPersonalAccessTokenRepository_Create_Call{Call: _e.mock.On("Create", ctx, token)}
+}
+
+func (_c *MockIPersonalAccessTokenRepository_Create_Call) Run(run func(ctx context.Context, token *domain.PersonalAccessToken)) *MockIPersonalAccessTokenRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.PersonalAccessToken
+		if args[1] != nil {
+			arg1 = args[1].(*domain.PersonalAccessToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_Create_Call) Return(err error) *MockIPersonalAccessTokenRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_Create_Call) RunAndReturn(run func(ctx context.Context, token *domain.PersonalAccessToken) error) *MockIPersonalAccessTokenRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIPersonalAccessTokenRepository
+func (_mock *MockIPersonalAccessTokenRepository) Delete(ctx context.Context, userID string, id string) error {
+	ret := _mock.Called(ctx, userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIPersonalAccessTokenRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIPersonalAccessTokenRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - id string
+func (_e *MockIPersonalAccessTokenRepository_Expecter) Delete(ctx interface{}, userID interface{}, id interface{}) *MockIPersonalAccessTokenRepository_Delete_Call {
+	return &MockIPersonalAccessTokenRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, userID, id)}
+}
+
+func (_c *MockIPersonalAccessTokenRepository_Delete_Call) Run(run func(ctx context.Context, userID string, id string)) *MockIPersonalAccessTokenRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_Delete_Call) Return(err error) *MockIPersonalAccessTokenRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, userID string, id string) error) *MockIPersonalAccessTokenRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTokenHash provides a mock function for the type MockIPersonalAccessTokenRepository
+func (_mock *MockIPersonalAccessTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTokenHash")
+	}
+
+	var r0 *domain.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.PersonalAccessToken, error)); ok {
+		return returnFunc(ctx, tokenHash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.PersonalAccessToken); ok {
+		r0 = returnFunc(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIPersonalAccessTokenRepository_FindByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTokenHash'
+type MockIPersonalAccessTokenRepository_FindByTokenHash_Call struct {
+	*mock.Call
+}
+
+// FindByTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokenHash string
+func (_e *MockIPersonalAccessTokenRepository_Expecter) FindByTokenHash(ctx interface{}, tokenHash interface{}) *MockIPersonalAccessTokenRepository_FindByTokenHash_Call {
+	return &MockIPersonalAccessTokenRepository_FindByTokenHash_Call{Call: _e.mock.On("FindByTokenHash", ctx, tokenHash)}
+}
+
+func (_c *MockIPersonalAccessTokenRepository_FindByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockIPersonalAccessTokenRepository_FindByTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_FindByTokenHash_Call) Return(personalAccessToken *domain.PersonalAccessToken, err error) *MockIPersonalAccessTokenRepository_FindByTokenHash_Call {
+	_c.Call.Return(personalAccessToken, err)
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_FindByTokenHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error)) *MockIPersonalAccessTokenRepository_FindByTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function for the type MockIPersonalAccessTokenRepository
+func (_mock *MockIPersonalAccessTokenRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.PersonalAccessToken, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 []*domain.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.PersonalAccessToken, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.PersonalAccessToken); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIPersonalAccessTokenRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type MockIPersonalAccessTokenRepository_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockIPersonalAccessTokenRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}) *MockIPersonalAccessTokenRepository_FindByUserID_Call {
+	return &MockIPersonalAccessTokenRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID)}
+}
+
+func (_c *MockIPersonalAccessTokenRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockIPersonalAccessTokenRepository_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_FindByUserID_Call) Return(personalAccessTokens []*domain.PersonalAccessToken, err error) *MockIPersonalAccessTokenRepository_FindByUserID_Call {
+	_c.Call.Return(personalAccessTokens, err)
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_FindByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.PersonalAccessToken, error)) *MockIPersonalAccessTokenRepository_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastUsed provides a mock function for the type MockIPersonalAccessTokenRepository
+func (_mock *MockIPersonalAccessTokenRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time, ip string) error {
+	ret := _mock.Called(ctx, id, usedAt, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastUsed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, string) error); ok {
+		r0 = returnFunc(ctx, id, usedAt, ip)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIPersonalAccessTokenRepository_UpdateLastUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastUsed'
+type MockIPersonalAccessTokenRepository_UpdateLastUsed_Call struct {
+	*mock.Call
+}
+
+// UpdateLastUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - usedAt time.Time
+//   - ip string
+func (_e *MockIPersonalAccessTokenRepository_Expecter) UpdateLastUsed(ctx interface{}, id interface{}, usedAt interface{}, ip interface{}) *MockIPersonalAccessTokenRepository_UpdateLastUsed_Call {
+	return &MockIPersonalAccessTokenRepository_UpdateLastUsed_Call{Call: _e.mock.On("UpdateLastUsed", ctx, id, usedAt, ip)}
+}
+
+func (_c *MockIPersonalAccessTokenRepository_UpdateLastUsed_Call) Run(run func(ctx context.Context, id string, usedAt time.Time, ip string)) *MockIPersonalAccessTokenRepository_UpdateLastUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_UpdateLastUsed_Call) Return(err error) *MockIPersonalAccessTokenRepository_UpdateLastUsed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenRepository_UpdateLastUsed_Call) RunAndReturn(run func(ctx context.Context, id string, usedAt time.Time, ip string) error) *MockIPersonalAccessTokenRepository_UpdateLastUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,314 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIPersonalAccessTokenUsecase creates a new instance of MockIPersonalAccessTokenUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIPersonalAccessTokenUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIPersonalAccessTokenUsecase {
+	mock := &MockIPersonalAccessTokenUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIPersonalAccessTokenUsecase is an autogenerated mock type for the IPersonalAccessTokenUsecase type
+type MockIPersonalAccessTokenUsecase struct {
+	mock.Mock
+}
+
+type MockIPersonalAccessTokenUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIPersonalAccessTokenUsecase) EXPECT() *MockIPersonalAccessTokenUsecase_Expecter {
+	return &MockIPersonalAccessTokenUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function for the type MockIPersonalAccessTokenUsecase
+func (_mock *MockIPersonalAccessTokenUsecase) Authenticate(token string, ip string) (*domain.PersonalAccessToken, *domain.User, error) {
+	ret := _mock.Called(token, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *domain.PersonalAccessToken
+	var r1 *domain.User
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*domain.PersonalAccessToken, *domain.User, error)); ok {
+		return returnFunc(token, ip)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *domain.PersonalAccessToken); ok {
+		r0 = returnFunc(token, ip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) *domain.User); ok {
+		r1 = returnFunc(token, ip)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, string) error); ok {
+		r2 = returnFunc(token, ip)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIPersonalAccessTokenUsecase_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockIPersonalAccessTokenUsecase_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - token string
+//   - ip string
+func (_e *MockIPersonalAccessTokenUsecase_Expecter) Authenticate(token interface{}, ip interface{}) *MockIPersonalAccessTokenUsecase_Authenticate_Call {
+	return &MockIPersonalAccessTokenUsecase_Authenticate_Call{Call: _e.mock.On("Authenticate", token, ip)}
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_Authenticate_Call) Run(run func(token string, ip string)) *MockIPersonalAccessTokenUsecase_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_Authenticate_Call) Return(personalAccessToken *domain.PersonalAccessToken, user *domain.User, err error) *MockIPersonalAccessTokenUsecase_Authenticate_Call {
+	_c.Call.Return(personalAccessToken, user, err)
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_Authenticate_Call) RunAndReturn(run func(token string, ip string) (*domain.PersonalAccessToken, *domain.User, error)) *MockIPersonalAccessTokenUsecase_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIPersonalAccessTokenUsecase
+func (_mock *MockIPersonalAccessTokenUsecase) Create(userID string, name string, scopes []domain.TokenScope, expiresIn time.Duration) (*domain.PersonalAccessToken, error) {
+	ret := _mock.Called(userID, name, scopes, expiresIn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, []domain.TokenScope, time.Duration) (*domain.PersonalAccessToken, error)); ok {
+		return returnFunc(userID, name, scopes, expiresIn)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, []domain.TokenScope, time.Duration) *domain.PersonalAccessToken); ok {
+		r0 = returnFunc(userID, name, scopes, expiresIn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, []domain.TokenScope, time.Duration) error); ok {
+		r1 = returnFunc(userID, name, scopes, expiresIn)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIPersonalAccessTokenUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIPersonalAccessTokenUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - userID string
+//   - name string
+//   - scopes []domain.TokenScope
+//   - expiresIn time.Duration
+func (_e *MockIPersonalAccessTokenUsecase_Expecter) Create(userID interface{}, name interface{}, scopes interface{}, expiresIn interface{}) *MockIPersonalAccessTokenUsecase_Create_Call {
+	return &MockIPersonalAccessTokenUsecase_Create_Call{Call: _e.mock.On("Create", userID, name, scopes, expiresIn)}
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_Create_Call) Run(run func(userID string, name string, scopes []domain.TokenScope, expiresIn time.Duration)) *MockIPersonalAccessTokenUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []domain.TokenScope
+		if args[2] != nil {
+			arg2 = args[2].([]domain.TokenScope)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_Create_Call) Return(personalAccessToken *domain.PersonalAccessToken, err error) *MockIPersonalAccessTokenUsecase_Create_Call {
+	_c.Call.Return(personalAccessToken, err)
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_Create_Call) RunAndReturn(run func(userID string, name string, scopes []domain.TokenScope, expiresIn time.Duration) (*domain.PersonalAccessToken, error)) *MockIPersonalAccessTokenUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockIPersonalAccessTokenUsecase
+func (_mock *MockIPersonalAccessTokenUsecase) List(userID string) ([]*domain.PersonalAccessToken, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]*domain.PersonalAccessToken, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []*domain.PersonalAccessToken); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIPersonalAccessTokenUsecase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockIPersonalAccessTokenUsecase_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIPersonalAccessTokenUsecase_Expecter) List(userID interface{}) *MockIPersonalAccessTokenUsecase_List_Call {
+	return &MockIPersonalAccessTokenUsecase_List_Call{Call: _e.mock.On("List", userID)}
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_List_Call) Run(run func(userID string)) *MockIPersonalAccessTokenUsecase_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_List_Call) Return(personalAccessTokens []*domain.PersonalAccessToken, err error) *MockIPersonalAccessTokenUsecase_List_Call {
+	_c.Call.Return(personalAccessTokens, err)
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_List_Call) RunAndReturn(run func(userID string) ([]*domain.PersonalAccessToken, error)) *MockIPersonalAccessTokenUsecase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function for the type MockIPersonalAccessTokenUsecase
+func (_mock *MockIPersonalAccessTokenUsecase) Revoke(userID string, id string) error {
+	ret := _mock.Called(userID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIPersonalAccessTokenUsecase_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type MockIPersonalAccessTokenUsecase_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - userID string
+//   - id string
+func (_e *MockIPersonalAccessTokenUsecase_Expecter) Revoke(userID interface{}, id interface{}) *MockIPersonalAccessTokenUsecase_Revoke_Call {
+	return &MockIPersonalAccessTokenUsecase_Revoke_Call{Call: _e.mock.On("Revoke", userID, id)}
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_Revoke_Call) Run(run func(userID string, id string)) *MockIPersonalAccessTokenUsecase_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_Revoke_Call) Return(err error) *MockIPersonalAccessTokenUsecase_Revoke_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIPersonalAccessTokenUsecase_Revoke_Call) RunAndReturn(run func(userID string, id string) error) *MockIPersonalAccessTokenUsecase_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
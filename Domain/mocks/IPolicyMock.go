@@ -0,0 +1,275 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIPolicy creates a new instance of MockIPolicy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIPolicy(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIPolicy {
+	mock := &MockIPolicy{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIPolicy is an autogenerated mock type for the IPolicy type
+type MockIPolicy struct {
+	mock.Mock
+}
+
+type MockIPolicy_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIPolicy) EXPECT() *MockIPolicy_Expecter {
+	return &MockIPolicy_Expecter{mock: &_m.Mock}
+}
+
+// Can provides a mock function for the type MockIPolicy
+func (_mock *MockIPolicy) Can(ctx context.Context, action domain.Action, resource domain.Resource) bool {
+	ret := _mock.Called(ctx, action, resource)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Can")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Action, domain.Resource) bool); ok {
+		r0 = returnFunc(ctx, action, resource)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockIPolicy_Can_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Can'
+type MockIPolicy_Can_Call struct {
+	*mock.Call
+}
+
+// Can is a helper method to define mock.On call
+//   - ctx context.Context
+//   - action domain.Action
+//   - resource domain.Resource
+func (_e *MockIPolicy_Expecter) Can(ctx interface{}, action interface{}, resource interface{}) *MockIPolicy_Can_Call {
+	return &MockIPolicy_Can_Call{Call: _e.mock.On("Can", ctx, action, resource)}
+}
+
+func (_c *MockIPolicy_Can_Call) Run(run func(ctx context.Context, action domain.Action, resource domain.Resource)) *MockIPolicy_Can_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Action
+		if args[1] != nil {
+			arg1 = args[1].(domain.Action)
+		}
+		var arg2 domain.Resource
+		if args[2] != nil {
+			arg2 = args[2].(domain.Resource)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPolicy_Can_Call) Return(b bool) *MockIPolicy_Can_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockIPolicy_Can_Call) RunAndReturn(run func(ctx context.Context, action domain.Action, resource domain.Resource) bool) *MockIPolicy_Can_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CanChangeRole provides a mock function for the type MockIPolicy
+func (_mock *MockIPolicy) CanChangeRole(actor domain.Actor, from domain.UserRole, to domain.UserRole) bool {
+	ret := _mock.Called(actor, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanChangeRole")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(domain.Actor, domain.UserRole, domain.UserRole) bool); ok {
+		r0 = returnFunc(actor, from, to)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockIPolicy_CanChangeRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CanChangeRole'
+type MockIPolicy_CanChangeRole_Call struct {
+	*mock.Call
+}
+
+// CanChangeRole is a helper method to define mock.On call
+//   - actor domain.Actor
+//   - from domain.UserRole
+//   - to domain.UserRole
+func (_e *MockIPolicy_Expecter) CanChangeRole(actor interface{}, from interface{}, to interface{}) *MockIPolicy_CanChangeRole_Call {
+	return &MockIPolicy_CanChangeRole_Call{Call: _e.mock.On("CanChangeRole", actor, from, to)}
+}
+
+func (_c *MockIPolicy_CanChangeRole_Call) Run(run func(actor domain.Actor, from domain.UserRole, to domain.UserRole)) *MockIPolicy_CanChangeRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.Actor
+		if args[0] != nil {
+			arg0 = args[0].(domain.Actor)
+		}
+		var arg1 domain.UserRole
+		if args[1] != nil {
+			arg1 = args[1].(domain.UserRole)
+		}
+		var arg2 domain.UserRole
+		if args[2] != nil {
+			arg2 = args[2].(domain.UserRole)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPolicy_CanChangeRole_Call) Return(b bool) *MockIPolicy_CanChangeRole_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockIPolicy_CanChangeRole_Call) RunAndReturn(run func(actor domain.Actor, from domain.UserRole, to domain.UserRole) bool) *MockIPolicy_CanChangeRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasPermission provides a mock function for the type MockIPolicy
+func (_mock *MockIPolicy) HasPermission(actor domain.Actor, permission domain.Permission) bool {
+	ret := _mock.Called(actor, permission)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasPermission")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(domain.Actor, domain.Permission) bool); ok {
+		r0 = returnFunc(actor, permission)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockIPolicy_HasPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasPermission'
+type MockIPolicy_HasPermission_Call struct {
+	*mock.Call
+}
+
+// HasPermission is a helper method to define mock.On call
+//   - actor domain.Actor
+//   - permission domain.Permission
+func (_e *MockIPolicy_Expecter) HasPermission(actor interface{}, permission interface{}) *MockIPolicy_HasPermission_Call {
+	return &MockIPolicy_HasPermission_Call{Call: _e.mock.On("HasPermission", actor, permission)}
+}
+
+func (_c *MockIPolicy_HasPermission_Call) Run(run func(actor domain.Actor, permission domain.Permission)) *MockIPolicy_HasPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.Actor
+		if args[0] != nil {
+			arg0 = args[0].(domain.Actor)
+		}
+		var arg1 domain.Permission
+		if args[1] != nil {
+			arg1 = args[1].(domain.Permission)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPolicy_HasPermission_Call) Return(b bool) *MockIPolicy_HasPermission_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockIPolicy_HasPermission_Call) RunAndReturn(run func(actor domain.Actor, permission domain.Permission) bool) *MockIPolicy_HasPermission_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Permissions provides a mock function for the type MockIPolicy
+func (_mock *MockIPolicy) Permissions(role domain.UserRole) []domain.Permission {
+	ret := _mock.Called(role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Permissions")
+	}
+
+	var r0 []domain.Permission
+	if returnFunc, ok := ret.Get(0).(func(domain.UserRole) []domain.Permission); ok {
+		r0 = returnFunc(role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Permission)
+		}
+	}
+	return r0
+}
+
+// MockIPolicy_Permissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Permissions'
+type MockIPolicy_Permissions_Call struct {
+	*mock.Call
+}
+
+// Permissions is a helper method to define mock.On call
+//   - role domain.UserRole
+func (_e *MockIPolicy_Expecter) Permissions(role interface{}) *MockIPolicy_Permissions_Call {
+	return &MockIPolicy_Permissions_Call{Call: _e.mock.On("Permissions", role)}
+}
+
+func (_c *MockIPolicy_Permissions_Call) Run(run func(role domain.UserRole)) *MockIPolicy_Permissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.UserRole
+		if args[0] != nil {
+			arg0 = args[0].(domain.UserRole)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPolicy_Permissions_Call) Return(permissions []domain.Permission) *MockIPolicy_Permissions_Call {
+	_c.Call.Return(permissions)
+	return _c
+}
+
+func (_c *MockIPolicy_Permissions_Call) RunAndReturn(run func(role domain.UserRole) []domain.Permission) *MockIPolicy_Permissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,6 +7,7 @@ package domain_mocks
 import (
 	"context"
 	"g6/blog-api/Domain"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -95,47 +96,47 @@ func (_c *MockIRefreshTokenRepository_DeleteByUserID_Call) RunAndReturn(run func
 	return _c
 }
 
-// FindByToken provides a mock function for the type MockIRefreshTokenRepository
-func (_mock *MockIRefreshTokenRepository) FindByToken(ctx context.Context, token string) (*domain.RefreshToken, error) {
-	ret := _mock.Called(ctx, token)
+// FindActiveByUserID provides a mock function for the type MockIRefreshTokenRepository
+func (_mock *MockIRefreshTokenRepository) FindActiveByUserID(ctx context.Context, userID string) ([]*domain.RefreshToken, error) {
+	ret := _mock.Called(ctx, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByToken")
+		panic("no return value specified for FindActiveByUserID")
 	}
 
-	var r0 *domain.RefreshToken
+	var r0 []*domain.RefreshToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.RefreshToken, error)); ok {
-		return returnFunc(ctx, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]*domain.RefreshToken, error)); ok {
+		return returnFunc(ctx, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.RefreshToken); ok {
-		r0 = returnFunc(ctx, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []*domain.RefreshToken); ok {
+		r0 = returnFunc(ctx, userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.RefreshToken)
+			r0 = ret.Get(0).([]*domain.RefreshToken)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, token)
+		r1 = returnFunc(ctx, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIRefreshTokenRepository_FindByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByToken'
-type MockIRefreshTokenRepository_FindByToken_Call struct {
+// MockIRefreshTokenRepository_FindActiveByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindActiveByUserID'
+type MockIRefreshTokenRepository_FindActiveByUserID_Call struct {
 	*mock.Call
 }
 
-// FindByToken is a helper method to define mock.On call
+// FindActiveByUserID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - token string
-func (_e *MockIRefreshTokenRepository_Expecter) FindByToken(ctx interface{}, token interface{}) *MockIRefreshTokenRepository_FindByToken_Call {
-	return &MockIRefreshTokenRepository_FindByToken_Call{Call: _e.mock.On("FindByToken", ctx, token)}
+//   - userID string
+func (_e *MockIRefreshTokenRepository_Expecter) FindActiveByUserID(ctx interface{}, userID interface{}) *MockIRefreshTokenRepository_FindActiveByUserID_Call {
+	return &MockIRefreshTokenRepository_FindActiveByUserID_Call{Call: _e.mock.On("FindActiveByUserID", ctx, userID)}
 }
 
-func (_c *MockIRefreshTokenRepository_FindByToken_Call) Run(run func(ctx context.Context, token string)) *MockIRefreshTokenRepository_FindByToken_Call {
+func (_c *MockIRefreshTokenRepository_FindActiveByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockIRefreshTokenRepository_FindActiveByUserID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -153,57 +154,57 @@ func (_c *MockIRefreshTokenRepository_FindByToken_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockIRefreshTokenRepository_FindByToken_Call) Return(refreshToken *domain.RefreshToken, err error) *MockIRefreshTokenRepository_FindByToken_Call {
-	_c.Call.Return(refreshToken, err)
+func (_c *MockIRefreshTokenRepository_FindActiveByUserID_Call) Return(refreshTokens []*domain.RefreshToken, err error) *MockIRefreshTokenRepository_FindActiveByUserID_Call {
+	_c.Call.Return(refreshTokens, err)
 	return _c
 }
 
-func (_c *MockIRefreshTokenRepository_FindByToken_Call) RunAndReturn(run func(ctx context.Context, token string) (*domain.RefreshToken, error)) *MockIRefreshTokenRepository_FindByToken_Call {
+func (_c *MockIRefreshTokenRepository_FindActiveByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) ([]*domain.RefreshToken, error)) *MockIRefreshTokenRepository_FindActiveByUserID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindTokenByUserID provides a mock function for the type MockIRefreshTokenRepository
-func (_mock *MockIRefreshTokenRepository) FindTokenByUserID(ctx context.Context, token string) (*domain.RefreshToken, error) {
-	ret := _mock.Called(ctx, token)
+// FindByTokenHash provides a mock function for the type MockIRefreshTokenRepository
+func (_mock *MockIRefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	ret := _mock.Called(ctx, tokenHash)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindTokenByUserID")
+		panic("no return value specified for FindByTokenHash")
 	}
 
 	var r0 *domain.RefreshToken
 	var r1 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.RefreshToken, error)); ok {
-		return returnFunc(ctx, token)
+		return returnFunc(ctx, tokenHash)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.RefreshToken); ok {
-		r0 = returnFunc(ctx, token)
+		r0 = returnFunc(ctx, tokenHash)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.RefreshToken)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, token)
+		r1 = returnFunc(ctx, tokenHash)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIRefreshTokenRepository_FindTokenByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTokenByUserID'
-type MockIRefreshTokenRepository_FindTokenByUserID_Call struct {
+// MockIRefreshTokenRepository_FindByTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTokenHash'
+type MockIRefreshTokenRepository_FindByTokenHash_Call struct {
 	*mock.Call
 }
 
-// FindTokenByUserID is a helper method to define mock.On call
+// FindByTokenHash is a helper method to define mock.On call
 //   - ctx context.Context
-//   - token string
-func (_e *MockIRefreshTokenRepository_Expecter) FindTokenByUserID(ctx interface{}, token interface{}) *MockIRefreshTokenRepository_FindTokenByUserID_Call {
-	return &MockIRefreshTokenRepository_FindTokenByUserID_Call{Call: _e.mock.On("FindTokenByUserID", ctx, token)}
+//   - tokenHash string
+func (_e *MockIRefreshTokenRepository_Expecter) FindByTokenHash(ctx interface{}, tokenHash interface{}) *MockIRefreshTokenRepository_FindByTokenHash_Call {
+	return &MockIRefreshTokenRepository_FindByTokenHash_Call{Call: _e.mock.On("FindByTokenHash", ctx, tokenHash)}
 }
 
-func (_c *MockIRefreshTokenRepository_FindTokenByUserID_Call) Run(run func(ctx context.Context, token string)) *MockIRefreshTokenRepository_FindTokenByUserID_Call {
+func (_c *MockIRefreshTokenRepository_FindByTokenHash_Call) Run(run func(ctx context.Context, tokenHash string)) *MockIRefreshTokenRepository_FindByTokenHash_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -221,103 +222,244 @@ func (_c *MockIRefreshTokenRepository_FindTokenByUserID_Call) Run(run func(ctx c
 	return _c
 }
 
-func (_c *MockIRefreshTokenRepository_FindTokenByUserID_Call) Return(refreshToken *domain.RefreshToken, err error) *MockIRefreshTokenRepository_FindTokenByUserID_Call {
+func (_c *MockIRefreshTokenRepository_FindByTokenHash_Call) Return(refreshToken *domain.RefreshToken, err error) *MockIRefreshTokenRepository_FindByTokenHash_Call {
 	_c.Call.Return(refreshToken, err)
 	return _c
 }
 
-func (_c *MockIRefreshTokenRepository_FindTokenByUserID_Call) RunAndReturn(run func(ctx context.Context, token string) (*domain.RefreshToken, error)) *MockIRefreshTokenRepository_FindTokenByUserID_Call {
+func (_c *MockIRefreshTokenRepository_FindByTokenHash_Call) RunAndReturn(run func(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)) *MockIRefreshTokenRepository_FindByTokenHash_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReplaceTokenByUserID provides a mock function for the type MockIRefreshTokenRepository
-func (_mock *MockIRefreshTokenRepository) ReplaceTokenByUserID(ctx context.Context, token *domain.RefreshToken) error {
-	ret := _mock.Called(ctx, token)
+// MarkUsed provides a mock function for the type MockIRefreshTokenRepository
+func (_mock *MockIRefreshTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	ret := _mock.Called(ctx, id, usedAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReplaceTokenByUserID")
+		panic("no return value specified for MarkUsed")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.RefreshToken) error); ok {
-		r0 = returnFunc(ctx, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, usedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockIRefreshTokenRepository_ReplaceTokenByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceTokenByUserID'
-type MockIRefreshTokenRepository_ReplaceTokenByUserID_Call struct {
+// MockIRefreshTokenRepository_MarkUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkUsed'
+type MockIRefreshTokenRepository_MarkUsed_Call struct {
 	*mock.Call
 }
 
-// ReplaceTokenByUserID is a helper method to define mock.On call
+// MarkUsed is a helper method to define mock.On call
 //   - ctx context.Context
-//   - token *domain.RefreshToken
-func (_e *MockIRefreshTokenRepository_Expecter) ReplaceTokenByUserID(ctx interface{}, token interface{}) *MockIRefreshTokenRepository_ReplaceTokenByUserID_Call {
-	return &MockIRefreshTokenRepository_ReplaceTokenByUserID_Call{Call: _e.mock.On("ReplaceTokenByUserID", ctx, token)}
+//   - id string
+//   - usedAt time.Time
+func (_e *MockIRefreshTokenRepository_Expecter) MarkUsed(ctx interface{}, id interface{}, usedAt interface{}) *MockIRefreshTokenRepository_MarkUsed_Call {
+	return &MockIRefreshTokenRepository_MarkUsed_Call{Call: _e.mock.On("MarkUsed", ctx, id, usedAt)}
 }
 
-func (_c *MockIRefreshTokenRepository_ReplaceTokenByUserID_Call) Run(run func(ctx context.Context, token *domain.RefreshToken)) *MockIRefreshTokenRepository_ReplaceTokenByUserID_Call {
+func (_c *MockIRefreshTokenRepository_MarkUsed_Call) Run(run func(ctx context.Context, id string, usedAt time.Time)) *MockIRefreshTokenRepository_MarkUsed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.RefreshToken
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.RefreshToken)
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRefreshTokenRepository_ReplaceTokenByUserID_Call) Return(err error) *MockIRefreshTokenRepository_ReplaceTokenByUserID_Call {
+func (_c *MockIRefreshTokenRepository_MarkUsed_Call) Return(err error) *MockIRefreshTokenRepository_MarkUsed_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIRefreshTokenRepository_ReplaceTokenByUserID_Call) RunAndReturn(run func(ctx context.Context, token *domain.RefreshToken) error) *MockIRefreshTokenRepository_ReplaceTokenByUserID_Call {
+func (_c *MockIRefreshTokenRepository_MarkUsed_Call) RunAndReturn(run func(ctx context.Context, id string, usedAt time.Time) error) *MockIRefreshTokenRepository_MarkUsed_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RevokeToken provides a mock function for the type MockIRefreshTokenRepository
-func (_mock *MockIRefreshTokenRepository) RevokeToken(ctx context.Context, token string) error {
-	ret := _mock.Called(ctx, token)
+// RevokeAllExcept provides a mock function for the type MockIRefreshTokenRepository
+func (_mock *MockIRefreshTokenRepository) RevokeAllExcept(ctx context.Context, userID string, exceptFamilyID string) (int64, error) {
+	ret := _mock.Called(ctx, userID, exceptFamilyID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RevokeToken")
+		panic("no return value specified for RevokeAllExcept")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return returnFunc(ctx, userID, exceptFamilyID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = returnFunc(ctx, userID, exceptFamilyID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, userID, exceptFamilyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRefreshTokenRepository_RevokeAllExcept_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAllExcept'
+type MockIRefreshTokenRepository_RevokeAllExcept_Call struct {
+	*mock.Call
+}
+
+// RevokeAllExcept is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - exceptFamilyID string
+func (_e *MockIRefreshTokenRepository_Expecter) RevokeAllExcept(ctx interface{}, userID interface{}, exceptFamilyID interface{}) *MockIRefreshTokenRepository_RevokeAllExcept_Call {
+	return &MockIRefreshTokenRepository_RevokeAllExcept_Call{Call: _e.mock.On("RevokeAllExcept", ctx, userID, exceptFamilyID)}
+}
+
+func (_c *MockIRefreshTokenRepository_RevokeAllExcept_Call) Run(run func(ctx context.Context, userID string, exceptFamilyID string)) *MockIRefreshTokenRepository_RevokeAllExcept_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRefreshTokenRepository_RevokeAllExcept_Call) Return(n int64, err error) *MockIRefreshTokenRepository_RevokeAllExcept_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRefreshTokenRepository_RevokeAllExcept_Call) RunAndReturn(run func(ctx context.Context, userID string, exceptFamilyID string) (int64, error)) *MockIRefreshTokenRepository_RevokeAllExcept_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeByID provides a mock function for the type MockIRefreshTokenRepository
+func (_mock *MockIRefreshTokenRepository) RevokeByID(ctx context.Context, userID string, familyID string) error {
+	ret := _mock.Called(ctx, userID, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, familyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRefreshTokenRepository_RevokeByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeByID'
+type MockIRefreshTokenRepository_RevokeByID_Call struct {
+	*mock.Call
+}
+
+// RevokeByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - familyID string
+func (_e *MockIRefreshTokenRepository_Expecter) RevokeByID(ctx interface{}, userID interface{}, familyID interface{}) *MockIRefreshTokenRepository_RevokeByID_Call {
+	return &MockIRefreshTokenRepository_RevokeByID_Call{Call: _e.mock.On("RevokeByID", ctx, userID, familyID)}
+}
+
+func (_c *MockIRefreshTokenRepository_RevokeByID_Call) Run(run func(ctx context.Context, userID string, familyID string)) *MockIRefreshTokenRepository_RevokeByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRefreshTokenRepository_RevokeByID_Call) Return(err error) *MockIRefreshTokenRepository_RevokeByID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRefreshTokenRepository_RevokeByID_Call) RunAndReturn(run func(ctx context.Context, userID string, familyID string) error) *MockIRefreshTokenRepository_RevokeByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeFamily provides a mock function for the type MockIRefreshTokenRepository
+func (_mock *MockIRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	ret := _mock.Called(ctx, familyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeFamily")
 	}
 
 	var r0 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, token)
+		r0 = returnFunc(ctx, familyID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockIRefreshTokenRepository_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
-type MockIRefreshTokenRepository_RevokeToken_Call struct {
+// MockIRefreshTokenRepository_RevokeFamily_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeFamily'
+type MockIRefreshTokenRepository_RevokeFamily_Call struct {
 	*mock.Call
 }
 
-// RevokeToken is a helper method to define mock.On call
+// RevokeFamily is a helper method to define mock.On call
 //   - ctx context.Context
-//   - token string
-func (_e *MockIRefreshTokenRepository_Expecter) RevokeToken(ctx interface{}, token interface{}) *MockIRefreshTokenRepository_RevokeToken_Call {
-	return &MockIRefreshTokenRepository_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx, token)}
+//   - familyID string
+func (_e *MockIRefreshTokenRepository_Expecter) RevokeFamily(ctx interface{}, familyID interface{}) *MockIRefreshTokenRepository_RevokeFamily_Call {
+	return &MockIRefreshTokenRepository_RevokeFamily_Call{Call: _e.mock.On("RevokeFamily", ctx, familyID)}
 }
 
-func (_c *MockIRefreshTokenRepository_RevokeToken_Call) Run(run func(ctx context.Context, token string)) *MockIRefreshTokenRepository_RevokeToken_Call {
+func (_c *MockIRefreshTokenRepository_RevokeFamily_Call) Run(run func(ctx context.Context, familyID string)) *MockIRefreshTokenRepository_RevokeFamily_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -335,12 +477,12 @@ func (_c *MockIRefreshTokenRepository_RevokeToken_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockIRefreshTokenRepository_RevokeToken_Call) Return(err error) *MockIRefreshTokenRepository_RevokeToken_Call {
+func (_c *MockIRefreshTokenRepository_RevokeFamily_Call) Return(err error) *MockIRefreshTokenRepository_RevokeFamily_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIRefreshTokenRepository_RevokeToken_Call) RunAndReturn(run func(ctx context.Context, token string) error) *MockIRefreshTokenRepository_RevokeToken_Call {
+func (_c *MockIRefreshTokenRepository_RevokeFamily_Call) RunAndReturn(run func(ctx context.Context, familyID string) error) *MockIRefreshTokenRepository_RevokeFamily_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -150,46 +150,46 @@ func (_c *MockIRefreshTokenUsecase_FindByToken_Call) RunAndReturn(run func(token
 	return _c
 }
 
-// FindByUserID provides a mock function for the type MockIRefreshTokenUsecase
-func (_mock *MockIRefreshTokenUsecase) FindByUserID(id string) (*domain.RefreshToken, error) {
-	ret := _mock.Called(id)
+// ListSessions provides a mock function for the type MockIRefreshTokenUsecase
+func (_mock *MockIRefreshTokenUsecase) ListSessions(userID string) ([]*domain.RefreshToken, error) {
+	ret := _mock.Called(userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindByUserID")
+		panic("no return value specified for ListSessions")
 	}
 
-	var r0 *domain.RefreshToken
+	var r0 []*domain.RefreshToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*domain.RefreshToken, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(string) ([]*domain.RefreshToken, error)); ok {
+		return returnFunc(userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *domain.RefreshToken); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(string) []*domain.RefreshToken); ok {
+		r0 = returnFunc(userID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.RefreshToken)
+			r0 = ret.Get(0).([]*domain.RefreshToken)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(id)
+		r1 = returnFunc(userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIRefreshTokenUsecase_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
-type MockIRefreshTokenUsecase_FindByUserID_Call struct {
+// MockIRefreshTokenUsecase_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockIRefreshTokenUsecase_ListSessions_Call struct {
 	*mock.Call
 }
 
-// FindByUserID is a helper method to define mock.On call
-//   - id string
-func (_e *MockIRefreshTokenUsecase_Expecter) FindByUserID(id interface{}) *MockIRefreshTokenUsecase_FindByUserID_Call {
-	return &MockIRefreshTokenUsecase_FindByUserID_Call{Call: _e.mock.On("FindByUserID", id)}
+// ListSessions is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIRefreshTokenUsecase_Expecter) ListSessions(userID interface{}) *MockIRefreshTokenUsecase_ListSessions_Call {
+	return &MockIRefreshTokenUsecase_ListSessions_Call{Call: _e.mock.On("ListSessions", userID)}
 }
 
-func (_c *MockIRefreshTokenUsecase_FindByUserID_Call) Run(run func(id string)) *MockIRefreshTokenUsecase_FindByUserID_Call {
+func (_c *MockIRefreshTokenUsecase_ListSessions_Call) Run(run func(userID string)) *MockIRefreshTokenUsecase_ListSessions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -202,63 +202,198 @@ func (_c *MockIRefreshTokenUsecase_FindByUserID_Call) Run(run func(id string)) *
 	return _c
 }
 
-func (_c *MockIRefreshTokenUsecase_FindByUserID_Call) Return(refreshToken *domain.RefreshToken, err error) *MockIRefreshTokenUsecase_FindByUserID_Call {
-	_c.Call.Return(refreshToken, err)
+func (_c *MockIRefreshTokenUsecase_ListSessions_Call) Return(refreshTokens []*domain.RefreshToken, err error) *MockIRefreshTokenUsecase_ListSessions_Call {
+	_c.Call.Return(refreshTokens, err)
 	return _c
 }
 
-func (_c *MockIRefreshTokenUsecase_FindByUserID_Call) RunAndReturn(run func(id string) (*domain.RefreshToken, error)) *MockIRefreshTokenUsecase_FindByUserID_Call {
+func (_c *MockIRefreshTokenUsecase_ListSessions_Call) RunAndReturn(run func(userID string) ([]*domain.RefreshToken, error)) *MockIRefreshTokenUsecase_ListSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReplaceToken provides a mock function for the type MockIRefreshTokenUsecase
-func (_mock *MockIRefreshTokenUsecase) ReplaceToken(token *domain.RefreshToken) error {
-	ret := _mock.Called(token)
+// ReportReuse provides a mock function for the type MockIRefreshTokenUsecase
+func (_mock *MockIRefreshTokenUsecase) ReportReuse(token *domain.RefreshToken, ip string, userAgent string) error {
+	ret := _mock.Called(token, ip, userAgent)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ReplaceToken")
+		panic("no return value specified for ReportReuse")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*domain.RefreshToken) error); ok {
-		r0 = returnFunc(token)
+	if returnFunc, ok := ret.Get(0).(func(*domain.RefreshToken, string, string) error); ok {
+		r0 = returnFunc(token, ip, userAgent)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockIRefreshTokenUsecase_ReplaceToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceToken'
-type MockIRefreshTokenUsecase_ReplaceToken_Call struct {
+// MockIRefreshTokenUsecase_ReportReuse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportReuse'
+type MockIRefreshTokenUsecase_ReportReuse_Call struct {
 	*mock.Call
 }
 
-// ReplaceToken is a helper method to define mock.On call
+// ReportReuse is a helper method to define mock.On call
 //   - token *domain.RefreshToken
-func (_e *MockIRefreshTokenUsecase_Expecter) ReplaceToken(token interface{}) *MockIRefreshTokenUsecase_ReplaceToken_Call {
-	return &MockIRefreshTokenUsecase_ReplaceToken_Call{Call: _e.mock.On("ReplaceToken", token)}
+//   - ip string
+//   - userAgent string
+func (_e *MockIRefreshTokenUsecase_Expecter) ReportReuse(token interface{}, ip interface{}, userAgent interface{}) *MockIRefreshTokenUsecase_ReportReuse_Call {
+	return &MockIRefreshTokenUsecase_ReportReuse_Call{Call: _e.mock.On("ReportReuse", token, ip, userAgent)}
 }
 
-func (_c *MockIRefreshTokenUsecase_ReplaceToken_Call) Run(run func(token *domain.RefreshToken)) *MockIRefreshTokenUsecase_ReplaceToken_Call {
+func (_c *MockIRefreshTokenUsecase_ReportReuse_Call) Run(run func(token *domain.RefreshToken, ip string, userAgent string)) *MockIRefreshTokenUsecase_ReportReuse_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 *domain.RefreshToken
 		if args[0] != nil {
 			arg0 = args[0].(*domain.RefreshToken)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRefreshTokenUsecase_ReportReuse_Call) Return(err error) *MockIRefreshTokenUsecase_ReportReuse_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRefreshTokenUsecase_ReportReuse_Call) RunAndReturn(run func(token *domain.RefreshToken, ip string, userAgent string) error) *MockIRefreshTokenUsecase_ReportReuse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeOtherSessions provides a mock function for the type MockIRefreshTokenUsecase
+func (_mock *MockIRefreshTokenUsecase) RevokeOtherSessions(userID string, currentSessionID string) (int64, error) {
+	ret := _mock.Called(userID, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeOtherSessions")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (int64, error)); ok {
+		return returnFunc(userID, currentSessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) int64); ok {
+		r0 = returnFunc(userID, currentSessionID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(userID, currentSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRefreshTokenUsecase_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type MockIRefreshTokenUsecase_RevokeOtherSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeOtherSessions is a helper method to define mock.On call
+//   - userID string
+//   - currentSessionID string
+func (_e *MockIRefreshTokenUsecase_Expecter) RevokeOtherSessions(userID interface{}, currentSessionID interface{}) *MockIRefreshTokenUsecase_RevokeOtherSessions_Call {
+	return &MockIRefreshTokenUsecase_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", userID, currentSessionID)}
+}
+
+func (_c *MockIRefreshTokenUsecase_RevokeOtherSessions_Call) Run(run func(userID string, currentSessionID string)) *MockIRefreshTokenUsecase_RevokeOtherSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRefreshTokenUsecase_RevokeOtherSessions_Call) Return(n int64, err error) *MockIRefreshTokenUsecase_RevokeOtherSessions_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRefreshTokenUsecase_RevokeOtherSessions_Call) RunAndReturn(run func(userID string, currentSessionID string) (int64, error)) *MockIRefreshTokenUsecase_RevokeOtherSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type MockIRefreshTokenUsecase
+func (_mock *MockIRefreshTokenUsecase) RevokeSession(userID string, sessionID string) error {
+	ret := _mock.Called(userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRefreshTokenUsecase_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockIRefreshTokenUsecase_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - userID string
+//   - sessionID string
+func (_e *MockIRefreshTokenUsecase_Expecter) RevokeSession(userID interface{}, sessionID interface{}) *MockIRefreshTokenUsecase_RevokeSession_Call {
+	return &MockIRefreshTokenUsecase_RevokeSession_Call{Call: _e.mock.On("RevokeSession", userID, sessionID)}
+}
+
+func (_c *MockIRefreshTokenUsecase_RevokeSession_Call) Run(run func(userID string, sessionID string)) *MockIRefreshTokenUsecase_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRefreshTokenUsecase_ReplaceToken_Call) Return(err error) *MockIRefreshTokenUsecase_ReplaceToken_Call {
+func (_c *MockIRefreshTokenUsecase_RevokeSession_Call) Return(err error) *MockIRefreshTokenUsecase_RevokeSession_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIRefreshTokenUsecase_ReplaceToken_Call) RunAndReturn(run func(token *domain.RefreshToken) error) *MockIRefreshTokenUsecase_ReplaceToken_Call {
+func (_c *MockIRefreshTokenUsecase_RevokeSession_Call) RunAndReturn(run func(userID string, sessionID string) error) *MockIRefreshTokenUsecase_RevokeSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -314,6 +449,63 @@ func (_c *MockIRefreshTokenUsecase_RevokedToken_Call) RunAndReturn(run func(toke
 	return _c
 }
 
+// Rotate provides a mock function for the type MockIRefreshTokenUsecase
+func (_mock *MockIRefreshTokenUsecase) Rotate(current *domain.RefreshToken, next *domain.RefreshToken) error {
+	ret := _mock.Called(current, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.RefreshToken, *domain.RefreshToken) error); ok {
+		r0 = returnFunc(current, next)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRefreshTokenUsecase_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type MockIRefreshTokenUsecase_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - current *domain.RefreshToken
+//   - next *domain.RefreshToken
+func (_e *MockIRefreshTokenUsecase_Expecter) Rotate(current interface{}, next interface{}) *MockIRefreshTokenUsecase_Rotate_Call {
+	return &MockIRefreshTokenUsecase_Rotate_Call{Call: _e.mock.On("Rotate", current, next)}
+}
+
+func (_c *MockIRefreshTokenUsecase_Rotate_Call) Run(run func(current *domain.RefreshToken, next *domain.RefreshToken)) *MockIRefreshTokenUsecase_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.RefreshToken
+		if args[0] != nil {
+			arg0 = args[0].(*domain.RefreshToken)
+		}
+		var arg1 *domain.RefreshToken
+		if args[1] != nil {
+			arg1 = args[1].(*domain.RefreshToken)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRefreshTokenUsecase_Rotate_Call) Return(err error) *MockIRefreshTokenUsecase_Rotate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRefreshTokenUsecase_Rotate_Call) RunAndReturn(run func(current *domain.RefreshToken, next *domain.RefreshToken) error) *MockIRefreshTokenUsecase_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function for the type MockIRefreshTokenUsecase
 func (_mock *MockIRefreshTokenUsecase) Save(token *domain.RefreshToken) error {
 	ret := _mock.Called(token)
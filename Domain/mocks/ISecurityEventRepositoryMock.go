@@ -0,0 +1,170 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockISecurityEventRepository creates a new instance of MockISecurityEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockISecurityEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockISecurityEventRepository {
+	mock := &MockISecurityEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockISecurityEventRepository is an autogenerated mock type for the ISecurityEventRepository type
+type MockISecurityEventRepository struct {
+	mock.Mock
+}
+
+type MockISecurityEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockISecurityEventRepository) EXPECT() *MockISecurityEventRepository_Expecter {
+	return &MockISecurityEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// Find provides a mock function for the type MockISecurityEventRepository
+func (_mock *MockISecurityEventRepository) Find(ctx context.Context, filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, int64, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 []*domain.SecurityEvent
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SecurityEventFilter) ([]*domain.SecurityEvent, int64, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SecurityEventFilter) []*domain.SecurityEvent); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.SecurityEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SecurityEventFilter) int64); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.SecurityEventFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockISecurityEventRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockISecurityEventRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.SecurityEventFilter
+func (_e *MockISecurityEventRepository_Expecter) Find(ctx interface{}, filter interface{}) *MockISecurityEventRepository_Find_Call {
+	return &MockISecurityEventRepository_Find_Call{Call: _e.mock.On("Find", ctx, filter)}
+}
+
+func (_c *MockISecurityEventRepository_Find_Call) Run(run func(ctx context.Context, filter domain.SecurityEventFilter)) *MockISecurityEventRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SecurityEventFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.SecurityEventFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockISecurityEventRepository_Find_Call) Return(securityEvents []*domain.SecurityEvent, n int64, err error) *MockISecurityEventRepository_Find_Call {
+	_c.Call.Return(securityEvents, n, err)
+	return _c
+}
+
+func (_c *MockISecurityEventRepository_Find_Call) RunAndReturn(run func(ctx context.Context, filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, int64, error)) *MockISecurityEventRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function for the type MockISecurityEventRepository
+func (_mock *MockISecurityEventRepository) Record(ctx context.Context, event *domain.SecurityEvent) error {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SecurityEvent) error); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockISecurityEventRepository_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockISecurityEventRepository_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *domain.SecurityEvent
+func (_e *MockISecurityEventRepository_Expecter) Record(ctx interface{}, event interface{}) *MockISecurityEventRepository_Record_Call {
+	return &MockISecurityEventRepository_Record_Call{Call: _e.mock.On("Record", ctx, event)}
+}
+
+func (_c *MockISecurityEventRepository_Record_Call) Run(run func(ctx context.Context, event *domain.SecurityEvent)) *MockISecurityEventRepository_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.SecurityEvent
+		if args[1] != nil {
+			arg1 = args[1].(*domain.SecurityEvent)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockISecurityEventRepository_Record_Call) Return(err error) *MockISecurityEventRepository_Record_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockISecurityEventRepository_Record_Call) RunAndReturn(run func(ctx context.Context, event *domain.SecurityEvent) error) *MockISecurityEventRepository_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
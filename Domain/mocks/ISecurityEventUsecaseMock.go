@@ -0,0 +1,237 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockISecurityEventUsecase creates a new instance of MockISecurityEventUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockISecurityEventUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockISecurityEventUsecase {
+	mock := &MockISecurityEventUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockISecurityEventUsecase is an autogenerated mock type for the ISecurityEventUsecase type
+type MockISecurityEventUsecase struct {
+	mock.Mock
+}
+
+type MockISecurityEventUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockISecurityEventUsecase) EXPECT() *MockISecurityEventUsecase_Expecter {
+	return &MockISecurityEventUsecase_Expecter{mock: &_m.Mock}
+}
+
+// RecentActivity provides a mock function for the type MockISecurityEventUsecase
+func (_mock *MockISecurityEventUsecase) RecentActivity(userID string, page int, pageSize int) ([]*domain.SecurityEvent, int64, error) {
+	ret := _mock.Called(userID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecentActivity")
+	}
+
+	var r0 []*domain.SecurityEvent
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, int, int) ([]*domain.SecurityEvent, int64, error)); ok {
+		return returnFunc(userID, page, pageSize)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int, int) []*domain.SecurityEvent); ok {
+		r0 = returnFunc(userID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.SecurityEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, int, int) int64); ok {
+		r1 = returnFunc(userID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, int, int) error); ok {
+		r2 = returnFunc(userID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockISecurityEventUsecase_RecentActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecentActivity'
+type MockISecurityEventUsecase_RecentActivity_Call struct {
+	*mock.Call
+}
+
+// RecentActivity is a helper method to define mock.On call
+//   - userID string
+//   - page int
+//   - pageSize int
+func (_e *MockISecurityEventUsecase_Expecter) RecentActivity(userID interface{}, page interface{}, pageSize interface{}) *MockISecurityEventUsecase_RecentActivity_Call {
+	return &MockISecurityEventUsecase_RecentActivity_Call{Call: _e.mock.On("RecentActivity", userID, page, pageSize)}
+}
+
+func (_c *MockISecurityEventUsecase_RecentActivity_Call) Run(run func(userID string, page int, pageSize int)) *MockISecurityEventUsecase_RecentActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockISecurityEventUsecase_RecentActivity_Call) Return(securityEvents []*domain.SecurityEvent, n int64, err error) *MockISecurityEventUsecase_RecentActivity_Call {
+	_c.Call.Return(securityEvents, n, err)
+	return _c
+}
+
+func (_c *MockISecurityEventUsecase_RecentActivity_Call) RunAndReturn(run func(userID string, page int, pageSize int) ([]*domain.SecurityEvent, int64, error)) *MockISecurityEventUsecase_RecentActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function for the type MockISecurityEventUsecase
+func (_mock *MockISecurityEventUsecase) Record(event *domain.SecurityEvent) error {
+	ret := _mock.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.SecurityEvent) error); ok {
+		r0 = returnFunc(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockISecurityEventUsecase_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockISecurityEventUsecase_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - event *domain.SecurityEvent
+func (_e *MockISecurityEventUsecase_Expecter) Record(event interface{}) *MockISecurityEventUsecase_Record_Call {
+	return &MockISecurityEventUsecase_Record_Call{Call: _e.mock.On("Record", event)}
+}
+
+func (_c *MockISecurityEventUsecase_Record_Call) Run(run func(event *domain.SecurityEvent)) *MockISecurityEventUsecase_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.SecurityEvent
+		if args[0] != nil {
+			arg0 = args[0].(*domain.SecurityEvent)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockISecurityEventUsecase_Record_Call) Return(err error) *MockISecurityEventUsecase_Record_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockISecurityEventUsecase_Record_Call) RunAndReturn(run func(event *domain.SecurityEvent) error) *MockISecurityEventUsecase_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockISecurityEventUsecase
+func (_mock *MockISecurityEventUsecase) Search(filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, int64, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*domain.SecurityEvent
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(domain.SecurityEventFilter) ([]*domain.SecurityEvent, int64, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(domain.SecurityEventFilter) []*domain.SecurityEvent); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.SecurityEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(domain.SecurityEventFilter) int64); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(domain.SecurityEventFilter) error); ok {
+		r2 = returnFunc(filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockISecurityEventUsecase_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockISecurityEventUsecase_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - filter domain.SecurityEventFilter
+func (_e *MockISecurityEventUsecase_Expecter) Search(filter interface{}) *MockISecurityEventUsecase_Search_Call {
+	return &MockISecurityEventUsecase_Search_Call{Call: _e.mock.On("Search", filter)}
+}
+
+func (_c *MockISecurityEventUsecase_Search_Call) Run(run func(filter domain.SecurityEventFilter)) *MockISecurityEventUsecase_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.SecurityEventFilter
+		if args[0] != nil {
+			arg0 = args[0].(domain.SecurityEventFilter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockISecurityEventUsecase_Search_Call) Return(securityEvents []*domain.SecurityEvent, n int64, err error) *MockISecurityEventUsecase_Search_Call {
+	_c.Call.Return(securityEvents, n, err)
+	return _c
+}
+
+func (_c *MockISecurityEventUsecase_Search_Call) RunAndReturn(run func(filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, int64, error)) *MockISecurityEventUsecase_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
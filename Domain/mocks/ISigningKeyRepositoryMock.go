@@ -0,0 +1,243 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockISigningKeyRepository creates a new instance of MockISigningKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockISigningKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockISigningKeyRepository {
+	mock := &MockISigningKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockISigningKeyRepository is an autogenerated mock type for the ISigningKeyRepository type
+type MockISigningKeyRepository struct {
+	mock.Mock
+}
+
+type MockISigningKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockISigningKeyRepository) EXPECT() *MockISigningKeyRepository_Expecter {
+	return &MockISigningKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindValid provides a mock function for the type MockISigningKeyRepository
+func (_mock *MockISigningKeyRepository) FindValid(ctx context.Context, now time.Time) ([]*domain.SigningKey, error) {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindValid")
+	}
+
+	var r0 []*domain.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]*domain.SigningKey, error)); ok {
+		return returnFunc(ctx, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []*domain.SigningKey); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockISigningKeyRepository_FindValid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindValid'
+type MockISigningKeyRepository_FindValid_Call struct {
+	*mock.Call
+}
+
+// FindValid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockISigningKeyRepository_Expecter) FindValid(ctx interface{}, now interface{}) *MockISigningKeyRepository_FindValid_Call {
+	return &MockISigningKeyRepository_FindValid_Call{Call: _e.mock.On("FindValid", ctx, now)}
+}
+
+func (_c *MockISigningKeyRepository_FindValid_Call) Run(run func(ctx context.Context, now time.Time)) *MockISigningKeyRepository_FindValid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockISigningKeyRepository_FindValid_Call) Return(signingKeys []*domain.SigningKey, err error) *MockISigningKeyRepository_FindValid_Call {
+	_c.Call.Return(signingKeys, err)
+	return _c
+}
+
+func (_c *MockISigningKeyRepository_FindValid_Call) RunAndReturn(run func(ctx context.Context, now time.Time) ([]*domain.SigningKey, error)) *MockISigningKeyRepository_FindValid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetireActive provides a mock function for the type MockISigningKeyRepository
+func (_mock *MockISigningKeyRepository) RetireActive(ctx context.Context, exceptKid string, retiredAt time.Time, expiresAt time.Time) (int64, error) {
+	ret := _mock.Called(ctx, exceptKid, retiredAt, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetireActive")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (int64, error)); ok {
+		return returnFunc(ctx, exceptKid, retiredAt, expiresAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) int64); ok {
+		r0 = returnFunc(ctx, exceptKid, retiredAt, expiresAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, exceptKid, retiredAt, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockISigningKeyRepository_RetireActive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetireActive'
+type MockISigningKeyRepository_RetireActive_Call struct {
+	*mock.Call
+}
+
+// RetireActive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exceptKid string
+//   - retiredAt time.Time
+//   - expiresAt time.Time
+func (_e *MockISigningKeyRepository_Expecter) RetireActive(ctx interface{}, exceptKid interface{}, retiredAt interface{}, expiresAt interface{}) *MockISigningKeyRepository_RetireActive_Call {
+	return &MockISigningKeyRepository_RetireActive_Call{Call: _e.mock.On("RetireActive", ctx, exceptKid, retiredAt, expiresAt)}
+}
+
+func (_c *MockISigningKeyRepository_RetireActive_Call) Run(run func(ctx context.Context, exceptKid string, retiredAt time.Time, expiresAt time.Time)) *MockISigningKeyRepository_RetireActive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockISigningKeyRepository_RetireActive_Call) Return(n int64, err error) *MockISigningKeyRepository_RetireActive_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockISigningKeyRepository_RetireActive_Call) RunAndReturn(run func(ctx context.Context, exceptKid string, retiredAt time.Time, expiresAt time.Time) (int64, error)) *MockISigningKeyRepository_RetireActive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function for the type MockISigningKeyRepository
+func (_mock *MockISigningKeyRepository) Save(ctx context.Context, key *domain.SigningKey) error {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SigningKey) error); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockISigningKeyRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type MockISigningKeyRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *domain.SigningKey
+func (_e *MockISigningKeyRepository_Expecter) Save(ctx interface{}, key interface{}) *MockISigningKeyRepository_Save_Call {
+	return &MockISigningKeyRepository_Save_Call{Call: _e.mock.On("Save", ctx, key)}
+}
+
+func (_c *MockISigningKeyRepository_Save_Call) Run(run func(ctx context.Context, key *domain.SigningKey)) *MockISigningKeyRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.SigningKey
+		if args[1] != nil {
+			arg1 = args[1].(*domain.SigningKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockISigningKeyRepository_Save_Call) Return(err error) *MockISigningKeyRepository_Save_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockISigningKeyRepository_Save_Call) RunAndReturn(run func(ctx context.Context, key *domain.SigningKey) error) *MockISigningKeyRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,227 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIStreamUsecase creates a new instance of MockIStreamUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIStreamUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIStreamUsecase {
+	mock := &MockIStreamUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIStreamUsecase is an autogenerated mock type for the IStreamUsecase type
+type MockIStreamUsecase struct {
+	mock.Mock
+}
+
+type MockIStreamUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIStreamUsecase) EXPECT() *MockIStreamUsecase_Expecter {
+	return &MockIStreamUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Listen provides a mock function for the type MockIStreamUsecase
+func (_mock *MockIStreamUsecase) Listen(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Listen")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIStreamUsecase_Listen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Listen'
+type MockIStreamUsecase_Listen_Call struct {
+	*mock.Call
+}
+
+// Listen is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIStreamUsecase_Expecter) Listen(ctx interface{}) *MockIStreamUsecase_Listen_Call {
+	return &MockIStreamUsecase_Listen_Call{Call: _e.mock.On("Listen", ctx)}
+}
+
+func (_c *MockIStreamUsecase_Listen_Call) Run(run func(ctx context.Context)) *MockIStreamUsecase_Listen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIStreamUsecase_Listen_Call) Return(err error) *MockIStreamUsecase_Listen_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIStreamUsecase_Listen_Call) RunAndReturn(run func(ctx context.Context) error) *MockIStreamUsecase_Listen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type MockIStreamUsecase
+func (_mock *MockIStreamUsecase) Publish(topic string, eventType domain.StreamEventType, data any) error {
+	ret := _mock.Called(topic, eventType, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, domain.StreamEventType, any) error); ok {
+		r0 = returnFunc(topic, eventType, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIStreamUsecase_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockIStreamUsecase_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - topic string
+//   - eventType domain.StreamEventType
+//   - data any
+func (_e *MockIStreamUsecase_Expecter) Publish(topic interface{}, eventType interface{}, data interface{}) *MockIStreamUsecase_Publish_Call {
+	return &MockIStreamUsecase_Publish_Call{Call: _e.mock.On("Publish", topic, eventType, data)}
+}
+
+func (_c *MockIStreamUsecase_Publish_Call) Run(run func(topic string, eventType domain.StreamEventType, data any)) *MockIStreamUsecase_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 domain.StreamEventType
+		if args[1] != nil {
+			arg1 = args[1].(domain.StreamEventType)
+		}
+		var arg2 any
+		if args[2] != nil {
+			arg2 = args[2].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIStreamUsecase_Publish_Call) Return(err error) *MockIStreamUsecase_Publish_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIStreamUsecase_Publish_Call) RunAndReturn(run func(topic string, eventType domain.StreamEventType, data any) error) *MockIStreamUsecase_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockIStreamUsecase
+func (_mock *MockIStreamUsecase) Subscribe(ctx context.Context, topic string, lastEventID string) (<-chan domain.StreamEvent, error) {
+	ret := _mock.Called(ctx, topic, lastEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan domain.StreamEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (<-chan domain.StreamEvent, error)); ok {
+		return returnFunc(ctx, topic, lastEventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) <-chan domain.StreamEvent); ok {
+		r0 = returnFunc(ctx, topic, lastEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan domain.StreamEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, topic, lastEventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIStreamUsecase_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockIStreamUsecase_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - topic string
+//   - lastEventID string
+func (_e *MockIStreamUsecase_Expecter) Subscribe(ctx interface{}, topic interface{}, lastEventID interface{}) *MockIStreamUsecase_Subscribe_Call {
+	return &MockIStreamUsecase_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx, topic, lastEventID)}
+}
+
+func (_c *MockIStreamUsecase_Subscribe_Call) Run(run func(ctx context.Context, topic string, lastEventID string)) *MockIStreamUsecase_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIStreamUsecase_Subscribe_Call) Return(streamEventCh <-chan domain.StreamEvent, err error) *MockIStreamUsecase_Subscribe_Call {
+	_c.Call.Return(streamEventCh, err)
+	return _c
+}
+
+func (_c *MockIStreamUsecase_Subscribe_Call) RunAndReturn(run func(ctx context.Context, topic string, lastEventID string) (<-chan domain.StreamEvent, error)) *MockIStreamUsecase_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,483 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockITagRepository creates a new instance of MockITagRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockITagRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockITagRepository {
+	mock := &MockITagRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockITagRepository is an autogenerated mock type for the ITagRepository type
+type MockITagRepository struct {
+	mock.Mock
+}
+
+type MockITagRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockITagRepository) EXPECT() *MockITagRepository_Expecter {
+	return &MockITagRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockITagRepository
+func (_mock *MockITagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	ret := _mock.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Tag) error); ok {
+		r0 = returnFunc(ctx, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITagRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockITagRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag *domain.Tag
+func (_e *MockITagRepository_Expecter) Create(ctx interface{}, tag interface{}) *MockITagRepository_Create_Call {
+	return &MockITagRepository_Create_Call{Call: _e.mock.On("Create", ctx, tag)}
+}
+
+func (_c *MockITagRepository_Create_Call) Run(run func(ctx context.Context, tag *domain.Tag)) *MockITagRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Tag
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Tag)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagRepository_Create_Call) Return(err error) *MockITagRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITagRepository_Create_Call) RunAndReturn(run func(ctx context.Context, tag *domain.Tag) error) *MockITagRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockITagRepository
+func (_mock *MockITagRepository) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITagRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockITagRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockITagRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockITagRepository_Delete_Call {
+	return &MockITagRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockITagRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockITagRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagRepository_Delete_Call) Return(err error) *MockITagRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITagRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockITagRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBySlug provides a mock function for the type MockITagRepository
+func (_mock *MockITagRepository) FindBySlug(ctx context.Context, slug string) (*domain.Tag, error) {
+	ret := _mock.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySlug")
+	}
+
+	var r0 *domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Tag, error)); ok {
+		return returnFunc(ctx, slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Tag); ok {
+		r0 = returnFunc(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagRepository_FindBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySlug'
+type MockITagRepository_FindBySlug_Call struct {
+	*mock.Call
+}
+
+// FindBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *MockITagRepository_Expecter) FindBySlug(ctx interface{}, slug interface{}) *MockITagRepository_FindBySlug_Call {
+	return &MockITagRepository_FindBySlug_Call{Call: _e.mock.On("FindBySlug", ctx, slug)}
+}
+
+func (_c *MockITagRepository_FindBySlug_Call) Run(run func(ctx context.Context, slug string)) *MockITagRepository_FindBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagRepository_FindBySlug_Call) Return(tag *domain.Tag, err error) *MockITagRepository_FindBySlug_Call {
+	_c.Call.Return(tag, err)
+	return _c
+}
+
+func (_c *MockITagRepository_FindBySlug_Call) RunAndReturn(run func(ctx context.Context, slug string) (*domain.Tag, error)) *MockITagRepository_FindBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Popular provides a mock function for the type MockITagRepository
+func (_mock *MockITagRepository) Popular(ctx context.Context, limit int) ([]*domain.Tag, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Popular")
+	}
+
+	var r0 []*domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]*domain.Tag, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []*domain.Tag); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagRepository_Popular_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Popular'
+type MockITagRepository_Popular_Call struct {
+	*mock.Call
+}
+
+// Popular is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockITagRepository_Expecter) Popular(ctx interface{}, limit interface{}) *MockITagRepository_Popular_Call {
+	return &MockITagRepository_Popular_Call{Call: _e.mock.On("Popular", ctx, limit)}
+}
+
+func (_c *MockITagRepository_Popular_Call) Run(run func(ctx context.Context, limit int)) *MockITagRepository_Popular_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagRepository_Popular_Call) Return(tags []*domain.Tag, err error) *MockITagRepository_Popular_Call {
+	_c.Call.Return(tags, err)
+	return _c
+}
+
+func (_c *MockITagRepository_Popular_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]*domain.Tag, error)) *MockITagRepository_Popular_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Search provides a mock function for the type MockITagRepository
+func (_mock *MockITagRepository) Search(ctx context.Context, prefix string, limit int) ([]*domain.Tag, error) {
+	ret := _mock.Called(ctx, prefix, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Search")
+	}
+
+	var r0 []*domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]*domain.Tag, error)); ok {
+		return returnFunc(ctx, prefix, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []*domain.Tag); ok {
+		r0 = returnFunc(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagRepository_Search_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Search'
+type MockITagRepository_Search_Call struct {
+	*mock.Call
+}
+
+// Search is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - limit int
+func (_e *MockITagRepository_Expecter) Search(ctx interface{}, prefix interface{}, limit interface{}) *MockITagRepository_Search_Call {
+	return &MockITagRepository_Search_Call{Call: _e.mock.On("Search", ctx, prefix, limit)}
+}
+
+func (_c *MockITagRepository_Search_Call) Run(run func(ctx context.Context, prefix string, limit int)) *MockITagRepository_Search_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagRepository_Search_Call) Return(tags []*domain.Tag, err error) *MockITagRepository_Search_Call {
+	_c.Call.Return(tags, err)
+	return _c
+}
+
+func (_c *MockITagRepository_Search_Call) RunAndReturn(run func(ctx context.Context, prefix string, limit int) ([]*domain.Tag, error)) *MockITagRepository_Search_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUsageCount provides a mock function for the type MockITagRepository
+func (_mock *MockITagRepository) SetUsageCount(ctx context.Context, slug string, count int) error {
+	ret := _mock.Called(ctx, slug, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUsageCount")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = returnFunc(ctx, slug, count)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITagRepository_SetUsageCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUsageCount'
+type MockITagRepository_SetUsageCount_Call struct {
+	*mock.Call
+}
+
+// SetUsageCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - count int
+func (_e *MockITagRepository_Expecter) SetUsageCount(ctx interface{}, slug interface{}, count interface{}) *MockITagRepository_SetUsageCount_Call {
+	return &MockITagRepository_SetUsageCount_Call{Call: _e.mock.On("SetUsageCount", ctx, slug, count)}
+}
+
+func (_c *MockITagRepository_SetUsageCount_Call) Run(run func(ctx context.Context, slug string, count int)) *MockITagRepository_SetUsageCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagRepository_SetUsageCount_Call) Return(err error) *MockITagRepository_SetUsageCount_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITagRepository_SetUsageCount_Call) RunAndReturn(run func(ctx context.Context, slug string, count int) error) *MockITagRepository_SetUsageCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockITagRepository
+func (_mock *MockITagRepository) Update(ctx context.Context, tag *domain.Tag) error {
+	ret := _mock.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Tag) error); ok {
+		r0 = returnFunc(ctx, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITagRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockITagRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag *domain.Tag
+func (_e *MockITagRepository_Expecter) Update(ctx interface{}, tag interface{}) *MockITagRepository_Update_Call {
+	return &MockITagRepository_Update_Call{Call: _e.mock.On("Update", ctx, tag)}
+}
+
+func (_c *MockITagRepository_Update_Call) Run(run func(ctx context.Context, tag *domain.Tag)) *MockITagRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Tag
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Tag)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagRepository_Update_Call) Return(err error) *MockITagRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITagRepository_Update_Call) RunAndReturn(run func(ctx context.Context, tag *domain.Tag) error) *MockITagRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,690 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockITagUsecase creates a new instance of MockITagUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockITagUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockITagUsecase {
+	mock := &MockITagUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockITagUsecase is an autogenerated mock type for the ITagUsecase type
+type MockITagUsecase struct {
+	mock.Mock
+}
+
+type MockITagUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockITagUsecase) EXPECT() *MockITagUsecase_Expecter {
+	return &MockITagUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Autocomplete provides a mock function for the type MockITagUsecase
+func (_mock *MockITagUsecase) Autocomplete(prefix string, limit int) ([]*domain.Tag, error) {
+	ret := _mock.Called(prefix, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Autocomplete")
+	}
+
+	var r0 []*domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, int) ([]*domain.Tag, error)); ok {
+		return returnFunc(prefix, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int) []*domain.Tag); ok {
+		r0 = returnFunc(prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = returnFunc(prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagUsecase_Autocomplete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Autocomplete'
+type MockITagUsecase_Autocomplete_Call struct {
+	*mock.Call
+}
+
+// Autocomplete is a helper method to define mock.On call
+//   - prefix string
+//   - limit int
+func (_e *MockITagUsecase_Expecter) Autocomplete(prefix interface{}, limit interface{}) *MockITagUsecase_Autocomplete_Call {
+	return &MockITagUsecase_Autocomplete_Call{Call: _e.mock.On("Autocomplete", prefix, limit)}
+}
+
+func (_c *MockITagUsecase_Autocomplete_Call) Run(run func(prefix string, limit int)) *MockITagUsecase_Autocomplete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagUsecase_Autocomplete_Call) Return(tags []*domain.Tag, err error) *MockITagUsecase_Autocomplete_Call {
+	_c.Call.Return(tags, err)
+	return _c
+}
+
+func (_c *MockITagUsecase_Autocomplete_Call) RunAndReturn(run func(prefix string, limit int) ([]*domain.Tag, error)) *MockITagUsecase_Autocomplete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockITagUsecase
+func (_mock *MockITagUsecase) Create(tag *domain.Tag) (*domain.Tag, error) {
+	ret := _mock.Called(tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.Tag) (*domain.Tag, error)); ok {
+		return returnFunc(tag)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*domain.Tag) *domain.Tag); ok {
+		r0 = returnFunc(tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*domain.Tag) error); ok {
+		r1 = returnFunc(tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockITagUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - tag *domain.Tag
+func (_e *MockITagUsecase_Expecter) Create(tag interface{}) *MockITagUsecase_Create_Call {
+	return &MockITagUsecase_Create_Call{Call: _e.mock.On("Create", tag)}
+}
+
+func (_c *MockITagUsecase_Create_Call) Run(run func(tag *domain.Tag)) *MockITagUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.Tag
+		if args[0] != nil {
+			arg0 = args[0].(*domain.Tag)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagUsecase_Create_Call) Return(tag1 *domain.Tag, err error) *MockITagUsecase_Create_Call {
+	_c.Call.Return(tag1, err)
+	return _c
+}
+
+func (_c *MockITagUsecase_Create_Call) RunAndReturn(run func(tag *domain.Tag) (*domain.Tag, error)) *MockITagUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockITagUsecase
+func (_mock *MockITagUsecase) Get(slug string) (*domain.Tag, error) {
+	ret := _mock.Called(slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.Tag, error)); ok {
+		return returnFunc(slug)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.Tag); ok {
+		r0 = returnFunc(slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagUsecase_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockITagUsecase_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - slug string
+func (_e *MockITagUsecase_Expecter) Get(slug interface{}) *MockITagUsecase_Get_Call {
+	return &MockITagUsecase_Get_Call{Call: _e.mock.On("Get", slug)}
+}
+
+func (_c *MockITagUsecase_Get_Call) Run(run func(slug string)) *MockITagUsecase_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagUsecase_Get_Call) Return(tag *domain.Tag, err error) *MockITagUsecase_Get_Call {
+	_c.Call.Return(tag, err)
+	return _c
+}
+
+func (_c *MockITagUsecase_Get_Call) RunAndReturn(run func(slug string) (*domain.Tag, error)) *MockITagUsecase_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Merge provides a mock function for the type MockITagUsecase
+func (_mock *MockITagUsecase) Merge(source string, target string) (*domain.Tag, error) {
+	ret := _mock.Called(source, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 *domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*domain.Tag, error)); ok {
+		return returnFunc(source, target)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *domain.Tag); ok {
+		r0 = returnFunc(source, target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(source, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagUsecase_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type MockITagUsecase_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - source string
+//   - target string
+func (_e *MockITagUsecase_Expecter) Merge(source interface{}, target interface{}) *MockITagUsecase_Merge_Call {
+	return &MockITagUsecase_Merge_Call{Call: _e.mock.On("Merge", source, target)}
+}
+
+func (_c *MockITagUsecase_Merge_Call) Run(run func(source string, target string)) *MockITagUsecase_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagUsecase_Merge_Call) Return(tag *domain.Tag, err error) *MockITagUsecase_Merge_Call {
+	_c.Call.Return(tag, err)
+	return _c
+}
+
+func (_c *MockITagUsecase_Merge_Call) RunAndReturn(run func(source string, target string) (*domain.Tag, error)) *MockITagUsecase_Merge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Normalize provides a mock function for the type MockITagUsecase
+func (_mock *MockITagUsecase) Normalize(ctx context.Context, names []string) ([]string, error) {
+	ret := _mock.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Normalize")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return returnFunc(ctx, names)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = returnFunc(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagUsecase_Normalize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Normalize'
+type MockITagUsecase_Normalize_Call struct {
+	*mock.Call
+}
+
+// Normalize is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *MockITagUsecase_Expecter) Normalize(ctx interface{}, names interface{}) *MockITagUsecase_Normalize_Call {
+	return &MockITagUsecase_Normalize_Call{Call: _e.mock.On("Normalize", ctx, names)}
+}
+
+func (_c *MockITagUsecase_Normalize_Call) Run(run func(ctx context.Context, names []string)) *MockITagUsecase_Normalize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagUsecase_Normalize_Call) Return(strings []string, err error) *MockITagUsecase_Normalize_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockITagUsecase_Normalize_Call) RunAndReturn(run func(ctx context.Context, names []string) ([]string, error)) *MockITagUsecase_Normalize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Popular provides a mock function for the type MockITagUsecase
+func (_mock *MockITagUsecase) Popular(limit int) ([]*domain.Tag, error) {
+	ret := _mock.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Popular")
+	}
+
+	var r0 []*domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]*domain.Tag, error)); ok {
+		return returnFunc(limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []*domain.Tag); ok {
+		r0 = returnFunc(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagUsecase_Popular_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Popular'
+type MockITagUsecase_Popular_Call struct {
+	*mock.Call
+}
+
+// Popular is a helper method to define mock.On call
+//   - limit int
+func (_e *MockITagUsecase_Expecter) Popular(limit interface{}) *MockITagUsecase_Popular_Call {
+	return &MockITagUsecase_Popular_Call{Call: _e.mock.On("Popular", limit)}
+}
+
+func (_c *MockITagUsecase_Popular_Call) Run(run func(limit int)) *MockITagUsecase_Popular_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagUsecase_Popular_Call) Return(tags []*domain.Tag, err error) *MockITagUsecase_Popular_Call {
+	_c.Call.Return(tags, err)
+	return _c
+}
+
+func (_c *MockITagUsecase_Popular_Call) RunAndReturn(run func(limit int) ([]*domain.Tag, error)) *MockITagUsecase_Popular_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshUsage provides a mock function for the type MockITagUsecase
+func (_mock *MockITagUsecase) RefreshUsage(ctx context.Context, slugs []string) error {
+	ret := _mock.Called(ctx, slugs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshUsage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = returnFunc(ctx, slugs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITagUsecase_RefreshUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshUsage'
+type MockITagUsecase_RefreshUsage_Call struct {
+	*mock.Call
+}
+
+// RefreshUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slugs []string
+func (_e *MockITagUsecase_Expecter) RefreshUsage(ctx interface{}, slugs interface{}) *MockITagUsecase_RefreshUsage_Call {
+	return &MockITagUsecase_RefreshUsage_Call{Call: _e.mock.On("RefreshUsage", ctx, slugs)}
+}
+
+func (_c *MockITagUsecase_RefreshUsage_Call) Run(run func(ctx context.Context, slugs []string)) *MockITagUsecase_RefreshUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagUsecase_RefreshUsage_Call) Return(err error) *MockITagUsecase_RefreshUsage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITagUsecase_RefreshUsage_Call) RunAndReturn(run func(ctx context.Context, slugs []string) error) *MockITagUsecase_RefreshUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rename provides a mock function for the type MockITagUsecase
+func (_mock *MockITagUsecase) Rename(slug string, name string) (*domain.Tag, error) {
+	ret := _mock.Called(slug, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rename")
+	}
+
+	var r0 *domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*domain.Tag, error)); ok {
+		return returnFunc(slug, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *domain.Tag); ok {
+		r0 = returnFunc(slug, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(slug, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagUsecase_Rename_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rename'
+type MockITagUsecase_Rename_Call struct {
+	*mock.Call
+}
+
+// Rename is a helper method to define mock.On call
+//   - slug string
+//   - name string
+func (_e *MockITagUsecase_Expecter) Rename(slug interface{}, name interface{}) *MockITagUsecase_Rename_Call {
+	return &MockITagUsecase_Rename_Call{Call: _e.mock.On("Rename", slug, name)}
+}
+
+func (_c *MockITagUsecase_Rename_Call) Run(run func(slug string, name string)) *MockITagUsecase_Rename_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagUsecase_Rename_Call) Return(tag *domain.Tag, err error) *MockITagUsecase_Rename_Call {
+	_c.Call.Return(tag, err)
+	return _c
+}
+
+func (_c *MockITagUsecase_Rename_Call) RunAndReturn(run func(slug string, name string) (*domain.Tag, error)) *MockITagUsecase_Rename_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function for the type MockITagUsecase
+func (_mock *MockITagUsecase) Resolve(ctx context.Context, names []string) ([]string, error) {
+	ret := _mock.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return returnFunc(ctx, names)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = returnFunc(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagUsecase_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type MockITagUsecase_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *MockITagUsecase_Expecter) Resolve(ctx interface{}, names interface{}) *MockITagUsecase_Resolve_Call {
+	return &MockITagUsecase_Resolve_Call{Call: _e.mock.On("Resolve", ctx, names)}
+}
+
+func (_c *MockITagUsecase_Resolve_Call) Run(run func(ctx context.Context, names []string)) *MockITagUsecase_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagUsecase_Resolve_Call) Return(strings []string, err error) *MockITagUsecase_Resolve_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockITagUsecase_Resolve_Call) RunAndReturn(run func(ctx context.Context, names []string) ([]string, error)) *MockITagUsecase_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockITagUsecase
+func (_mock *MockITagUsecase) Update(slug string, tag *domain.Tag) (*domain.Tag, error) {
+	ret := _mock.Called(slug, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.Tag
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, *domain.Tag) (*domain.Tag, error)); ok {
+		return returnFunc(slug, tag)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, *domain.Tag) *domain.Tag); ok {
+		r0 = returnFunc(slug, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tag)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, *domain.Tag) error); ok {
+		r1 = returnFunc(slug, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITagUsecase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockITagUsecase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - slug string
+//   - tag *domain.Tag
+func (_e *MockITagUsecase_Expecter) Update(slug interface{}, tag interface{}) *MockITagUsecase_Update_Call {
+	return &MockITagUsecase_Update_Call{Call: _e.mock.On("Update", slug, tag)}
+}
+
+func (_c *MockITagUsecase_Update_Call) Run(run func(slug string, tag *domain.Tag)) *MockITagUsecase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 *domain.Tag
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Tag)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITagUsecase_Update_Call) Return(tag1 *domain.Tag, err error) *MockITagUsecase_Update_Call {
+	_c.Call.Return(tag1, err)
+	return _c
+}
+
+func (_c *MockITagUsecase_Update_Call) RunAndReturn(run func(slug string, tag *domain.Tag) (*domain.Tag, error)) *MockITagUsecase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
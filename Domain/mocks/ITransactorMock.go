@@ -0,0 +1,95 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockITransactor creates a new instance of MockITransactor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockITransactor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockITransactor {
+	mock := &MockITransactor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockITransactor is an autogenerated mock type for the ITransactor type
+type MockITransactor struct {
+	mock.Mock
+}
+
+type MockITransactor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockITransactor) EXPECT() *MockITransactor_Expecter {
+	return &MockITransactor_Expecter{mock: &_m.Mock}
+}
+
+// WithTransaction provides a mock function for the type MockITransactor
+func (_mock *MockITransactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	ret := _mock.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTransaction")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) error); ok {
+		r0 = returnFunc(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITransactor_WithTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTransaction'
+type MockITransactor_WithTransaction_Call struct {
+	*mock.Call
+}
+
+// WithTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(ctx context.Context) error
+func (_e *MockITransactor_Expecter) WithTransaction(ctx interface{}, fn interface{}) *MockITransactor_WithTransaction_Call {
+	return &MockITransactor_WithTransaction_Call{Call: _e.mock.On("WithTransaction", ctx, fn)}
+}
+
+func (_c *MockITransactor_WithTransaction_Call) Run(run func(ctx context.Context, fn func(ctx context.Context) error)) *MockITransactor_WithTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(ctx context.Context) error
+		if args[1] != nil {
+			arg1 = args[1].(func(ctx context.Context) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITransactor_WithTransaction_Call) Return(err error) *MockITransactor_WithTransaction_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITransactor_WithTransaction_Call) RunAndReturn(run func(ctx context.Context, fn func(ctx context.Context) error) error) *MockITransactor_WithTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
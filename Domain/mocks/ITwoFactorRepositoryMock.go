@@ -0,0 +1,340 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockITwoFactorRepository creates a new instance of MockITwoFactorRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockITwoFactorRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockITwoFactorRepository {
+	mock := &MockITwoFactorRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockITwoFactorRepository is an autogenerated mock type for the ITwoFactorRepository type
+type MockITwoFactorRepository struct {
+	mock.Mock
+}
+
+type MockITwoFactorRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockITwoFactorRepository) EXPECT() *MockITwoFactorRepository_Expecter {
+	return &MockITwoFactorRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteByUserID provides a mock function for the type MockITwoFactorRepository
+func (_mock *MockITwoFactorRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUserID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITwoFactorRepository_DeleteByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserID'
+type MockITwoFactorRepository_DeleteByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockITwoFactorRepository_Expecter) DeleteByUserID(ctx interface{}, userID interface{}) *MockITwoFactorRepository_DeleteByUserID_Call {
+	return &MockITwoFactorRepository_DeleteByUserID_Call{Call: _e.mock.On("DeleteByUserID", ctx, userID)}
+}
+
+func (_c *MockITwoFactorRepository_DeleteByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockITwoFactorRepository_DeleteByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorRepository_DeleteByUserID_Call) Return(err error) *MockITwoFactorRepository_DeleteByUserID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITwoFactorRepository_DeleteByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) error) *MockITwoFactorRepository_DeleteByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByUserID provides a mock function for the type MockITwoFactorRepository
+func (_mock *MockITwoFactorRepository) FindByUserID(ctx context.Context, userID string) (*domain.TwoFactor, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByUserID")
+	}
+
+	var r0 *domain.TwoFactor
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.TwoFactor, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.TwoFactor); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TwoFactor)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITwoFactorRepository_FindByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByUserID'
+type MockITwoFactorRepository_FindByUserID_Call struct {
+	*mock.Call
+}
+
+// FindByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockITwoFactorRepository_Expecter) FindByUserID(ctx interface{}, userID interface{}) *MockITwoFactorRepository_FindByUserID_Call {
+	return &MockITwoFactorRepository_FindByUserID_Call{Call: _e.mock.On("FindByUserID", ctx, userID)}
+}
+
+func (_c *MockITwoFactorRepository_FindByUserID_Call) Run(run func(ctx context.Context, userID string)) *MockITwoFactorRepository_FindByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorRepository_FindByUserID_Call) Return(twoFactor *domain.TwoFactor, err error) *MockITwoFactorRepository_FindByUserID_Call {
+	_c.Call.Return(twoFactor, err)
+	return _c
+}
+
+func (_c *MockITwoFactorRepository_FindByUserID_Call) RunAndReturn(run func(ctx context.Context, userID string) (*domain.TwoFactor, error)) *MockITwoFactorRepository_FindByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicy provides a mock function for the type MockITwoFactorRepository
+func (_mock *MockITwoFactorRepository) GetPolicy(ctx context.Context) (*domain.TwoFactorPolicy, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicy")
+	}
+
+	var r0 *domain.TwoFactorPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*domain.TwoFactorPolicy, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *domain.TwoFactorPolicy); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TwoFactorPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITwoFactorRepository_GetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicy'
+type MockITwoFactorRepository_GetPolicy_Call struct {
+	*mock.Call
+}
+
+// GetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockITwoFactorRepository_Expecter) GetPolicy(ctx interface{}) *MockITwoFactorRepository_GetPolicy_Call {
+	return &MockITwoFactorRepository_GetPolicy_Call{Call: _e.mock.On("GetPolicy", ctx)}
+}
+
+func (_c *MockITwoFactorRepository_GetPolicy_Call) Run(run func(ctx context.Context)) *MockITwoFactorRepository_GetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorRepository_GetPolicy_Call) Return(twoFactorPolicy *domain.TwoFactorPolicy, err error) *MockITwoFactorRepository_GetPolicy_Call {
+	_c.Call.Return(twoFactorPolicy, err)
+	return _c
+}
+
+func (_c *MockITwoFactorRepository_GetPolicy_Call) RunAndReturn(run func(ctx context.Context) (*domain.TwoFactorPolicy, error)) *MockITwoFactorRepository_GetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SavePolicy provides a mock function for the type MockITwoFactorRepository
+func (_mock *MockITwoFactorRepository) SavePolicy(ctx context.Context, policy *domain.TwoFactorPolicy) error {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.TwoFactorPolicy) error); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITwoFactorRepository_SavePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePolicy'
+type MockITwoFactorRepository_SavePolicy_Call struct {
+	*mock.Call
+}
+
+// SavePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *domain.TwoFactorPolicy
+func (_e *MockITwoFactorRepository_Expecter) SavePolicy(ctx interface{}, policy interface{}) *MockITwoFactorRepository_SavePolicy_Call {
+	return &MockITwoFactorRepository_SavePolicy_Call{Call: _e.mock.On("SavePolicy", ctx, policy)}
+}
+
+func (_c *MockITwoFactorRepository_SavePolicy_Call) Run(run func(ctx context.Context, policy *domain.TwoFactorPolicy)) *MockITwoFactorRepository_SavePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.TwoFactorPolicy
+		if args[1] != nil {
+			arg1 = args[1].(*domain.TwoFactorPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorRepository_SavePolicy_Call) Return(err error) *MockITwoFactorRepository_SavePolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITwoFactorRepository_SavePolicy_Call) RunAndReturn(run func(ctx context.Context, policy *domain.TwoFactorPolicy) error) *MockITwoFactorRepository_SavePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockITwoFactorRepository
+func (_mock *MockITwoFactorRepository) Upsert(ctx context.Context, twoFactor *domain.TwoFactor) error {
+	ret := _mock.Called(ctx, twoFactor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.TwoFactor) error); ok {
+		r0 = returnFunc(ctx, twoFactor)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITwoFactorRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockITwoFactorRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - twoFactor *domain.TwoFactor
+func (_e *MockITwoFactorRepository_Expecter) Upsert(ctx interface{}, twoFactor interface{}) *MockITwoFactorRepository_Upsert_Call {
+	return &MockITwoFactorRepository_Upsert_Call{Call: _e.mock.On("Upsert", ctx, twoFactor)}
+}
+
+func (_c *MockITwoFactorRepository_Upsert_Call) Run(run func(ctx context.Context, twoFactor *domain.TwoFactor)) *MockITwoFactorRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.TwoFactor
+		if args[1] != nil {
+			arg1 = args[1].(*domain.TwoFactor)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorRepository_Upsert_Call) Return(err error) *MockITwoFactorRepository_Upsert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITwoFactorRepository_Upsert_Call) RunAndReturn(run func(ctx context.Context, twoFactor *domain.TwoFactor) error) *MockITwoFactorRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
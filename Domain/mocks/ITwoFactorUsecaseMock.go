@@ -0,0 +1,576 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockITwoFactorUsecase creates a new instance of MockITwoFactorUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockITwoFactorUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockITwoFactorUsecase {
+	mock := &MockITwoFactorUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockITwoFactorUsecase is an autogenerated mock type for the ITwoFactorUsecase type
+type MockITwoFactorUsecase struct {
+	mock.Mock
+}
+
+type MockITwoFactorUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockITwoFactorUsecase) EXPECT() *MockITwoFactorUsecase_Expecter {
+	return &MockITwoFactorUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Confirm provides a mock function for the type MockITwoFactorUsecase
+func (_mock *MockITwoFactorUsecase) Confirm(userID string, code string) ([]string, error) {
+	ret := _mock.Called(userID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Confirm")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) ([]string, error)); ok {
+		return returnFunc(userID, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) []string); ok {
+		r0 = returnFunc(userID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(userID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITwoFactorUsecase_Confirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Confirm'
+type MockITwoFactorUsecase_Confirm_Call struct {
+	*mock.Call
+}
+
+// Confirm is a helper method to define mock.On call
+//   - userID string
+//   - code string
+func (_e *MockITwoFactorUsecase_Expecter) Confirm(userID interface{}, code interface{}) *MockITwoFactorUsecase_Confirm_Call {
+	return &MockITwoFactorUsecase_Confirm_Call{Call: _e.mock.On("Confirm", userID, code)}
+}
+
+func (_c *MockITwoFactorUsecase_Confirm_Call) Run(run func(userID string, code string)) *MockITwoFactorUsecase_Confirm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_Confirm_Call) Return(strings []string, err error) *MockITwoFactorUsecase_Confirm_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_Confirm_Call) RunAndReturn(run func(userID string, code string) ([]string, error)) *MockITwoFactorUsecase_Confirm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Disable provides a mock function for the type MockITwoFactorUsecase
+func (_mock *MockITwoFactorUsecase) Disable(userID string, code string) error {
+	ret := _mock.Called(userID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Disable")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(userID, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITwoFactorUsecase_Disable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disable'
+type MockITwoFactorUsecase_Disable_Call struct {
+	*mock.Call
+}
+
+// Disable is a helper method to define mock.On call
+//   - userID string
+//   - code string
+func (_e *MockITwoFactorUsecase_Expecter) Disable(userID interface{}, code interface{}) *MockITwoFactorUsecase_Disable_Call {
+	return &MockITwoFactorUsecase_Disable_Call{Call: _e.mock.On("Disable", userID, code)}
+}
+
+func (_c *MockITwoFactorUsecase_Disable_Call) Run(run func(userID string, code string)) *MockITwoFactorUsecase_Disable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_Disable_Call) Return(err error) *MockITwoFactorUsecase_Disable_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_Disable_Call) RunAndReturn(run func(userID string, code string) error) *MockITwoFactorUsecase_Disable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enroll provides a mock function for the type MockITwoFactorUsecase
+func (_mock *MockITwoFactorUsecase) Enroll(userID string) (*domain.TwoFactorEnrollment, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enroll")
+	}
+
+	var r0 *domain.TwoFactorEnrollment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.TwoFactorEnrollment, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.TwoFactorEnrollment); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TwoFactorEnrollment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITwoFactorUsecase_Enroll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enroll'
+type MockITwoFactorUsecase_Enroll_Call struct {
+	*mock.Call
+}
+
+// Enroll is a helper method to define mock.On call
+//   - userID string
+func (_e *MockITwoFactorUsecase_Expecter) Enroll(userID interface{}) *MockITwoFactorUsecase_Enroll_Call {
+	return &MockITwoFactorUsecase_Enroll_Call{Call: _e.mock.On("Enroll", userID)}
+}
+
+func (_c *MockITwoFactorUsecase_Enroll_Call) Run(run func(userID string)) *MockITwoFactorUsecase_Enroll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_Enroll_Call) Return(twoFactorEnrollment *domain.TwoFactorEnrollment, err error) *MockITwoFactorUsecase_Enroll_Call {
+	_c.Call.Return(twoFactorEnrollment, err)
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_Enroll_Call) RunAndReturn(run func(userID string) (*domain.TwoFactorEnrollment, error)) *MockITwoFactorUsecase_Enroll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicy provides a mock function for the type MockITwoFactorUsecase
+func (_mock *MockITwoFactorUsecase) GetPolicy() (*domain.TwoFactorPolicy, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicy")
+	}
+
+	var r0 *domain.TwoFactorPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*domain.TwoFactorPolicy, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *domain.TwoFactorPolicy); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TwoFactorPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITwoFactorUsecase_GetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicy'
+type MockITwoFactorUsecase_GetPolicy_Call struct {
+	*mock.Call
+}
+
+// GetPolicy is a helper method to define mock.On call
+func (_e *MockITwoFactorUsecase_Expecter) GetPolicy() *MockITwoFactorUsecase_GetPolicy_Call {
+	return &MockITwoFactorUsecase_GetPolicy_Call{Call: _e.mock.On("GetPolicy")}
+}
+
+func (_c *MockITwoFactorUsecase_GetPolicy_Call) Run(run func()) *MockITwoFactorUsecase_GetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_GetPolicy_Call) Return(twoFactorPolicy *domain.TwoFactorPolicy, err error) *MockITwoFactorUsecase_GetPolicy_Call {
+	_c.Call.Return(twoFactorPolicy, err)
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_GetPolicy_Call) RunAndReturn(run func() (*domain.TwoFactorPolicy, error)) *MockITwoFactorUsecase_GetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsEnabled provides a mock function for the type MockITwoFactorUsecase
+func (_mock *MockITwoFactorUsecase) IsEnabled(userID string) (bool, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsEnabled")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITwoFactorUsecase_IsEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsEnabled'
+type MockITwoFactorUsecase_IsEnabled_Call struct {
+	*mock.Call
+}
+
+// IsEnabled is a helper method to define mock.On call
+//   - userID string
+func (_e *MockITwoFactorUsecase_Expecter) IsEnabled(userID interface{}) *MockITwoFactorUsecase_IsEnabled_Call {
+	return &MockITwoFactorUsecase_IsEnabled_Call{Call: _e.mock.On("IsEnabled", userID)}
+}
+
+func (_c *MockITwoFactorUsecase_IsEnabled_Call) Run(run func(userID string)) *MockITwoFactorUsecase_IsEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_IsEnabled_Call) Return(b bool, err error) *MockITwoFactorUsecase_IsEnabled_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_IsEnabled_Call) RunAndReturn(run func(userID string) (bool, error)) *MockITwoFactorUsecase_IsEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsRequired provides a mock function for the type MockITwoFactorUsecase
+func (_mock *MockITwoFactorUsecase) IsRequired(role domain.UserRole) (bool, error) {
+	ret := _mock.Called(role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsRequired")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(domain.UserRole) (bool, error)); ok {
+		return returnFunc(role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(domain.UserRole) bool); ok {
+		r0 = returnFunc(role)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(domain.UserRole) error); ok {
+		r1 = returnFunc(role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITwoFactorUsecase_IsRequired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsRequired'
+type MockITwoFactorUsecase_IsRequired_Call struct {
+	*mock.Call
+}
+
+// IsRequired is a helper method to define mock.On call
+//   - role domain.UserRole
+func (_e *MockITwoFactorUsecase_Expecter) IsRequired(role interface{}) *MockITwoFactorUsecase_IsRequired_Call {
+	return &MockITwoFactorUsecase_IsRequired_Call{Call: _e.mock.On("IsRequired", role)}
+}
+
+func (_c *MockITwoFactorUsecase_IsRequired_Call) Run(run func(role domain.UserRole)) *MockITwoFactorUsecase_IsRequired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.UserRole
+		if args[0] != nil {
+			arg0 = args[0].(domain.UserRole)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_IsRequired_Call) Return(b bool, err error) *MockITwoFactorUsecase_IsRequired_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_IsRequired_Call) RunAndReturn(run func(role domain.UserRole) (bool, error)) *MockITwoFactorUsecase_IsRequired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegenerateRecoveryCodes provides a mock function for the type MockITwoFactorUsecase
+func (_mock *MockITwoFactorUsecase) RegenerateRecoveryCodes(userID string, code string) ([]string, error) {
+	ret := _mock.Called(userID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateRecoveryCodes")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) ([]string, error)); ok {
+		return returnFunc(userID, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) []string); ok {
+		r0 = returnFunc(userID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(userID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockITwoFactorUsecase_RegenerateRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateRecoveryCodes'
+type MockITwoFactorUsecase_RegenerateRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// RegenerateRecoveryCodes is a helper method to define mock.On call
+//   - userID string
+//   - code string
+func (_e *MockITwoFactorUsecase_Expecter) RegenerateRecoveryCodes(userID interface{}, code interface{}) *MockITwoFactorUsecase_RegenerateRecoveryCodes_Call {
+	return &MockITwoFactorUsecase_RegenerateRecoveryCodes_Call{Call: _e.mock.On("RegenerateRecoveryCodes", userID, code)}
+}
+
+func (_c *MockITwoFactorUsecase_RegenerateRecoveryCodes_Call) Run(run func(userID string, code string)) *MockITwoFactorUsecase_RegenerateRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_RegenerateRecoveryCodes_Call) Return(strings []string, err error) *MockITwoFactorUsecase_RegenerateRecoveryCodes_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_RegenerateRecoveryCodes_Call) RunAndReturn(run func(userID string, code string) ([]string, error)) *MockITwoFactorUsecase_RegenerateRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePolicy provides a mock function for the type MockITwoFactorUsecase
+func (_mock *MockITwoFactorUsecase) UpdatePolicy(policy *domain.TwoFactorPolicy) error {
+	ret := _mock.Called(policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.TwoFactorPolicy) error); ok {
+		r0 = returnFunc(policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITwoFactorUsecase_UpdatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePolicy'
+type MockITwoFactorUsecase_UpdatePolicy_Call struct {
+	*mock.Call
+}
+
+// UpdatePolicy is a helper method to define mock.On call
+//   - policy *domain.TwoFactorPolicy
+func (_e *MockITwoFactorUsecase_Expecter) UpdatePolicy(policy interface{}) *MockITwoFactorUsecase_UpdatePolicy_Call {
+	return &MockITwoFactorUsecase_UpdatePolicy_Call{Call: _e.mock.On("UpdatePolicy", policy)}
+}
+
+func (_c *MockITwoFactorUsecase_UpdatePolicy_Call) Run(run func(policy *domain.TwoFactorPolicy)) *MockITwoFactorUsecase_UpdatePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.TwoFactorPolicy
+		if args[0] != nil {
+			arg0 = args[0].(*domain.TwoFactorPolicy)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_UpdatePolicy_Call) Return(err error) *MockITwoFactorUsecase_UpdatePolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_UpdatePolicy_Call) RunAndReturn(run func(policy *domain.TwoFactorPolicy) error) *MockITwoFactorUsecase_UpdatePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function for the type MockITwoFactorUsecase
+func (_mock *MockITwoFactorUsecase) Verify(userID string, code string) error {
+	ret := _mock.Called(userID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(userID, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockITwoFactorUsecase_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockITwoFactorUsecase_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - userID string
+//   - code string
+func (_e *MockITwoFactorUsecase_Expecter) Verify(userID interface{}, code interface{}) *MockITwoFactorUsecase_Verify_Call {
+	return &MockITwoFactorUsecase_Verify_Call{Call: _e.mock.On("Verify", userID, code)}
+}
+
+func (_c *MockITwoFactorUsecase_Verify_Call) Run(run func(userID string, code string)) *MockITwoFactorUsecase_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_Verify_Call) Return(err error) *MockITwoFactorUsecase_Verify_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockITwoFactorUsecase_Verify_Call) RunAndReturn(run func(userID string, code string) error) *MockITwoFactorUsecase_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -553,6 +553,69 @@ func (_c *MockIUserRepository_InvalidateTokens_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// UpdatePassword provides a mock function for the type MockIUserRepository
+func (_mock *MockIUserRepository) UpdatePassword(ctx context.Context, userID string, hash string) error {
+	ret := _mock.Called(ctx, userID, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, userID, hash)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserRepository_UpdatePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePassword'
+type MockIUserRepository_UpdatePassword_Call struct {
+	*mock.Call
+}
+
+// UpdatePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - hash string
+func (_e *MockIUserRepository_Expecter) UpdatePassword(ctx interface{}, userID interface{}, hash interface{}) *MockIUserRepository_UpdatePassword_Call {
+	return &MockIUserRepository_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, userID, hash)}
+}
+
+func (_c *MockIUserRepository_UpdatePassword_Call) Run(run func(ctx context.Context, userID string, hash string)) *MockIUserRepository_UpdatePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserRepository_UpdatePassword_Call) Return(err error) *MockIUserRepository_UpdatePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserRepository_UpdatePassword_Call) RunAndReturn(run func(ctx context.Context, userID string, hash string) error) *MockIUserRepository_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function for the type MockIUserRepository
 func (_mock *MockIUserRepository) UpdateUser(context1 context.Context, s string, user *domain.User) error {
 	ret := _mock.Called(context1, s, user)
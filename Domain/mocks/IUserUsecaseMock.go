@@ -458,6 +458,63 @@ func (_c *MockIUserUsecase_Register_Call) RunAndReturn(run func(request *domain.
 	return _c
 }
 
+// RehashPassword provides a mock function for the type MockIUserUsecase
+func (_mock *MockIUserUsecase) RehashPassword(user *domain.User, password string) error {
+	ret := _mock.Called(user, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RehashPassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.User, string) error); ok {
+		r0 = returnFunc(user, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUserUsecase_RehashPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RehashPassword'
+type MockIUserUsecase_RehashPassword_Call struct {
+	*mock.Call
+}
+
+// RehashPassword is a helper method to define mock.On call
+//   - user *domain.User
+//   - password string
+func (_e *MockIUserUsecase_Expecter) RehashPassword(user interface{}, password interface{}) *MockIUserUsecase_RehashPassword_Call {
+	return &MockIUserUsecase_RehashPassword_Call{Call: _e.mock.On("RehashPassword", user, password)}
+}
+
+func (_c *MockIUserUsecase_RehashPassword_Call) Run(run func(user *domain.User, password string)) *MockIUserUsecase_RehashPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.User
+		if args[0] != nil {
+			arg0 = args[0].(*domain.User)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserUsecase_RehashPassword_Call) Return(err error) *MockIUserUsecase_RehashPassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUserUsecase_RehashPassword_Call) RunAndReturn(run func(user *domain.User, password string) error) *MockIUserUsecase_RehashPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateProfile provides a mock function for the type MockIUserUsecase
 func (_mock *MockIUserUsecase) UpdateProfile(userID string, update domain.UserProfileUpdate, fileName string) (*domain.User, error) {
 	ret := _mock.Called(userID, update, fileName)
@@ -0,0 +1,427 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIWebhookDeliveryRepository creates a new instance of MockIWebhookDeliveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIWebhookDeliveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIWebhookDeliveryRepository {
+	mock := &MockIWebhookDeliveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIWebhookDeliveryRepository is an autogenerated mock type for the IWebhookDeliveryRepository type
+type MockIWebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+type MockIWebhookDeliveryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIWebhookDeliveryRepository) EXPECT() *MockIWebhookDeliveryRepository_Expecter {
+	return &MockIWebhookDeliveryRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimNext provides a mock function for the type MockIWebhookDeliveryRepository
+func (_mock *MockIWebhookDeliveryRepository) ClaimNext(ctx context.Context, now time.Time, leaseUntil time.Time) (*domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, now, leaseUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimNext")
+	}
+
+	var r0 *domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) (*domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, now, leaseUntil)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) *domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, now, leaseUntil)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, now, leaseUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookDeliveryRepository_ClaimNext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimNext'
+type MockIWebhookDeliveryRepository_ClaimNext_Call struct {
+	*mock.Call
+}
+
+// ClaimNext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+func (_e *MockIWebhookDeliveryRepository_Expecter) ClaimNext(ctx interface{}, now interface{}, leaseUntil interface{}) *MockIWebhookDeliveryRepository_ClaimNext_Call {
+	return &MockIWebhookDeliveryRepository_ClaimNext_Call{Call: _e.mock.On("ClaimNext", ctx, now, leaseUntil)}
+}
+
+func (_c *MockIWebhookDeliveryRepository_ClaimNext_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time)) *MockIWebhookDeliveryRepository_ClaimNext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_ClaimNext_Call) Return(webhookDelivery *domain.WebhookDelivery, err error) *MockIWebhookDeliveryRepository_ClaimNext_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_ClaimNext_Call) RunAndReturn(run func(ctx context.Context, now time.Time, leaseUntil time.Time) (*domain.WebhookDelivery, error)) *MockIWebhookDeliveryRepository_ClaimNext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByWebhook provides a mock function for the type MockIWebhookDeliveryRepository
+func (_mock *MockIWebhookDeliveryRepository) DeleteByWebhook(ctx context.Context, webhookID string) error {
+	ret := _mock.Called(ctx, webhookID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, webhookID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIWebhookDeliveryRepository_DeleteByWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByWebhook'
+type MockIWebhookDeliveryRepository_DeleteByWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteByWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookID string
+func (_e *MockIWebhookDeliveryRepository_Expecter) DeleteByWebhook(ctx interface{}, webhookID interface{}) *MockIWebhookDeliveryRepository_DeleteByWebhook_Call {
+	return &MockIWebhookDeliveryRepository_DeleteByWebhook_Call{Call: _e.mock.On("DeleteByWebhook", ctx, webhookID)}
+}
+
+func (_c *MockIWebhookDeliveryRepository_DeleteByWebhook_Call) Run(run func(ctx context.Context, webhookID string)) *MockIWebhookDeliveryRepository_DeleteByWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_DeleteByWebhook_Call) Return(err error) *MockIWebhookDeliveryRepository_DeleteByWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_DeleteByWebhook_Call) RunAndReturn(run func(ctx context.Context, webhookID string) error) *MockIWebhookDeliveryRepository_DeleteByWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enqueue provides a mock function for the type MockIWebhookDeliveryRepository
+func (_mock *MockIWebhookDeliveryRepository) Enqueue(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	ret := _mock.Called(ctx, deliveries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enqueue")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*domain.WebhookDelivery) error); ok {
+		r0 = returnFunc(ctx, deliveries)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIWebhookDeliveryRepository_Enqueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enqueue'
+type MockIWebhookDeliveryRepository_Enqueue_Call struct {
+	*mock.Call
+}
+
+// Enqueue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveries []*domain.WebhookDelivery
+func (_e *MockIWebhookDeliveryRepository_Expecter) Enqueue(ctx interface{}, deliveries interface{}) *MockIWebhookDeliveryRepository_Enqueue_Call {
+	return &MockIWebhookDeliveryRepository_Enqueue_Call{Call: _e.mock.On("Enqueue", ctx, deliveries)}
+}
+
+func (_c *MockIWebhookDeliveryRepository_Enqueue_Call) Run(run func(ctx context.Context, deliveries []*domain.WebhookDelivery)) *MockIWebhookDeliveryRepository_Enqueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*domain.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].([]*domain.WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_Enqueue_Call) Return(err error) *MockIWebhookDeliveryRepository_Enqueue_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_Enqueue_Call) RunAndReturn(run func(ctx context.Context, deliveries []*domain.WebhookDelivery) error) *MockIWebhookDeliveryRepository_Enqueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockIWebhookDeliveryRepository
+func (_mock *MockIWebhookDeliveryRepository) Find(ctx context.Context, filter domain.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 []*domain.WebhookDelivery
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WebhookDeliveryFilter) []*domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.WebhookDeliveryFilter) int64); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.WebhookDeliveryFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIWebhookDeliveryRepository_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockIWebhookDeliveryRepository_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.WebhookDeliveryFilter
+func (_e *MockIWebhookDeliveryRepository_Expecter) Find(ctx interface{}, filter interface{}) *MockIWebhookDeliveryRepository_Find_Call {
+	return &MockIWebhookDeliveryRepository_Find_Call{Call: _e.mock.On("Find", ctx, filter)}
+}
+
+func (_c *MockIWebhookDeliveryRepository_Find_Call) Run(run func(ctx context.Context, filter domain.WebhookDeliveryFilter)) *MockIWebhookDeliveryRepository_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.WebhookDeliveryFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.WebhookDeliveryFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_Find_Call) Return(webhookDeliverys []*domain.WebhookDelivery, n int64, err error) *MockIWebhookDeliveryRepository_Find_Call {
+	_c.Call.Return(webhookDeliverys, n, err)
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_Find_Call) RunAndReturn(run func(ctx context.Context, filter domain.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error)) *MockIWebhookDeliveryRepository_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockIWebhookDeliveryRepository
+func (_mock *MockIWebhookDeliveryRepository) FindByID(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookDeliveryRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockIWebhookDeliveryRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIWebhookDeliveryRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockIWebhookDeliveryRepository_FindByID_Call {
+	return &MockIWebhookDeliveryRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockIWebhookDeliveryRepository_FindByID_Call) Run(run func(ctx context.Context, id string)) *MockIWebhookDeliveryRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_FindByID_Call) Return(webhookDelivery *domain.WebhookDelivery, err error) *MockIWebhookDeliveryRepository_FindByID_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.WebhookDelivery, error)) *MockIWebhookDeliveryRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIWebhookDeliveryRepository
+func (_mock *MockIWebhookDeliveryRepository) Update(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.WebhookDelivery) error); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIWebhookDeliveryRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIWebhookDeliveryRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *domain.WebhookDelivery
+func (_e *MockIWebhookDeliveryRepository_Expecter) Update(ctx interface{}, delivery interface{}) *MockIWebhookDeliveryRepository_Update_Call {
+	return &MockIWebhookDeliveryRepository_Update_Call{Call: _e.mock.On("Update", ctx, delivery)}
+}
+
+func (_c *MockIWebhookDeliveryRepository_Update_Call) Run(run func(ctx context.Context, delivery *domain.WebhookDelivery)) *MockIWebhookDeliveryRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].(*domain.WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_Update_Call) Return(err error) *MockIWebhookDeliveryRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIWebhookDeliveryRepository_Update_Call) RunAndReturn(run func(ctx context.Context, delivery *domain.WebhookDelivery) error) *MockIWebhookDeliveryRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
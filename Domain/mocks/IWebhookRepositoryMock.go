@@ -0,0 +1,408 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIWebhookRepository creates a new instance of MockIWebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIWebhookRepository {
+	mock := &MockIWebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIWebhookRepository is an autogenerated mock type for the IWebhookRepository type
+type MockIWebhookRepository struct {
+	mock.Mock
+}
+
+type MockIWebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIWebhookRepository) EXPECT() *MockIWebhookRepository_Expecter {
+	return &MockIWebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIWebhookRepository
+func (_mock *MockIWebhookRepository) Create(ctx context.Context, webhook *domain.Webhook) error {
+	ret := _mock.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Webhook) error); ok {
+		r0 = returnFunc(ctx, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIWebhookRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIWebhookRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *domain.Webhook
+func (_e *MockIWebhookRepository_Expecter) Create(ctx interface{}, webhook interface{}) *MockIWebhookRepository_Create_Call {
+	return &MockIWebhookRepository_Create_Call{Call: _e.mock.On("Create", ctx, webhook)}
+}
+
+func (_c *MockIWebhookRepository_Create_Call) Run(run func(ctx context.Context, webhook *domain.Webhook)) *MockIWebhookRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookRepository_Create_Call) Return(err error) *MockIWebhookRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIWebhookRepository_Create_Call) RunAndReturn(run func(ctx context.Context, webhook *domain.Webhook) error) *MockIWebhookRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIWebhookRepository
+func (_mock *MockIWebhookRepository) Delete(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIWebhookRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIWebhookRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIWebhookRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockIWebhookRepository_Delete_Call {
+	return &MockIWebhookRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockIWebhookRepository_Delete_Call) Run(run func(ctx context.Context, id string)) *MockIWebhookRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookRepository_Delete_Call) Return(err error) *MockIWebhookRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIWebhookRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockIWebhookRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockIWebhookRepository
+func (_mock *MockIWebhookRepository) FindAll(ctx context.Context) ([]*domain.Webhook, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []*domain.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*domain.Webhook, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*domain.Webhook); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockIWebhookRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIWebhookRepository_Expecter) FindAll(ctx interface{}) *MockIWebhookRepository_FindAll_Call {
+	return &MockIWebhookRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+}
+
+func (_c *MockIWebhookRepository_FindAll_Call) Run(run func(ctx context.Context)) *MockIWebhookRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookRepository_FindAll_Call) Return(webhooks []*domain.Webhook, err error) *MockIWebhookRepository_FindAll_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockIWebhookRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context) ([]*domain.Webhook, error)) *MockIWebhookRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockIWebhookRepository
+func (_mock *MockIWebhookRepository) FindByID(ctx context.Context, id string) (*domain.Webhook, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Webhook, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Webhook); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookRepository_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockIWebhookRepository_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockIWebhookRepository_Expecter) FindByID(ctx interface{}, id interface{}) *MockIWebhookRepository_FindByID_Call {
+	return &MockIWebhookRepository_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockIWebhookRepository_FindByID_Call) Run(run func(ctx context.Context, id string)) *MockIWebhookRepository_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookRepository_FindByID_Call) Return(webhook *domain.Webhook, err error) *MockIWebhookRepository_FindByID_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *MockIWebhookRepository_FindByID_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Webhook, error)) *MockIWebhookRepository_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSubscribed provides a mock function for the type MockIWebhookRepository
+func (_mock *MockIWebhookRepository) FindSubscribed(ctx context.Context, event domain.WebhookEventType) ([]*domain.Webhook, error) {
+	ret := _mock.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSubscribed")
+	}
+
+	var r0 []*domain.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WebhookEventType) ([]*domain.Webhook, error)); ok {
+		return returnFunc(ctx, event)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WebhookEventType) []*domain.Webhook); ok {
+		r0 = returnFunc(ctx, event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.WebhookEventType) error); ok {
+		r1 = returnFunc(ctx, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookRepository_FindSubscribed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSubscribed'
+type MockIWebhookRepository_FindSubscribed_Call struct {
+	*mock.Call
+}
+
+// FindSubscribed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event domain.WebhookEventType
+func (_e *MockIWebhookRepository_Expecter) FindSubscribed(ctx interface{}, event interface{}) *MockIWebhookRepository_FindSubscribed_Call {
+	return &MockIWebhookRepository_FindSubscribed_Call{Call: _e.mock.On("FindSubscribed", ctx, event)}
+}
+
+func (_c *MockIWebhookRepository_FindSubscribed_Call) Run(run func(ctx context.Context, event domain.WebhookEventType)) *MockIWebhookRepository_FindSubscribed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.WebhookEventType
+		if args[1] != nil {
+			arg1 = args[1].(domain.WebhookEventType)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookRepository_FindSubscribed_Call) Return(webhooks []*domain.Webhook, err error) *MockIWebhookRepository_FindSubscribed_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockIWebhookRepository_FindSubscribed_Call) RunAndReturn(run func(ctx context.Context, event domain.WebhookEventType) ([]*domain.Webhook, error)) *MockIWebhookRepository_FindSubscribed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIWebhookRepository
+func (_mock *MockIWebhookRepository) Update(ctx context.Context, webhook *domain.Webhook) error {
+	ret := _mock.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Webhook) error); ok {
+		r0 = returnFunc(ctx, webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIWebhookRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIWebhookRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook *domain.Webhook
+func (_e *MockIWebhookRepository_Expecter) Update(ctx interface{}, webhook interface{}) *MockIWebhookRepository_Update_Call {
+	return &MockIWebhookRepository_Update_Call{Call: _e.mock.On("Update", ctx, webhook)}
+}
+
+func (_c *MockIWebhookRepository_Update_Call) Run(run func(ctx context.Context, webhook *domain.Webhook)) *MockIWebhookRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookRepository_Update_Call) Return(err error) *MockIWebhookRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIWebhookRepository_Update_Call) RunAndReturn(run func(ctx context.Context, webhook *domain.Webhook) error) *MockIWebhookRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,698 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package domain_mocks
+
+import (
+	"context"
+	"g6/blog-api/Domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIWebhookUsecase creates a new instance of MockIWebhookUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIWebhookUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIWebhookUsecase {
+	mock := &MockIWebhookUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIWebhookUsecase is an autogenerated mock type for the IWebhookUsecase type
+type MockIWebhookUsecase struct {
+	mock.Mock
+}
+
+type MockIWebhookUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIWebhookUsecase) EXPECT() *MockIWebhookUsecase_Expecter {
+	return &MockIWebhookUsecase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) Create(webhook *domain.Webhook) (*domain.Webhook, error) {
+	ret := _mock.Called(webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*domain.Webhook) (*domain.Webhook, error)); ok {
+		return returnFunc(webhook)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*domain.Webhook) *domain.Webhook); ok {
+		r0 = returnFunc(webhook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*domain.Webhook) error); ok {
+		r1 = returnFunc(webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookUsecase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIWebhookUsecase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - webhook *domain.Webhook
+func (_e *MockIWebhookUsecase_Expecter) Create(webhook interface{}) *MockIWebhookUsecase_Create_Call {
+	return &MockIWebhookUsecase_Create_Call{Call: _e.mock.On("Create", webhook)}
+}
+
+func (_c *MockIWebhookUsecase_Create_Call) Run(run func(webhook *domain.Webhook)) *MockIWebhookUsecase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *domain.Webhook
+		if args[0] != nil {
+			arg0 = args[0].(*domain.Webhook)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Create_Call) Return(webhook1 *domain.Webhook, err error) *MockIWebhookUsecase_Create_Call {
+	_c.Call.Return(webhook1, err)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Create_Call) RunAndReturn(run func(webhook *domain.Webhook) (*domain.Webhook, error)) *MockIWebhookUsecase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) Delete(id string) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIWebhookUsecase_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIWebhookUsecase_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id string
+func (_e *MockIWebhookUsecase_Expecter) Delete(id interface{}) *MockIWebhookUsecase_Delete_Call {
+	return &MockIWebhookUsecase_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockIWebhookUsecase_Delete_Call) Run(run func(id string)) *MockIWebhookUsecase_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Delete_Call) Return(err error) *MockIWebhookUsecase_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Delete_Call) RunAndReturn(run func(id string) error) *MockIWebhookUsecase_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Deliveries provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) Deliveries(filter domain.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deliveries")
+	}
+
+	var r0 []*domain.WebhookDelivery
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(domain.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(domain.WebhookDeliveryFilter) []*domain.WebhookDelivery); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(domain.WebhookDeliveryFilter) int64); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(domain.WebhookDeliveryFilter) error); ok {
+		r2 = returnFunc(filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIWebhookUsecase_Deliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deliveries'
+type MockIWebhookUsecase_Deliveries_Call struct {
+	*mock.Call
+}
+
+// Deliveries is a helper method to define mock.On call
+//   - filter domain.WebhookDeliveryFilter
+func (_e *MockIWebhookUsecase_Expecter) Deliveries(filter interface{}) *MockIWebhookUsecase_Deliveries_Call {
+	return &MockIWebhookUsecase_Deliveries_Call{Call: _e.mock.On("Deliveries", filter)}
+}
+
+func (_c *MockIWebhookUsecase_Deliveries_Call) Run(run func(filter domain.WebhookDeliveryFilter)) *MockIWebhookUsecase_Deliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.WebhookDeliveryFilter
+		if args[0] != nil {
+			arg0 = args[0].(domain.WebhookDeliveryFilter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Deliveries_Call) Return(webhookDeliverys []*domain.WebhookDelivery, n int64, err error) *MockIWebhookUsecase_Deliveries_Call {
+	_c.Call.Return(webhookDeliverys, n, err)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Deliveries_Call) RunAndReturn(run func(filter domain.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error)) *MockIWebhookUsecase_Deliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Dispatch provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) Dispatch(event domain.WebhookEventType, data any) error {
+	ret := _mock.Called(event, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dispatch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(domain.WebhookEventType, any) error); ok {
+		r0 = returnFunc(event, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIWebhookUsecase_Dispatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dispatch'
+type MockIWebhookUsecase_Dispatch_Call struct {
+	*mock.Call
+}
+
+// Dispatch is a helper method to define mock.On call
+//   - event domain.WebhookEventType
+//   - data any
+func (_e *MockIWebhookUsecase_Expecter) Dispatch(event interface{}, data interface{}) *MockIWebhookUsecase_Dispatch_Call {
+	return &MockIWebhookUsecase_Dispatch_Call{Call: _e.mock.On("Dispatch", event, data)}
+}
+
+func (_c *MockIWebhookUsecase_Dispatch_Call) Run(run func(event domain.WebhookEventType, data any)) *MockIWebhookUsecase_Dispatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.WebhookEventType
+		if args[0] != nil {
+			arg0 = args[0].(domain.WebhookEventType)
+		}
+		var arg1 any
+		if args[1] != nil {
+			arg1 = args[1].(any)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Dispatch_Call) Return(err error) *MockIWebhookUsecase_Dispatch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Dispatch_Call) RunAndReturn(run func(event domain.WebhookEventType, data any) error) *MockIWebhookUsecase_Dispatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) FindByID(id string) (*domain.Webhook, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *domain.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.Webhook, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.Webhook); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookUsecase_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockIWebhookUsecase_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - id string
+func (_e *MockIWebhookUsecase_Expecter) FindByID(id interface{}) *MockIWebhookUsecase_FindByID_Call {
+	return &MockIWebhookUsecase_FindByID_Call{Call: _e.mock.On("FindByID", id)}
+}
+
+func (_c *MockIWebhookUsecase_FindByID_Call) Run(run func(id string)) *MockIWebhookUsecase_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_FindByID_Call) Return(webhook *domain.Webhook, err error) *MockIWebhookUsecase_FindByID_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_FindByID_Call) RunAndReturn(run func(id string) (*domain.Webhook, error)) *MockIWebhookUsecase_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDelivery provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) FindDelivery(webhookID string, id string) (*domain.WebhookDelivery, error) {
+	ret := _mock.Called(webhookID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDelivery")
+	}
+
+	var r0 *domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*domain.WebhookDelivery, error)); ok {
+		return returnFunc(webhookID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *domain.WebhookDelivery); ok {
+		r0 = returnFunc(webhookID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(webhookID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookUsecase_FindDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDelivery'
+type MockIWebhookUsecase_FindDelivery_Call struct {
+	*mock.Call
+}
+
+// FindDelivery is a helper method to define mock.On call
+//   - webhookID string
+//   - id string
+func (_e *MockIWebhookUsecase_Expecter) FindDelivery(webhookID interface{}, id interface{}) *MockIWebhookUsecase_FindDelivery_Call {
+	return &MockIWebhookUsecase_FindDelivery_Call{Call: _e.mock.On("FindDelivery", webhookID, id)}
+}
+
+func (_c *MockIWebhookUsecase_FindDelivery_Call) Run(run func(webhookID string, id string)) *MockIWebhookUsecase_FindDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_FindDelivery_Call) Return(webhookDelivery *domain.WebhookDelivery, err error) *MockIWebhookUsecase_FindDelivery_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_FindDelivery_Call) RunAndReturn(run func(webhookID string, id string) (*domain.WebhookDelivery, error)) *MockIWebhookUsecase_FindDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) List() ([]*domain.Webhook, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*domain.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*domain.Webhook, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*domain.Webhook); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookUsecase_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockIWebhookUsecase_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+func (_e *MockIWebhookUsecase_Expecter) List() *MockIWebhookUsecase_List_Call {
+	return &MockIWebhookUsecase_List_Call{Call: _e.mock.On("List")}
+}
+
+func (_c *MockIWebhookUsecase_List_Call) Run(run func()) *MockIWebhookUsecase_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_List_Call) Return(webhooks []*domain.Webhook, err error) *MockIWebhookUsecase_List_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_List_Call) RunAndReturn(run func() ([]*domain.Webhook, error)) *MockIWebhookUsecase_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessDue provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) ProcessDue(ctx context.Context) (int, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessDue")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookUsecase_ProcessDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessDue'
+type MockIWebhookUsecase_ProcessDue_Call struct {
+	*mock.Call
+}
+
+// ProcessDue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIWebhookUsecase_Expecter) ProcessDue(ctx interface{}) *MockIWebhookUsecase_ProcessDue_Call {
+	return &MockIWebhookUsecase_ProcessDue_Call{Call: _e.mock.On("ProcessDue", ctx)}
+}
+
+func (_c *MockIWebhookUsecase_ProcessDue_Call) Run(run func(ctx context.Context)) *MockIWebhookUsecase_ProcessDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_ProcessDue_Call) Return(n int, err error) *MockIWebhookUsecase_ProcessDue_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_ProcessDue_Call) RunAndReturn(run func(ctx context.Context) (int, error)) *MockIWebhookUsecase_ProcessDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendTest provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) SendTest(id string) (*domain.WebhookDelivery, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendTest")
+	}
+
+	var r0 *domain.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.WebhookDelivery, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.WebhookDelivery); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookUsecase_SendTest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendTest'
+type MockIWebhookUsecase_SendTest_Call struct {
+	*mock.Call
+}
+
+// SendTest is a helper method to define mock.On call
+//   - id string
+func (_e *MockIWebhookUsecase_Expecter) SendTest(id interface{}) *MockIWebhookUsecase_SendTest_Call {
+	return &MockIWebhookUsecase_SendTest_Call{Call: _e.mock.On("SendTest", id)}
+}
+
+func (_c *MockIWebhookUsecase_SendTest_Call) Run(run func(id string)) *MockIWebhookUsecase_SendTest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_SendTest_Call) Return(webhookDelivery *domain.WebhookDelivery, err error) *MockIWebhookUsecase_SendTest_Call {
+	_c.Call.Return(webhookDelivery, err)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_SendTest_Call) RunAndReturn(run func(id string) (*domain.WebhookDelivery, error)) *MockIWebhookUsecase_SendTest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) Update(id string, webhook *domain.Webhook) (*domain.Webhook, error) {
+	ret := _mock.Called(id, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *domain.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, *domain.Webhook) (*domain.Webhook, error)); ok {
+		return returnFunc(id, webhook)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, *domain.Webhook) *domain.Webhook); ok {
+		r0 = returnFunc(id, webhook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, *domain.Webhook) error); ok {
+		r1 = returnFunc(id, webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIWebhookUsecase_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIWebhookUsecase_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - id string
+//   - webhook *domain.Webhook
+func (_e *MockIWebhookUsecase_Expecter) Update(id interface{}, webhook interface{}) *MockIWebhookUsecase_Update_Call {
+	return &MockIWebhookUsecase_Update_Call{Call: _e.mock.On("Update", id, webhook)}
+}
+
+func (_c *MockIWebhookUsecase_Update_Call) Run(run func(id string, webhook *domain.Webhook)) *MockIWebhookUsecase_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 *domain.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(*domain.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Update_Call) Return(webhook1 *domain.Webhook, err error) *MockIWebhookUsecase_Update_Call {
+	_c.Call.Return(webhook1, err)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Update_Call) RunAndReturn(run func(id string, webhook *domain.Webhook) (*domain.Webhook, error)) *MockIWebhookUsecase_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wake provides a mock function for the type MockIWebhookUsecase
+func (_mock *MockIWebhookUsecase) Wake() <-chan struct{} {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wake")
+	}
+
+	var r0 <-chan struct{}
+	if returnFunc, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+	return r0
+}
+
+// MockIWebhookUsecase_Wake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wake'
+type MockIWebhookUsecase_Wake_Call struct {
+	*mock.Call
+}
+
+// Wake is a helper method to define mock.On call
+func (_e *MockIWebhookUsecase_Expecter) Wake() *MockIWebhookUsecase_Wake_Call {
+	return &MockIWebhookUsecase_Wake_Call{Call: _e.mock.On("Wake")}
+}
+
+func (_c *MockIWebhookUsecase_Wake_Call) Run(run func()) *MockIWebhookUsecase_Wake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Wake_Call) Return(valCh <-chan struct{}) *MockIWebhookUsecase_Wake_Call {
+	_c.Call.Return(valCh)
+	return _c
+}
+
+func (_c *MockIWebhookUsecase_Wake_Call) RunAndReturn(run func() <-chan struct{}) *MockIWebhookUsecase_Wake_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type NotificationType string
+
+const (
+	NotificationPostComment NotificationType = "post_comment" // someone commented on the recipient's post
+	NotificationPostLike    NotificationType = "post_like"    // someone liked the recipient's post
+	NotificationFollow      NotificationType = "follow"       // someone followed the recipient's posts
+)
+
+// NotificationTypes lists every type, a user can turn each of them off
+var NotificationTypes = []NotificationType{
+	NotificationPostComment,
+	NotificationPostLike,
+	NotificationFollow,
+}
+
+// Notification tells the recipient that the actor did something to a target of theirs, a
+// comment, a post or, for a follow, the recipient. PostID is the post the target belongs to, for
+// linking to it.
+type Notification struct {
+	ID          string
+	RecipientID string
+	ActorID     string
+	Type        NotificationType
+	TargetType  string
+	TargetID    string
+	PostID      string
+	Read        bool
+	ReadAt      time.Time
+	CreatedAt   time.Time
+}
+
+type NotificationFilter struct {
+	RecipientID string
+	UnreadOnly  bool
+	Page        int
+	PageSize    int
+}
+
+// NotificationPreferences are the types a user turned off, every other type is delivered
+type NotificationPreferences struct {
+	UserID    string
+	Disabled  []NotificationType
+	UpdatedAt time.Time
+}
+
+type INotificationUsecase interface {
+	// Notify delivers the notification unless the actor is the recipient or the recipient
+	// turned its type off
+	Notify(notification *Notification) error
+	// List returns the recipient's notifications newest first, with the total number of matches
+	List(filter NotificationFilter) ([]*Notification, int64, error)
+	UnreadCount(userID string) (int64, error)
+	MarkRead(userID, id string) error
+	// MarkAllRead returns how many notifications were unread
+	MarkAllRead(userID string) (int64, error)
+	// Preferences tells for every type whether it is delivered
+	Preferences(userID string) (map[NotificationType]bool, error)
+	// UpdatePreferences changes the given types and returns the preferences of every type
+	UpdatePreferences(userID string, enabled map[NotificationType]bool) (map[NotificationType]bool, error)
+}
+
+type INotificationRepository interface {
+	// Create stores the notification, an unread one of the same actor, type and target is
+	// brought back to the top instead of repeated
+	Create(ctx context.Context, notification *Notification) error
+	Find(ctx context.Context, filter NotificationFilter) ([]*Notification, int64, error)
+	CountUnread(ctx context.Context, recipientID string) (int64, error)
+	// MarkRead returns ErrNotificationNotFound unless the notification belongs to the recipient
+	MarkRead(ctx context.Context, recipientID, id string, readAt time.Time) error
+	MarkAllRead(ctx context.Context, recipientID string, readAt time.Time) (int64, error)
+	// FindPreferences returns ErrNotFound when the user never changed them
+	FindPreferences(ctx context.Context, userID string) (*NotificationPreferences, error)
+	SavePreferences(ctx context.Context, preferences *NotificationPreferences) error
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// OIDCIdentity is what an OpenID Connect provider tells us about the user, taken from the verified ID token
+type OIDCIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	GivenName     string
+	FamilyName    string
+	Picture       string
+}
+
+// OIDCLoginState is kept between the redirect to the provider and the callback.
+// It is looked up by State and can be used once.
+type OIDCLoginState struct {
+	State        string
+	Provider     string
+	Nonce        string
+	CodeVerifier string // PKCE verifier, the provider only ever sees its S256 challenge
+	LinkUserID   string // set when a logged in user links the provider instead of logging in
+	// set with LinkUserID when the login re-confirms the user before this provider is linked
+	LinkProvider string
+	// set with LinkUserID when the login re-confirms the user before this provider is unlinked
+	UnlinkProvider string
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+}
+
+// OIDCLoginResult is the outcome of a provider callback
+type OIDCLoginResult struct {
+	User     *User
+	Created  bool            // a new account was registered
+	Linked   *LinkedIdentity // set when the callback linked the provider to the logged in user
+	Unlinked string          // the provider unlinked after the callback re-confirmed the logged in user
+	// set when the callback re-confirmed the logged in user, the link continues at this provider URL
+	LinkURL   string
+	LinkState string
+}
+
+type IOIDCProvider interface {
+	Name() string
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	// Exchange redeems the code and returns the identity from the verified ID token
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*OIDCIdentity, error)
+}
+
+type IOIDCUsecase interface {
+	Providers() []string
+	HasProvider(name string) bool
+	// Begin returns the provider URL to redirect the browser to and the state bound to it
+	Begin(provider string) (authURL string, state string, err error)
+	// Complete finishes the login, registering the user on first login, or finishes a link started with BeginLink
+	Complete(provider, state, code string) (*OIDCLoginResult, error)
+
+	LoginMethods(userID string) (*LoginMethods, error)
+	// BeginLink starts linking a provider to the user's account, the password re-confirms the user.
+	// An account without a password confirms with confirmWith, another of its linked providers, instead.
+	// Complete then answers with the URL of the provider to link.
+	BeginLink(userID, provider, password, confirmWith string) (authURL string, state string, err error)
+	Unlink(userID, provider, password string) error
+	// BeginUnlink re-confirms an account without a password by a login with confirmWith, another of its
+	// linked providers. Complete unlinks the provider once that login matches the account.
+	BeginUnlink(userID, provider, confirmWith string) (authURL string, state string, err error)
+}
+
+type IOIDCStateRepository interface {
+	Save(ctx context.Context, state *OIDCLoginState) error
+	// Consume returns the state and deletes it, so a state cannot be replayed
+	Consume(ctx context.Context, state string) (*OIDCLoginState, error)
+}
@@ -5,24 +5,38 @@ import (
 	"time"
 )
 
+// OTPPurpose scopes a one-time code to the flow it was sent for, a code can only be redeemed
+// for its own purpose
+type OTPPurpose string
+
+const (
+	OTPPurposeEmailVerification OTPPurpose = "email_verification"
+	OTPPurposeLogin             OTPPurpose = "login"
+	OTPPurposeSensitiveAction   OTPPurpose = "sensitive_action"
+)
+
+// OTP is the pending code of one email and purpose. Attempts counts the codes sent,
+// FailedAttempts the wrong guesses at the current code.
 type OTP struct {
-	ID        string
-	Email     string
-	CodeHash  string
-	ExpiresAt time.Time
-	Attempts  int
-	CreatedAt time.Time
+	ID             string
+	Email          string
+	Purpose        OTPPurpose
+	CodeHash       string
+	ExpiresAt      time.Time
+	Attempts       int
+	FailedAttempts int
+	CreatedAt      time.Time
 }
 
 type IOTPUsecase interface {
-	RequestOTP(email string) error
-	VerifyOTP(email, code string) (*OTP, error)
+	RequestOTP(email string, purpose OTPPurpose) error
+	VerifyOTP(email string, purpose OTPPurpose, code string) (*OTP, error)
 	DeleteByID(id string) error
 }
 
 type IOTPRepository interface {
 	SaveOTP(ctx context.Context, otp *OTP) error
-	FindOTPByEmail(ctx context.Context, email string) (*OTP, error)
+	FindOTPByEmailAndPurpose(ctx context.Context, email string, purpose OTPPurpose) (*OTP, error)
 	DeleteOTPByID(ctx context.Context, id string) error
 	UpdateOTPByID(ctx context.Context, otp *OTP) error
 }
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// PasswordRule names one requirement of the password policy, clients use it to point at what failed
+type PasswordRule string
+
+const (
+	PasswordRuleMinLength   PasswordRule = "min_length"
+	PasswordRuleMaxLength   PasswordRule = "max_length"
+	PasswordRuleUppercase   PasswordRule = "uppercase"
+	PasswordRuleLowercase   PasswordRule = "lowercase"
+	PasswordRuleDigit       PasswordRule = "digit"
+	PasswordRuleSymbol      PasswordRule = "symbol"
+	PasswordRuleNotReused   PasswordRule = "not_reused"
+	PasswordRuleNotBreached PasswordRule = "not_breached"
+)
+
+type PasswordViolation struct {
+	Rule    PasswordRule `json:"rule"`
+	Message string       `json:"message"`
+}
+
+// PasswordPolicyError lists every rule a new password failed, not only the first one
+type PasswordPolicyError struct {
+	Violations []PasswordViolation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Message)
+	}
+	return "password does not meet the policy: " + strings.Join(messages, "; ")
+}
+
+// PasswordHistory is a hash of a password a user has set, kept to refuse reusing it
+type PasswordHistory struct {
+	ID           string
+	UserID       string
+	PasswordHash string
+	CreatedAt    time.Time
+}
+
+type IPasswordPolicy interface {
+	// Validate checks a new password. For an existing user it is also compared with their
+	// current and recent passwords. A failed check returns a *PasswordPolicyError.
+	Validate(ctx context.Context, user *User, password string) error
+	// Remember records the hash the user has just set, for the reuse check
+	Remember(ctx context.Context, userID, passwordHash string) error
+}
+
+type IPasswordHistoryRepository interface {
+	Create(ctx context.Context, entry *PasswordHistory) error
+	// FindRecent returns the user's newest entries first
+	FindRecent(ctx context.Context, userID string, limit int) ([]*PasswordHistory, error)
+}
+
+// IBreachedPasswordChecker reports passwords that appear in known data breaches
+type IBreachedPasswordChecker interface {
+	IsBreached(password string) (bool, error)
+}
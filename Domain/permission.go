@@ -0,0 +1,85 @@
+package domain
+
+import "context"
+
+// Permission is a single capability granted to roles by the policy
+type Permission string
+
+const (
+	PermPostRead      Permission = "post:read"
+	PermPostCreate    Permission = "post:create"
+	PermPostUpdateOwn Permission = "post:update:own"
+	PermPostDeleteOwn Permission = "post:delete:own"
+	PermPostDeleteAny Permission = "post:delete:any"
+
+	PermCommentCreate    Permission = "comment:create"
+	PermCommentUpdateOwn Permission = "comment:update:own"
+	PermCommentDeleteOwn Permission = "comment:delete:own"
+	PermCommentModerate  Permission = "comment:moderate" // edit or delete any comment
+
+	PermUserPromote    Permission = "user:promote"     // promote users to admin
+	PermUserRoleManage Permission = "user:role:manage" // any role change, superadmin included
+	PermUserBan        Permission = "user:ban"
+
+	PermSecurityPolicyManage Permission = "security:policy:manage"
+	PermTokenAdminScope      Permission = "token:scope:admin" // create personal access tokens with the admin scope
+
+	PermAccountLockManage Permission = "security:lock:manage" // list and lift login lockouts
+	PermSecurityAuditRead Permission = "security:audit:read"  // search the security audit log of all users
+
+	PermEmailTemplatePreview Permission = "email:template:preview"
+	PermEmailOutboxManage    Permission = "email:outbox:manage" // list queued emails and retry dead ones
+
+	PermWebhookManage Permission = "webhook:manage" // subscribe webhooks and read their delivery log
+
+	PermTagManage      Permission = "tag:manage"      // edit, rename and merge tags, which rewrites the posts
+	PermCategoryManage Permission = "category:manage" // create, move and delete categories
+)
+
+// Action is something done to a resource. The policy decides per action which
+// permission allows it on any resource and which only on the actor's own.
+type Action string
+
+const (
+	ActionPostUpdate    Action = "post:update"
+	ActionPostDelete    Action = "post:delete"
+	ActionCommentUpdate Action = "comment:update"
+	ActionCommentDelete Action = "comment:delete"
+)
+
+// Resource is the target of an action, OwnerID is the user it belongs to
+type Resource struct {
+	Type    string
+	ID      string
+	OwnerID string
+}
+
+// Actor is the caller of a request. Scopes is nil for browser sessions
+// and holds the token scopes for requests made with a personal access token.
+type Actor struct {
+	UserID string
+	Role   UserRole
+	Scopes []TokenScope
+}
+
+// ActorFromContext reads the caller that AuthMiddleware stored on the request context
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	userID, _ := ctx.Value("user_id").(string)
+	if userID == "" {
+		return Actor{}, false
+	}
+	role, _ := ctx.Value("role").(string)
+	scopes, _ := ctx.Value("token_scopes").([]TokenScope)
+	return Actor{UserID: userID, Role: UserRole(role), Scopes: scopes}, true
+}
+
+type IPolicy interface {
+	// Permissions lists what the role grants
+	Permissions(role UserRole) []Permission
+	// HasPermission reports whether the actor's role, and its token scopes if any, grant the permission
+	HasPermission(actor Actor, permission Permission) bool
+	// Can reports whether the actor on ctx may perform the action on the resource
+	Can(ctx context.Context, action Action, resource Resource) bool
+	// CanChangeRole reports whether the actor may move a user from one role to another
+	CanChangeRole(actor Actor, from, to UserRole) bool
+}
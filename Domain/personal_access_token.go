@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// TokenScope limits what a personal access token may do
+type TokenScope string
+
+const (
+	ScopeReadPosts     TokenScope = "read:posts"
+	ScopeWritePosts    TokenScope = "write:posts"
+	ScopeWriteComments TokenScope = "write:comments"
+	ScopeAdmin         TokenScope = "admin"
+)
+
+// PersonalAccessTokenPrefix marks a bearer token as a personal access token rather than a JWT
+const PersonalAccessTokenPrefix = "g6pat_"
+
+func (s TokenScope) IsValid() bool {
+	switch s {
+	case ScopeReadPosts, ScopeWritePosts, ScopeWriteComments, ScopeAdmin:
+		return true
+	}
+	return false
+}
+
+type PersonalAccessToken struct {
+	ID         string
+	UserID     string
+	Name       string
+	Token      string // raw token, only set when the token is created and never stored
+	TokenHash  string
+	Scopes     []TokenScope
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	LastUsedIP string
+	CreatedAt  time.Time
+}
+
+// HasScope reports whether the token grants the scope, admin grants every scope
+func (t *PersonalAccessToken) HasScope(scope TokenScope) bool {
+	for _, s := range t.Scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+type IPersonalAccessTokenUsecase interface {
+	Create(userID, name string, scopes []TokenScope, expiresIn time.Duration) (*PersonalAccessToken, error)
+	List(userID string) ([]*PersonalAccessToken, error)
+	Revoke(userID, id string) error
+	Authenticate(token, ip string) (*PersonalAccessToken, *User, error)
+}
+
+type IPersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *PersonalAccessToken) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*PersonalAccessToken, error)
+	FindByUserID(ctx context.Context, userID string) ([]*PersonalAccessToken, error)
+	CountByUserID(ctx context.Context, userID string) (int64, error)
+	Delete(ctx context.Context, userID, id string) error
+	UpdateLastUsed(ctx context.Context, id string, usedAt time.Time, ip string) error
+}
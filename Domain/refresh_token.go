@@ -17,12 +17,22 @@ type PasswordResetToken struct {
 	CreatedAt time.Time
 }
 
+// RefreshToken is one link of a login session. Every refresh issues a new token in the
+// same family and marks the old one used; only the hash of the token is ever stored.
 type RefreshToken struct {
-	Token     string
-	UserID    string
-	ExpiresAt time.Time
-	Revoked   bool
-	CreatedAt time.Time
+	ID         string
+	FamilyID   string
+	Token      string // raw value, only set in memory when the token is issued
+	TokenHash  string
+	UserID     string
+	UserAgent  string
+	IP         string
+	ExpiresAt  time.Time
+	Revoked    bool
+	Used       bool
+	UsedAt     time.Time
+	CreatedAt  time.Time
+	LastUsedAt time.Time
 }
 
 type RefreshTokenResponse struct {
@@ -36,22 +46,29 @@ type IAuthService interface {
 	GenerateTokens(user User) (RefreshTokenResponse, error)
 	ValidateToken(tokenString string) (jwt.MapClaims, error)
 	ValidateRefreshToken(token string) (jwt.MapClaims, error)
+	GeneratePreAuthToken(user User) (string, time.Time, error)
+	ValidatePreAuthToken(token string) (jwt.MapClaims, error)
 }
 
 type IRefreshTokenUsecase interface {
 	FindByToken(token string) (*RefreshToken, error)
 	Save(token *RefreshToken) error
 	DeleteByUserID(userID string) error
-	ReplaceToken(token *RefreshToken) error
+	Rotate(current *RefreshToken, next *RefreshToken) error
+	ReportReuse(token *RefreshToken, ip, userAgent string) error
 	RevokedToken(token *RefreshToken) error
-	FindByUserID(id string) (*RefreshToken, error)
+	ListSessions(userID string) ([]*RefreshToken, error)
+	RevokeSession(userID, sessionID string) error
+	RevokeOtherSessions(userID, currentSessionID string) (int64, error)
 }
 
 type IRefreshTokenRepository interface {
 	Save(ctx context.Context, token *RefreshToken) error
-	FindByToken(ctx context.Context, token string) (*RefreshToken, error)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
 	DeleteByUserID(ctx context.Context, userID string) error
-	ReplaceTokenByUserID(ctx context.Context, token *RefreshToken) error
-	RevokeToken(ctx context.Context, token string) error
-	FindTokenByUserID(ctx context.Context, token string) (*RefreshToken, error)
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) error
+	RevokeFamily(ctx context.Context, familyID string) error
+	FindActiveByUserID(ctx context.Context, userID string) ([]*RefreshToken, error)
+	RevokeByID(ctx context.Context, userID, familyID string) error
+	RevokeAllExcept(ctx context.Context, userID, exceptFamilyID string) (int64, error)
 }
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type SecurityEventType string
+
+const (
+	SecurityEventRefreshTokenReuse SecurityEventType = "refresh_token_reuse"
+	SecurityEventAccountLocked     SecurityEventType = "account_locked"
+	SecurityEventLogin             SecurityEventType = "login"
+	SecurityEventPasswordChange    SecurityEventType = "password_change"
+	SecurityEventPasswordReset     SecurityEventType = "password_reset"
+	SecurityEventRoleChange        SecurityEventType = "role_change"
+	SecurityEventOTPVerification   SecurityEventType = "otp_verification"
+	SecurityEventTokenRevoked      SecurityEventType = "token_revoked"
+)
+
+type SecurityEventOutcome string
+
+const (
+	SecurityEventSuccess SecurityEventOutcome = "success"
+	SecurityEventFailure SecurityEventOutcome = "failure"
+)
+
+// SecurityEvent is an entry of the append-only audit log of security relevant actions.
+// UserID is the account the event concerns, ActorID who caused it: the same user, an admin
+// changing someone's role, or nobody for a failed login.
+type SecurityEvent struct {
+	ID        string
+	Type      SecurityEventType
+	ActorID   string
+	UserID    string
+	Outcome   SecurityEventOutcome
+	IP        string
+	UserAgent string
+	Details   string
+	CreatedAt time.Time
+}
+
+// SecurityEventFilter narrows the audit log, zero fields match everything
+type SecurityEventFilter struct {
+	UserID   string
+	ActorID  string
+	Types    []SecurityEventType
+	Outcome  SecurityEventOutcome
+	IP       string
+	From     time.Time
+	To       time.Time
+	Page     int
+	PageSize int
+}
+
+type ISecurityEventUsecase interface {
+	// Record appends an event to the audit log
+	Record(event *SecurityEvent) error
+	// Search returns the matching events newest first, with the total number of matches
+	Search(filter SecurityEventFilter) ([]*SecurityEvent, int64, error)
+	// RecentActivity returns the events concerning one user, newest first
+	RecentActivity(userID string, page, pageSize int) ([]*SecurityEvent, int64, error)
+}
+
+type ISecurityEventRepository interface {
+	Record(ctx context.Context, event *SecurityEvent) error
+	Find(ctx context.Context, filter SecurityEventFilter) ([]*SecurityEvent, int64, error)
+}
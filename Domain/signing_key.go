@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type SigningAlgorithm string
+
+const (
+	SigningAlgorithmRS256 SigningAlgorithm = "RS256"
+	SigningAlgorithmEdDSA SigningAlgorithm = "EdDSA"
+)
+
+// SigningKey is one key pair of the JWT key set. Only the newest active key signs;
+// retired keys keep verifying (and stay in the JWKS) until RetireAt passes.
+type SigningKey struct {
+	Kid              string
+	Algorithm        SigningAlgorithm
+	PrivateKeyCipher string // PKCS8 PEM, encrypted at rest
+	PublicKeyPEM     string
+	Active           bool
+	CreatedAt        time.Time
+	RetiredAt        time.Time
+	ExpiresAt        time.Time // zero while active, retired keys are dropped after this
+}
+
+type ISigningKeyRepository interface {
+	Save(ctx context.Context, key *SigningKey) error
+	FindValid(ctx context.Context, now time.Time) ([]*SigningKey, error)
+	RetireActive(ctx context.Context, exceptKid string, retiredAt, expiresAt time.Time) (int64, error)
+}
+
+// JSONWebKey is the public half of a signing key as published in the JWKS (RFC 7517)
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+type IJWKSProvider interface {
+	JWKS(ctx context.Context) (*JSONWebKeySet, error)
+}
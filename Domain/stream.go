@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type StreamEventType string
+
+const (
+	StreamEventNotification   StreamEventType = "notification"
+	StreamEventCommentCreated StreamEventType = "comment_created"
+	StreamEventCommentUpdated StreamEventType = "comment_updated"
+	StreamEventCommentDeleted StreamEventType = "comment_deleted"
+	StreamEventReactions      StreamEventType = "reactions"
+	// StreamEventReset tells a resuming client that events were missed, it should reload instead
+	StreamEventReset StreamEventType = "reset"
+)
+
+// StreamEvent is one event of a live stream. Its ID orders the events of a topic, a client that
+// reconnects with the ID of the last event it got receives the ones after it.
+type StreamEvent struct {
+	ID   string          `json:"id"`
+	Type StreamEventType `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// NotificationTopic is the stream of the notifications of one user
+func NotificationTopic(userID string) string {
+	return fmt.Sprintf("user:%s:notifications", userID)
+}
+
+// PostTopic is the stream of the comments and reaction counts of one post
+func PostTopic(postID string) string {
+	return fmt.Sprintf("post:%s", postID)
+}
+
+// the data of the stream events
+
+type NotificationStreamData struct {
+	ID         string           `json:"id"`
+	Type       NotificationType `json:"type"`
+	ActorID    string           `json:"actor_id"`
+	TargetType string           `json:"target_type"`
+	TargetID   string           `json:"target_id"`
+	PostID     string           `json:"post_id,omitempty"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+type CommentStreamData struct {
+	ID        string    `json:"id"`
+	BlogID    string    `json:"blog_id"`
+	AuthorID  string    `json:"author_id,omitempty"`
+	Comment   string    `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitzero"`
+}
+
+type ReactionsStreamData struct {
+	BlogID   string `json:"blog_id"`
+	Likes    int    `json:"likes"`
+	Dislikes int    `json:"dislikes"`
+}
+
+// IStreamUsecase carries live events to the clients connected to any server
+type IStreamUsecase interface {
+	// Publish sends an event to every subscriber of the topic, data is encoded as JSON
+	Publish(topic string, eventType StreamEventType, data any) error
+	// Subscribe returns the events of the topic until ctx is done. With lastEventID it first
+	// replays the events after it that are still kept. The channel is closed early when the
+	// subscriber falls behind, it should reconnect with the last ID it got.
+	Subscribe(ctx context.Context, topic, lastEventID string) (<-chan StreamEvent, error)
+	// Listen receives the events published by every server and hands them to the local
+	// subscribers, until ctx is done
+	Listen(ctx context.Context) error
+}
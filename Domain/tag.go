@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Tag is a canonical tag. Posts store its slug, the synonyms are slugs that stand for it, so
+// `golang` and `go-lang` can be written for `go`. UsageCount is the number of posts with the tag.
+type Tag struct {
+	ID          string
+	Name        string
+	Slug        string
+	Description string
+	Synonyms    []string
+	UsageCount  int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TagSlug is the lowercase form of a tag name with every run of other characters than letters
+// and digits made a single dash, `Go Lang!` is `go-lang`
+func TagSlug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return b.String()
+}
+
+type ITagUsecase interface {
+	// Normalize returns the canonical slugs of the tags of a post, without repeats. Tags that
+	// are not known yet are created.
+	Normalize(ctx context.Context, names []string) ([]string, error)
+	// Resolve returns the canonical slugs of the tags to search for, unknown tags are kept
+	// as their slug
+	Resolve(ctx context.Context, names []string) ([]string, error)
+	// RefreshUsage recounts the posts of the tags
+	RefreshUsage(ctx context.Context, slugs []string) error
+
+	Autocomplete(prefix string, limit int) ([]*Tag, error)
+	Popular(limit int) ([]*Tag, error)
+	// Get finds a tag by its slug or one of its synonyms
+	Get(slug string) (*Tag, error)
+	Create(tag *Tag) (*Tag, error)
+	// Update replaces the description and synonyms of a tag
+	Update(slug string, tag *Tag) (*Tag, error)
+	// Rename gives the tag a new name and slug, the old slug becomes a synonym
+	Rename(slug, name string) (*Tag, error)
+	// Merge makes source a synonym of target, its posts are tagged with target instead
+	Merge(source, target string) (*Tag, error)
+}
+
+type ITagRepository interface {
+	Create(ctx context.Context, tag *Tag) error // ErrTagConflict when the slug is taken
+	// FindBySlug finds the tag with the slug or the synonym, ErrTagNotFound when there is none
+	FindBySlug(ctx context.Context, slug string) (*Tag, error)
+	// Search finds the tags whose slug or a synonym starts with prefix, most used first
+	Search(ctx context.Context, prefix string, limit int) ([]*Tag, error)
+	Popular(ctx context.Context, limit int) ([]*Tag, error)
+	Update(ctx context.Context, tag *Tag) error
+	Delete(ctx context.Context, id string) error
+	SetUsageCount(ctx context.Context, slug string, count int) error
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type TwoFactor struct {
+	UserID             string
+	SecretCipher       string // TOTP secret, encrypted at rest
+	Enabled            bool
+	RecoveryCodeHashes []string
+	LastUsedStep       int64 // last accepted TOTP time step, prevents replaying a code
+	ConfirmedAt        time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type TwoFactorEnrollment struct {
+	Secret     string
+	OTPAuthURI string
+}
+
+// TwoFactorPolicy lists the roles that must use two-factor authentication to log in
+type TwoFactorPolicy struct {
+	RequiredRoles []UserRole
+	UpdatedBy     string
+	UpdatedAt     time.Time
+}
+
+type ITwoFactorUsecase interface {
+	Enroll(userID string) (*TwoFactorEnrollment, error)
+	Confirm(userID, code string) ([]string, error)
+	Verify(userID, code string) error
+	Disable(userID, code string) error
+	RegenerateRecoveryCodes(userID, code string) ([]string, error)
+	IsEnabled(userID string) (bool, error)
+	IsRequired(role UserRole) (bool, error)
+	GetPolicy() (*TwoFactorPolicy, error)
+	UpdatePolicy(policy *TwoFactorPolicy) error
+}
+
+type ITwoFactorRepository interface {
+	Upsert(ctx context.Context, twoFactor *TwoFactor) error
+	FindByUserID(ctx context.Context, userID string) (*TwoFactor, error)
+	DeleteByUserID(ctx context.Context, userID string) error
+	GetPolicy(ctx context.Context) (*TwoFactorPolicy, error)
+	SavePolicy(ctx context.Context, policy *TwoFactorPolicy) error
+}
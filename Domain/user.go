@@ -19,6 +19,7 @@ type User struct {
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 	Provider   string
+	Language   string // preferred language of emails, a BCP 47 tag such as "fr" or "en-GB"
 }
 
 type UserRole string
@@ -33,6 +34,7 @@ type UserProfileUpdate struct {
 	FirstName  string
 	LastName   string
 	Bio        string
+	Language   string
 	AvatarData []byte
 }
 
@@ -44,6 +46,9 @@ type IUserUsecase interface {
 	UpdateUser(id string, user *User) (*User, error)
 	UpdateProfile(userID string, update UserProfileUpdate, fileName string) (*User, error)
 	ChangePassword(userID, oldPassword, newPassword string) error
+	// RehashPassword stores a new hash of the password the user has just logged in with,
+	// when their stored hash predates the configured algorithm or parameters
+	RehashPassword(user *User, password string) error
 
 	// anti
 	Register(request *User) error
@@ -68,5 +73,7 @@ type IUserRepository interface {
 	FindByUsernameOrEmail(context.Context, string) (User, error)
 	InvalidateTokens(context.Context, string) error
 	ChangeRole(context.Context, string, string, string) error
+	// UpdatePassword only replaces the password hash, the rest of the user is left as stored
+	UpdatePassword(ctx context.Context, userID, hash string) error
 }
 
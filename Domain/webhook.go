@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type WebhookEventType string
+
+const (
+	WebhookPostPublished  WebhookEventType = "post.published"
+	WebhookPostUpdated    WebhookEventType = "post.updated"
+	WebhookPostDeleted    WebhookEventType = "post.deleted"
+	WebhookCommentCreated WebhookEventType = "comment.created"
+	WebhookTest           WebhookEventType = "webhook.test" // sent on an admin's request, whatever the webhook subscribed to
+)
+
+// WebhookEventTypes lists the events a webhook can subscribe to
+var WebhookEventTypes = []WebhookEventType{
+	WebhookPostPublished,
+	WebhookPostUpdated,
+	WebhookPostDeleted,
+	WebhookCommentCreated,
+}
+
+// WebhookSecretPrefix marks a generated webhook secret
+const WebhookSecretPrefix = "whsec_"
+
+// Webhook is a URL told about the events it subscribed to. Every delivery is signed with
+// its Secret, an inactive webhook gets nothing.
+type Webhook struct {
+	ID          string
+	URL         string
+	Secret      string
+	Events      []WebhookEventType
+	Active      bool
+	Description string
+	CreatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySending   WebhookDeliveryStatus = "sending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryDead      WebhookDeliveryStatus = "dead" // gave up after the last attempt
+)
+
+// WebhookAttempt is one request of a delivery, StatusCode is 0 when no response came back
+type WebhookAttempt struct {
+	At         time.Time
+	StatusCode int
+	Error      string
+	Duration   time.Duration
+}
+
+// WebhookDelivery is an event on its way to one webhook. Payload is the exact body that is
+// signed and sent, EventID is the same for every webhook told about the event.
+type WebhookDelivery struct {
+	ID            string
+	WebhookID     string
+	EventID       string
+	Event         WebhookEventType
+	Payload       string
+	Status        WebhookDeliveryStatus
+	Attempts      []WebhookAttempt
+	NextAttemptAt time.Time // for a sending delivery, when its claim runs out
+	DeliveredAt   time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// WebhookDeliveryFilter narrows a webhook's delivery log, zero fields match everything
+type WebhookDeliveryFilter struct {
+	WebhookID string
+	Status    WebhookDeliveryStatus
+	Event     WebhookEventType
+	Page      int
+	PageSize  int
+}
+
+// WebhookPostData is the data of the post events, post.deleted carries the post as it was
+type WebhookPostData struct {
+	ID         string    `json:"id"`
+	Title      string    `json:"title"`
+	Content    string    `json:"content"`
+	AuthorID   string    `json:"author_id"`
+	AuthorName string    `json:"author_name,omitempty"`
+	Tags       []string  `json:"tags"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type WebhookCommentData struct {
+	ID        string    `json:"id"`
+	BlogID    string    `json:"blog_id"`
+	AuthorID  string    `json:"author_id"`
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IWebhookUsecase manages the webhooks and delivers the blog's events to them, a worker sends
+// the deliveries with retries
+type IWebhookUsecase interface {
+	// Create generates a secret when the webhook has none
+	Create(webhook *Webhook) (*Webhook, error)
+	List() ([]*Webhook, error)
+	FindByID(id string) (*Webhook, error)
+	// Update replaces the webhook's settings, an empty Secret keeps the current one
+	Update(id string, webhook *Webhook) (*Webhook, error)
+	// Delete removes the webhook and its delivery log
+	Delete(id string) error
+	// Dispatch queues the event for every active webhook subscribed to it
+	Dispatch(event WebhookEventType, data any) error
+	// SendTest sends a webhook.test event right away and returns the delivery with its outcome,
+	// it is not retried
+	SendTest(id string) (*WebhookDelivery, error)
+	Deliveries(filter WebhookDeliveryFilter) ([]*WebhookDelivery, int64, error)
+	FindDelivery(webhookID, id string) (*WebhookDelivery, error)
+	// ProcessDue sends the deliveries that are due and returns how many it attempted
+	ProcessDue(ctx context.Context) (int, error)
+	// Wake is signalled whenever a delivery is queued
+	Wake() <-chan struct{}
+}
+
+type IWebhookRepository interface {
+	Create(ctx context.Context, webhook *Webhook) error
+	FindByID(ctx context.Context, id string) (*Webhook, error)
+	FindAll(ctx context.Context) ([]*Webhook, error)
+	// FindSubscribed returns the active webhooks subscribed to the event
+	FindSubscribed(ctx context.Context, event WebhookEventType) ([]*Webhook, error)
+	Update(ctx context.Context, webhook *Webhook) error
+	Delete(ctx context.Context, id string) error
+}
+
+type IWebhookDeliveryRepository interface {
+	Enqueue(ctx context.Context, deliveries []*WebhookDelivery) error
+	// ClaimNext marks the longest due delivery as sending until leaseUntil and returns it, or nil
+	// when none is due. Deliveries whose claim ran out, because a worker died, are due again.
+	ClaimNext(ctx context.Context, now, leaseUntil time.Time) (*WebhookDelivery, error)
+	Update(ctx context.Context, delivery *WebhookDelivery) error
+	FindByID(ctx context.Context, id string) (*WebhookDelivery, error)
+	Find(ctx context.Context, filter WebhookDeliveryFilter) ([]*WebhookDelivery, int64, error)
+	DeleteByWebhook(ctx context.Context, webhookID string) error
+}
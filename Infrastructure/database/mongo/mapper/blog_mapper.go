@@ -14,6 +14,7 @@ type BlogPostModel struct {
 	AuthorID        primitive.ObjectID `bson:"author_id"`
 	AuthorName      string             `bson:"author_name"` // for easy access to author's name: first_name + last_name
 	Tags            []string           `bson:"tags,omitempty"`
+	CategoryID      primitive.ObjectID `bson:"category_id,omitempty"` // posts from before categories have none
 	CreatedAt       primitive.DateTime `bson:"created_at"`
 	UpdatedAt       primitive.DateTime `bson:"updated_at"`
 	Likes           int                `bson:"likes"`
@@ -53,6 +54,14 @@ func (b *BlogPostModel) Parse(bp *domain.BlogPost) error {
 	b.AuthorID = authorID
 	b.AuthorName = bp.AuthorName
 	b.Tags = bp.Tags
+	b.CategoryID = primitive.NilObjectID
+	if bp.CategoryID != "" {
+		categoryID, err := primitive.ObjectIDFromHex(bp.CategoryID)
+		if err != nil {
+			return fmt.Errorf("invalid category ID: %w", err)
+		}
+		b.CategoryID = categoryID
+	}
 	b.CreatedAt = primitive.NewDateTimeFromTime(bp.CreatedAt)
 	b.UpdatedAt = primitive.NewDateTimeFromTime(bp.UpdatedAt)
 	b.Likes = bp.Likes
@@ -64,6 +73,10 @@ func (b *BlogPostModel) Parse(bp *domain.BlogPost) error {
 }
 
 func (b *BlogPostModel) ToDomain() *domain.BlogPost {
+	categoryID := ""
+	if !b.CategoryID.IsZero() {
+		categoryID = b.CategoryID.Hex()
+	}
 	return &domain.BlogPost{
 		ID:              b.ID.Hex(),
 		Title:           b.Title,
@@ -71,6 +84,7 @@ func (b *BlogPostModel) ToDomain() *domain.BlogPost {
 		AuthorID:        b.AuthorID.Hex(),
 		AuthorName:      b.AuthorName,
 		Tags:            b.Tags,
+		CategoryID:      categoryID,
 		CreatedAt:       b.CreatedAt.Time(),
 		UpdatedAt:       b.UpdatedAt.Time(),
 		Likes:           b.Likes,
@@ -0,0 +1,66 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CategoryDB struct {
+	ID          primitive.ObjectID   `bson:"_id,omitempty"`
+	Name        string               `bson:"name"`
+	Slug        string               `bson:"slug"`
+	Description string               `bson:"description,omitempty"`
+	ParentID    primitive.ObjectID   `bson:"parent_id,omitempty"`
+	Ancestors   []primitive.ObjectID `bson:"ancestors"`
+	CreatedAt   time.Time            `bson:"created_at"`
+	UpdatedAt   time.Time            `bson:"updated_at"`
+}
+
+func CategoryFromDomain(category *domain.Category) *CategoryDB {
+	id := primitive.NewObjectID()
+	if category.ID != "" {
+		if parsed, err := primitive.ObjectIDFromHex(category.ID); err == nil {
+			id = parsed
+		}
+	}
+	parentID, _ := primitive.ObjectIDFromHex(category.ParentID)
+	ancestors := make([]primitive.ObjectID, 0, len(category.Ancestors))
+	for _, ancestor := range category.Ancestors {
+		if ancestorID, err := primitive.ObjectIDFromHex(ancestor); err == nil {
+			ancestors = append(ancestors, ancestorID)
+		}
+	}
+	return &CategoryDB{
+		ID:          id,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		ParentID:    parentID,
+		Ancestors:   ancestors,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+	}
+}
+
+func CategoryToDomain(category *CategoryDB) *domain.Category {
+	parentID := ""
+	if !category.ParentID.IsZero() {
+		parentID = category.ParentID.Hex()
+	}
+	ancestors := make([]string, 0, len(category.Ancestors))
+	for _, ancestor := range category.Ancestors {
+		ancestors = append(ancestors, ancestor.Hex())
+	}
+	return &domain.Category{
+		ID:          category.ID.Hex(),
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		ParentID:    parentID,
+		Ancestors:   ancestors,
+		CreatedAt:   category.CreatedAt,
+		UpdatedAt:   category.UpdatedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+type DigestSubscriptionDB struct {
+	UserID     string    `bson:"user_id"`
+	Frequency  string    `bson:"frequency"`
+	Authors    []string  `bson:"authors"`
+	Tags       []string  `bson:"tags"`
+	LastSentAt time.Time `bson:"last_sent_at"`
+	NextSendAt time.Time `bson:"next_send_at"`
+	UpdatedAt  time.Time `bson:"updated_at"`
+}
+
+func DigestSubscriptionFromDomain(subscription *domain.DigestSubscription) *DigestSubscriptionDB {
+	authors := subscription.Authors
+	if authors == nil {
+		authors = []string{}
+	}
+	tags := subscription.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return &DigestSubscriptionDB{
+		UserID:     subscription.UserID,
+		Frequency:  string(subscription.Frequency),
+		Authors:    authors,
+		Tags:       tags,
+		LastSentAt: subscription.LastSentAt,
+		NextSendAt: subscription.NextSendAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+}
+
+func DigestSubscriptionToDomain(subscription *DigestSubscriptionDB) *domain.DigestSubscription {
+	return &domain.DigestSubscription{
+		UserID:     subscription.UserID,
+		Frequency:  domain.DigestFrequency(subscription.Frequency),
+		Authors:    subscription.Authors,
+		Tags:       subscription.Tags,
+		LastSentAt: subscription.LastSentAt,
+		NextSendAt: subscription.NextSendAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type OutboxEmailDB struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	To            string             `bson:"to"`
+	Subject       string             `bson:"subject,omitempty"`
+	Body          string             `bson:"body,omitempty"`
+	Template      string             `bson:"template,omitempty"`
+	Data          map[string]any     `bson:"data,omitempty"`
+	Status        string             `bson:"status"`
+	Attempts      int                `bson:"attempts"`
+	LastError     string             `bson:"last_error,omitempty"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`
+	SentAt        time.Time          `bson:"sent_at,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at"`
+}
+
+func OutboxEmailFromDomain(email *domain.OutboxEmail) *OutboxEmailDB {
+	id := primitive.NewObjectID()
+	if email.ID != "" {
+		if parsed, err := primitive.ObjectIDFromHex(email.ID); err == nil {
+			id = parsed
+		}
+	}
+	return &OutboxEmailDB{
+		ID:            id,
+		To:            email.To,
+		Subject:       email.Subject,
+		Body:          email.Body,
+		Template:      email.Template,
+		Data:          email.Data,
+		Status:        string(email.Status),
+		Attempts:      email.Attempts,
+		LastError:     email.LastError,
+		NextAttemptAt: email.NextAttemptAt,
+		SentAt:        email.SentAt,
+		CreatedAt:     email.CreatedAt,
+		UpdatedAt:     email.UpdatedAt,
+	}
+}
+
+func OutboxEmailToDomain(email *OutboxEmailDB) *domain.OutboxEmail {
+	return &domain.OutboxEmail{
+		ID:            email.ID.Hex(),
+		To:            email.To,
+		Subject:       email.Subject,
+		Body:          email.Body,
+		Template:      email.Template,
+		Data:          email.Data,
+		Status:        domain.OutboxEmailStatus(email.Status),
+		Attempts:      email.Attempts,
+		LastError:     email.LastError,
+		NextAttemptAt: email.NextAttemptAt,
+		SentAt:        email.SentAt,
+		CreatedAt:     email.CreatedAt,
+		UpdatedAt:     email.UpdatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DomainEventDB struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	Type          string             `bson:"type"`
+	AggregateID   string             `bson:"aggregate_id"`
+	Payload       string             `bson:"payload"`
+	Status        string             `bson:"status"`
+	Delivered     []string           `bson:"delivered"`
+	Attempts      int                `bson:"attempts"`
+	LastError     string             `bson:"last_error,omitempty"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at"`
+}
+
+func DomainEventFromDomain(event *domain.DomainEvent) *DomainEventDB {
+	id := primitive.NewObjectID()
+	if event.ID != "" {
+		if parsed, err := primitive.ObjectIDFromHex(event.ID); err == nil {
+			id = parsed
+		}
+	}
+	delivered := event.Delivered
+	if delivered == nil {
+		delivered = []string{}
+	}
+	return &DomainEventDB{
+		ID:            id,
+		Type:          string(event.Type),
+		AggregateID:   event.AggregateID,
+		Payload:       event.Payload,
+		Status:        string(event.Status),
+		Delivered:     delivered,
+		Attempts:      event.Attempts,
+		LastError:     event.LastError,
+		NextAttemptAt: event.NextAttemptAt,
+		CreatedAt:     event.CreatedAt,
+		UpdatedAt:     event.UpdatedAt,
+	}
+}
+
+func DomainEventToDomain(event *DomainEventDB) *domain.DomainEvent {
+	return &domain.DomainEvent{
+		ID:            event.ID.Hex(),
+		Type:          domain.DomainEventType(event.Type),
+		AggregateID:   event.AggregateID,
+		Payload:       event.Payload,
+		Status:        domain.DomainEventStatus(event.Status),
+		Delivered:     event.Delivered,
+		Attempts:      event.Attempts,
+		LastError:     event.LastError,
+		NextAttemptAt: event.NextAttemptAt,
+		CreatedAt:     event.CreatedAt,
+		UpdatedAt:     event.UpdatedAt,
+	}
+}
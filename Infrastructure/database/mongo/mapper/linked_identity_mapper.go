@@ -0,0 +1,46 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type LinkedIdentityDB struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	UserID      string             `bson:"user_id"`
+	Provider    string             `bson:"provider"`
+	Subject     string             `bson:"subject"`
+	Email       string             `bson:"email"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	LastLoginAt time.Time          `bson:"last_login_at,omitempty"`
+}
+
+func LinkedIdentityFromDomain(identity *domain.LinkedIdentity) *LinkedIdentityDB {
+	createdAt := identity.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return &LinkedIdentityDB{
+		ID:          primitive.NewObjectID(),
+		UserID:      identity.UserID,
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		Email:       identity.Email,
+		CreatedAt:   createdAt,
+		LastLoginAt: identity.LastLoginAt,
+	}
+}
+
+func LinkedIdentityToDomain(identity *LinkedIdentityDB) *domain.LinkedIdentity {
+	return &domain.LinkedIdentity{
+		ID:          identity.ID.Hex(),
+		UserID:      identity.UserID,
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		Email:       identity.Email,
+		CreatedAt:   identity.CreatedAt,
+		LastLoginAt: identity.LastLoginAt,
+	}
+}
@@ -0,0 +1,49 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MagicLinkDB struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Email       string             `bson:"email"`
+	UserID      string             `bson:"user_id,omitempty"`
+	TokenHash   string             `bson:"token_hash"`
+	BindingHash string             `bson:"binding_hash"`
+	IP          string             `bson:"ip"`
+	ExpiresAt   time.Time          `bson:"expires_at"`
+	CreatedAt   time.Time          `bson:"created_at"`
+}
+
+func MagicLinkFromDomain(link *domain.MagicLink) *MagicLinkDB {
+	createdAt := link.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return &MagicLinkDB{
+		ID:          primitive.NewObjectID(),
+		Email:       link.Email,
+		UserID:      link.UserID,
+		TokenHash:   link.TokenHash,
+		BindingHash: link.BindingHash,
+		IP:          link.IP,
+		ExpiresAt:   link.ExpiresAt,
+		CreatedAt:   createdAt,
+	}
+}
+
+func MagicLinkToDomain(link *MagicLinkDB) *domain.MagicLink {
+	return &domain.MagicLink{
+		ID:          link.ID.Hex(),
+		Email:       link.Email,
+		UserID:      link.UserID,
+		TokenHash:   link.TokenHash,
+		BindingHash: link.BindingHash,
+		IP:          link.IP,
+		ExpiresAt:   link.ExpiresAt,
+		CreatedAt:   link.CreatedAt,
+	}
+}
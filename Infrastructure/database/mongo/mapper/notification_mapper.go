@@ -0,0 +1,85 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type NotificationDB struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	RecipientID string             `bson:"recipient_id"`
+	ActorID     string             `bson:"actor_id"`
+	Type        string             `bson:"type"`
+	TargetType  string             `bson:"target_type"`
+	TargetID    string             `bson:"target_id"`
+	PostID      string             `bson:"post_id,omitempty"`
+	Read        bool               `bson:"read"`
+	ReadAt      time.Time          `bson:"read_at,omitempty"`
+	CreatedAt   time.Time          `bson:"created_at"`
+}
+
+func NotificationFromDomain(notification *domain.Notification) *NotificationDB {
+	createdAt := notification.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return &NotificationDB{
+		ID:          primitive.NewObjectID(),
+		RecipientID: notification.RecipientID,
+		ActorID:     notification.ActorID,
+		Type:        string(notification.Type),
+		TargetType:  notification.TargetType,
+		TargetID:    notification.TargetID,
+		PostID:      notification.PostID,
+		Read:        notification.Read,
+		ReadAt:      notification.ReadAt,
+		CreatedAt:   createdAt,
+	}
+}
+
+func NotificationToDomain(notification *NotificationDB) *domain.Notification {
+	return &domain.Notification{
+		ID:          notification.ID.Hex(),
+		RecipientID: notification.RecipientID,
+		ActorID:     notification.ActorID,
+		Type:        domain.NotificationType(notification.Type),
+		TargetType:  notification.TargetType,
+		TargetID:    notification.TargetID,
+		PostID:      notification.PostID,
+		Read:        notification.Read,
+		ReadAt:      notification.ReadAt,
+		CreatedAt:   notification.CreatedAt,
+	}
+}
+
+type NotificationPreferencesDB struct {
+	UserID    string    `bson:"user_id"`
+	Disabled  []string  `bson:"disabled"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+func NotificationPreferencesFromDomain(preferences *domain.NotificationPreferences) *NotificationPreferencesDB {
+	disabled := make([]string, 0, len(preferences.Disabled))
+	for _, t := range preferences.Disabled {
+		disabled = append(disabled, string(t))
+	}
+	return &NotificationPreferencesDB{
+		UserID:    preferences.UserID,
+		Disabled:  disabled,
+		UpdatedAt: preferences.UpdatedAt,
+	}
+}
+
+func NotificationPreferencesToDomain(preferences *NotificationPreferencesDB) *domain.NotificationPreferences {
+	disabled := make([]domain.NotificationType, 0, len(preferences.Disabled))
+	for _, t := range preferences.Disabled {
+		disabled = append(disabled, domain.NotificationType(t))
+	}
+	return &domain.NotificationPreferences{
+		UserID:    preferences.UserID,
+		Disabled:  disabled,
+		UpdatedAt: preferences.UpdatedAt,
+	}
+}
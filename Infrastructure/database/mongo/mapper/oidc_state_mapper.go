@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type OIDCStateDB struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	State          string             `bson:"state"`
+	Provider       string             `bson:"provider"`
+	Nonce          string             `bson:"nonce"`
+	CodeVerifier   string             `bson:"code_verifier"`
+	LinkUserID     string             `bson:"link_user_id,omitempty"`
+	LinkProvider   string             `bson:"link_provider,omitempty"`
+	UnlinkProvider string             `bson:"unlink_provider,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	ExpiresAt      time.Time          `bson:"expires_at"`
+}
+
+func OIDCStateFromDomain(state *domain.OIDCLoginState) *OIDCStateDB {
+	createdAt := state.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return &OIDCStateDB{
+		ID:             primitive.NewObjectID(),
+		State:          state.State,
+		Provider:       state.Provider,
+		Nonce:          state.Nonce,
+		CodeVerifier:   state.CodeVerifier,
+		LinkUserID:     state.LinkUserID,
+		LinkProvider:   state.LinkProvider,
+		UnlinkProvider: state.UnlinkProvider,
+		CreatedAt:      createdAt,
+		ExpiresAt:      state.ExpiresAt,
+	}
+}
+
+func OIDCStateToDomain(state *OIDCStateDB) *domain.OIDCLoginState {
+	return &domain.OIDCLoginState{
+		State:          state.State,
+		Provider:       state.Provider,
+		Nonce:          state.Nonce,
+		CodeVerifier:   state.CodeVerifier,
+		LinkUserID:     state.LinkUserID,
+		LinkProvider:   state.LinkProvider,
+		UnlinkProvider: state.UnlinkProvider,
+		CreatedAt:      state.CreatedAt,
+		ExpiresAt:      state.ExpiresAt,
+	}
+}
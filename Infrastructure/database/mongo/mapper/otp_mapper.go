@@ -8,12 +8,14 @@ import (
 )
 
 type OtpDB struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	Email     string             `bson:"email"`
-	CodeHash  string             `bson:"code_hash"`
-	ExpiresAt time.Time          `bson:"expires_at"`
-	Attempts  int                `bson:"attempts"`
-	CreatedAt time.Time          `bson:"created_at"`
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	Email          string             `bson:"email"`
+	Purpose        string             `bson:"purpose"`
+	CodeHash       string             `bson:"code_hash"`
+	ExpiresAt      time.Time          `bson:"expires_at"`
+	Attempts       int                `bson:"attempts"`
+	FailedAttempts int                `bson:"failed_attempts"`
+	CreatedAt      time.Time          `bson:"created_at"`
 }
 
 // from otp to db model
@@ -27,23 +29,27 @@ func OtpFromDomain(otp *domain.OTP) *OtpDB {
 		}
 	}
 	return &OtpDB{
-		ID:        id,
-		Email:     otp.Email,
-		CodeHash:  otp.CodeHash,
-		ExpiresAt: otp.ExpiresAt,
-		Attempts:  otp.Attempts,
-		CreatedAt: time.Now(),
+		ID:             id,
+		Email:          otp.Email,
+		Purpose:        string(otp.Purpose),
+		CodeHash:       otp.CodeHash,
+		ExpiresAt:      otp.ExpiresAt,
+		Attempts:       otp.Attempts,
+		FailedAttempts: otp.FailedAttempts,
+		CreatedAt:      otp.CreatedAt,
 	}
 }
 
 // from db model to otp
 func OtpToDomain(otp *OtpDB) *domain.OTP {
 	return &domain.OTP{
-		ID:        otp.ID.Hex(),
-		Email:     otp.Email,
-		CodeHash:  otp.CodeHash,
-		ExpiresAt: otp.ExpiresAt,
-		Attempts:  otp.Attempts,
-		CreatedAt: otp.CreatedAt,
+		ID:             otp.ID.Hex(),
+		Email:          otp.Email,
+		Purpose:        domain.OTPPurpose(otp.Purpose),
+		CodeHash:       otp.CodeHash,
+		ExpiresAt:      otp.ExpiresAt,
+		Attempts:       otp.Attempts,
+		FailedAttempts: otp.FailedAttempts,
+		CreatedAt:      otp.CreatedAt,
 	}
 }
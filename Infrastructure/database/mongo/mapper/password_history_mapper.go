@@ -0,0 +1,37 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PasswordHistoryDB struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	UserID       string             `bson:"user_id"`
+	PasswordHash string             `bson:"password_hash"`
+	CreatedAt    time.Time          `bson:"created_at"`
+}
+
+func PasswordHistoryFromDomain(entry *domain.PasswordHistory) *PasswordHistoryDB {
+	createdAt := entry.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return &PasswordHistoryDB{
+		ID:           primitive.NewObjectID(),
+		UserID:       entry.UserID,
+		PasswordHash: entry.PasswordHash,
+		CreatedAt:    createdAt,
+	}
+}
+
+func PasswordHistoryToDomain(entry *PasswordHistoryDB) *domain.PasswordHistory {
+	return &domain.PasswordHistory{
+		ID:           entry.ID.Hex(),
+		UserID:       entry.UserID,
+		PasswordHash: entry.PasswordHash,
+		CreatedAt:    entry.CreatedAt,
+	}
+}
@@ -0,0 +1,61 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PersonalAccessTokenDB stores the token hash only, the raw token is shown once at creation
+type PersonalAccessTokenDB struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	UserID     string             `bson:"user_id"`
+	Name       string             `bson:"name"`
+	TokenHash  string             `bson:"token_hash"`
+	Scopes     []string           `bson:"scopes"`
+	ExpiresAt  time.Time          `bson:"expires_at"`
+	LastUsedAt time.Time          `bson:"last_used_at,omitempty"`
+	LastUsedIP string             `bson:"last_used_ip,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at"`
+}
+
+func PersonalAccessTokenFromDomain(token *domain.PersonalAccessToken) *PersonalAccessTokenDB {
+	createdAt := token.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	scopes := make([]string, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	return &PersonalAccessTokenDB{
+		ID:         primitive.NewObjectID(),
+		UserID:     token.UserID,
+		Name:       token.Name,
+		TokenHash:  token.TokenHash,
+		Scopes:     scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		LastUsedIP: token.LastUsedIP,
+		CreatedAt:  createdAt,
+	}
+}
+
+func PersonalAccessTokenToDomain(token *PersonalAccessTokenDB) *domain.PersonalAccessToken {
+	scopes := make([]domain.TokenScope, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, domain.TokenScope(scope))
+	}
+	return &domain.PersonalAccessToken{
+		ID:         token.ID.Hex(),
+		UserID:     token.UserID,
+		Name:       token.Name,
+		TokenHash:  token.TokenHash,
+		Scopes:     scopes,
+		ExpiresAt:  token.ExpiresAt,
+		LastUsedAt: token.LastUsedAt,
+		LastUsedIP: token.LastUsedIP,
+		CreatedAt:  token.CreatedAt,
+	}
+}
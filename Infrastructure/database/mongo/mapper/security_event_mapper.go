@@ -0,0 +1,52 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type SecurityEventDB struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Type      string             `bson:"type"`
+	ActorID   string             `bson:"actor_id,omitempty"`
+	UserID    string             `bson:"user_id"`
+	Outcome   string             `bson:"outcome,omitempty"`
+	IP        string             `bson:"ip"`
+	UserAgent string             `bson:"user_agent"`
+	Details   string             `bson:"details"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+func SecurityEventFromDomain(event *domain.SecurityEvent) *SecurityEventDB {
+	createdAt := event.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	return &SecurityEventDB{
+		ID:        primitive.NewObjectID(),
+		Type:      string(event.Type),
+		ActorID:   event.ActorID,
+		UserID:    event.UserID,
+		Outcome:   string(event.Outcome),
+		IP:        event.IP,
+		UserAgent: event.UserAgent,
+		Details:   event.Details,
+		CreatedAt: createdAt,
+	}
+}
+
+func SecurityEventToDomain(event *SecurityEventDB) *domain.SecurityEvent {
+	return &domain.SecurityEvent{
+		ID:        event.ID.Hex(),
+		Type:      domain.SecurityEventType(event.Type),
+		ActorID:   event.ActorID,
+		UserID:    event.UserID,
+		Outcome:   domain.SecurityEventOutcome(event.Outcome),
+		IP:        event.IP,
+		UserAgent: event.UserAgent,
+		Details:   event.Details,
+		CreatedAt: event.CreatedAt,
+	}
+}
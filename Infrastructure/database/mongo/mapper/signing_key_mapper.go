@@ -0,0 +1,43 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+type SigningKeyDB struct {
+	Kid              string    `bson:"_id"`
+	Algorithm        string    `bson:"algorithm"`
+	PrivateKeyCipher string    `bson:"private_key_cipher"`
+	PublicKeyPEM     string    `bson:"public_key_pem"`
+	Active           bool      `bson:"active"`
+	CreatedAt        time.Time `bson:"created_at"`
+	RetiredAt        time.Time `bson:"retired_at,omitempty"`
+	ExpiresAt        time.Time `bson:"expires_at,omitempty"`
+}
+
+func SigningKeyFromDomain(key *domain.SigningKey) *SigningKeyDB {
+	return &SigningKeyDB{
+		Kid:              key.Kid,
+		Algorithm:        string(key.Algorithm),
+		PrivateKeyCipher: key.PrivateKeyCipher,
+		PublicKeyPEM:     key.PublicKeyPEM,
+		Active:           key.Active,
+		CreatedAt:        key.CreatedAt,
+		RetiredAt:        key.RetiredAt,
+		ExpiresAt:        key.ExpiresAt,
+	}
+}
+
+func SigningKeyToDomain(key *SigningKeyDB) *domain.SigningKey {
+	return &domain.SigningKey{
+		Kid:              key.Kid,
+		Algorithm:        domain.SigningAlgorithm(key.Algorithm),
+		PrivateKeyCipher: key.PrivateKeyCipher,
+		PublicKeyPEM:     key.PublicKeyPEM,
+		Active:           key.Active,
+		CreatedAt:        key.CreatedAt,
+		RetiredAt:        key.RetiredAt,
+		ExpiresAt:        key.ExpiresAt,
+	}
+}
@@ -0,0 +1,59 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type TagDB struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Name        string             `bson:"name"`
+	Slug        string             `bson:"slug"`
+	Description string             `bson:"description,omitempty"`
+	Synonyms    []string           `bson:"synonyms"`
+	UsageCount  int                `bson:"usage_count"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
+}
+
+func TagFromDomain(tag *domain.Tag) *TagDB {
+	id := primitive.NewObjectID()
+	if tag.ID != "" {
+		if parsed, err := primitive.ObjectIDFromHex(tag.ID); err == nil {
+			id = parsed
+		}
+	}
+	synonyms := tag.Synonyms
+	if synonyms == nil {
+		synonyms = []string{}
+	}
+	return &TagDB{
+		ID:          id,
+		Name:        tag.Name,
+		Slug:        tag.Slug,
+		Description: tag.Description,
+		Synonyms:    synonyms,
+		UsageCount:  tag.UsageCount,
+		CreatedAt:   tag.CreatedAt,
+		UpdatedAt:   tag.UpdatedAt,
+	}
+}
+
+func TagToDomain(tag *TagDB) *domain.Tag {
+	synonyms := tag.Synonyms
+	if synonyms == nil {
+		synonyms = []string{}
+	}
+	return &domain.Tag{
+		ID:          tag.ID.Hex(),
+		Name:        tag.Name,
+		Slug:        tag.Slug,
+		Description: tag.Description,
+		Synonyms:    synonyms,
+		UsageCount:  tag.UsageCount,
+		CreatedAt:   tag.CreatedAt,
+		UpdatedAt:   tag.UpdatedAt,
+	}
+}
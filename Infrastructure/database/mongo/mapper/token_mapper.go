@@ -3,32 +3,66 @@ package mapper
 import (
 	domain "g6/blog-api/Domain"
 	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// RefreshTokenDB has no raw token field on purpose, only its hash is persisted
 type RefreshTokenDB struct {
-	Token     string    `bson:"token"`
-	UserID    string    `bson:"user_id"`
-	Revoked   bool      `bson:"revoked"`
-	ExpiresAt time.Time `bson:"expires_at"`
-	CreatedAt time.Time `bson:"created_at"`
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	FamilyID   string             `bson:"family_id"`
+	TokenHash  string             `bson:"token_hash"`
+	UserID     string             `bson:"user_id"`
+	UserAgent  string             `bson:"user_agent"`
+	IP         string             `bson:"ip"`
+	Revoked    bool               `bson:"revoked"`
+	Used       bool               `bson:"used"`
+	UsedAt     time.Time          `bson:"used_at,omitempty"`
+	ExpiresAt  time.Time          `bson:"expires_at"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	LastUsedAt time.Time          `bson:"last_used_at"`
 }
 
 func FromRefreshTokenEntityToDB(token *domain.RefreshToken) *RefreshTokenDB {
+	id := primitive.NewObjectID()
+	if token.ID != "" {
+		if oid, err := primitive.ObjectIDFromHex(token.ID); err == nil {
+			id = oid
+		}
+	}
+	createdAt := token.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
 	return &RefreshTokenDB{
-		Token:     token.Token,
-		UserID:    token.UserID,
-		Revoked:   token.Revoked,
-		ExpiresAt: token.ExpiresAt,
-		CreatedAt: time.Now(),
+		ID:         id,
+		FamilyID:   token.FamilyID,
+		TokenHash:  token.TokenHash,
+		UserID:     token.UserID,
+		UserAgent:  token.UserAgent,
+		IP:         token.IP,
+		Revoked:    token.Revoked,
+		Used:       token.Used,
+		UsedAt:     token.UsedAt,
+		ExpiresAt:  token.ExpiresAt,
+		CreatedAt:  createdAt,
+		LastUsedAt: token.LastUsedAt,
 	}
 }
 
 func FromRefreshTokenDBToEntity(tokenDB *RefreshTokenDB) *domain.RefreshToken {
 	return &domain.RefreshToken{
-		Token:     tokenDB.Token,
-		UserID:    tokenDB.UserID,
-		Revoked:   tokenDB.Revoked,
-		ExpiresAt: tokenDB.ExpiresAt,
-		CreatedAt: tokenDB.CreatedAt,
+		ID:         tokenDB.ID.Hex(),
+		FamilyID:   tokenDB.FamilyID,
+		TokenHash:  tokenDB.TokenHash,
+		UserID:     tokenDB.UserID,
+		UserAgent:  tokenDB.UserAgent,
+		IP:         tokenDB.IP,
+		Revoked:    tokenDB.Revoked,
+		Used:       tokenDB.Used,
+		UsedAt:     tokenDB.UsedAt,
+		ExpiresAt:  tokenDB.ExpiresAt,
+		CreatedAt:  tokenDB.CreatedAt,
+		LastUsedAt: tokenDB.LastUsedAt,
 	}
 }
@@ -0,0 +1,75 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+type TwoFactorDB struct {
+	UserID             string    `bson:"user_id"`
+	SecretCipher       string    `bson:"secret_cipher"`
+	Enabled            bool      `bson:"enabled"`
+	RecoveryCodeHashes []string  `bson:"recovery_code_hashes"`
+	LastUsedStep       int64     `bson:"last_used_step"`
+	ConfirmedAt        time.Time `bson:"confirmed_at"`
+	CreatedAt          time.Time `bson:"created_at"`
+	UpdatedAt          time.Time `bson:"updated_at"`
+}
+
+type TwoFactorPolicyDB struct {
+	ID            string    `bson:"_id"`
+	RequiredRoles []string  `bson:"required_roles"`
+	UpdatedBy     string    `bson:"updated_by"`
+	UpdatedAt     time.Time `bson:"updated_at"`
+}
+
+func TwoFactorFromDomain(twoFactor *domain.TwoFactor) *TwoFactorDB {
+	return &TwoFactorDB{
+		UserID:             twoFactor.UserID,
+		SecretCipher:       twoFactor.SecretCipher,
+		Enabled:            twoFactor.Enabled,
+		RecoveryCodeHashes: twoFactor.RecoveryCodeHashes,
+		LastUsedStep:       twoFactor.LastUsedStep,
+		ConfirmedAt:        twoFactor.ConfirmedAt,
+		CreatedAt:          twoFactor.CreatedAt,
+		UpdatedAt:          twoFactor.UpdatedAt,
+	}
+}
+
+func TwoFactorToDomain(twoFactor *TwoFactorDB) *domain.TwoFactor {
+	return &domain.TwoFactor{
+		UserID:             twoFactor.UserID,
+		SecretCipher:       twoFactor.SecretCipher,
+		Enabled:            twoFactor.Enabled,
+		RecoveryCodeHashes: twoFactor.RecoveryCodeHashes,
+		LastUsedStep:       twoFactor.LastUsedStep,
+		ConfirmedAt:        twoFactor.ConfirmedAt,
+		CreatedAt:          twoFactor.CreatedAt,
+		UpdatedAt:          twoFactor.UpdatedAt,
+	}
+}
+
+func TwoFactorPolicyFromDomain(id string, policy *domain.TwoFactorPolicy) *TwoFactorPolicyDB {
+	roles := make([]string, 0, len(policy.RequiredRoles))
+	for _, role := range policy.RequiredRoles {
+		roles = append(roles, string(role))
+	}
+	return &TwoFactorPolicyDB{
+		ID:            id,
+		RequiredRoles: roles,
+		UpdatedBy:     policy.UpdatedBy,
+		UpdatedAt:     policy.UpdatedAt,
+	}
+}
+
+func TwoFactorPolicyToDomain(policy *TwoFactorPolicyDB) *domain.TwoFactorPolicy {
+	roles := make([]domain.UserRole, 0, len(policy.RequiredRoles))
+	for _, role := range policy.RequiredRoles {
+		roles = append(roles, domain.UserRole(role))
+	}
+	return &domain.TwoFactorPolicy{
+		RequiredRoles: roles,
+		UpdatedBy:     policy.UpdatedBy,
+		UpdatedAt:     policy.UpdatedAt,
+	}
+}
@@ -20,6 +20,8 @@ type UserModel struct {
 	AvatarURL  string             `bson:"avatar_url"`
 	CreatedAt  time.Time          `bson:"created_at"`
 	UpdatedAt  time.Time          `bson:"updated_at"`
+	Provider   string             `bson:"provider,omitempty"`
+	Language   string             `bson:"language,omitempty"`
 }
 
 func UserToDomain(user *UserModel) *domain.User {
@@ -36,6 +38,8 @@ func UserToDomain(user *UserModel) *domain.User {
 		AvatarURL:  user.AvatarURL,
 		CreatedAt:  user.CreatedAt,
 		UpdatedAt:  user.UpdatedAt,
+		Provider:   user.Provider,
+		Language:   user.Language,
 	}
 }
 
@@ -53,6 +57,8 @@ func UserFromDomain(user *domain.User) *UserModel {
 		AvatarURL:  user.AvatarURL,
 		CreatedAt:  user.CreatedAt,
 		UpdatedAt:  user.UpdatedAt,
+		Provider:   user.Provider,
+		Language:   user.Language,
 	}
 }
 
@@ -0,0 +1,139 @@
+package mapper
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type WebhookDB struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	URL         string             `bson:"url"`
+	Secret      string             `bson:"secret"`
+	Events      []string           `bson:"events"`
+	Active      bool               `bson:"active"`
+	Description string             `bson:"description,omitempty"`
+	CreatedBy   string             `bson:"created_by,omitempty"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
+}
+
+func WebhookFromDomain(webhook *domain.Webhook) *WebhookDB {
+	id := primitive.NewObjectID()
+	if webhook.ID != "" {
+		if parsed, err := primitive.ObjectIDFromHex(webhook.ID); err == nil {
+			id = parsed
+		}
+	}
+	events := make([]string, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+	return &WebhookDB{
+		ID:          id,
+		URL:         webhook.URL,
+		Secret:      webhook.Secret,
+		Events:      events,
+		Active:      webhook.Active,
+		Description: webhook.Description,
+		CreatedBy:   webhook.CreatedBy,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+func WebhookToDomain(webhook *WebhookDB) *domain.Webhook {
+	events := make([]domain.WebhookEventType, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		events = append(events, domain.WebhookEventType(event))
+	}
+	return &domain.Webhook{
+		ID:          webhook.ID.Hex(),
+		URL:         webhook.URL,
+		Secret:      webhook.Secret,
+		Events:      events,
+		Active:      webhook.Active,
+		Description: webhook.Description,
+		CreatedBy:   webhook.CreatedBy,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+type WebhookAttemptDB struct {
+	At         time.Time `bson:"at"`
+	StatusCode int       `bson:"status_code,omitempty"`
+	Error      string    `bson:"error,omitempty"`
+	DurationMs int64     `bson:"duration_ms"`
+}
+
+type WebhookDeliveryDB struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	WebhookID     string             `bson:"webhook_id"`
+	EventID       string             `bson:"event_id"`
+	Event         string             `bson:"event"`
+	Payload       string             `bson:"payload"`
+	Status        string             `bson:"status"`
+	Attempts      []WebhookAttemptDB `bson:"attempts"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`
+	DeliveredAt   time.Time          `bson:"delivered_at,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at"`
+}
+
+func WebhookDeliveryFromDomain(delivery *domain.WebhookDelivery) *WebhookDeliveryDB {
+	id := primitive.NewObjectID()
+	if delivery.ID != "" {
+		if parsed, err := primitive.ObjectIDFromHex(delivery.ID); err == nil {
+			id = parsed
+		}
+	}
+	attempts := make([]WebhookAttemptDB, 0, len(delivery.Attempts))
+	for _, attempt := range delivery.Attempts {
+		attempts = append(attempts, WebhookAttemptDB{
+			At:         attempt.At,
+			StatusCode: attempt.StatusCode,
+			Error:      attempt.Error,
+			DurationMs: attempt.Duration.Milliseconds(),
+		})
+	}
+	return &WebhookDeliveryDB{
+		ID:            id,
+		WebhookID:     delivery.WebhookID,
+		EventID:       delivery.EventID,
+		Event:         string(delivery.Event),
+		Payload:       delivery.Payload,
+		Status:        string(delivery.Status),
+		Attempts:      attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		DeliveredAt:   delivery.DeliveredAt,
+		CreatedAt:     delivery.CreatedAt,
+		UpdatedAt:     delivery.UpdatedAt,
+	}
+}
+
+func WebhookDeliveryToDomain(delivery *WebhookDeliveryDB) *domain.WebhookDelivery {
+	attempts := make([]domain.WebhookAttempt, 0, len(delivery.Attempts))
+	for _, attempt := range delivery.Attempts {
+		attempts = append(attempts, domain.WebhookAttempt{
+			At:         attempt.At,
+			StatusCode: attempt.StatusCode,
+			Error:      attempt.Error,
+			Duration:   time.Duration(attempt.DurationMs) * time.Millisecond,
+		})
+	}
+	return &domain.WebhookDelivery{
+		ID:            delivery.ID.Hex(),
+		WebhookID:     delivery.WebhookID,
+		EventID:       delivery.EventID,
+		Event:         domain.WebhookEventType(delivery.Event),
+		Payload:       delivery.Payload,
+		Status:        domain.WebhookDeliveryStatus(delivery.Status),
+		Attempts:      attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		DeliveredAt:   delivery.DeliveredAt,
+		CreatedAt:     delivery.CreatedAt,
+		UpdatedAt:     delivery.UpdatedAt,
+	}
+}
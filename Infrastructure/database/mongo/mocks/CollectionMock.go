@@ -189,6 +189,72 @@ func (_c *MockCollection_CountDocuments_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// DeleteMany provides a mock function for the type MockCollection
+func (_mock *MockCollection) DeleteMany(ctx context.Context, filter any) (int64, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMany")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, any) (int64, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, any) int64); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, any) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollection_DeleteMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMany'
+type MockCollection_DeleteMany_Call struct {
+	*mock.Call
+}
+
+// DeleteMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter any
+func (_e *MockCollection_Expecter) DeleteMany(ctx interface{}, filter interface{}) *MockCollection_DeleteMany_Call {
+	return &MockCollection_DeleteMany_Call{Call: _e.mock.On("DeleteMany", ctx, filter)}
+}
+
+func (_c *MockCollection_DeleteMany_Call) Run(run func(ctx context.Context, filter any)) *MockCollection_DeleteMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 any
+		if args[1] != nil {
+			arg1 = args[1].(any)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollection_DeleteMany_Call) Return(n int64, err error) *MockCollection_DeleteMany_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCollection_DeleteMany_Call) RunAndReturn(run func(ctx context.Context, filter any) (int64, error)) *MockCollection_DeleteMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteOne provides a mock function for the type MockCollection
 func (_mock *MockCollection) DeleteOne(ctx context.Context, filter any) (int64, error) {
 	ret := _mock.Called(ctx, filter)
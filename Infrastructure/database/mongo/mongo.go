@@ -23,6 +23,7 @@ type Collection interface {
 	InsertOne(ctx context.Context, document any) (*mongo.InsertOneResult, error)
 	InsertMany(ctx context.Context, documents []any) (*mongo.InsertManyResult, error)
 	DeleteOne(ctx context.Context, filter any) (int64, error)
+	DeleteMany(ctx context.Context, filter any) (int64, error)
 	Find(ctx context.Context, filter any, opts ...*options.FindOptions) (Cursor, error)
 	CountDocuments(ctx context.Context, filter any, opts ...*options.CountOptions) (int64, error)
 	Aggregate(ctx context.Context, pipeline any) (Cursor, error)
@@ -157,6 +158,14 @@ func (mc *mongoCollection) DeleteOne(ctx context.Context, filter any) (int64, er
 	return res.DeletedCount, err
 }
 
+func (mc *mongoCollection) DeleteMany(ctx context.Context, filter any) (int64, error) {
+	res, err := mc.coll.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
 func (mc *mongoCollection) Find(ctx context.Context, filter any, opts ...*options.FindOptions) (Cursor, error) {
 	cursor, err := mc.coll.Find(ctx, filter, opts...)
 	return &mongoCursor{mc: cursor}, err
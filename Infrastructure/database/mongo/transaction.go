@@ -0,0 +1,35 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Transactor runs functions in MongoDB transactions, which need a replica set or a sharded
+// cluster. Without transactions the function runs on its own and a failure halfway leaves the
+// writes made until then.
+type Transactor struct {
+	client       Client
+	transactions bool
+}
+
+func NewTransactor(client Client, transactions bool) *Transactor {
+	return &Transactor{client: client, transactions: transactions}
+}
+
+func (t *Transactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !t.transactions {
+		return fn(ctx)
+	}
+	session, err := t.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (any, error) {
+		return nil, fn(sessionCtx)
+	})
+	return err
+}
@@ -20,7 +20,7 @@ func RecencySort(recency domain.Recency) bson.D {
 }
 
 // BuildBlogPostFilterQuery constructs a MongoDB query based on the provided BlogPostFilter.
-// It filters blog posts by tags, title, and author name.
+// It filters blog posts by tags, category, title, author name, author IDs and creation time.
 func BuildBlogPostFilterQuery(filter *domain.BlogPostFilter) bson.M {
 	query := bson.M{}
 
@@ -32,6 +32,16 @@ func BuildBlogPostFilterQuery(filter *domain.BlogPostFilter) bson.M {
 		query["tags"] = bson.M{"$in": filter.Tags}
 	}
 
+	if len(filter.CategoryIDs) > 0 {
+		categoryIDs := make([]primitive.ObjectID, 0, len(filter.CategoryIDs))
+		for _, id := range filter.CategoryIDs {
+			if categoryID, err := primitive.ObjectIDFromHex(id); err == nil {
+				categoryIDs = append(categoryIDs, categoryID)
+			}
+		}
+		query["category_id"] = bson.M{"$in": categoryIDs}
+	}
+
 	if filter.Title != "" {
 		query["title"] = bson.M{
 			"$regex": primitive.Regex{Pattern: filter.Title, Options: "i"},
@@ -44,6 +54,20 @@ func BuildBlogPostFilterQuery(filter *domain.BlogPostFilter) bson.M {
 		}
 	}
 
+	if len(filter.AuthorIDs) > 0 {
+		authorIDs := make([]primitive.ObjectID, 0, len(filter.AuthorIDs))
+		for _, id := range filter.AuthorIDs {
+			if authorID, err := primitive.ObjectIDFromHex(id); err == nil {
+				authorIDs = append(authorIDs, authorID)
+			}
+		}
+		query["author_id"] = bson.M{"$in": authorIDs}
+	}
+
+	if !filter.CreatedAfter.IsZero() {
+		query["created_at"] = bson.M{"$gt": filter.CreatedAfter}
+	}
+
 	return query
 }
 
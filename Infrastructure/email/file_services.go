@@ -0,0 +1,75 @@
+package email
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	domain "g6/blog-api/Domain"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/gomail.v2"
+)
+
+// FileEmailService writes every email as an .eml file into a directory instead of sending it,
+// for development and tests without an SMTP server. Any mail client opens the files.
+type FileEmailService struct {
+	renderer
+	dir  string
+	from string
+}
+
+func NewFileEmailService(dir, from string, templates domain.IEmailTemplates, users domain.IUserRepository) (*FileEmailService, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileEmailService{
+		renderer: renderer{templates: templates, users: users},
+		dir:      dir,
+		from:     from,
+	}, nil
+}
+
+func (s *FileEmailService) SendEmail(ctx context.Context, to, subject, body string) error {
+	return s.write(to, htmlMessage(s.from, to, subject, body))
+}
+
+func (s *FileEmailService) SendTemplate(ctx context.Context, to, templateName string, data domain.EmailTemplateData) error {
+	email, err := s.render(ctx, to, templateName, data)
+	if err != nil {
+		return err
+	}
+	return s.write(to, templateMessage(s.from, to, email))
+}
+
+// write names the file after the time and recipient, so a directory listing reads like an inbox.
+// The file is renamed into place once complete, watchers never see half an email.
+func (s *FileEmailService) write(to string, m *gomail.Message) error {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return err
+	}
+	recipient := strings.Map(func(r rune) rune {
+		if r == '@' || r == '.' || r == '-' || r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return r
+		}
+		return '_'
+	}, to)
+	name := time.Now().UTC().Format("20060102T150405.000Z") + "-" + recipient + "-" + hex.EncodeToString(suffix) + ".eml"
+
+	tmp, err := os.CreateTemp(s.dir, ".email-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := m.WriteTo(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(s.dir, name))
+}
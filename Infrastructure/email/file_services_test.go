@@ -0,0 +1,188 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// FileEmailServiceSuite writes emails into a temporary directory and reads them back the way a
+// mail client would
+type FileEmailServiceSuite struct {
+	suite.Suite
+	dir       string
+	mockUsers *domain_mocks.MockIUserRepository
+	service   *FileEmailService
+}
+
+func (s *FileEmailServiceSuite) SetupTest() {
+	templates, err := NewTemplates(EmbeddedTemplates(), "en")
+	s.Require().NoError(err)
+	s.dir = filepath.Join(s.T().TempDir(), "outbox")
+	s.mockUsers = domain_mocks.NewMockIUserRepository(s.T())
+	s.service, err = NewFileEmailService(s.dir, "Blog <noreply@example.com>", templates, s.mockUsers)
+	s.Require().NoError(err)
+}
+
+func TestFileEmailServiceSuite(t *testing.T) {
+	suite.Run(t, new(FileEmailServiceSuite))
+}
+
+// readMessage parses the only email in the directory
+func (s *FileEmailServiceSuite) readMessage() (string, *mail.Message) {
+	entries, err := os.ReadDir(s.dir)
+	s.Require().NoError(err)
+	// the temporary file is gone once the email is renamed into place
+	s.Require().Len(entries, 1)
+	name := entries[0].Name()
+
+	file, err := os.Open(filepath.Join(s.dir, name))
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { file.Close() })
+	message, err := mail.ReadMessage(file)
+	s.Require().NoError(err)
+	return name, message
+}
+
+type messagePart struct {
+	contentType string
+	body        string
+}
+
+// readParts reads the parts of a multipart message, multipart decodes quoted-printable bodies
+func (s *FileEmailServiceSuite) readParts(message *mail.Message) (string, []messagePart) {
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	s.Require().NoError(err)
+	s.Require().True(strings.HasPrefix(mediaType, "multipart/"), mediaType)
+
+	var parts []messagePart
+	reader := multipart.NewReader(message.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		s.Require().NoError(err)
+		body, err := io.ReadAll(part)
+		s.Require().NoError(err)
+		contentType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		s.Require().NoError(err)
+		parts = append(parts, messagePart{contentType: contentType, body: string(body)})
+	}
+	return mediaType, parts
+}
+
+func (s *FileEmailServiceSuite) TestSendTemplate() {
+	data := domain.EmailTemplateData{
+		"Name":      "Jane <Doe>",
+		"URL":       "https://example.com/login?token=abc&next=/",
+		"ExpiresIn": "15m0s",
+	}
+
+	s.Run("MultipartAlternative", func() {
+		s.SetupTest()
+		s.mockUsers.On("GetUserByEmail", mock.Anything, "jane@example.com").Return(&domain.User{Language: "en"}, nil)
+
+		s.Require().NoError(s.service.SendTemplate(context.Background(), "jane@example.com", domain.EmailTemplateMagicLink, data))
+
+		name, message := s.readMessage()
+		s.True(strings.HasSuffix(name, ".eml"))
+		s.Contains(name, "-jane@example.com-")
+		s.Equal("Blog <noreply@example.com>", message.Header.Get("From"))
+		s.Equal("jane@example.com", message.Header.Get("To"))
+		s.Equal("Your login link", message.Header.Get("Subject"))
+		s.Equal("en", message.Header.Get("Content-Language"))
+
+		mediaType, parts := s.readParts(message)
+		s.Equal("multipart/alternative", mediaType)
+		// clients show the last part they can, plain text comes first
+		s.Require().Len(parts, 2)
+		s.Equal("text/plain", parts[0].contentType)
+		s.Contains(parts[0].body, "Dear Jane <Doe>,")
+		s.Contains(parts[0].body, "https://example.com/login?token=abc&next=/")
+		s.Equal("text/html", parts[1].contentType)
+		s.Contains(parts[1].body, "Dear Jane &lt;Doe&gt;,")
+		s.Contains(parts[1].body, `href="https://example.com/login?token=abc&amp;next=/"`)
+	})
+
+	s.Run("UserLanguage", func() {
+		s.SetupTest()
+		s.mockUsers.On("GetUserByEmail", mock.Anything, "jane@example.com").Return(&domain.User{Language: "fr"}, nil)
+
+		s.Require().NoError(s.service.SendTemplate(context.Background(), "jane@example.com", domain.EmailTemplateMagicLink, data))
+
+		_, message := s.readMessage()
+		s.Equal("fr", message.Header.Get("Content-Language"))
+		s.Equal("Votre lien de connexion", message.Header.Get("Subject"))
+		_, parts := s.readParts(message)
+		s.Contains(parts[0].body, "Bonjour Jane <Doe>,")
+	})
+
+	s.Run("LocaleInDataWins", func() {
+		s.SetupTest()
+
+		s.Require().NoError(s.service.SendTemplate(context.Background(), "jane@example.com", domain.EmailTemplateMagicLink,
+			domain.EmailTemplateData{"Name": "Jane", "URL": "https://example.com", "ExpiresIn": "15m0s", "Locale": "fr"}))
+
+		_, message := s.readMessage()
+		s.Equal("fr", message.Header.Get("Content-Language"))
+		s.mockUsers.AssertNotCalled(s.T(), "GetUserByEmail", mock.Anything, mock.Anything)
+	})
+
+	s.Run("EncodedSubject", func() {
+		s.SetupTest()
+		s.mockUsers.On("GetUserByEmail", mock.Anything, "jane@example.com").Return(&domain.User{Language: "fr"}, nil)
+
+		s.Require().NoError(s.service.SendTemplate(context.Background(), "jane@example.com", domain.EmailTemplateAccountLocked, domain.EmailTemplateData{
+			"Name": "Jane", "Failures": 5, "IP": "203.0.113.7", "LockedUntil": "soon", "URL": "https://example.com/unlock",
+		}))
+
+		_, message := s.readMessage()
+		// headers are ASCII, the accents travel as an encoded word
+		raw := message.Header.Get("Subject")
+		s.True(strings.HasPrefix(raw, "=?UTF-8?"), raw)
+		subject, err := new(mime.WordDecoder).DecodeHeader(raw)
+		s.NoError(err)
+		s.Equal("Votre compte a été verrouillé", subject)
+	})
+
+	s.Run("UnknownTemplate", func() {
+		s.SetupTest()
+		s.mockUsers.On("GetUserByEmail", mock.Anything, "jane@example.com").Return(nil, errors.New("not found"))
+
+		err := s.service.SendTemplate(context.Background(), "jane@example.com", "missing", data)
+
+		s.Equal(domain.ErrEmailTemplateNotFound, err)
+		entries, _ := os.ReadDir(s.dir)
+		s.Empty(entries)
+	})
+}
+
+func (s *FileEmailServiceSuite) TestSendEmail() {
+	s.Require().NoError(s.service.SendEmail(context.Background(), "Jane+news@example.com", "Hello", "<p>Hi Jane</p>"))
+
+	name, message := s.readMessage()
+	// characters a file name should not carry are replaced
+	s.Contains(name, "-Jane_news@example.com-")
+	s.Equal("Hello", message.Header.Get("Subject"))
+	mediaType, _, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	s.Require().NoError(err)
+	s.Equal("text/html", mediaType)
+	s.Equal("quoted-printable", message.Header.Get("Content-Transfer-Encoding"))
+	body, err := io.ReadAll(message.Body)
+	s.Require().NoError(err)
+	s.Equal("<p>Hi Jane</p>", string(body))
+}
@@ -2,27 +2,36 @@ package email
 
 import (
 	"context"
+	domain "g6/blog-api/Domain"
+
 	"gopkg.in/gomail.v2"
 )
 
 type GomailEmailService struct {
+	renderer
 	dialer *gomail.Dialer
 	from   string
 }
 
-func NewGomailEmailService(smtpHost string, smtpPort int, from, username, password string) *GomailEmailService {
+// NewGomailEmailService sends through SMTP, templated emails are rendered in the preferred
+// language of the user with the recipient's address
+func NewGomailEmailService(smtpHost string, smtpPort int, from, username, password string, templates domain.IEmailTemplates, users domain.IUserRepository) *GomailEmailService {
 	dialer := gomail.NewDialer(smtpHost, smtpPort, username, password)
 	return &GomailEmailService{
-		dialer: dialer,
-		from:   from,
+		renderer: renderer{templates: templates, users: users},
+		dialer:   dialer,
+		from:     from,
 	}
 }
 
 func (s *GomailEmailService) SendEmail(ctx context.Context, to, subject, body string) error {
-	m := gomail.NewMessage()
-	m.SetHeader("From", s.from)
-	m.SetHeader("To", to)
-	m.SetHeader("Subject", subject)
-	m.SetBody("text/html", body)
-	return s.dialer.DialAndSend(m)
+	return s.dialer.DialAndSend(htmlMessage(s.from, to, subject, body))
+}
+
+func (s *GomailEmailService) SendTemplate(ctx context.Context, to, templateName string, data domain.EmailTemplateData) error {
+	email, err := s.render(ctx, to, templateName, data)
+	if err != nil {
+		return err
+	}
+	return s.dialer.DialAndSend(templateMessage(s.from, to, email))
 }
@@ -0,0 +1,46 @@
+package email
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+
+	"gopkg.in/gomail.v2"
+)
+
+// renderer renders templated emails in the preferred language of the user with the
+// recipient's address, unless the data names a locale
+type renderer struct {
+	templates domain.IEmailTemplates
+	users     domain.IUserRepository
+}
+
+func (r renderer) render(ctx context.Context, to, templateName string, data domain.EmailTemplateData) (*domain.RenderedEmail, error) {
+	locale, _ := data["Locale"].(string)
+	if locale == "" && r.users != nil {
+		if user, err := r.users.GetUserByEmail(ctx, to); err == nil && user != nil {
+			locale = user.Language
+		}
+	}
+	return r.templates.Render(templateName, locale, data)
+}
+
+func htmlMessage(from, to, subject, body string) *gomail.Message {
+	m := gomail.NewMessage()
+	m.SetHeader("From", from)
+	m.SetHeader("To", to)
+	m.SetHeader("Subject", subject)
+	m.SetBody("text/html", body)
+	return m
+}
+
+// templateMessage is a multipart/alternative message, mail clients show the last part they can
+func templateMessage(from, to string, email *domain.RenderedEmail) *gomail.Message {
+	m := gomail.NewMessage()
+	m.SetHeader("From", from)
+	m.SetHeader("To", to)
+	m.SetHeader("Subject", email.Subject)
+	m.SetHeader("Content-Language", email.Locale)
+	m.SetBody("text/plain", email.Text)
+	m.AddAlternative("text/html", email.HTML)
+	return m
+}
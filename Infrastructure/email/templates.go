@@ -0,0 +1,197 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	domain "g6/blog-api/Domain"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var embeddedTemplates embed.FS
+
+// EmbeddedTemplates are the templates built into the binary
+func EmbeddedTemplates() fs.FS {
+	templates, _ := fs.Sub(embeddedTemplates, "templates")
+	return templates
+}
+
+// templateSamples is the data templates are previewed with
+var templateSamples = map[string]domain.EmailTemplateData{
+	domain.EmailTemplateOTP: {
+		"Code":             "123456",
+		"Purpose":          string(domain.OTPPurposeEmailVerification),
+		"ExpiresInMinutes": 10,
+	},
+	domain.EmailTemplatePasswordReset: {
+		"Name":      "Jane Doe",
+		"Token":     "3f2b8c1e-5d4a-4e7b-9c6f-0a1b2c3d4e5f",
+		"ExpiresIn": "15m0s",
+	},
+	domain.EmailTemplateMagicLink: {
+		"Name":      "Jane Doe",
+		"URL":       "http://localhost:3000/auth/magic-link?token=preview",
+		"ExpiresIn": "15m0s",
+	},
+	domain.EmailTemplateAccountLocked: {
+		"Name":        "Jane Doe",
+		"Failures":    5,
+		"IP":          "203.0.113.7",
+		"LockedUntil": "Mon, 02 Jan 2006 15:34:05 UTC",
+		"URL":         "http://localhost:3000/auth/unlock?token=preview",
+	},
+	domain.EmailTemplateDigest: {
+		"Name":      "Jane Doe",
+		"Frequency": string(domain.DigestWeekly),
+		"Posts": []map[string]any{
+			{
+				"Title":      "Getting started with Go",
+				"AuthorName": "John Smith",
+				"Excerpt":    "Go is a small language with a big standard library, here is how to write your first program…",
+				"URL":        "http://localhost:3000/posts/preview",
+			},
+		},
+		"UnsubscribeURL": "http://localhost:3000/digests/unsubscribe?token=preview",
+	},
+}
+
+type localizedTemplate struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// Templates are the email templates of every locale. The root of their file system holds the
+// shared layout.html.tmpl and layout.txt.tmpl, and a directory per locale with a common.tmpl
+// and a <name>.html.tmpl and <name>.txt.tmpl for each template. The text file also defines
+// the subject.
+type Templates struct {
+	defaultLocale string
+	templates     map[string]map[string]localizedTemplate // by locale, then name
+}
+
+// NewTemplates parses every template up front, so a broken one stops the server instead of an email
+func NewTemplates(fsys fs.FS, defaultLocale string) (*Templates, error) {
+	t := &Templates{
+		defaultLocale: normalizeLocale(defaultLocale),
+		templates:     map[string]map[string]localizedTemplate{},
+	}
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		locale := entry.Name()
+		files, err := fs.Glob(fsys, path.Join(locale, "*.html.tmpl"))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			name := strings.TrimSuffix(path.Base(file), ".html.tmpl")
+			html, err := htmltemplate.New(name).Option("missingkey=error").
+				ParseFS(fsys, "layout.html.tmpl", path.Join(locale, "common.tmpl"), file)
+			if err != nil {
+				return nil, fmt.Errorf("email template %s/%s: %w", locale, name, err)
+			}
+			text, err := texttemplate.New(name).Option("missingkey=error").
+				ParseFS(fsys, "layout.txt.tmpl", path.Join(locale, "common.tmpl"), path.Join(locale, name+".txt.tmpl"))
+			if err != nil {
+				return nil, fmt.Errorf("email template %s/%s: %w", locale, name, err)
+			}
+			if t.templates[locale] == nil {
+				t.templates[locale] = map[string]localizedTemplate{}
+			}
+			t.templates[locale][name] = localizedTemplate{html: html, text: text}
+		}
+	}
+	if len(t.templates[t.defaultLocale]) == 0 {
+		return nil, fmt.Errorf("no email templates for the default locale %q", t.defaultLocale)
+	}
+	return t, nil
+}
+
+// normalizeLocale turns en_US and EN-us into en-us
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// lookup finds the template in the locale, its base language or the default locale
+func (t *Templates) lookup(name, locale string) (string, localizedTemplate, bool) {
+	locale = normalizeLocale(locale)
+	candidates := []string{locale}
+	if base, _, found := strings.Cut(locale, "-"); found {
+		candidates = append(candidates, base)
+	}
+	candidates = append(candidates, t.defaultLocale)
+	for _, candidate := range candidates {
+		if tmpl, ok := t.templates[candidate][name]; ok {
+			return candidate, tmpl, true
+		}
+	}
+	return "", localizedTemplate{}, false
+}
+
+func (t *Templates) Render(name, locale string, data domain.EmailTemplateData) (*domain.RenderedEmail, error) {
+	locale, tmpl, ok := t.lookup(name, locale)
+	if !ok {
+		return nil, domain.ErrEmailTemplateNotFound
+	}
+	values := make(domain.EmailTemplateData, len(data)+1)
+	for key, value := range data {
+		values[key] = value
+	}
+	values["Locale"] = locale
+
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", values); err != nil {
+		return nil, err
+	}
+	if err := tmpl.text.ExecuteTemplate(&text, "layout", values); err != nil {
+		return nil, err
+	}
+	if err := tmpl.html.ExecuteTemplate(&html, "layout", values); err != nil {
+		return nil, err
+	}
+	return &domain.RenderedEmail{
+		Locale:  locale,
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    html.String(),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+	}, nil
+}
+
+func (t *Templates) Preview(name, locale string) (*domain.RenderedEmail, error) {
+	return t.Render(name, locale, templateSamples[name])
+}
+
+func (t *Templates) Names() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, templates := range t.templates {
+		for name := range templates {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (t *Templates) Locales() []string {
+	locales := make([]string, 0, len(t.templates))
+	for locale := range t.templates {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
@@ -0,0 +1,190 @@
+package email
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	domain "g6/blog-api/Domain"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// TemplatesSuite renders the templates built into the binary, and a small set where the French
+// translation of one template is missing
+type TemplatesSuite struct {
+	suite.Suite
+	embedded *Templates
+	partial  *Templates
+}
+
+var partialTemplates = fstest.MapFS{
+	"layout.html.tmpl":     {Data: []byte(`{{define "layout"}}<html lang="{{.Locale}}">{{template "content" .}}</html>{{end}}`)},
+	"layout.txt.tmpl":      {Data: []byte(`{{define "layout"}}{{template "content" .}}{{end}}`)},
+	"en/common.tmpl":       {Data: []byte(`{{define "greeting"}}Dear {{.Name}},{{end}}`)},
+	"en/welcome.html.tmpl": {Data: []byte(`{{define "content"}}<p>{{template "greeting" .}}</p>{{end}}`)},
+	"en/welcome.txt.tmpl":  {Data: []byte(`{{define "subject"}}Welcome{{end}}{{define "content"}}{{template "greeting" .}}{{end}}`)},
+	"en/reset.html.tmpl":   {Data: []byte(`{{define "content"}}<p>{{template "greeting" .}}</p>{{end}}`)},
+	"en/reset.txt.tmpl":    {Data: []byte(`{{define "subject"}}Reset{{end}}{{define "content"}}{{template "greeting" .}}{{end}}`)},
+	"fr/common.tmpl":       {Data: []byte(`{{define "greeting"}}Bonjour {{.Name}},{{end}}`)},
+	"fr/reset.html.tmpl":   {Data: []byte(`{{define "content"}}<p>{{template "greeting" .}}</p>{{end}}`)},
+	"fr/reset.txt.tmpl":    {Data: []byte(`{{define "subject"}}Réinitialisation{{end}}{{define "content"}}{{template "greeting" .}}{{end}}`)},
+}
+
+func (s *TemplatesSuite) SetupTest() {
+	var err error
+	s.embedded, err = NewTemplates(EmbeddedTemplates(), "en")
+	s.Require().NoError(err)
+	s.partial, err = NewTemplates(partialTemplates, "en")
+	s.Require().NoError(err)
+}
+
+func TestTemplatesSuite(t *testing.T) {
+	suite.Run(t, new(TemplatesSuite))
+}
+
+func (s *TemplatesSuite) TestLocaleFallback() {
+	tests := []struct {
+		name        string
+		template    string
+		locale      string
+		wantLocale  string
+		wantSubject string
+	}{
+		{name: "Translated", template: "reset", locale: "fr", wantLocale: "fr", wantSubject: "Réinitialisation"},
+		{name: "RegionFallsBackToLanguage", template: "reset", locale: "fr-CA", wantLocale: "fr", wantSubject: "Réinitialisation"},
+		{name: "LocaleNormalized", template: "reset", locale: "FR_ca", wantLocale: "fr", wantSubject: "Réinitialisation"},
+		{name: "TranslationMissing", template: "welcome", locale: "fr", wantLocale: "en", wantSubject: "Welcome"},
+		{name: "UnknownLocale", template: "reset", locale: "de", wantLocale: "en", wantSubject: "Reset"},
+		{name: "NoLocale", template: "reset", locale: "", wantLocale: "en", wantSubject: "Reset"},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			email, err := s.partial.Render(tt.template, tt.locale, domain.EmailTemplateData{"Name": "Jane"})
+
+			s.Require().NoError(err)
+			s.Equal(tt.wantLocale, email.Locale)
+			s.Equal(tt.wantSubject, email.Subject)
+			// the whole email is in one language, the greeting comes from the same locale
+			s.Contains(email.HTML, `lang="`+tt.wantLocale+`"`)
+			if tt.wantLocale == "fr" {
+				s.Contains(email.Text, "Bonjour Jane,")
+			} else {
+				s.Contains(email.Text, "Dear Jane,")
+			}
+		})
+	}
+
+	s.Run("UnknownTemplate", func() {
+		_, err := s.partial.Render("missing", "fr", domain.EmailTemplateData{"Name": "Jane"})
+
+		s.Equal(domain.ErrEmailTemplateNotFound, err)
+	})
+}
+
+func (s *TemplatesSuite) TestEscaping() {
+	tests := []struct {
+		name     string
+		template string
+		data     domain.EmailTemplateData
+		wantHTML []string
+		notHTML  []string
+		wantText []string
+	}{
+		{
+			name:     "MarkupInName",
+			template: domain.EmailTemplateMagicLink,
+			data:     domain.EmailTemplateData{"Name": `<script>alert("x")</script>`, "URL": "https://example.com/login?token=t", "ExpiresIn": "15m0s"},
+			wantHTML: []string{`&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;`},
+			notHTML:  []string{"<script>"},
+			// the plain text part is not HTML, the name stays as it was
+			wantText: []string{`<script>alert("x")</script>`},
+		},
+		{
+			name:     "AttributeBreakoutInURL",
+			template: domain.EmailTemplateMagicLink,
+			data:     domain.EmailTemplateData{"Name": "Jane", "URL": `https://example.com/?a="><img src=x>`, "ExpiresIn": "15m0s"},
+			notHTML:  []string{`"><img`},
+			wantText: []string{`https://example.com/?a="><img src=x>`},
+		},
+		{
+			name:     "ScriptURL",
+			template: domain.EmailTemplateMagicLink,
+			data:     domain.EmailTemplateData{"Name": "Jane", "URL": "javascript:alert(1)", "ExpiresIn": "15m0s"},
+			wantHTML: []string{`href="#ZgotmplZ"`},
+			notHTML:  []string{"javascript:"},
+		},
+		{
+			name:     "MarkupInDigestPost",
+			template: domain.EmailTemplateDigest,
+			data: domain.EmailTemplateData{
+				"Name":      "Jane",
+				"Frequency": string(domain.DigestWeekly),
+				"Posts": []map[string]any{{
+					"Title":      "<b>Bold</b> & co",
+					"AuthorName": "<i>Author</i>",
+					"Excerpt":    "<img src=x onerror=alert(1)>",
+					"URL":        "https://example.com/posts/1",
+				}},
+				"UnsubscribeURL": "https://example.com/unsubscribe",
+			},
+			wantHTML: []string{"&lt;b&gt;Bold&lt;/b&gt; &amp; co", "&lt;i&gt;Author&lt;/i&gt;", "&lt;img src=x onerror=alert(1)&gt;"},
+			notHTML:  []string{"<b>", "<i>", "<img"},
+			wantText: []string{"<b>Bold</b> & co"},
+		},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			email, err := s.embedded.Render(tt.template, "en", tt.data)
+
+			s.Require().NoError(err)
+			for _, want := range tt.wantHTML {
+				s.Contains(email.HTML, want)
+			}
+			for _, unwanted := range tt.notHTML {
+				s.NotContains(email.HTML, unwanted)
+			}
+			for _, want := range tt.wantText {
+				s.Contains(email.Text, want)
+			}
+		})
+	}
+}
+
+// every template renders a plain text part next to the HTML, with the same content
+func (s *TemplatesSuite) TestTextPart() {
+	tests := []struct {
+		template string
+		want     []string
+	}{
+		{template: domain.EmailTemplateOTP, want: []string{"123456"}},
+		{template: domain.EmailTemplatePasswordReset, want: []string{"Jane Doe", "3f2b8c1e-5d4a-4e7b-9c6f-0a1b2c3d4e5f"}},
+		{template: domain.EmailTemplateMagicLink, want: []string{"Jane Doe", "http://localhost:3000/auth/magic-link?token=preview"}},
+		{template: domain.EmailTemplateAccountLocked, want: []string{"Jane Doe", "203.0.113.7", "http://localhost:3000/auth/unlock?token=preview"}},
+		{template: domain.EmailTemplateDigest, want: []string{"Getting started with Go", "http://localhost:3000/digests/unsubscribe?token=preview"}},
+	}
+	for _, locale := range s.embedded.Locales() {
+		for _, tt := range tests {
+			s.Run(locale+"/"+tt.template, func() {
+				email, err := s.embedded.Preview(tt.template, locale)
+
+				s.Require().NoError(err)
+				s.Equal(locale, email.Locale)
+				s.NotEmpty(email.Subject)
+				s.Contains(email.HTML, "<html")
+				s.NotContains(email.Text, "<html")
+				s.NotContains(email.Text, "<p>")
+				s.True(strings.HasSuffix(email.Text, "\n"))
+				for _, want := range tt.want {
+					s.Contains(email.HTML, htmlEscaped(want))
+					s.Contains(email.Text, want)
+				}
+			})
+		}
+	}
+}
+
+// htmlEscaped is how html/template writes a value into an attribute or text
+func htmlEscaped(value string) string {
+	return strings.NewReplacer("&", "&amp;", "'", "&#39;", `"`, "&#34;").Replace(value)
+}
@@ -1,10 +1,10 @@
 package middleware
 
 import (
+	"errors"
 	"net/http"
-	"time"
+	"strings"
 
-	"g6/blog-api/Delivery/bootstrap"
 	domain "g6/blog-api/Domain"
 	utils "g6/blog-api/Utils"
 
@@ -12,31 +12,57 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-// AuthMiddleware checks if the user is authenticated by verifying the JWT token
-func AuthMiddleware(env bootstrap.Env) gin.HandlerFunc {
+// AuthMiddleware checks if the user is authenticated. A personal access token or an access JWT
+// is taken from the Authorization: Bearer header, otherwise the JWT from the access_token cookie.
+// Unsafe requests authenticated by the cookie also need the CSRF token in the X-CSRF-Token header.
+// JWTs are verified through the auth service, which resolves the signing key from the token's kid.
+func AuthMiddleware(authService domain.IAuthService, tokens domain.IPersonalAccessTokenUsecase) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		tokenStr, err := utils.GetCookie(c, "access_token")
-		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "No access token found in cookies, please login again"})
-			c.Abort()
-			return
-		}
+		tokenStr, fromHeader := bearerToken(c)
+		if !fromHeader {
+			cookie, err := utils.GetCookie(c, "access_token")
+			if err != nil {
+				c.JSON(http.StatusUnauthorized, gin.H{"error": "No access token found in cookies, please login again"})
+				c.Abort()
+				return
+			}
+			tokenStr = cookie
 
-		// Parse and validate the JWT
-		token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
-			return []byte(env.ATS), nil
-		})
+			// a Bearer token is never sent by the browser on its own, a cookie is
+			if !validCSRFToken(c) {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "missing or invalid CSRF token"})
+				return
+			}
+		}
 
-		if err != nil || !token.Valid {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+		if strings.HasPrefix(tokenStr, domain.PersonalAccessTokenPrefix) {
+			token, user, err := tokens.Authenticate(tokenStr, c.ClientIP())
+			if errors.Is(err, domain.ErrAccessTokenExpired) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token expired"})
+				return
+			}
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+				return
+			}
+			c.Set("user_id", user.ID)
+			c.Set("role", string(user.Role))
+			c.Set("is_verified", user.IsVerified)
+			c.Set("token_scopes", token.Scopes)
+			c.Next()
 			return
 		}
-		claims := token.Claims.(jwt.MapClaims)
-		// check if the token has not expired
-		if exp, ok := claims["exp"].(float64); !ok || exp < float64(time.Now().Unix()) {
+
+		// Parse and validate the JWT, expiry included
+		claims, err := authService.ValidateToken(tokenStr)
+		if errors.Is(err, jwt.ErrTokenExpired) {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token expired"})
 			return
 		}
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+			return
+		}
 
 		// Set user ID and role in the context for further use
 		c.Set("user_id", claims["sub"].(string))
@@ -55,21 +81,37 @@ func AuthMiddleware(env bootstrap.Env) gin.HandlerFunc {
 	}
 }
 
-func SuperAdminOnly() gin.HandlerFunc {
+func bearerToken(c *gin.Context) (string, bool) {
+	header := c.GetHeader("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+// RequirePermission lets the request through when the policy grants the permission to the
+// caller's role, and to the token's scopes for personal access tokens. It runs after AuthMiddleware.
+func RequirePermission(policy domain.IPolicy, permission domain.Permission) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		if c.GetString("role") != string(domain.RoleSuperAdmin) {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "super_admin only"})
+		actor, ok := domain.ActorFromContext(c)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": domain.ErrUnauthorized.Error()})
+			return
+		}
+		if !policy.HasPermission(actor, permission) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "missing permission " + string(permission)})
 			return
 		}
 		c.Next()
 	}
 }
 
-func AdminOnly() gin.HandlerFunc {
+// SessionOnly rejects personal access tokens, for account management that needs a real login
+func SessionOnly() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		role := c.GetString("role")
-		if role != string(domain.RoleAdmin) && role != string(domain.RoleSuperAdmin) {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "admin or super_admin only"})
+		if _, ok := c.Get("token_scopes"); ok {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "personal access tokens cannot be used here"})
 			return
 		}
 		c.Next()
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	utils "g6/blog-api/Utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+)
+
+type CSRFMiddlewareSuite struct {
+	suite.Suite
+	router *gin.Engine
+}
+
+func (s *CSRFMiddlewareSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.router = gin.New()
+	s.router.POST("/auth/logout", CSRFMiddleware("refresh_token"), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+}
+
+func TestCSRFMiddlewareSuite(t *testing.T) {
+	suite.Run(t, new(CSRFMiddlewareSuite))
+}
+
+func (s *CSRFMiddlewareSuite) logout(cookies []*http.Cookie, header string) int {
+	req := httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
+	for _, cookie := range cookies {
+		req.AddCookie(cookie)
+	}
+	if header != "" {
+		req.Header.Set(utils.CSRFHeaderName, header)
+	}
+	w := httptest.NewRecorder()
+	s.router.ServeHTTP(w, req)
+	return w.Code
+}
+
+func (s *CSRFMiddlewareSuite) TestCSRFMiddleware() {
+	refresh := &http.Cookie{Name: "refresh_token", Value: "refresh-token"}
+	csrf := &http.Cookie{Name: utils.CSRFCookieName, Value: "csrf-token"}
+
+	s.Run("MatchingToken", func() {
+		s.Equal(http.StatusOK, s.logout([]*http.Cookie{refresh, csrf}, "csrf-token"))
+	})
+
+	s.Run("CrossSiteWithoutHeader", func() {
+		s.Equal(http.StatusForbidden, s.logout([]*http.Cookie{refresh, csrf}, ""))
+	})
+
+	s.Run("WrongToken", func() {
+		s.Equal(http.StatusForbidden, s.logout([]*http.Cookie{refresh, csrf}, "guessed"))
+	})
+
+	s.Run("NoCSRFCookie", func() {
+		s.Equal(http.StatusForbidden, s.logout([]*http.Cookie{refresh}, "csrf-token"))
+	})
+
+	s.Run("NoSessionCookie", func() {
+		s.Equal(http.StatusOK, s.logout(nil, ""))
+	})
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"g6/blog-api/Infrastructure/redis"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitPolicy allows Limit requests per Window. A PerRoute policy counts every route
+// it guards on its own, otherwise all of them share one count.
+type RateLimitPolicy struct {
+	Name     string
+	Limit    int64
+	Window   time.Duration
+	PerRoute bool
+}
+
+// ParseRateLimitPolicy reads a "<requests>/<window>" spec such as "100/1m" or "5/15m".
+// An empty spec falls back to the given default, "off" disables the policy and returns nil.
+func ParseRateLimitPolicy(name, spec, fallback string, perRoute bool) (*RateLimitPolicy, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		spec = fallback
+	}
+	if strings.EqualFold(spec, "off") {
+		return nil, nil
+	}
+	requests, window, found := strings.Cut(spec, "/")
+	if !found {
+		return nil, fmt.Errorf("rate limit %s: %q is not <requests>/<window>", name, spec)
+	}
+	limit, err := strconv.ParseInt(strings.TrimSpace(requests), 10, 64)
+	if err != nil || limit <= 0 {
+		return nil, fmt.Errorf("rate limit %s: invalid request count %q", name, requests)
+	}
+	duration, err := time.ParseDuration(strings.TrimSpace(window))
+	if err != nil || duration < time.Second {
+		return nil, fmt.Errorf("rate limit %s: invalid window %q", name, window)
+	}
+	return &RateLimitPolicy{Name: name, Limit: limit, Window: duration, PerRoute: perRoute}, nil
+}
+
+// RateLimiter counts requests in Redis with a sliding window: the count of the current
+// window plus the previous window's count, weighted by how much of it still overlaps.
+type RateLimiter struct {
+	client   redis.RedisClient
+	policies map[string]RateLimitPolicy
+	now      func() time.Time
+}
+
+func NewRateLimiter(client redis.RedisClient, policies ...RateLimitPolicy) *RateLimiter {
+	limiter := &RateLimiter{client: client, policies: map[string]RateLimitPolicy{}, now: time.Now}
+	for _, policy := range policies {
+		limiter.policies[policy.Name] = policy
+	}
+	return limiter
+}
+
+// Limit returns the middleware enforcing the named policy. Requests are counted per user when
+// the auth middleware ran before it, per IP otherwise, so a policy used ahead of the auth
+// middleware is per IP only. Unconfigured policies let everything through.
+func (l *RateLimiter) Limit(name string) gin.HandlerFunc {
+	policy, ok := l.policies[name]
+	if !ok {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	return func(c *gin.Context) {
+		subject := "ip:" + c.ClientIP()
+		if userID := c.GetString("user_id"); userID != "" {
+			subject = "user:" + userID
+		}
+		route := "*"
+		if policy.PerRoute {
+			route = c.Request.Method + " " + c.FullPath()
+		}
+
+		now := l.now()
+		windowStart := now.Truncate(policy.Window)
+		elapsed := now.Sub(windowStart)
+		keys := l.client.Service()
+		key := keys.GenerateRateLimitKey(policy.Name, route, subject, windowStart.Unix())
+
+		ctx := c.Request.Context()
+		count, err := l.client.Increment(ctx, key)
+		if err != nil {
+			// Redis being down must not take the API down with it
+			c.Next()
+			return
+		}
+		if count == 1 {
+			// the count is still read as the previous window during the next one
+			_ = l.client.Expire(ctx, key, 2*policy.Window)
+		}
+		var previous int64
+		if value, err := l.client.Get(ctx, keys.GenerateRateLimitKey(policy.Name, route, subject, windowStart.Add(-policy.Window).Unix())); err == nil {
+			previous, _ = strconv.ParseInt(value, 10, 64)
+		}
+
+		overlap := 1 - float64(elapsed)/float64(policy.Window)
+		used := count + int64(float64(previous)*overlap)
+		reset := int64(math.Ceil((policy.Window - elapsed).Seconds()))
+
+		c.Header("RateLimit-Limit", strconv.FormatInt(policy.Limit, 10))
+		c.Header("RateLimit-Remaining", strconv.FormatInt(max(policy.Limit-used, 0), 10))
+		c.Header("RateLimit-Reset", strconv.FormatInt(reset, 10))
+		c.Header("RateLimit-Policy", fmt.Sprintf("%d;w=%d", policy.Limit, int64(policy.Window.Seconds())))
+		if used > policy.Limit {
+			c.Header("Retry-After", strconv.FormatInt(reset, 10))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests, try again later"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,231 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"g6/blog-api/Infrastructure/redis"
+	redis_mocks "g6/blog-api/Infrastructure/redis/mocks"
+
+	"github.com/gin-gonic/gin"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// RateLimiterSuite runs the limiter against a mocked Redis that keeps the counts in memory, at
+// a clock the tests set
+type RateLimiterSuite struct {
+	suite.Suite
+	mockRedis *redis_mocks.MockRedisClient
+	counts    map[string]int64
+	now       time.Time
+	limiter   *RateLimiter
+}
+
+func (s *RateLimiterSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	s.counts = map[string]int64{}
+	// 15 seconds into a minute window
+	s.now = time.Unix(1_700_000_040, 0).Add(15 * time.Second)
+	s.mockRedis = redis_mocks.NewMockRedisClient(s.T())
+	s.mockRedis.On("Service").Return(&redis.RedisService{}).Maybe()
+	s.mockRedis.On("Increment", mock.Anything, mock.Anything).Return(func(ctx context.Context, key string) (int64, error) {
+		s.counts[key]++
+		return s.counts[key], nil
+	}).Maybe()
+	s.mockRedis.On("Expire", mock.Anything, mock.Anything, 2*time.Minute).Return(nil).Maybe()
+	s.mockRedis.On("Get", mock.Anything, mock.Anything).Return(func(ctx context.Context, key string) (string, error) {
+		count, ok := s.counts[key]
+		if !ok {
+			return "", goredis.Nil
+		}
+		return strconv.FormatInt(count, 10), nil
+	}).Maybe()
+	s.limiter = NewRateLimiter(s.mockRedis,
+		RateLimitPolicy{Name: "global", Limit: 10, Window: time.Minute},
+		RateLimitPolicy{Name: "auth", Limit: 2, Window: time.Minute, PerRoute: true},
+	)
+	s.limiter.now = func() time.Time { return s.now }
+}
+
+func TestRateLimiterSuite(t *testing.T) {
+	suite.Run(t, new(RateLimiterSuite))
+}
+
+// request sends a request through the named policy, as the user when userID is set
+func (s *RateLimiterSuite) request(policy, path, userID string) *httptest.ResponseRecorder {
+	router := gin.New()
+	router.POST(path, func(c *gin.Context) {
+		if userID != "" {
+			c.Set("user_id", userID)
+		}
+		c.Next()
+	}, s.limiter.Limit(policy), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	req := httptest.NewRequest(http.MethodPost, path, nil)
+	req.RemoteAddr = "192.0.2.1:1234"
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+// windowKey is the count key of a subject in the window starting windowsAgo windows before now
+func (s *RateLimiterSuite) windowKey(policy, route, subject string, windowsAgo int) string {
+	start := s.now.Truncate(time.Minute).Add(-time.Duration(windowsAgo) * time.Minute)
+	return (&redis.RedisService{}).GenerateRateLimitKey(policy, route, subject, start.Unix())
+}
+
+func (s *RateLimiterSuite) TestHeaders() {
+	s.Run("Allowed", func() {
+		s.SetupTest()
+
+		w := s.request("auth", "/login", "")
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Equal("2", w.Header().Get("RateLimit-Limit"))
+		s.Equal("1", w.Header().Get("RateLimit-Remaining"))
+		s.Equal("45", w.Header().Get("RateLimit-Reset"))
+		s.Equal("2;w=60", w.Header().Get("RateLimit-Policy"))
+		s.Empty(w.Header().Get("Retry-After"))
+	})
+
+	s.Run("Refused", func() {
+		s.SetupTest()
+		s.request("auth", "/login", "")
+		s.request("auth", "/login", "")
+
+		w := s.request("auth", "/login", "")
+
+		s.Equal(http.StatusTooManyRequests, w.Code)
+		s.Equal("0", w.Header().Get("RateLimit-Remaining"))
+		s.Equal("45", w.Header().Get("Retry-After"))
+	})
+}
+
+func (s *RateLimiterSuite) TestSlidingWindow() {
+	s.Run("PreviousWindowWeighted", func() {
+		s.SetupTest()
+		// 45 of the previous window's 60 seconds still overlap: 8 * 0.75 = 6 requests
+		s.counts[s.windowKey("global", "*", "ip:192.0.2.1", 1)] = 8
+
+		for i := 0; i < 4; i++ {
+			s.Equal(http.StatusOK, s.request("global", "/posts", "").Code)
+		}
+		w := s.request("global", "/posts", "")
+
+		s.Equal(http.StatusTooManyRequests, w.Code)
+	})
+
+	s.Run("OlderWindowsForgotten", func() {
+		s.SetupTest()
+		s.counts[s.windowKey("global", "*", "ip:192.0.2.1", 2)] = 100
+
+		w := s.request("global", "/posts", "")
+
+		s.Equal(http.StatusOK, w.Code)
+		s.Equal("9", w.Header().Get("RateLimit-Remaining"))
+	})
+
+	s.Run("NewWindowStartsOver", func() {
+		s.SetupTest()
+		s.request("auth", "/login", "")
+		s.request("auth", "/login", "")
+		s.Equal(http.StatusTooManyRequests, s.request("auth", "/login", "").Code)
+
+		// at the end of the next window the previous one no longer overlaps
+		s.now = s.now.Truncate(time.Minute).Add(2*time.Minute - time.Second)
+
+		s.Equal(http.StatusOK, s.request("auth", "/login", "").Code)
+	})
+}
+
+func (s *RateLimiterSuite) TestSubject() {
+	s.Run("PerUserWhenAuthenticated", func() {
+		s.SetupTest()
+
+		s.request("global", "/posts", "u1")
+
+		s.Equal(int64(1), s.counts[s.windowKey("global", "*", "user:u1", 0)])
+		s.NotContains(s.counts, s.windowKey("global", "*", "ip:192.0.2.1", 0))
+	})
+
+	s.Run("PerIPOtherwise", func() {
+		s.SetupTest()
+
+		s.request("global", "/posts", "")
+
+		s.Equal(int64(1), s.counts[s.windowKey("global", "*", "ip:192.0.2.1", 0)])
+	})
+
+	s.Run("UsersDoNotShareTheIPCount", func() {
+		s.SetupTest()
+		s.request("auth", "/login", "u1")
+		s.request("auth", "/login", "u1")
+
+		s.Equal(http.StatusTooManyRequests, s.request("auth", "/login", "u1").Code)
+		s.Equal(http.StatusOK, s.request("auth", "/login", "u2").Code)
+		s.Equal(http.StatusOK, s.request("auth", "/login", "").Code)
+	})
+
+	s.Run("PerRouteCountsEachRoute", func() {
+		s.SetupTest()
+		s.request("auth", "/login", "")
+		s.request("auth", "/login", "")
+
+		s.Equal(http.StatusOK, s.request("auth", "/register", "").Code)
+		s.Equal(int64(1), s.counts[s.windowKey("auth", "POST /register", "ip:192.0.2.1", 0)])
+	})
+}
+
+func (s *RateLimiterSuite) TestUnconfiguredPolicy() {
+	w := s.request("ai", "/generate", "")
+
+	s.Equal(http.StatusOK, w.Code)
+	s.Empty(w.Header().Get("RateLimit-Limit"))
+	s.mockRedis.AssertNotCalled(s.T(), "Increment", mock.Anything, mock.Anything)
+}
+
+func (s *RateLimiterSuite) TestRedisDown() {
+	s.mockRedis = redis_mocks.NewMockRedisClient(s.T())
+	s.mockRedis.On("Service").Return(&redis.RedisService{})
+	s.mockRedis.On("Increment", mock.Anything, mock.Anything).Return(int64(0), errors.New("connection refused"))
+	s.limiter = NewRateLimiter(s.mockRedis, RateLimitPolicy{Name: "global", Limit: 1, Window: time.Minute})
+
+	s.Equal(http.StatusOK, s.request("global", "/posts", "").Code)
+	s.Equal(http.StatusOK, s.request("global", "/posts", "").Code)
+}
+
+func (s *RateLimiterSuite) TestParseRateLimitPolicy() {
+	tests := []struct {
+		name    string
+		spec    string
+		want    *RateLimitPolicy
+		wantErr bool
+	}{
+		{name: "Spec", spec: "100/1m", want: &RateLimitPolicy{Name: "p", Limit: 100, Window: time.Minute}},
+		{name: "EmptyUsesFallback", spec: " ", want: &RateLimitPolicy{Name: "p", Limit: 5, Window: 15 * time.Minute}},
+		{name: "Off", spec: "OFF", want: nil},
+		{name: "NoWindow", spec: "100", wantErr: true},
+		{name: "ZeroRequests", spec: "0/1m", wantErr: true},
+		{name: "WindowBelowASecond", spec: "10/500ms", wantErr: true},
+	}
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			policy, err := ParseRateLimitPolicy("p", tt.spec, "5/15m", false)
+
+			if tt.wantErr {
+				s.Error(err)
+				return
+			}
+			s.NoError(err)
+			s.Equal(tt.want, policy)
+		})
+	}
+}
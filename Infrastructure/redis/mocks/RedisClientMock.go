@@ -40,6 +40,156 @@ func (_m *MockRedisClient) EXPECT() *MockRedisClient_Expecter {
 	return &MockRedisClient_Expecter{mock: &_m.Mock}
 }
 
+// AddToSet provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) AddToSet(ctx context.Context, key string, members ...string) error {
+	var tmpRet mock.Arguments
+	if len(members) > 0 {
+		tmpRet = _mock.Called(ctx, key, members)
+	} else {
+		tmpRet = _mock.Called(ctx, key)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToSet")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...string) error); ok {
+		r0 = returnFunc(ctx, key, members...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_AddToSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToSet'
+type MockRedisClient_AddToSet_Call struct {
+	*mock.Call
+}
+
+// AddToSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - members ...string
+func (_e *MockRedisClient_Expecter) AddToSet(ctx interface{}, key interface{}, members ...interface{}) *MockRedisClient_AddToSet_Call {
+	return &MockRedisClient_AddToSet_Call{Call: _e.mock.On("AddToSet",
+		append([]interface{}{ctx, key}, members...)...)}
+}
+
+func (_c *MockRedisClient_AddToSet_Call) Run(run func(ctx context.Context, key string, members ...string)) *MockRedisClient_AddToSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		var variadicArgs []string
+		if len(args) > 2 {
+			variadicArgs = args[2].([]string)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_AddToSet_Call) Return(err error) *MockRedisClient_AddToSet_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_AddToSet_Call) RunAndReturn(run func(ctx context.Context, key string, members ...string) error) *MockRedisClient_AddToSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddToStream provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) AddToStream(ctx context.Context, key string, maxLen int64, values map[string]any) (string, error) {
+	ret := _mock.Called(ctx, key, maxLen, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToStream")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, map[string]any) (string, error)); ok {
+		return returnFunc(ctx, key, maxLen, values)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int64, map[string]any) string); ok {
+		r0 = returnFunc(ctx, key, maxLen, values)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int64, map[string]any) error); ok {
+		r1 = returnFunc(ctx, key, maxLen, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisClient_AddToStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToStream'
+type MockRedisClient_AddToStream_Call struct {
+	*mock.Call
+}
+
+// AddToStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - maxLen int64
+//   - values map[string]any
+func (_e *MockRedisClient_Expecter) AddToStream(ctx interface{}, key interface{}, maxLen interface{}, values interface{}) *MockRedisClient_AddToStream_Call {
+	return &MockRedisClient_AddToStream_Call{Call: _e.mock.On("AddToStream", ctx, key, maxLen, values)}
+}
+
+func (_c *MockRedisClient_AddToStream_Call) Run(run func(ctx context.Context, key string, maxLen int64, values map[string]any)) *MockRedisClient_AddToStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		var arg3 map[string]any
+		if args[3] != nil {
+			arg3 = args[3].(map[string]any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_AddToStream_Call) Return(s string, err error) *MockRedisClient_AddToStream_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRedisClient_AddToStream_Call) RunAndReturn(run func(ctx context.Context, key string, maxLen int64, values map[string]any) (string, error)) *MockRedisClient_AddToStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function for the type MockRedisClient
 func (_mock *MockRedisClient) Close() error {
 	ret := _mock.Called()
@@ -558,6 +708,209 @@ func (_c *MockRedisClient_Increment_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// PSubscribe provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) PSubscribe(ctx context.Context, patterns ...string) *redis.PubSub {
+	var tmpRet mock.Arguments
+	if len(patterns) > 0 {
+		tmpRet = _mock.Called(ctx, patterns)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for PSubscribe")
+	}
+
+	var r0 *redis.PubSub
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...string) *redis.PubSub); ok {
+		r0 = returnFunc(ctx, patterns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*redis.PubSub)
+		}
+	}
+	return r0
+}
+
+// MockRedisClient_PSubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PSubscribe'
+type MockRedisClient_PSubscribe_Call struct {
+	*mock.Call
+}
+
+// PSubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - patterns ...string
+func (_e *MockRedisClient_Expecter) PSubscribe(ctx interface{}, patterns ...interface{}) *MockRedisClient_PSubscribe_Call {
+	return &MockRedisClient_PSubscribe_Call{Call: _e.mock.On("PSubscribe",
+		append([]interface{}{ctx}, patterns...)...)}
+}
+
+func (_c *MockRedisClient_PSubscribe_Call) Run(run func(ctx context.Context, patterns ...string)) *MockRedisClient_PSubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		var variadicArgs []string
+		if len(args) > 1 {
+			variadicArgs = args[1].([]string)
+		}
+		arg1 = variadicArgs
+		run(
+			arg0,
+			arg1...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_PSubscribe_Call) Return(pubSub *redis.PubSub) *MockRedisClient_PSubscribe_Call {
+	_c.Call.Return(pubSub)
+	return _c
+}
+
+func (_c *MockRedisClient_PSubscribe_Call) RunAndReturn(run func(ctx context.Context, patterns ...string) *redis.PubSub) *MockRedisClient_PSubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) Publish(ctx context.Context, channel string, message any) error {
+	ret := _mock.Called(ctx, channel, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, any) error); ok {
+		r0 = returnFunc(ctx, channel, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockRedisClient_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+//   - message any
+func (_e *MockRedisClient_Expecter) Publish(ctx interface{}, channel interface{}, message interface{}) *MockRedisClient_Publish_Call {
+	return &MockRedisClient_Publish_Call{Call: _e.mock.On("Publish", ctx, channel, message)}
+}
+
+func (_c *MockRedisClient_Publish_Call) Run(run func(ctx context.Context, channel string, message any)) *MockRedisClient_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 any
+		if args[2] != nil {
+			arg2 = args[2].(any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_Publish_Call) Return(err error) *MockRedisClient_Publish_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_Publish_Call) RunAndReturn(run func(ctx context.Context, channel string, message any) error) *MockRedisClient_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFromSet provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) RemoveFromSet(ctx context.Context, key string, members ...string) error {
+	var tmpRet mock.Arguments
+	if len(members) > 0 {
+		tmpRet = _mock.Called(ctx, key, members)
+	} else {
+		tmpRet = _mock.Called(ctx, key)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromSet")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, ...string) error); ok {
+		r0 = returnFunc(ctx, key, members...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRedisClient_RemoveFromSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromSet'
+type MockRedisClient_RemoveFromSet_Call struct {
+	*mock.Call
+}
+
+// RemoveFromSet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - members ...string
+func (_e *MockRedisClient_Expecter) RemoveFromSet(ctx interface{}, key interface{}, members ...interface{}) *MockRedisClient_RemoveFromSet_Call {
+	return &MockRedisClient_RemoveFromSet_Call{Call: _e.mock.On("RemoveFromSet",
+		append([]interface{}{ctx, key}, members...)...)}
+}
+
+func (_c *MockRedisClient_RemoveFromSet_Call) Run(run func(ctx context.Context, key string, members ...string)) *MockRedisClient_RemoveFromSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		var variadicArgs []string
+		if len(args) > 2 {
+			variadicArgs = args[2].([]string)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_RemoveFromSet_Call) Return(err error) *MockRedisClient_RemoveFromSet_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRedisClient_RemoveFromSet_Call) RunAndReturn(run func(ctx context.Context, key string, members ...string) error) *MockRedisClient_RemoveFromSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Service provides a mock function for the type MockRedisClient
 func (_mock *MockRedisClient) Service() *redis0.RedisService {
 	ret := _mock.Called()
@@ -672,3 +1025,223 @@ func (_c *MockRedisClient_Set_Call) RunAndReturn(run func(ctx context.Context, k
 	_c.Call.Return(run)
 	return _c
 }
+
+// SetMembers provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) SetMembers(ctx context.Context, key string) ([]string, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMembers")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisClient_SetMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMembers'
+type MockRedisClient_SetMembers_Call struct {
+	*mock.Call
+}
+
+// SetMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockRedisClient_Expecter) SetMembers(ctx interface{}, key interface{}) *MockRedisClient_SetMembers_Call {
+	return &MockRedisClient_SetMembers_Call{Call: _e.mock.On("SetMembers", ctx, key)}
+}
+
+func (_c *MockRedisClient_SetMembers_Call) Run(run func(ctx context.Context, key string)) *MockRedisClient_SetMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_SetMembers_Call) Return(strings []string, err error) *MockRedisClient_SetMembers_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockRedisClient_SetMembers_Call) RunAndReturn(run func(ctx context.Context, key string) ([]string, error)) *MockRedisClient_SetMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamRange provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) StreamRange(ctx context.Context, key string, start string, stop string, count int64) ([]redis.XMessage, error) {
+	ret := _mock.Called(ctx, key, start, stop, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamRange")
+	}
+
+	var r0 []redis.XMessage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, int64) ([]redis.XMessage, error)); ok {
+		return returnFunc(ctx, key, start, stop, count)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, int64) []redis.XMessage); ok {
+		r0 = returnFunc(ctx, key, start, stop, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]redis.XMessage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, int64) error); ok {
+		r1 = returnFunc(ctx, key, start, stop, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisClient_StreamRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamRange'
+type MockRedisClient_StreamRange_Call struct {
+	*mock.Call
+}
+
+// StreamRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - start string
+//   - stop string
+//   - count int64
+func (_e *MockRedisClient_Expecter) StreamRange(ctx interface{}, key interface{}, start interface{}, stop interface{}, count interface{}) *MockRedisClient_StreamRange_Call {
+	return &MockRedisClient_StreamRange_Call{Call: _e.mock.On("StreamRange", ctx, key, start, stop, count)}
+}
+
+func (_c *MockRedisClient_StreamRange_Call) Run(run func(ctx context.Context, key string, start string, stop string, count int64)) *MockRedisClient_StreamRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 int64
+		if args[4] != nil {
+			arg4 = args[4].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_StreamRange_Call) Return(xMessages []redis.XMessage, err error) *MockRedisClient_StreamRange_Call {
+	_c.Call.Return(xMessages, err)
+	return _c
+}
+
+func (_c *MockRedisClient_StreamRange_Call) RunAndReturn(run func(ctx context.Context, key string, start string, stop string, count int64) ([]redis.XMessage, error)) *MockRedisClient_StreamRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TTL provides a mock function for the type MockRedisClient
+func (_mock *MockRedisClient) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TTL")
+	}
+
+	var r0 time.Duration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (time.Duration, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) time.Duration); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRedisClient_TTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TTL'
+type MockRedisClient_TTL_Call struct {
+	*mock.Call
+}
+
+// TTL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockRedisClient_Expecter) TTL(ctx interface{}, key interface{}) *MockRedisClient_TTL_Call {
+	return &MockRedisClient_TTL_Call{Call: _e.mock.On("TTL", ctx, key)}
+}
+
+func (_c *MockRedisClient_TTL_Call) Run(run func(ctx context.Context, key string)) *MockRedisClient_TTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRedisClient_TTL_Call) Return(duration time.Duration, err error) *MockRedisClient_TTL_Call {
+	_c.Call.Return(duration, err)
+	return _c
+}
+
+func (_c *MockRedisClient_TTL_Call) RunAndReturn(run func(ctx context.Context, key string) (time.Duration, error)) *MockRedisClient_TTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
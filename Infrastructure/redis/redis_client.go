@@ -19,6 +19,14 @@ type RedisClient interface {
 	Increment(ctx context.Context, key string) (int64, error)
 	Decrement(ctx context.Context, key string) (int64, error)
 	Expire(ctx context.Context, key string, expiration time.Duration) error
+	TTL(ctx context.Context, key string) (time.Duration, error)
+	AddToSet(ctx context.Context, key string, members ...string) error
+	SetMembers(ctx context.Context, key string) ([]string, error)
+	RemoveFromSet(ctx context.Context, key string, members ...string) error
+	Publish(ctx context.Context, channel string, message any) error
+	PSubscribe(ctx context.Context, patterns ...string) *redis.PubSub
+	AddToStream(ctx context.Context, key string, maxLen int64, values map[string]any) (string, error)
+	StreamRange(ctx context.Context, key, start, stop string, count int64) ([]redis.XMessage, error)
 	GetCacheExpiry() time.Duration
 	Service() *RedisService
 }
@@ -106,6 +114,76 @@ func (r *redisClient) Expire(ctx context.Context, key string, expiration time.Du
 	return nil
 }
 
+// TTL is the time left before the key expires, zero when it does not exist or never expires
+func (r *redisClient) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ttl of key %s: %w", key, err)
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (r *redisClient) AddToSet(ctx context.Context, key string, members ...string) error {
+	if err := r.client.SAdd(ctx, key, members).Err(); err != nil {
+		return fmt.Errorf("failed to add to set %s: %w", key, err)
+	}
+	return nil
+}
+
+func (r *redisClient) SetMembers(ctx context.Context, key string) ([]string, error) {
+	members, err := r.client.SMembers(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members of set %s: %w", key, err)
+	}
+	return members, nil
+}
+
+func (r *redisClient) RemoveFromSet(ctx context.Context, key string, members ...string) error {
+	if err := r.client.SRem(ctx, key, members).Err(); err != nil {
+		return fmt.Errorf("failed to remove from set %s: %w", key, err)
+	}
+	return nil
+}
+
+func (r *redisClient) Publish(ctx context.Context, channel string, message any) error {
+	if err := r.client.Publish(ctx, channel, message).Err(); err != nil {
+		return fmt.Errorf("failed to publish to channel %s: %w", channel, err)
+	}
+	return nil
+}
+
+// PSubscribe listens to every channel matching the patterns, it reconnects by itself until closed
+func (r *redisClient) PSubscribe(ctx context.Context, patterns ...string) *redis.PubSub {
+	return r.client.PSubscribe(ctx, patterns...)
+}
+
+// AddToStream appends an entry to the stream, which keeps about its last maxLen entries, and
+// returns the entry's ID
+func (r *redisClient) AddToStream(ctx context.Context, key string, maxLen int64, values map[string]any) (string, error) {
+	id, err := r.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: maxLen,
+		Approx: true,
+		Values: values,
+	}).Result()
+	if err != nil {
+		return "", fmt.Errorf("failed to add to stream %s: %w", key, err)
+	}
+	return id, nil
+}
+
+// StreamRange returns at most count entries between start and stop, "(" before an ID excludes it
+func (r *redisClient) StreamRange(ctx context.Context, key, start, stop string, count int64) ([]redis.XMessage, error) {
+	messages, err := r.client.XRangeN(ctx, key, start, stop, count).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stream %s: %w", key, err)
+	}
+	return messages, nil
+}
+
 func (r *redisClient) GetCacheExpiry() time.Duration {
 	if r.cacheExpiry <= 0 {
 		return 1 * time.Hour
@@ -5,6 +5,7 @@ import (
 	domain "g6/blog-api/Domain"
 	"sort"
 	"strings"
+	"time"
 )
 
 type RedisService struct{}
@@ -12,7 +13,13 @@ type RedisService struct{}
 func (r *RedisService) GenerateRedisKey(filter *domain.BlogPostFilter) string {
 	sort.Strings(filter.Tags)
 	tags := strings.Join(filter.Tags, ",")
-	return fmt.Sprintf("blogs:page=%d:size=%d:recency=%s:tags=%s:author=%s:title=%s:popular=%t",
+	sort.Strings(filter.AuthorIDs)
+	createdAfter := ""
+	if !filter.CreatedAfter.IsZero() {
+		createdAfter = filter.CreatedAfter.UTC().Format(time.RFC3339Nano)
+	}
+	// the category as asked for, lists of a category that was moved catch up when they expire
+	return fmt.Sprintf("blogs:page=%d:size=%d:recency=%s:tags=%s:author=%s:title=%s:popular=%t:authors=%s:after=%s:category=%s:subcategories=%t",
 		filter.Page,
 		filter.PageSize,
 		filter.Recency,
@@ -20,6 +27,10 @@ func (r *RedisService) GenerateRedisKey(filter *domain.BlogPostFilter) string {
 		filter.AuthorName,
 		filter.Title,
 		filter.Popular,
+		strings.Join(filter.AuthorIDs, ","),
+		createdAfter,
+		filter.Category,
+		filter.IncludeSubcategories,
 	)
 }
 
@@ -41,4 +52,54 @@ func (r *RedisService) GenerateBlogPostAuthorKey(authorID string) string {
 
 func (r *RedisService) GenerateBlogCommentKey(id string) string {
 	return fmt.Sprintf("blogcomment:%s", id)
-}
\ No newline at end of file
+}
+
+// login attempt keys, kind is "id" for a login identifier or "ip" for a client address
+func (r *RedisService) GenerateLoginFailuresKey(kind, value string) string {
+	return fmt.Sprintf("login:failures:%s:%s", kind, value)
+}
+
+func (r *RedisService) GenerateLoginDelayKey(identifier string) string {
+	return fmt.Sprintf("login:delay:%s", identifier)
+}
+
+func (r *RedisService) GenerateLoginBlockedIPKey(ip string) string {
+	return fmt.Sprintf("login:blocked:ip:%s", ip)
+}
+
+func (r *RedisService) GenerateLoginLockKey(identifier string) string {
+	return fmt.Sprintf("login:lock:%s", identifier)
+}
+
+// GenerateLoginLocksKey is the set of identifiers that have been locked, for admins to list
+func (r *RedisService) GenerateLoginLocksKey() string {
+	return "login:locks"
+}
+
+func (r *RedisService) GenerateLoginUnlockKey(tokenHash string) string {
+	return fmt.Sprintf("login:unlock:%s", tokenHash)
+}
+
+// GenerateRateLimitKey is the request count of one subject, a user or an IP, in the window starting at windowStart
+func (r *RedisService) GenerateRateLimitKey(policy, route, subject string, windowStart int64) string {
+	return fmt.Sprintf("ratelimit:%s:%s:%s:%d", policy, route, subject, windowStart)
+}
+
+// GenerateUnreadNotificationsKey caches the number of unread notifications of a user
+func (r *RedisService) GenerateUnreadNotificationsKey(userID string) string {
+	return fmt.Sprintf("notifications:unread:%s", userID)
+}
+
+// live stream keys, every topic has a pub/sub channel and a capped log for resuming
+func (r *RedisService) GenerateStreamChannel(topic string) string {
+	return fmt.Sprintf("stream:%s", topic)
+}
+
+// GenerateStreamChannelPattern matches the channel of every topic
+func (r *RedisService) GenerateStreamChannelPattern() string {
+	return "stream:*"
+}
+
+func (r *RedisService) GenerateStreamLogKey(topic string) string {
+	return fmt.Sprintf("streamlog:%s", topic)
+}
@@ -0,0 +1,122 @@
+package security
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// BreachedPasswords checks passwords against a local copy of a breach corpus. The file is never
+// loaded, it is sorted by hash and every check binary searches it, reading a few lines at a time.
+type BreachedPasswords struct {
+	file *os.File
+	size int64
+}
+
+// OpenBreachedPasswords opens a file with one upper or lower case SHA-1 hex hash per line sorted
+// by hash, optionally followed by ":<count>", as in the Pwned Passwords "ordered by hash" download.
+// Only the first line is checked here, a malformed line met later fails that check.
+func OpenBreachedPasswords(path string) (*BreachedPasswords, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	breached := &BreachedPasswords{file: file, size: info.Size()}
+	line, err := breached.lineAt(0)
+	if err == nil {
+		_, err = breachedHash(line)
+	}
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("breached passwords %s: %w", path, err)
+	}
+	return breached, nil
+}
+
+func (b *BreachedPasswords) IsBreached(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	// find the first line whose hash is not below the password's
+	low, high := int64(0), b.size
+	for low < high {
+		mid := low + (high-low)/2
+		line, err := b.lineAt(mid)
+		if err != nil {
+			return false, err
+		}
+		if line == "" {
+			high = mid
+			continue
+		}
+		lineHash, err := breachedHash(line)
+		if err != nil {
+			return false, err
+		}
+		if lineHash < hash {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	line, err := b.lineAt(low)
+	if err != nil || line == "" {
+		return false, err
+	}
+	lineHash, err := breachedHash(line)
+	if err != nil {
+		return false, err
+	}
+	return lineHash == hash, nil
+}
+
+func (b *BreachedPasswords) Close() error {
+	return b.file.Close()
+}
+
+// lineAt reads the first line starting at or after offset, "" when there is none. The line
+// offset falls into is skipped, unless offset is where it starts.
+func (b *BreachedPasswords) lineAt(offset int64) (string, error) {
+	start := offset
+	if offset > 0 {
+		start = offset - 1
+	}
+	reader := bufio.NewReader(io.NewSectionReader(b.file, start, b.size-start))
+	if offset > 0 {
+		_, err := reader.ReadString('\n')
+		if err == io.EOF {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	line, err := reader.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// breachedHash is the upper case hash of a line, without its count
+func breachedHash(line string) (string, error) {
+	hash, _, _ := strings.Cut(line, ":")
+	hash = strings.ToUpper(hash)
+	if len(hash) != sha1.Size*2 {
+		return "", fmt.Errorf("%q is not a SHA-1 hash", hash)
+	}
+	if _, err := hex.DecodeString(hash); err != nil {
+		return "", fmt.Errorf("%q is not a SHA-1 hash", hash)
+	}
+	return hash, nil
+}
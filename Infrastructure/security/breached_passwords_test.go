@@ -0,0 +1,141 @@
+package security
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// BreachedPasswordsSuite searches breach lists written to a temporary directory
+type BreachedPasswordsSuite struct {
+	suite.Suite
+}
+
+func TestBreachedPasswordsSuite(t *testing.T) {
+	suite.Run(t, new(BreachedPasswordsSuite))
+}
+
+func sha1Hex(password string) string {
+	sum := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// open writes the lines to a file and opens it as a breach list
+func (s *BreachedPasswordsSuite) open(lines []string, newline string) *BreachedPasswords {
+	path := filepath.Join(s.T().TempDir(), "breached.txt")
+	s.Require().NoError(os.WriteFile(path, []byte(strings.Join(lines, newline)+newline), 0o600))
+	breached, err := OpenBreachedPasswords(path)
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { breached.Close() })
+	return breached
+}
+
+// sortedList is the hashes of password-0 to password-<n-1> with counts, ordered by hash
+func sortedList(n int) []string {
+	lines := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		lines = append(lines, fmt.Sprintf("%s:%d", sha1Hex(fmt.Sprintf("password-%d", i)), i+1))
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func (s *BreachedPasswordsSuite) TestIsBreached() {
+	s.Run("EveryListedPasswordFound", func() {
+		breached := s.open(sortedList(500), "\n")
+
+		for i := 0; i < 500; i++ {
+			found, err := breached.IsBreached(fmt.Sprintf("password-%d", i))
+			s.NoError(err)
+			s.True(found, "password-%d", i)
+		}
+	})
+
+	s.Run("UnlistedPasswordsNotFound", func() {
+		breached := s.open(sortedList(500), "\n")
+
+		for i := 500; i < 600; i++ {
+			found, err := breached.IsBreached(fmt.Sprintf("password-%d", i))
+			s.NoError(err)
+			s.False(found, "password-%d", i)
+		}
+	})
+
+	s.Run("BelowTheFirstAndAboveTheLastHash", func() {
+		lines := sortedList(10)
+		breached := s.open(lines, "\n")
+
+		below, above := 0, 0
+		for i := 10; i < 200; i++ {
+			password := fmt.Sprintf("password-%d", i)
+			switch hash := sha1Hex(password); {
+			case hash < lines[0]:
+				below++
+			case hash > lines[len(lines)-1]:
+				above++
+			default:
+				continue
+			}
+			found, err := breached.IsBreached(password)
+			s.NoError(err)
+			s.False(found, password)
+		}
+		s.Positive(below)
+		s.Positive(above)
+	})
+
+	s.Run("SingleLine", func() {
+		breached := s.open([]string{sha1Hex("password")}, "\n")
+
+		found, err := breached.IsBreached("password")
+
+		s.NoError(err)
+		s.True(found)
+	})
+
+	s.Run("LowerCaseAndCRLF", func() {
+		lines := sortedList(50)
+		for i := range lines {
+			lines[i] = strings.ToLower(lines[i])
+		}
+		breached := s.open(lines, "\r\n")
+
+		found, err := breached.IsBreached("password-7")
+
+		s.NoError(err)
+		s.True(found)
+	})
+}
+
+func (s *BreachedPasswordsSuite) TestOpen() {
+	s.Run("NotAHashList", func() {
+		path := filepath.Join(s.T().TempDir(), "breached.txt")
+		s.Require().NoError(os.WriteFile(path, []byte("password\n"), 0o600))
+
+		_, err := OpenBreachedPasswords(path)
+
+		s.Error(err)
+	})
+
+	s.Run("Empty", func() {
+		path := filepath.Join(s.T().TempDir(), "breached.txt")
+		s.Require().NoError(os.WriteFile(path, nil, 0o600))
+
+		_, err := OpenBreachedPasswords(path)
+
+		s.Error(err)
+	})
+
+	s.Run("Missing", func() {
+		_, err := OpenBreachedPasswords(filepath.Join(s.T().TempDir(), "missing.txt"))
+
+		s.Error(err)
+	})
+}
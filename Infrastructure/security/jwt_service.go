@@ -1,50 +1,60 @@
 package security
 
 import (
-	"errors"
+	"fmt"
 	domain "g6/blog-api/Domain"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// token types, carried in the typ claim so one kind of token cannot be used as another
+const (
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+	tokenTypePreAuth = "pre_auth"
 )
 
 type JwtService struct {
-	AccessSecret  string
-	RefreshSecret string
+	Keys          *KeySet
 	AccessExpiry  time.Duration
 	RefreshExpiry time.Duration
+	PreAuthExpiry time.Duration
 }
 
-func NewJWTService(accessSecret, refreshSecret string, accessExpiry, refreshExpiry int) domain.IAuthService {
+func NewJWTService(keys *KeySet, accessExpiry, refreshExpiry, preAuthExpiry int) domain.IAuthService {
 	return &JwtService{
-		AccessSecret:  accessSecret,
-		RefreshSecret: refreshSecret,
+		Keys:          keys,
 		AccessExpiry:  time.Duration(accessExpiry) * time.Minute,
 		RefreshExpiry: time.Duration(refreshExpiry) * time.Hour,
+		PreAuthExpiry: time.Duration(preAuthExpiry) * time.Minute,
 	}
 }
 
 func (s *JwtService) GenerateTokens(user domain.User) (domain.RefreshTokenResponse, error) {
 	accessClaims := jwt.MapClaims{
 		"sub":         user.ID,
+		"typ":         tokenTypeAccess,
 		"username":    user.Username,
 		"is_verified": user.IsVerified,
 		"role":        user.Role,
 		"exp":         time.Now().Add(s.AccessExpiry).Unix(),
 	}
-	accessToken := jwt.NewWithClaims(jwt.SigningMethodHS256, accessClaims)
-	accessTokenStr, err := accessToken.SignedString([]byte(s.AccessSecret))
+	accessTokenStr, err := s.Keys.Sign(accessClaims)
 	if err != nil {
 		return domain.RefreshTokenResponse{}, err
 	}
 
+	// jti keeps refresh tokens unique when the same user logs in on two devices at once
 	refreshClaims := jwt.MapClaims{
 		"sub":      user.ID,
+		"typ":      tokenTypeRefresh,
 		"username": user.Username,
+		"jti":      uuid.NewString(),
 		"exp":      time.Now().Add(s.RefreshExpiry).Unix(),
 	}
-	refreshToken := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims)
-	refreshTokenStr, err := refreshToken.SignedString([]byte(s.RefreshSecret))
+	refreshTokenStr, err := s.Keys.Sign(refreshClaims)
 	if err != nil {
 		return domain.RefreshTokenResponse{}, err
 	}
@@ -58,33 +68,43 @@ func (s *JwtService) GenerateTokens(user domain.User) (domain.RefreshTokenRespon
 }
 
 func (s *JwtService) ValidateToken(token string) (jwt.MapClaims, error) {
-	parsedToken, err := jwt.Parse(token, func(token *jwt.Token) (any, error) {
-		if method, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || method.Alg() != jwt.SigningMethodHS256.Alg() {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(s.AccessSecret), nil
-	})
-	if err != nil {
-		return nil, errors.New("invalid token: " + err.Error())
+	return s.validate(token, tokenTypeAccess)
+}
+
+func (s *JwtService) ValidateRefreshToken(token string) (jwt.MapClaims, error) {
+	return s.validate(token, tokenTypeRefresh)
+}
+
+// GeneratePreAuthToken issues a short-lived token proving the password step of a two-step login.
+// Its typ claim makes sure it can never be used as an access token.
+func (s *JwtService) GeneratePreAuthToken(user domain.User) (string, time.Time, error) {
+	expiresAt := time.Now().Add(s.PreAuthExpiry)
+	claims := jwt.MapClaims{
+		"sub": user.ID,
+		"typ": tokenTypePreAuth,
+		"exp": expiresAt.Unix(),
 	}
-	if claims, ok := parsedToken.Claims.(jwt.MapClaims); ok && parsedToken.Valid {
-		return claims, nil
+	tokenStr, err := s.Keys.Sign(claims)
+	if err != nil {
+		return "", time.Time{}, err
 	}
-	return nil, errors.New("invalid token")
+	return tokenStr, expiresAt, nil
 }
 
-func (s *JwtService) ValidateRefreshToken(token string) (jwt.MapClaims, error) {
-	parsedToken, err := jwt.Parse(token, func(token *jwt.Token) (any, error) {
-		if method, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || method.Alg() != jwt.SigningMethodHS256.Alg() {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(s.RefreshSecret), nil
-	})
+func (s *JwtService) ValidatePreAuthToken(token string) (jwt.MapClaims, error) {
+	return s.validate(token, tokenTypePreAuth)
+}
+
+// validate verifies the signature through the key set and checks the token type.
+// The jwt errors are wrapped so callers can still tell an expired token apart.
+func (s *JwtService) validate(token, tokenType string) (jwt.MapClaims, error) {
+	parsedToken, err := jwt.Parse(token, s.Keys.Keyfunc, jwt.WithValidMethods(s.Keys.ValidMethods()))
 	if err != nil {
-		return nil, errors.New("invalid token: " + err.Error())
+		return nil, fmt.Errorf("invalid token: %w", err)
 	}
-	if claims, ok := parsedToken.Claims.(jwt.MapClaims); ok && parsedToken.Valid {
-		return claims, nil
+	claims, ok := parsedToken.Claims.(jwt.MapClaims)
+	if !ok || !parsedToken.Valid || claims["typ"] != tokenType {
+		return nil, fmt.Errorf("invalid token")
 	}
-	return nil, errors.New("invalid token")
+	return claims, nil
 }
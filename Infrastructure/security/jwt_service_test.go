@@ -0,0 +1,46 @@
+package security
+
+import (
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+func (s *KeySetSuite) TestJwtServiceTokenTypes() {
+	service := NewJWTService(s.newKeySet(domain.SigningAlgorithmEdDSA, time.Hour), 15, 24, 5)
+	tokens, err := service.GenerateTokens(domain.User{ID: "1", Username: "john", Role: domain.RoleUser})
+	s.Require().NoError(err)
+	preAuth, _, err := service.GeneratePreAuthToken(domain.User{ID: "1"})
+	s.Require().NoError(err)
+
+	s.Run("AccessToken", func() {
+		claims, err := service.ValidateToken(tokens.AccessToken)
+		s.NoError(err)
+		s.Equal("1", claims["sub"])
+		s.Equal("access", claims["typ"])
+
+		_, err = service.ValidateRefreshToken(tokens.AccessToken)
+		s.EqualError(err, "invalid token")
+	})
+
+	s.Run("RefreshTokenIsNoAccessToken", func() {
+		_, err := service.ValidateToken(tokens.RefreshToken)
+		s.EqualError(err, "invalid token")
+
+		claims, err := service.ValidateRefreshToken(tokens.RefreshToken)
+		s.NoError(err)
+		s.NotEmpty(claims["jti"])
+	})
+
+	s.Run("PreAuthTokenIsNoAccessToken", func() {
+		_, err := service.ValidateToken(preAuth)
+		s.EqualError(err, "invalid token")
+
+		_, err = service.ValidatePreAuthToken(preAuth)
+		s.NoError(err)
+	})
+
+	s.Run("Tampered", func() {
+		_, err := service.ValidateToken(tokens.AccessToken + "x")
+		s.ErrorContains(err, "invalid token")
+	})
+}
@@ -0,0 +1,313 @@
+package security
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	domain "g6/blog-api/Domain"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	rsaKeyBits = 2048
+	// how often a running server picks up keys rotated by another process
+	keySetReloadInterval = time.Minute
+	// an unknown kid triggers a reload, but not more often than this
+	keySetMissReloadInterval = 5 * time.Second
+)
+
+var ErrUnknownSigningKey = errors.New("unknown signing key")
+
+type loadedKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+	active  bool
+}
+
+// KeySet holds the asymmetric JWT keys. The newest active key signs, every loaded key verifies.
+// Keys live in the database so all instances and the rotate-keys command share them.
+type KeySet struct {
+	repo          domain.ISigningKeyRepository
+	encryptionKey string
+	algorithm     domain.SigningAlgorithm
+	retention     time.Duration
+	ctxtimeout    time.Duration
+
+	mu       sync.RWMutex
+	keys     map[string]*loadedKey
+	signing  *loadedKey
+	loadedAt time.Time
+}
+
+// NewKeySet creates the key set. algorithm is used for newly generated keys and retention
+// is how long a retired key keeps verifying, it should cover the refresh token lifetime.
+func NewKeySet(repo domain.ISigningKeyRepository, encryptionKey string, algorithm domain.SigningAlgorithm, retention, timeout time.Duration) *KeySet {
+	return &KeySet{
+		repo:          repo,
+		encryptionKey: encryptionKey,
+		algorithm:     algorithm,
+		retention:     retention,
+		ctxtimeout:    timeout,
+		keys:          map[string]*loadedKey{},
+	}
+}
+
+// Load reads the keys from the database and creates the first key when there is none
+func (ks *KeySet) Load(ctx context.Context) error {
+	if err := ks.reload(ctx); err != nil {
+		return err
+	}
+	ks.mu.RLock()
+	hasSigningKey := ks.signing != nil
+	ks.mu.RUnlock()
+	if hasSigningKey {
+		return nil
+	}
+	_, err := ks.Rotate(ctx)
+	return err
+}
+
+// Rotate generates a new signing key and retires the previous active keys.
+// Retired keys stay valid for verification until the retention period ends.
+func (ks *KeySet) Rotate(ctx context.Context) (*domain.SigningKey, error) {
+	key, err := ks.generate()
+	if err != nil {
+		return nil, err
+	}
+	if err := ks.repo.Save(ctx, key); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if _, err := ks.repo.RetireActive(ctx, key.Kid, now, now.Add(ks.retention)); err != nil {
+		return nil, err
+	}
+	if err := ks.reload(ctx); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Sign signs the claims with the current key and sets the kid header
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	ks.reloadIfStale(keySetReloadInterval)
+
+	ks.mu.RLock()
+	key := ks.signing
+	ks.mu.RUnlock()
+	if key == nil {
+		return "", errors.New("no active signing key")
+	}
+
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.kid
+	return token.SignedString(key.private)
+}
+
+// Keyfunc resolves the verification key from the kid header, for use with jwt.Parse
+func (ks *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("token has no kid header")
+	}
+
+	key := ks.lookup(kid)
+	if key == nil {
+		// the key may have been rotated in by another instance
+		ks.reloadIfStale(keySetMissReloadInterval)
+		key = ks.lookup(kid)
+	}
+	if key == nil {
+		return nil, ErrUnknownSigningKey
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+	return key.public, nil
+}
+
+// ValidMethods lists the algorithms the parser should accept
+func (ks *KeySet) ValidMethods() []string {
+	return []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}
+}
+
+// JWKS returns the public keys of every key that can still verify tokens
+func (ks *KeySet) JWKS(ctx context.Context) (*domain.JSONWebKeySet, error) {
+	ks.reloadIfStale(keySetReloadInterval)
+
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	set := &domain.JSONWebKeySet{Keys: make([]domain.JSONWebKey, 0, len(ks.keys))}
+	for _, key := range ks.keys {
+		jwk := domain.JSONWebKey{Kid: key.kid, Use: "sig", Alg: key.method.Alg()}
+		switch public := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set, nil
+}
+
+func (ks *KeySet) lookup(kid string) *loadedKey {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	return ks.keys[kid]
+}
+
+func (ks *KeySet) reloadIfStale(maxAge time.Duration) {
+	ks.mu.RLock()
+	stale := time.Since(ks.loadedAt) > maxAge
+	ks.mu.RUnlock()
+	if !stale {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), ks.ctxtimeout)
+	defer cancel()
+	// on failure the keys loaded before stay in use
+	_ = ks.reload(ctx)
+}
+
+func (ks *KeySet) reload(ctx context.Context) error {
+	stored, err := ks.repo.FindValid(ctx, time.Now())
+	if err != nil {
+		ks.mu.Lock()
+		ks.loadedAt = time.Now() // do not hammer the database while it is down
+		ks.mu.Unlock()
+		return err
+	}
+
+	keys := make(map[string]*loadedKey, len(stored))
+	var signing *loadedKey
+	for _, s := range stored {
+		key, err := ks.parse(s)
+		if err != nil {
+			return fmt.Errorf("signing key %s: %w", s.Kid, err)
+		}
+		keys[key.kid] = key
+		// stored keys come newest first
+		if key.active && signing == nil {
+			signing = key
+		}
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.signing = signing
+	ks.loadedAt = time.Now()
+	ks.mu.Unlock()
+	return nil
+}
+
+func (ks *KeySet) parse(stored *domain.SigningKey) (*loadedKey, error) {
+	key := &loadedKey{kid: stored.Kid, active: stored.Active}
+	switch stored.Algorithm {
+	case domain.SigningAlgorithmRS256:
+		key.method = jwt.SigningMethodRS256
+	case domain.SigningAlgorithmEdDSA:
+		key.method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", stored.Algorithm)
+	}
+
+	block, _ := pem.Decode([]byte(stored.PublicKeyPEM))
+	if block == nil {
+		return nil, errors.New("invalid public key")
+	}
+	public, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key.public = public
+
+	// retired keys only verify, there is no need to decrypt them
+	if !stored.Active {
+		return key, nil
+	}
+	privatePEM, err := DecryptSecret(ks.encryptionKey, stored.PrivateKeyCipher)
+	if err != nil {
+		return nil, err
+	}
+	block, _ = pem.Decode([]byte(privatePEM))
+	if block == nil {
+		return nil, errors.New("invalid private key")
+	}
+	private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := private.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key cannot sign")
+	}
+	key.private = signer
+	return key, nil
+}
+
+func (ks *KeySet) generate() (*domain.SigningKey, error) {
+	var private crypto.Signer
+	var err error
+	switch ks.algorithm {
+	case domain.SigningAlgorithmRS256:
+		private, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case domain.SigningAlgorithmEdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", ks.algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, err
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(private.Public())
+	if err != nil {
+		return nil, err
+	}
+	privatePEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})
+
+	cipher, err := EncryptSecret(ks.encryptionKey, string(privatePEM))
+	if err != nil {
+		return nil, err
+	}
+
+	kid := make([]byte, 8)
+	if _, err := rand.Read(kid); err != nil {
+		return nil, err
+	}
+
+	return &domain.SigningKey{
+		Kid:              hex.EncodeToString(kid),
+		Algorithm:        ks.algorithm,
+		PrivateKeyCipher: cipher,
+		PublicKeyPEM:     string(publicPEM),
+		Active:           true,
+		CreatedAt:        time.Now(),
+	}, nil
+}
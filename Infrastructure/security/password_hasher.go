@@ -0,0 +1,187 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrPasswordMismatch      = errors.New("password does not match")
+	ErrUnknownPasswordHash   = errors.New("unknown password hash format")
+	ErrMalformedPasswordHash = errors.New("malformed password hash")
+)
+
+// PasswordHasher is one password hashing algorithm. Both hash formats are accepted: argon2id
+// hashes are PHC strings ($argon2id$...), bcrypt hashes keep their modular crypt format
+// ($2a$, $2b$ or $2y$) that every bcrypt library reads. Either way the algorithm and parameters
+// a stored hash was made with can be read back from it.
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	// Verify returns ErrPasswordMismatch when the password does not match the hash
+	Verify(hash, password string) error
+	// Recognizes reports whether the hash was produced by this algorithm
+	Recognizes(hash string) bool
+	// Outdated reports whether the hash was made with other parameters than the current ones
+	Outdated(hash string) bool
+}
+
+// Argon2idHasher hashes with argon2id, Memory is in KiB
+type Argon2idHasher struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2idHasher follows the OWASP recommendation of at least 19 MiB and two iterations
+var DefaultArgon2idHasher = Argon2idHasher{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+type argon2idParams struct {
+	version            int
+	memory, iterations uint32
+	parallelism        uint8
+	salt, key          []byte
+}
+
+func (h Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, h.Iterations, h.Memory, h.Parallelism, h.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.Memory, h.Iterations, h.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h Argon2idHasher) Verify(hash, password string) error {
+	params, err := parseArgon2id(hash)
+	if err != nil {
+		return err
+	}
+	key := argon2.IDKey([]byte(password), params.salt, params.iterations, params.memory, params.parallelism, uint32(len(params.key)))
+	if subtle.ConstantTimeCompare(key, params.key) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+func (h Argon2idHasher) Recognizes(hash string) bool {
+	return strings.HasPrefix(hash, "$argon2id$")
+}
+
+func (h Argon2idHasher) Outdated(hash string) bool {
+	params, err := parseArgon2id(hash)
+	if err != nil {
+		return true
+	}
+	return params.version != argon2.Version ||
+		params.memory != h.Memory ||
+		params.iterations != h.Iterations ||
+		params.parallelism != h.Parallelism ||
+		uint32(len(params.salt)) != h.SaltLength ||
+		uint32(len(params.key)) != h.KeyLength
+}
+
+// parseArgon2id reads $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>
+func parseArgon2id(hash string) (*argon2idParams, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, ErrMalformedPasswordHash
+	}
+	params := &argon2idParams{}
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &params.version); err != nil {
+		return nil, ErrMalformedPasswordHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return nil, ErrMalformedPasswordHash
+	}
+	var err error
+	if params.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, ErrMalformedPasswordHash
+	}
+	if params.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(params.key) == 0 {
+		return nil, ErrMalformedPasswordHash
+	}
+	return params, nil
+}
+
+// BcryptHasher hashes with bcrypt, in the $2b$<cost>$ modular crypt format rather than a PHC string.
+// bcrypt only uses the first 72 bytes of a password and refuses longer ones.
+type BcryptHasher struct {
+	Cost int
+}
+
+func (h BcryptHasher) Hash(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+func (h BcryptHasher) Verify(hash, password string) error {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return ErrPasswordMismatch
+	}
+	return err
+}
+
+func (h BcryptHasher) Recognizes(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func (h BcryptHasher) Outdated(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.Cost
+}
+
+// PasswordHashing hashes new passwords with the current hasher and verifies stored hashes with
+// whichever hasher recognizes them, so hashes made under an older configuration keep working.
+type PasswordHashing struct {
+	current PasswordHasher
+	hashers []PasswordHasher
+}
+
+func NewPasswordHashing(current PasswordHasher, legacy ...PasswordHasher) *PasswordHashing {
+	return &PasswordHashing{
+		current: current,
+		hashers: append([]PasswordHasher{current}, legacy...),
+	}
+}
+
+func (p *PasswordHashing) Hash(password string) (string, error) {
+	return p.current.Hash(password)
+}
+
+func (p *PasswordHashing) Verify(hash, password string) error {
+	for _, hasher := range p.hashers {
+		if hasher.Recognizes(hash) {
+			return hasher.Verify(hash, password)
+		}
+	}
+	return ErrUnknownPasswordHash
+}
+
+// NeedsRehash reports whether the hash should be replaced, because it was made with another
+// algorithm or other parameters than the current hasher's
+func (p *PasswordHashing) NeedsRehash(hash string) bool {
+	return !p.current.Recognizes(hash) || p.current.Outdated(hash)
+}
@@ -1,22 +1,38 @@
 package security
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
 	"encoding/hex"
+	"fmt"
+	"math/big"
 
 	"golang.org/x/crypto/bcrypt"
 )
 
+// passwordHashing is replaced with the configured algorithm at startup, until then
+// passwords are hashed with bcrypt as they always were
+var passwordHashing = NewPasswordHashing(BcryptHasher{Cost: bcrypt.DefaultCost}, DefaultArgon2idHasher)
+
+// SetPasswordHashing changes how passwords are hashed from now on, call it before serving requests
+func SetPasswordHashing(hashing *PasswordHashing) {
+	passwordHashing = hashing
+}
+
 func HashPassword(password string) (string, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return "", err
-	}
-	return string(hashedPassword), nil
+	return passwordHashing.Hash(password)
 }
 
 func ValidatePassword(hashedPassword, password string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	return passwordHashing.Verify(hashedPassword, password)
+}
+
+// PasswordNeedsRehash reports whether a stored hash predates the current algorithm or parameters
+func PasswordNeedsRehash(hashedPassword string) bool {
+	return passwordHashing.NeedsRehash(hashedPassword)
 }
 
 func HashToken(token string) (string, error) {
@@ -24,6 +40,15 @@ func HashToken(token string) (string, error) {
 	return hex.EncodeToString(hash[:]), nil
 }
 
+// GenerateOpaqueToken returns a random url-safe token with the given prefix, store it with HashToken
+func GenerateOpaqueToken(prefix string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
 func ValidateTokenHash(tokenHash, token string) (bool, error) {
 	hashedToken, err := HashToken(token)
 	if err != nil {
@@ -32,14 +57,24 @@ func ValidateTokenHash(tokenHash, token string) (bool, error) {
 	return hashedToken == tokenHash, nil
 }
 
-// hash OTP code
-func HashOTPCode(code string) string {
-	hash := sha256.Sum256([]byte(code))
-	return hex.EncodeToString(hash[:])
+// GenerateOTPCode returns a random six digit code
+func GenerateOTPCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// HashOTPCode hashes a code with HMAC-SHA256 keyed by the secret salt, so a leaked hash
+// cannot be brute forced over the million possible codes without the salt
+func HashOTPCode(code, salt string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(code))
+	return hex.EncodeToString(mac.Sum(nil))
 }
 
-// verify OTP code
-func VerifyOTPCode(hashedCode, code string) bool {
-	hashedInputCode := HashOTPCode(code)
-	return hashedInputCode == hashedCode
+// VerifyOTPCode compares the code against the stored hash in constant time
+func VerifyOTPCode(hashedCode, code, salt string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashOTPCode(code, salt)), []byte(hashedCode)) == 1
 }
@@ -0,0 +1,115 @@
+package security
+
+import (
+	"context"
+	"slices"
+
+	domain "g6/blog-api/Domain"
+)
+
+var userPermissions = []domain.Permission{
+	domain.PermPostRead,
+	domain.PermPostCreate,
+	domain.PermPostUpdateOwn,
+	domain.PermPostDeleteOwn,
+	domain.PermCommentCreate,
+	domain.PermCommentUpdateOwn,
+	domain.PermCommentDeleteOwn,
+}
+
+var adminPermissions = append(slices.Clone(userPermissions),
+	domain.PermPostDeleteAny,
+	domain.PermCommentModerate,
+	domain.PermUserPromote,
+	domain.PermUserBan,
+	domain.PermTokenAdminScope,
+	domain.PermAccountLockManage,
+	domain.PermEmailTemplatePreview,
+	domain.PermEmailOutboxManage,
+	domain.PermWebhookManage,
+	domain.PermTagManage,
+	domain.PermCategoryManage,
+)
+
+// DefaultRolePermissions is the permission set of every role
+var DefaultRolePermissions = map[domain.UserRole][]domain.Permission{
+	domain.RoleUser:  userPermissions,
+	domain.RoleAdmin: adminPermissions,
+	domain.RoleSuperAdmin: append(slices.Clone(adminPermissions),
+		domain.PermUserRoleManage,
+		domain.PermSecurityPolicyManage,
+		domain.PermSecurityAuditRead,
+	),
+}
+
+// the permissions a personal access token scope unlocks, the admin scope unlocks everything
+var scopePermissions = map[domain.TokenScope][]domain.Permission{
+	domain.ScopeReadPosts:     {domain.PermPostRead},
+	domain.ScopeWritePosts:    {domain.PermPostCreate, domain.PermPostUpdateOwn, domain.PermPostDeleteOwn},
+	domain.ScopeWriteComments: {domain.PermCommentCreate, domain.PermCommentUpdateOwn, domain.PermCommentDeleteOwn},
+}
+
+// which permission allows an action on any resource, and which only on the actor's own
+var actionPermissions = map[domain.Action]struct{ any, own domain.Permission }{
+	domain.ActionPostUpdate:    {own: domain.PermPostUpdateOwn},
+	domain.ActionPostDelete:    {any: domain.PermPostDeleteAny, own: domain.PermPostDeleteOwn},
+	domain.ActionCommentUpdate: {any: domain.PermCommentModerate, own: domain.PermCommentUpdateOwn},
+	domain.ActionCommentDelete: {any: domain.PermCommentModerate, own: domain.PermCommentDeleteOwn},
+}
+
+// the role changes user:promote allows, user:role:manage allows any
+var promotions = map[domain.UserRole]domain.UserRole{
+	domain.RoleUser: domain.RoleAdmin,
+}
+
+// Policy is the single place that decides who may do what
+type Policy struct {
+	roles map[domain.UserRole][]domain.Permission
+}
+
+func NewPolicy(roles map[domain.UserRole][]domain.Permission) domain.IPolicy {
+	return &Policy{roles: roles}
+}
+
+func (p *Policy) Permissions(role domain.UserRole) []domain.Permission {
+	return slices.Clone(p.roles[role])
+}
+
+func (p *Policy) HasPermission(actor domain.Actor, permission domain.Permission) bool {
+	if permission == "" || !slices.Contains(p.roles[actor.Role], permission) {
+		return false
+	}
+	// browser sessions are not scoped
+	if actor.Scopes == nil {
+		return true
+	}
+	for _, scope := range actor.Scopes {
+		if scope == domain.ScopeAdmin || slices.Contains(scopePermissions[scope], permission) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) Can(ctx context.Context, action domain.Action, resource domain.Resource) bool {
+	actor, ok := domain.ActorFromContext(ctx)
+	if !ok {
+		return false
+	}
+	rule, ok := actionPermissions[action]
+	if !ok {
+		return false
+	}
+	if p.HasPermission(actor, rule.any) {
+		return true
+	}
+	return resource.OwnerID != "" && resource.OwnerID == actor.UserID && p.HasPermission(actor, rule.own)
+}
+
+func (p *Policy) CanChangeRole(actor domain.Actor, from, to domain.UserRole) bool {
+	if p.HasPermission(actor, domain.PermUserRoleManage) {
+		return true
+	}
+	promotion, ok := promotions[from]
+	return ok && promotion == to && p.HasPermission(actor, domain.PermUserPromote)
+}
@@ -65,35 +65,8 @@ func (b *blogPostRepo) Delete(ctx context.Context, id string) *domain.DomainErro
 		}
 	}
 
-	userID, ok := ctx.Value("user_id").(string)
-	if !ok || userID == "" {
-		return &domain.DomainError{
-			Err:  errors.New("unauthorized: missing user ID"),
-			Code: http.StatusUnauthorized,
-		}
-	}
-
-	role, _ := ctx.Value("role").(string)
-	isAdmin := role == "admin" || role == "superadmin"
-
-	var filter bson.M
-
-	if isAdmin {
-		// Admins can delete any blog post
-		filter = bson.M{"_id": oid}
-	} else {
-		// Regular users can only delete their own blog post
-		authorOID, err := primitive.ObjectIDFromHex(userID)
-		if err != nil {
-			return &domain.DomainError{
-				Err:  errors.New("invalid user ID"),
-				Code: http.StatusBadRequest,
-			}
-		}
-		filter = bson.M{"_id": oid, "author_id": authorOID}
-	}
-
-	result, err := b.db.Collection(b.collections.BlogPosts).DeleteOne(ctx, filter)
+	// who may delete the post is decided by the usecase through the policy
+	result, err := b.db.Collection(b.collections.BlogPosts).DeleteOne(ctx, bson.M{"_id": oid})
 	if err != nil {
 		return &domain.DomainError{
 			Err:  err,
@@ -103,7 +76,7 @@ func (b *blogPostRepo) Delete(ctx context.Context, id string) *domain.DomainErro
 
 	if result == 0 {
 		return &domain.DomainError{
-			Err:  fmt.Errorf("not found or not authorized to delete blog post with ID %s", id),
+			Err:  fmt.Errorf("blog post with ID %s not found", id),
 			Code: http.StatusNotFound,
 		}
 	}
@@ -193,29 +166,26 @@ func (b *blogPostRepo) Update(ctx context.Context, id string, blog domain.BlogPo
 		}
 	}
 
-	// Extract user_id from context and assert it's an ObjectID
-	userID, ok := ctx.Value("user_id").(primitive.ObjectID)
-	if !ok {
+	categoryID, err := primitive.ObjectIDFromHex(blog.CategoryID)
+	if err != nil {
 		return nil, &domain.DomainError{
-			Err:  fmt.Errorf("invalid or missing user_id in context"),
-			Code: http.StatusUnauthorized,
+			Err:  fmt.Errorf("invalid category ID: %w", err),
+			Code: http.StatusBadRequest,
 		}
 	}
 
-	// Filter must ensure both blog ID and author ID match
-	filter := bson.M{
-		"_id":       oid,
-		"author_id": userID,
-	}
+	// who may update the post is decided by the usecase through the policy
+	filter := bson.M{"_id": oid}
 
 	// Set update fields
 	blog.UpdatedAt = time.Now()
 	update := bson.M{
 		"$set": bson.M{
-			"title":      blog.Title,
-			"content":    blog.Content,
-			"tags":       blog.Tags,
-			"updated_at": primitive.NewDateTimeFromTime(blog.UpdatedAt),
+			"title":       blog.Title,
+			"content":     blog.Content,
+			"tags":        blog.Tags,
+			"category_id": categoryID,
+			"updated_at":  primitive.NewDateTimeFromTime(blog.UpdatedAt),
 		},
 	}
 
@@ -230,14 +200,13 @@ func (b *blogPostRepo) Update(ctx context.Context, id string, blog domain.BlogPo
 
 	if res.MatchedCount == 0 {
 		return nil, &domain.DomainError{
-			Err:  fmt.Errorf("blog not found or unauthorized to update"),
-			Code: http.StatusForbidden,
+			Err:  fmt.Errorf("blog post with ID %s not found", id),
+			Code: http.StatusNotFound,
 		}
 	}
 
 	// Return updated blog
 	blog.ID = oid.Hex()
-	blog.AuthorID = userID.Hex()
 	return &blog, nil
 }
 
@@ -363,6 +332,157 @@ func (b *blogPostRepo) UpdateReactionCount(ctx context.Context, is_like bool, id
 	return b.GetBlogByID(ctx, id)
 }
 
+// RefreshCounts implements domain.BlogRepository. The counts are taken from the reactions and
+// comments of the post, so running it again for the same change does not count it twice.
+func (b *blogPostRepo) RefreshCounts(ctx context.Context, id string) (*domain.BlogPost, *domain.DomainError) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, &domain.DomainError{
+			Err:  err,
+			Code: http.StatusBadRequest,
+		}
+	}
+
+	reactions := b.db.Collection(b.collections.BlogUserReactions)
+	likes, err := reactions.CountDocuments(ctx, bson.M{"blog_id": oid, "is_like": true})
+	if err != nil {
+		return nil, &domain.DomainError{
+			Err:  err,
+			Code: http.StatusInternalServerError,
+		}
+	}
+	dislikes, err := reactions.CountDocuments(ctx, bson.M{"blog_id": oid, "is_like": false})
+	if err != nil {
+		return nil, &domain.DomainError{
+			Err:  err,
+			Code: http.StatusInternalServerError,
+		}
+	}
+	comments, err := b.db.Collection(b.collections.BlogComments).CountDocuments(ctx, bson.M{"blog_id": oid})
+	if err != nil {
+		return nil, &domain.DomainError{
+			Err:  err,
+			Code: http.StatusInternalServerError,
+		}
+	}
+
+	var blogModel mapper.BlogPostModel
+	err = b.db.Collection(b.collections.BlogPosts).FindOne(ctx, bson.M{"_id": oid}).Decode(&blogModel)
+	if err == mongo.ErrNoDocuments() {
+		return nil, &domain.DomainError{
+			Err:  fmt.Errorf("blog post with ID %s not found", id),
+			Code: http.StatusNotFound,
+		}
+	} else if err != nil {
+		return nil, &domain.DomainError{
+			Err:  err,
+			Code: http.StatusInternalServerError,
+		}
+	}
+
+	blogModel.Likes = int(likes)
+	blogModel.Dislikes = int(dislikes)
+	blogModel.CommentCount = int(comments)
+	blogModel.PopularityScore = utils.CalculatePopularityScore(blogModel.Likes, blogModel.ViewCount, blogModel.CommentCount, blogModel.Dislikes)
+	_, err = b.db.Collection(b.collections.BlogPosts).UpdateOne(ctx, bson.M{"_id": oid}, bson.M{
+		"$set": bson.M{
+			"likes":            blogModel.Likes,
+			"dislikes":         blogModel.Dislikes,
+			"comment_count":    blogModel.CommentCount,
+			"popularity_score": blogModel.PopularityScore,
+		},
+	})
+	if err != nil {
+		return nil, &domain.DomainError{
+			Err:  err,
+			Code: http.StatusInternalServerError,
+		}
+	}
+
+	return blogModel.ToDomain(), nil
+}
+
+// UpdateAuthorName implements domain.BlogRepository.
+func (b *blogPostRepo) UpdateAuthorName(ctx context.Context, authorID string, name string) *domain.DomainError {
+	oid, err := primitive.ObjectIDFromHex(authorID)
+	if err != nil {
+		return &domain.DomainError{
+			Err:  err,
+			Code: http.StatusBadRequest,
+		}
+	}
+
+	_, err = b.db.Collection(b.collections.BlogPosts).UpdateMany(ctx, bson.M{"author_id": oid}, bson.M{
+		"$set": bson.M{"author_name": name},
+	})
+	if err != nil {
+		return &domain.DomainError{
+			Err:  err,
+			Code: http.StatusInternalServerError,
+		}
+	}
+	return nil
+}
+
+// ReplaceTags implements domain.BlogRepository.
+func (b *blogPostRepo) ReplaceTags(ctx context.Context, from []string, to string) *domain.DomainError {
+	posts := b.db.Collection(b.collections.BlogPosts)
+	tagged := bson.M{"tags": bson.M{"$in": from}}
+
+	// a field cannot be added to and pulled from in one update, the new tag goes on first so a
+	// failure in between leaves no post without it
+	if _, err := posts.UpdateMany(ctx, tagged, bson.M{"$addToSet": bson.M{"tags": to}}); err != nil {
+		return &domain.DomainError{
+			Err:  fmt.Errorf("failed to add tag %s: %w", to, err),
+			Code: http.StatusInternalServerError,
+		}
+	}
+	removed := make([]string, 0, len(from))
+	for _, tag := range from {
+		if tag != to {
+			removed = append(removed, tag)
+		}
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	if _, err := posts.UpdateMany(ctx, bson.M{"tags": bson.M{"$in": removed}}, bson.M{"$pull": bson.M{"tags": bson.M{"$in": removed}}}); err != nil {
+		return &domain.DomainError{
+			Err:  fmt.Errorf("failed to remove replaced tags: %w", err),
+			Code: http.StatusInternalServerError,
+		}
+	}
+	return nil
+}
+
+// CountByTag implements domain.BlogRepository.
+func (b *blogPostRepo) CountByTag(ctx context.Context, tag string) (int, *domain.DomainError) {
+	count, err := b.db.Collection(b.collections.BlogPosts).CountDocuments(ctx, bson.M{"tags": tag})
+	if err != nil {
+		return 0, &domain.DomainError{
+			Err:  err,
+			Code: http.StatusInternalServerError,
+		}
+	}
+	return int(count), nil
+}
+
+// CountByCategory implements domain.BlogRepository.
+func (b *blogPostRepo) CountByCategory(ctx context.Context, categoryID string) (int, *domain.DomainError) {
+	oid, err := primitive.ObjectIDFromHex(categoryID)
+	if err != nil {
+		return 0, nil
+	}
+	count, err := b.db.Collection(b.collections.BlogPosts).CountDocuments(ctx, bson.M{"category_id": oid})
+	if err != nil {
+		return 0, &domain.DomainError{
+			Err:  err,
+			Code: http.StatusInternalServerError,
+		}
+	}
+	return int(count), nil
+}
+
 // NewBlogPostRepo creates a new instance of blogPostRepo.
 func NewBlogPostRepo(database mongo.Database, collections *mongo.Collections) domain.BlogPostRepository {
 	return &blogPostRepo{
@@ -6,7 +6,6 @@ import (
 	domain "g6/blog-api/Domain"
 	"g6/blog-api/Infrastructure/database/mongo"
 	"g6/blog-api/Infrastructure/database/mongo/mapper"
-	"g6/blog-api/Infrastructure/database/mongo/utils"
 	"net/http"
 	"time"
 
@@ -80,18 +79,6 @@ func (u *BlogUserReactionRepo) Create(ctx context.Context, reaction *domain.Blog
 			blogReaction.ID = oid
 		}
 
-		// Update blog's like/dislike counter
-		counterField := "likes"
-		if !reaction.IsLike {
-			counterField = "dislikes"
-		}
-		if _, err := blogCollection.UpdateOne(ctx, blogFilter, bson.M{"$inc": bson.M{counterField: 1}}); err != nil {
-			return nil, &domain.DomainError{
-				Err:  fmt.Errorf("failed to increment %s: %w", counterField, err),
-				Code: http.StatusInternalServerError,
-			}
-		}
-
 		return blogReaction.ToDomain(), nil
 	} else if err != nil {
 		// Unexpected DB error
@@ -121,43 +108,6 @@ func (u *BlogUserReactionRepo) Create(ctx context.Context, reaction *domain.Blog
 		}
 	}
 
-	// Adjust blog's like/dislike counters
-	adjust := bson.M{
-		"likes":    0,
-		"dislikes": 0,
-	}
-	if reaction.IsLike {
-		adjust["likes"] = 1
-		adjust["dislikes"] = -1
-	} else {
-		adjust["likes"] = -1
-		adjust["dislikes"] = 1
-	}
-
-	if _, err := blogCollection.UpdateOne(ctx, blogFilter, bson.M{"$inc": adjust}); err != nil {
-		return nil, &domain.DomainError{
-			Err:  fmt.Errorf("failed to adjust blog reaction counters: %w", err),
-			Code: http.StatusInternalServerError,
-		}
-	}
-
-	// Update popularity score, find the blog again
-	var blogModel mapper.BlogPostModel
-	if err := blogCollection.FindOne(ctx, blogFilter).Decode(&blogModel); err != nil {
-		return nil, &domain.DomainError{
-			Err:  fmt.Errorf("failed to find blog for popularity score update: %w", err),
-			Code: http.StatusInternalServerError,
-		}
-	}
-
-	ps := utils.CalculatePopularityScore(blogModel.Likes, blogModel.ViewCount, blogModel.CommentCount, blogModel.Dislikes)
-	if _, err := blogCollection.UpdateOne(ctx, blogFilter, bson.M{"$set": bson.M{"popularity_score": ps}}); err != nil {
-		return nil, &domain.DomainError{
-			Err:  fmt.Errorf("failed to update blog popularity score: %w", err),
-			Code: http.StatusInternalServerError,
-		}
-	}
-
 	// Update the return object with new timestamp and existing id
 	reaction.ID = existing.ID.Hex()
 	reaction.CreatedAt = time.Now()
@@ -198,23 +148,6 @@ func (u *BlogUserReactionRepo) Delete(ctx context.Context, id string) *domain.Do
 		}
 	}
 
-	// Decrement the correct count
-	decField := "likes"
-	if !reaction.IsLike {
-		decField = "dislikes"
-	}
-
-	// Update the blog's like/dislike counter
-	_, err = u.db.Collection(u.collections.BlogPosts).UpdateOne(ctx, bson.M{"_id": reaction.BlogID}, bson.M{
-		"$inc": bson.M{decField: -1},
-	})
-
-	if err != nil {
-		return &domain.DomainError{
-			Err:  fmt.Errorf("failed to decrement %s count: %w", decField, err),
-			Code: http.StatusInternalServerError,
-		}
-	}
 	return nil
 }
 
@@ -255,3 +188,28 @@ func (u *BlogUserReactionRepo) GetUserReaction(ctx context.Context, blogID strin
 	// Convert the MongoDB model back to the domain model
 	return reaction.ToDomain(), nil
 }
+
+func (u *BlogUserReactionRepo) GetReactionByID(ctx context.Context, id string) (*domain.BlogUserReaction, *domain.DomainError) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, &domain.DomainError{
+			Err:  fmt.Errorf("invalid ObjectID: %w", err),
+			Code: http.StatusBadRequest,
+		}
+	}
+
+	var reaction mapper.BlogUserReactionModel
+	err = u.db.Collection(u.collections.BlogUserReactions).FindOne(ctx, bson.M{"_id": oid}).Decode(&reaction)
+	if err == mongo.ErrNoDocuments() {
+		return nil, &domain.DomainError{
+			Err:  fmt.Errorf("no reaction found with ID %s", id),
+			Code: http.StatusNotFound,
+		}
+	} else if err != nil {
+		return nil, &domain.DomainError{
+			Err:  fmt.Errorf("failed to find reaction: %w", err),
+			Code: http.StatusInternalServerError,
+		}
+	}
+	return reaction.ToDomain(), nil
+}
@@ -12,7 +12,6 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	mongodriver "go.mongodb.org/mongo-driver/mongo"
 )
@@ -46,8 +45,6 @@ func TestBlogUserReactionRepo_Create_NewReaction_Success(t *testing.T) {
 	insertedID := primitive.NewObjectID()
 	mockReactionCollection.On("InsertOne", ctx, mock.Anything).Return(&mongodriver.InsertOneResult{InsertedID: insertedID}, nil)
 
-	mockBlogCollection.On("UpdateOne", ctx, bson.M{"_id": blogID}, bson.M{"$inc": bson.M{"likes": 1}}).Return(&mongodriver.UpdateResult{MatchedCount: 1, ModifiedCount: 1}, nil)
-
 	repo := NewUserReactionRepo(mockDB, &mongo.Collections{BlogUserReactions: "blog_user_reactions", BlogPosts: "blog_posts"})
 
 	reaction := &domain.BlogUserReaction{
@@ -128,11 +125,9 @@ func TestBlogUserReactionRepo_Delete_Success(t *testing.T) {
 
 	mockDB := mongo_mocks.NewMockDatabase(t)
 	mockReactionCollection := mongo_mocks.NewMockCollection(t)
-	mockBlogCollection := mongo_mocks.NewMockCollection(t)
 	mockSingleResult := mongo_mocks.NewMockSingleResult(t)
 
 	mockDB.On("Collection", "blog_user_reactions").Return(mockReactionCollection)
-	mockDB.On("Collection", "blog_posts").Return(mockBlogCollection)
 
 	foundReaction := mapper.BlogUserReactionModel{
 		ID:     reactionID,
@@ -147,8 +142,6 @@ func TestBlogUserReactionRepo_Delete_Success(t *testing.T) {
 
 	mockReactionCollection.On("DeleteOne", ctx, mock.Anything).Return(int64(1), nil)
 
-	mockBlogCollection.On("UpdateOne", ctx, bson.M{"_id": blogID}, bson.M{"$inc": bson.M{"likes": -1}}).Return(&mongodriver.UpdateResult{MatchedCount: 1, ModifiedCount: 1}, nil)
-
 	repo := NewUserReactionRepo(mockDB, &mongo.Collections{BlogUserReactions: "blog_user_reactions", BlogPosts: "blog_posts"})
 
 	err := repo.Delete(ctx, reactionID.Hex())
@@ -156,7 +149,6 @@ func TestBlogUserReactionRepo_Delete_Success(t *testing.T) {
 	assert.Nil(t, err, "Expected no error")
 	mockDB.AssertExpectations(t)
 	mockReactionCollection.AssertExpectations(t)
-	mockBlogCollection.AssertExpectations(t)
 }
 
 func TestBlogUserReactionRepo_Delete_InvalidID(t *testing.T) {
@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CategoryRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewCategoryRepository(db mongo.Database, collection string) domain.ICategoryRepository {
+	return &CategoryRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+// Create inserts the category unless its slug is taken
+func (repo *CategoryRepository) Create(ctx context.Context, category *domain.Category) error {
+	model := mapper.CategoryFromDomain(category)
+	result, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx,
+		bson.M{"slug": model.Slug},
+		bson.M{"$setOnInsert": model},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return err
+	}
+	if result.UpsertedCount == 0 {
+		return domain.ErrCategoryConflict
+	}
+	category.ID = model.ID.Hex()
+	return nil
+}
+
+func (repo *CategoryRepository) FindByID(ctx context.Context, id string) (*domain.Category, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, domain.ErrCategoryNotFound
+	}
+	return repo.findOne(ctx, bson.M{"_id": objectID})
+}
+
+func (repo *CategoryRepository) FindBySlug(ctx context.Context, slug string) (*domain.Category, error) {
+	return repo.findOne(ctx, bson.M{"slug": slug})
+}
+
+func (repo *CategoryRepository) findOne(ctx context.Context, query bson.M) (*domain.Category, error) {
+	var model mapper.CategoryDB
+	if err := repo.DB.Collection(repo.Collection).FindOne(ctx, query).Decode(&model); err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return mapper.CategoryToDomain(&model), nil
+}
+
+func (repo *CategoryRepository) List(ctx context.Context) ([]*domain.Category, error) {
+	return repo.find(ctx, bson.M{})
+}
+
+func (repo *CategoryRepository) Descendants(ctx context.Context, id string) ([]*domain.Category, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return []*domain.Category{}, nil
+	}
+	return repo.find(ctx, bson.M{"ancestors": objectID})
+}
+
+// find returns the categories by name
+func (repo *CategoryRepository) find(ctx context.Context, query bson.M) ([]*domain.Category, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cursor, err := repo.DB.Collection(repo.Collection).Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.CategoryDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	categories := make([]*domain.Category, 0, len(models))
+	for i := range models {
+		categories = append(categories, mapper.CategoryToDomain(&models[i]))
+	}
+	return categories, nil
+}
+
+func (repo *CategoryRepository) Update(ctx context.Context, category *domain.Category) error {
+	model := mapper.CategoryFromDomain(category)
+	set := bson.M{
+		"name":        model.Name,
+		"slug":        model.Slug,
+		"description": model.Description,
+		"ancestors":   model.Ancestors,
+		"updated_at":  model.UpdatedAt,
+	}
+	update := bson.M{"$set": set}
+	if model.ParentID.IsZero() {
+		update["$unset"] = bson.M{"parent_id": ""}
+	} else {
+		set["parent_id"] = model.ParentID
+	}
+	result, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx, bson.M{"_id": model.ID}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (repo *CategoryRepository) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrCategoryNotFound
+	}
+	deleted, err := repo.DB.Collection(repo.Collection).DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domain.ErrCategoryNotFound
+	}
+	return nil
+}
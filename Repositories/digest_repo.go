@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type DigestRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewDigestRepository(db mongo.Database, collection string) domain.IDigestRepository {
+	return &DigestRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+func (repo *DigestRepository) FindByUserID(ctx context.Context, userID string) (*domain.DigestSubscription, error) {
+	var model mapper.DigestSubscriptionDB
+	err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"user_id": userID}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return mapper.DigestSubscriptionToDomain(&model), nil
+}
+
+func (repo *DigestRepository) Save(ctx context.Context, subscription *domain.DigestSubscription) error {
+	model := mapper.DigestSubscriptionFromDomain(subscription)
+	_, err := repo.DB.Collection(repo.Collection).UpdateOne(
+		ctx,
+		bson.M{"user_id": model.UserID},
+		bson.M{"$set": model},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// ClaimNext takes the first due subscription that no other worker claims in the meantime. The
+// claim only succeeds while the subscription is still due at the time it was read with.
+func (repo *DigestRepository) ClaimNext(ctx context.Context, now, leaseUntil time.Time) (*domain.DigestSubscription, error) {
+	collection := repo.DB.Collection(repo.Collection)
+	due := bson.M{
+		"frequency":    bson.M{"$in": []domain.DigestFrequency{domain.DigestDaily, domain.DigestWeekly}},
+		"next_send_at": bson.M{"$lte": now},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "next_send_at", Value: 1}}).
+		SetLimit(claimCandidates)
+	cursor, err := collection.Find(ctx, due, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.DigestSubscriptionDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	for i := range models {
+		model := &models[i]
+		result, err := collection.UpdateOne(ctx,
+			bson.M{"user_id": model.UserID, "frequency": model.Frequency, "next_send_at": model.NextSendAt},
+			bson.M{"$set": bson.M{"next_send_at": leaseUntil}},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if result.ModifiedCount == 1 {
+			model.NextSendAt = leaseUntil
+			return mapper.DigestSubscriptionToDomain(model), nil
+		}
+	}
+	return nil, nil
+}
+
+func (repo *DigestRepository) MarkSent(ctx context.Context, userID string, sentAt, nextSendAt time.Time) error {
+	_, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx,
+		bson.M{"user_id": userID},
+		bson.M{"$set": bson.M{"last_sent_at": sentAt, "next_send_at": nextSendAt}},
+	)
+	return err
+}
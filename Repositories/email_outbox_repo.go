@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// claimCandidates is how many due emails a claim looks at, in case other workers take the first ones
+const claimCandidates = 10
+
+type EmailOutboxRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewEmailOutboxRepository(db mongo.Database, collection string) domain.IEmailOutboxRepository {
+	return &EmailOutboxRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+func (repo *EmailOutboxRepository) Enqueue(ctx context.Context, email *domain.OutboxEmail) error {
+	model := mapper.OutboxEmailFromDomain(email)
+	if _, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, model); err != nil {
+		return err
+	}
+	email.ID = model.ID.Hex()
+	return nil
+}
+
+// ClaimNext takes the first due email that no other worker claims in the meantime. The claim
+// only succeeds while the email is still in the state it was read in.
+func (repo *EmailOutboxRepository) ClaimNext(ctx context.Context, now, leaseUntil time.Time) (*domain.OutboxEmail, error) {
+	collection := repo.DB.Collection(repo.Collection)
+	due := bson.M{
+		"status":          bson.M{"$in": []domain.OutboxEmailStatus{domain.OutboxEmailPending, domain.OutboxEmailSending}},
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetLimit(claimCandidates)
+	cursor, err := collection.Find(ctx, due, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.OutboxEmailDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	for i := range models {
+		model := &models[i]
+		result, err := collection.UpdateOne(ctx,
+			bson.M{"_id": model.ID, "status": model.Status, "next_attempt_at": model.NextAttemptAt},
+			bson.M{"$set": bson.M{"status": domain.OutboxEmailSending, "next_attempt_at": leaseUntil, "updated_at": now}},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if result.ModifiedCount == 1 {
+			model.Status = string(domain.OutboxEmailSending)
+			model.NextAttemptAt = leaseUntil
+			model.UpdatedAt = now
+			return mapper.OutboxEmailToDomain(model), nil
+		}
+	}
+	return nil, nil
+}
+
+func (repo *EmailOutboxRepository) Update(ctx context.Context, email *domain.OutboxEmail) error {
+	model := mapper.OutboxEmailFromDomain(email)
+	_, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx, bson.M{"_id": model.ID}, bson.M{"$set": bson.M{
+		"body":            model.Body,
+		"data":            model.Data,
+		"status":          model.Status,
+		"attempts":        model.Attempts,
+		"last_error":      model.LastError,
+		"next_attempt_at": model.NextAttemptAt,
+		"sent_at":         model.SentAt,
+		"updated_at":      model.UpdatedAt,
+	}})
+	return err
+}
+
+func (repo *EmailOutboxRepository) FindByID(ctx context.Context, id string) (*domain.OutboxEmail, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, domain.ErrOutboxEmailNotFound
+	}
+	var model mapper.OutboxEmailDB
+	if err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"_id": objectID}).Decode(&model); err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrOutboxEmailNotFound
+		}
+		return nil, err
+	}
+	return mapper.OutboxEmailToDomain(&model), nil
+}
+
+// Find pages through the matching emails, newest first
+func (repo *EmailOutboxRepository) Find(ctx context.Context, filter domain.OutboxEmailFilter) ([]*domain.OutboxEmail, int64, error) {
+	query := bson.M{}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.To != "" {
+		query["to"] = filter.To
+	}
+
+	collection := repo.DB.Collection(repo.Collection)
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64((filter.Page - 1) * filter.PageSize)).
+		SetLimit(int64(filter.PageSize))
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.OutboxEmailDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, 0, err
+	}
+	emails := make([]*domain.OutboxEmail, 0, len(models))
+	for i := range models {
+		emails = append(emails, mapper.OutboxEmailToDomain(&models[i]))
+	}
+	return emails, total, nil
+}
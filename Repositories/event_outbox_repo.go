@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type EventOutboxRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewEventOutboxRepository(db mongo.Database, collection string) domain.IEventOutboxRepository {
+	return &EventOutboxRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+// Append inserts the event with the context's session, so it is committed with the change it
+// records
+func (repo *EventOutboxRepository) Append(ctx context.Context, event *domain.DomainEvent) error {
+	model := mapper.DomainEventFromDomain(event)
+	if _, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, model); err != nil {
+		return err
+	}
+	event.ID = model.ID.Hex()
+	return nil
+}
+
+// ClaimNext takes the oldest due event that no other dispatcher claims in the meantime, events
+// are handed out in the order they were published
+func (repo *EventOutboxRepository) ClaimNext(ctx context.Context, now, leaseUntil time.Time) (*domain.DomainEvent, error) {
+	collection := repo.DB.Collection(repo.Collection)
+	due := bson.M{
+		"status":          bson.M{"$in": []domain.DomainEventStatus{domain.DomainEventPending, domain.DomainEventDispatching}},
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(claimCandidates)
+	cursor, err := collection.Find(ctx, due, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.DomainEventDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	for i := range models {
+		model := &models[i]
+		result, err := collection.UpdateOne(ctx,
+			bson.M{"_id": model.ID, "status": model.Status, "next_attempt_at": model.NextAttemptAt},
+			bson.M{"$set": bson.M{"status": domain.DomainEventDispatching, "next_attempt_at": leaseUntil, "updated_at": now}},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if result.ModifiedCount == 1 {
+			model.Status = string(domain.DomainEventDispatching)
+			model.NextAttemptAt = leaseUntil
+			model.UpdatedAt = now
+			return mapper.DomainEventToDomain(model), nil
+		}
+	}
+	return nil, nil
+}
+
+func (repo *EventOutboxRepository) Update(ctx context.Context, event *domain.DomainEvent) error {
+	model := mapper.DomainEventFromDomain(event)
+	_, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx, bson.M{"_id": model.ID}, bson.M{"$set": bson.M{
+		"status":          model.Status,
+		"delivered":       model.Delivered,
+		"attempts":        model.Attempts,
+		"last_error":      model.LastError,
+		"next_attempt_at": model.NextAttemptAt,
+		"updated_at":      model.UpdatedAt,
+	}})
+	return err
+}
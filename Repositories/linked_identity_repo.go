@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type LinkedIdentityRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewLinkedIdentityRepository(db mongo.Database, collection string) domain.ILinkedIdentityRepository {
+	return &LinkedIdentityRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+func (repo *LinkedIdentityRepository) Create(ctx context.Context, identity *domain.LinkedIdentity) error {
+	model := mapper.LinkedIdentityFromDomain(identity)
+	if _, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, model); err != nil {
+		return err
+	}
+	identity.ID = model.ID.Hex()
+	identity.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (repo *LinkedIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*domain.LinkedIdentity, error) {
+	var model mapper.LinkedIdentityDB
+	err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"provider": provider, "subject": subject}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrIdentityNotFound
+		}
+		return nil, err
+	}
+	return mapper.LinkedIdentityToDomain(&model), nil
+}
+
+// list the identities of a user, oldest first
+func (repo *LinkedIdentityRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.LinkedIdentity, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := repo.DB.Collection(repo.Collection).Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.LinkedIdentityDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	identities := make([]*domain.LinkedIdentity, 0, len(models))
+	for i := range models {
+		identities = append(identities, mapper.LinkedIdentityToDomain(&models[i]))
+	}
+	return identities, nil
+}
+
+func (repo *LinkedIdentityRepository) Delete(ctx context.Context, userID, provider string) error {
+	deleted, err := repo.DB.Collection(repo.Collection).DeleteOne(ctx, bson.M{"user_id": userID, "provider": provider})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domain.ErrIdentityNotFound
+	}
+	return nil
+}
+
+func (repo *LinkedIdentityRepository) UpdateLastLogin(ctx context.Context, id string, at time.Time) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrIdentityNotFound
+	}
+	_, err = repo.DB.Collection(repo.Collection).UpdateOne(
+		ctx,
+		bson.M{"_id": oid},
+		bson.M{"$set": bson.M{"last_login_at": at}},
+	)
+	return err
+}
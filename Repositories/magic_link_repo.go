@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type MagicLinkRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewMagicLinkRepository(db mongo.Database, collection string) domain.IMagicLinkRepository {
+	return &MagicLinkRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+func (repo *MagicLinkRepository) Create(ctx context.Context, link *domain.MagicLink) error {
+	model := mapper.MagicLinkFromDomain(link)
+	if _, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, model); err != nil {
+		return err
+	}
+	link.ID = model.ID.Hex()
+	link.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (repo *MagicLinkRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.MagicLink, error) {
+	var model mapper.MagicLinkDB
+	err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"token_hash": tokenHash}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrMagicLinkInvalid
+		}
+		return nil, err
+	}
+	return mapper.MagicLinkToDomain(&model), nil
+}
+
+func (repo *MagicLinkRepository) Consume(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrMagicLinkInvalid
+	}
+	deleted, err := repo.DB.Collection(repo.Collection).DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domain.ErrMagicLinkInvalid
+	}
+	return nil
+}
+
+func (repo *MagicLinkRepository) CountByEmailSince(ctx context.Context, email string, since time.Time) (int64, error) {
+	return repo.DB.Collection(repo.Collection).CountDocuments(ctx, bson.M{"email": email, "created_at": bson.M{"$gte": since}})
+}
+
+func (repo *MagicLinkRepository) CountByIPSince(ctx context.Context, ip string, since time.Time) (int64, error) {
+	return repo.DB.Collection(repo.Collection).CountDocuments(ctx, bson.M{"ip": ip, "created_at": bson.M{"$gte": since}})
+}
@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type NotificationRepository struct {
+	DB                   mongo.Database
+	Collection           string
+	PreferenceCollection string
+}
+
+func NewNotificationRepository(db mongo.Database, collection, preferenceCollection string) domain.INotificationRepository {
+	return &NotificationRepository{
+		DB:                   db,
+		Collection:           collection,
+		PreferenceCollection: preferenceCollection,
+	}
+}
+
+func (repo *NotificationRepository) Create(ctx context.Context, notification *domain.Notification) error {
+	collection := repo.DB.Collection(repo.Collection)
+	model := mapper.NotificationFromDomain(notification)
+
+	// liking a post again after taking the like back should not notify twice
+	var existing mapper.NotificationDB
+	err := collection.FindOne(ctx, bson.M{
+		"recipient_id": model.RecipientID,
+		"actor_id":     model.ActorID,
+		"type":         model.Type,
+		"target_id":    model.TargetID,
+		"read":         false,
+	}).Decode(&existing)
+	switch {
+	case err == nil:
+		if _, err := collection.UpdateOne(ctx, bson.M{"_id": existing.ID}, bson.M{"$set": bson.M{"created_at": model.CreatedAt}}); err != nil {
+			return err
+		}
+		model.ID = existing.ID
+	case err == mongo.ErrNoDocuments():
+		if _, err := collection.InsertOne(ctx, model); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+	notification.ID = model.ID.Hex()
+	notification.CreatedAt = model.CreatedAt
+	return nil
+}
+
+// Find pages through the recipient's notifications, newest first
+func (repo *NotificationRepository) Find(ctx context.Context, filter domain.NotificationFilter) ([]*domain.Notification, int64, error) {
+	query := bson.M{"recipient_id": filter.RecipientID}
+	if filter.UnreadOnly {
+		query["read"] = false
+	}
+
+	collection := repo.DB.Collection(repo.Collection)
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64((filter.Page - 1) * filter.PageSize)).
+		SetLimit(int64(filter.PageSize))
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.NotificationDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, 0, err
+	}
+	notifications := make([]*domain.Notification, 0, len(models))
+	for i := range models {
+		notifications = append(notifications, mapper.NotificationToDomain(&models[i]))
+	}
+	return notifications, total, nil
+}
+
+func (repo *NotificationRepository) CountUnread(ctx context.Context, recipientID string) (int64, error) {
+	return repo.DB.Collection(repo.Collection).CountDocuments(ctx, bson.M{"recipient_id": recipientID, "read": false})
+}
+
+func (repo *NotificationRepository) MarkRead(ctx context.Context, recipientID, id string, readAt time.Time) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrNotificationNotFound
+	}
+	result, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx,
+		bson.M{"_id": oid, "recipient_id": recipientID},
+		// a notification read before keeps its first read time
+		bson.A{bson.M{"$set": bson.M{
+			"read":    true,
+			"read_at": bson.M{"$cond": bson.A{"$read", "$read_at", readAt}},
+		}}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrNotificationNotFound
+	}
+	return nil
+}
+
+func (repo *NotificationRepository) MarkAllRead(ctx context.Context, recipientID string, readAt time.Time) (int64, error) {
+	result, err := repo.DB.Collection(repo.Collection).UpdateMany(ctx,
+		bson.M{"recipient_id": recipientID, "read": false},
+		bson.M{"$set": bson.M{"read": true, "read_at": readAt}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+func (repo *NotificationRepository) FindPreferences(ctx context.Context, userID string) (*domain.NotificationPreferences, error) {
+	var model mapper.NotificationPreferencesDB
+	err := repo.DB.Collection(repo.PreferenceCollection).FindOne(ctx, bson.M{"user_id": userID}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return mapper.NotificationPreferencesToDomain(&model), nil
+}
+
+func (repo *NotificationRepository) SavePreferences(ctx context.Context, preferences *domain.NotificationPreferences) error {
+	model := mapper.NotificationPreferencesFromDomain(preferences)
+	_, err := repo.DB.Collection(repo.PreferenceCollection).UpdateOne(
+		ctx,
+		bson.M{"user_id": model.UserID},
+		bson.M{"$set": model},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
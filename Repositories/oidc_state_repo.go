@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type OIDCStateRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewOIDCStateRepository(db mongo.Database, collection string) domain.IOIDCStateRepository {
+	return &OIDCStateRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+func (repo *OIDCStateRepository) Save(ctx context.Context, state *domain.OIDCLoginState) error {
+	_, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, mapper.OIDCStateFromDomain(state))
+	return err
+}
+
+// Consume only succeeds for the caller whose delete removed the document,
+// so two callbacks racing with the same state cannot both log in
+func (repo *OIDCStateRepository) Consume(ctx context.Context, state string) (*domain.OIDCLoginState, error) {
+	var model mapper.OIDCStateDB
+	err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"state": state}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrOIDCInvalidState
+		}
+		return nil, err
+	}
+	deleted, err := repo.DB.Collection(repo.Collection).DeleteOne(ctx, bson.M{"_id": model.ID})
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, domain.ErrOIDCInvalidState
+	}
+	return mapper.OIDCStateToDomain(&model), nil
+}
@@ -8,6 +8,7 @@ import (
 	"g6/blog-api/Infrastructure/database/mongo/mapper"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type OTPRepository struct {
@@ -36,10 +37,10 @@ func (r *OTPRepository) SaveOTP(ctx context.Context, otp *domain.OTP) error {
 	return nil
 }
 
-// FindOTPByEmail
-func (r *OTPRepository) FindOTPByEmail(ctx context.Context, email string) (*domain.OTP, error) {
+// FindOTPByEmailAndPurpose
+func (r *OTPRepository) FindOTPByEmailAndPurpose(ctx context.Context, email string, purpose domain.OTPPurpose) (*domain.OTP, error) {
 	var otpModel mapper.OtpDB
-	err := r.db.Collection(r.collection).FindOne(ctx, bson.M{"email": email}).Decode(&otpModel)
+	err := r.db.Collection(r.collection).FindOne(ctx, bson.M{"email": email, "purpose": string(purpose)}).Decode(&otpModel)
 	if err != nil {
 		if err == mongo.ErrNoDocuments() {
 			return nil, domain.ErrOTPNotFound
@@ -49,18 +50,28 @@ func (r *OTPRepository) FindOTPByEmail(ctx context.Context, email string) (*doma
 	return mapper.OtpToDomain(&otpModel), nil
 }
 
-// delete OTP by email
+// DeleteOTPByID deletes the OTP, ErrOTPNotFound tells it was already gone
 func (r *OTPRepository) DeleteOTPByID(ctx context.Context, id string) error {
-	_, err := r.db.Collection(r.collection).DeleteOne(ctx, bson.M{"_id": id})
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrOTPNotFound
+	}
+	deleted, err := r.db.Collection(r.collection).DeleteOne(ctx, bson.M{"_id": objectID})
 	if err != nil {
 		return fmt.Errorf("failed to delete OTP with id %s: %w", id, err)
 	}
+	if deleted == 0 {
+		return domain.ErrOTPNotFound
+	}
 	return nil
 }
 
 // update otp by id
 func (r *OTPRepository) UpdateOTPByID(ctx context.Context, otp *domain.OTP) error {
 	otpModel := mapper.OtpFromDomain(otp)
+	if otpModel == nil {
+		return domain.ErrOTPNotFound
+	}
 	_, err := r.db.Collection(r.collection).UpdateOne(ctx, bson.M{"_id": otpModel.ID}, bson.M{"$set": otpModel})
 	if err != nil {
 		return fmt.Errorf("failed to update OTP with id %s: %w", otp.ID, err)
@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domain "g6/blog-api/Domain"
+	dbmongo "g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	mocks "g6/blog-api/Infrastructure/database/mongo/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// newOTPRepoWithStore backs the collection mock with the documents of stored, keyed by _id
+func newOTPRepoWithStore(t *testing.T, stored map[primitive.ObjectID]*mapper.OtpDB) *OTPRepository {
+	mockDB := mocks.NewMockDatabase(t)
+	mockColl := mocks.NewMockCollection(t)
+	mockDB.On("Collection", "otps").Return(mockColl).Maybe()
+	mockColl.On("InsertOne", mock.Anything, mock.Anything).Return(func(ctx context.Context, document any) (*mongo.InsertOneResult, error) {
+		otp := document.(*mapper.OtpDB)
+		stored[otp.ID] = otp
+		return &mongo.InsertOneResult{InsertedID: otp.ID}, nil
+	}).Maybe()
+	mockColl.On("DeleteOne", mock.Anything, mock.Anything).Return(func(ctx context.Context, filter any) (int64, error) {
+		id, ok := filter.(bson.M)["_id"].(primitive.ObjectID)
+		if _, found := stored[id]; !ok || !found {
+			return 0, nil
+		}
+		delete(stored, id)
+		return 1, nil
+	}).Maybe()
+	mockColl.On("FindOne", mock.Anything, mock.Anything).Return(func(ctx context.Context, filter any) dbmongo.SingleResult {
+		result := mocks.NewMockSingleResult(t)
+		result.On("Decode", mock.Anything).Return(func(v any) error {
+			for _, otp := range stored {
+				if otp.Email == filter.(bson.M)["email"] && otp.Purpose == filter.(bson.M)["purpose"] {
+					*v.(*mapper.OtpDB) = *otp
+					return nil
+				}
+			}
+			return mongo.ErrNoDocuments
+		})
+		return result
+	}).Maybe()
+	return &OTPRepository{db: mockDB, collection: "otps"}
+}
+
+func TestOTPRepository_DeleteOTPByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deleted OTP is not found afterwards", func(t *testing.T) {
+		repo := newOTPRepoWithStore(t, map[primitive.ObjectID]*mapper.OtpDB{})
+		created := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+		require.NoError(t, repo.SaveOTP(ctx, &domain.OTP{
+			Email:     "test@example.com",
+			Purpose:   domain.OTPPurposeEmailVerification,
+			CodeHash:  "hash",
+			ExpiresAt: time.Now().Add(time.Minute),
+			CreatedAt: created,
+		}))
+		otp, err := repo.FindOTPByEmailAndPurpose(ctx, "test@example.com", domain.OTPPurposeEmailVerification)
+		require.NoError(t, err)
+		assert.Equal(t, created, otp.CreatedAt)
+
+		require.NoError(t, repo.DeleteOTPByID(ctx, otp.ID))
+
+		_, err = repo.FindOTPByEmailAndPurpose(ctx, "test@example.com", domain.OTPPurposeEmailVerification)
+		assert.Equal(t, domain.ErrOTPNotFound, err)
+		// a second redemption finds nothing to delete
+		assert.Equal(t, domain.ErrOTPNotFound, repo.DeleteOTPByID(ctx, otp.ID))
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		repo := newOTPRepoWithStore(t, map[primitive.ObjectID]*mapper.OtpDB{})
+
+		assert.Equal(t, domain.ErrOTPNotFound, repo.DeleteOTPByID(ctx, "otp1"))
+	})
+}
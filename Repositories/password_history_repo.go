@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PasswordHistoryRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewPasswordHistoryRepository(db mongo.Database, collection string) domain.IPasswordHistoryRepository {
+	return &PasswordHistoryRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+func (repo *PasswordHistoryRepository) Create(ctx context.Context, entry *domain.PasswordHistory) error {
+	model := mapper.PasswordHistoryFromDomain(entry)
+	if _, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, model); err != nil {
+		return err
+	}
+	entry.ID = model.ID.Hex()
+	entry.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (repo *PasswordHistoryRepository) FindRecent(ctx context.Context, userID string, limit int) ([]*domain.PasswordHistory, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(int64(limit))
+	cursor, err := repo.DB.Collection(repo.Collection).Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.PasswordHistoryDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	entries := make([]*domain.PasswordHistory, 0, len(models))
+	for i := range models {
+		entries = append(entries, mapper.PasswordHistoryToDomain(&models[i]))
+	}
+	return entries, nil
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PersonalAccessTokenRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewPersonalAccessTokenRepository(db mongo.Database, collection string) domain.IPersonalAccessTokenRepository {
+	return &PersonalAccessTokenRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+func (repo *PersonalAccessTokenRepository) Create(ctx context.Context, token *domain.PersonalAccessToken) error {
+	model := mapper.PersonalAccessTokenFromDomain(token)
+	if _, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, model); err != nil {
+		return err
+	}
+	token.ID = model.ID.Hex()
+	token.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (repo *PersonalAccessTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.PersonalAccessToken, error) {
+	var model mapper.PersonalAccessTokenDB
+	err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"token_hash": tokenHash}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrAccessTokenNotFound
+		}
+		return nil, err
+	}
+	return mapper.PersonalAccessTokenToDomain(&model), nil
+}
+
+// list the tokens of a user, newest first
+func (repo *PersonalAccessTokenRepository) FindByUserID(ctx context.Context, userID string) ([]*domain.PersonalAccessToken, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := repo.DB.Collection(repo.Collection).Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.PersonalAccessTokenDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	tokens := make([]*domain.PersonalAccessToken, 0, len(models))
+	for i := range models {
+		tokens = append(tokens, mapper.PersonalAccessTokenToDomain(&models[i]))
+	}
+	return tokens, nil
+}
+
+func (repo *PersonalAccessTokenRepository) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	return repo.DB.Collection(repo.Collection).CountDocuments(ctx, bson.M{"user_id": userID})
+}
+
+// delete a token, scoped to its owner so users cannot revoke each other's tokens
+func (repo *PersonalAccessTokenRepository) Delete(ctx context.Context, userID, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrAccessTokenNotFound
+	}
+	deleted, err := repo.DB.Collection(repo.Collection).DeleteOne(ctx, bson.M{"_id": oid, "user_id": userID})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domain.ErrAccessTokenNotFound
+	}
+	return nil
+}
+
+func (repo *PersonalAccessTokenRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time, ip string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrAccessTokenNotFound
+	}
+	_, err = repo.DB.Collection(repo.Collection).UpdateOne(
+		ctx,
+		bson.M{"_id": oid},
+		bson.M{"$set": bson.M{"last_used_at": usedAt, "last_used_ip": ip}},
+	)
+	return err
+}
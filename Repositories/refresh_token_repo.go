@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	domain "g6/blog-api/Domain"
+	"time"
 
 	"g6/blog-api/Infrastructure/database/mongo"
 	"g6/blog-api/Infrastructure/database/mongo/mapper"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type RefreshTokenRepository struct {
@@ -28,12 +31,13 @@ func (repo *RefreshTokenRepository) Save(ctx context.Context, token *domain.Refr
 	if _, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, tokenDb); err != nil {
 		return err
 	}
+	token.ID = tokenDb.ID.Hex()
 	return nil
 }
 
-func (repo *RefreshTokenRepository) FindByToken(ctx context.Context, token string) (*domain.RefreshToken, error) {
+func (repo *RefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
 	var tokenDB mapper.RefreshTokenDB
-	err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"token": token}).Decode(&tokenDB)
+	err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"token_hash": tokenHash}).Decode(&tokenDB)
 	if err != nil {
 		if err == mongo.ErrNoDocuments() {
 			return nil, fmt.Errorf("refresh token not found")
@@ -48,25 +52,32 @@ func (repo *RefreshTokenRepository) DeleteByUserID(ctx context.Context, userID s
 	return err
 }
 
-func (repo *RefreshTokenRepository) ReplaceTokenByUserID(ctx context.Context, token *domain.RefreshToken) error {
-	tokenDB := mapper.FromRefreshTokenEntityToDB(token)
-	_, err := repo.DB.Collection(repo.Collection).UpdateOne(
+// mark a token as used. The filter only matches unused tokens, so of two requests
+// racing with the same token only one wins and the other sees ErrRefreshTokenReused
+func (repo *RefreshTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrSessionNotFound
+	}
+	result, err := repo.DB.Collection(repo.Collection).UpdateOne(
 		ctx,
-		bson.M{"user_id": token.UserID},
-		bson.M{"$set": tokenDB},
+		bson.M{"_id": oid, "used": false},
+		bson.M{"$set": bson.M{"used": true, "used_at": usedAt}},
 	)
 	if err != nil {
-		fmt.Println(err.Error())
 		return err
 	}
+	if result.MatchedCount == 0 {
+		return domain.ErrRefreshTokenReused
+	}
 	return nil
 }
 
-// revoke refresh token
-func (repo *RefreshTokenRepository) RevokeToken(ctx context.Context, token string) error {
-	_, err := repo.DB.Collection(repo.Collection).UpdateOne(
+// revoke every token of a family, which ends the session
+func (repo *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	_, err := repo.DB.Collection(repo.Collection).UpdateMany(
 		ctx,
-		bson.M{"token": token},
+		bson.M{"family_id": familyID},
 		bson.M{"$set": bson.M{"revoked": true}},
 	)
 	if err != nil {
@@ -75,15 +86,57 @@ func (repo *RefreshTokenRepository) RevokeToken(ctx context.Context, token strin
 	return nil
 }
 
-// find token by user id
-func (repo *RefreshTokenRepository) FindTokenByUserID(ctx context.Context, userID string) (*domain.RefreshToken, error) {
-	var tokenDB mapper.RefreshTokenDB
-	err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"user_id": userID}).Decode(&tokenDB)
+// find the current token of every live session of a user, most recently used first
+func (repo *RefreshTokenRepository) FindActiveByUserID(ctx context.Context, userID string) ([]*domain.RefreshToken, error) {
+	filter := bson.M{
+		"user_id":    userID,
+		"revoked":    false,
+		"used":       false,
+		"expires_at": bson.M{"$gt": time.Now()},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "last_used_at", Value: -1}, {Key: "created_at", Value: -1}})
+	cursor, err := repo.DB.Collection(repo.Collection).Find(ctx, filter, opts)
 	if err != nil {
-		if err == mongo.ErrNoDocuments() {
-			return nil, fmt.Errorf("refresh token not found")
-		}
 		return nil, err
 	}
-	return mapper.FromRefreshTokenDBToEntity(&tokenDB), nil
+	defer cursor.Close(ctx)
+
+	var tokensDB []mapper.RefreshTokenDB
+	if err := cursor.All(ctx, &tokensDB); err != nil {
+		return nil, err
+	}
+	tokens := make([]*domain.RefreshToken, 0, len(tokensDB))
+	for i := range tokensDB {
+		tokens = append(tokens, mapper.FromRefreshTokenDBToEntity(&tokensDB[i]))
+	}
+	return tokens, nil
+}
+
+// revoke a single session, scoped to its owner so users cannot revoke each other's sessions
+func (repo *RefreshTokenRepository) RevokeByID(ctx context.Context, userID, familyID string) error {
+	result, err := repo.DB.Collection(repo.Collection).UpdateMany(
+		ctx,
+		bson.M{"family_id": familyID, "user_id": userID, "revoked": false},
+		bson.M{"$set": bson.M{"revoked": true}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrSessionNotFound
+	}
+	return nil
+}
+
+// revoke every live session of the user except the given one, returns how many were revoked
+func (repo *RefreshTokenRepository) RevokeAllExcept(ctx context.Context, userID, exceptFamilyID string) (int64, error) {
+	result, err := repo.DB.Collection(repo.Collection).UpdateMany(
+		ctx,
+		bson.M{"user_id": userID, "revoked": false, "used": false, "family_id": bson.M{"$ne": exceptFamilyID}},
+		bson.M{"$set": bson.M{"revoked": true}},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return result.ModifiedCount, nil
 }
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SecurityEventRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewSecurityEventRepository(db mongo.Database, collection string) domain.ISecurityEventRepository {
+	return &SecurityEventRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+// Record appends an event, events are never updated or deleted
+func (repo *SecurityEventRepository) Record(ctx context.Context, event *domain.SecurityEvent) error {
+	model := mapper.SecurityEventFromDomain(event)
+	if _, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, model); err != nil {
+		return err
+	}
+	event.ID = model.ID.Hex()
+	event.CreatedAt = model.CreatedAt
+	return nil
+}
+
+// Find pages through the matching events, newest first
+func (repo *SecurityEventRepository) Find(ctx context.Context, filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, int64, error) {
+	query := bson.M{}
+	if filter.UserID != "" {
+		query["user_id"] = filter.UserID
+	}
+	if filter.ActorID != "" {
+		query["actor_id"] = filter.ActorID
+	}
+	if len(filter.Types) > 0 {
+		query["type"] = bson.M{"$in": filter.Types}
+	}
+	if filter.Outcome != "" {
+		query["outcome"] = filter.Outcome
+	}
+	if filter.IP != "" {
+		query["ip"] = filter.IP
+	}
+	if !filter.From.IsZero() || !filter.To.IsZero() {
+		createdAt := bson.M{}
+		if !filter.From.IsZero() {
+			createdAt["$gte"] = filter.From
+		}
+		if !filter.To.IsZero() {
+			createdAt["$lt"] = filter.To
+		}
+		query["created_at"] = createdAt
+	}
+
+	collection := repo.DB.Collection(repo.Collection)
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64((filter.Page - 1) * filter.PageSize)).
+		SetLimit(int64(filter.PageSize))
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.SecurityEventDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, 0, err
+	}
+	events := make([]*domain.SecurityEvent, 0, len(models))
+	for i := range models {
+		events = append(events, mapper.SecurityEventToDomain(&models[i]))
+	}
+	return events, total, nil
+}
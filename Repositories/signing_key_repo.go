@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SigningKeyRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewSigningKeyRepository(db mongo.Database, collection string) domain.ISigningKeyRepository {
+	return &SigningKeyRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+func (r *SigningKeyRepository) Save(ctx context.Context, key *domain.SigningKey) error {
+	_, err := r.DB.Collection(r.Collection).InsertOne(ctx, mapper.SigningKeyFromDomain(key))
+	return err
+}
+
+// FindValid returns the active keys and the retired keys that have not expired, newest first
+func (r *SigningKeyRepository) FindValid(ctx context.Context, now time.Time) ([]*domain.SigningKey, error) {
+	filter := bson.M{"$or": []bson.M{
+		{"active": true},
+		{"expires_at": bson.M{"$gt": now}},
+	}}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.DB.Collection(r.Collection).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.SigningKeyDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	keys := make([]*domain.SigningKey, 0, len(models))
+	for i := range models {
+		keys = append(keys, mapper.SigningKeyToDomain(&models[i]))
+	}
+	return keys, nil
+}
+
+// RetireActive stops every active key except exceptKid from signing
+func (r *SigningKeyRepository) RetireActive(ctx context.Context, exceptKid string, retiredAt, expiresAt time.Time) (int64, error) {
+	result, err := r.DB.Collection(r.Collection).UpdateMany(
+		ctx,
+		bson.M{"active": true, "_id": bson.M{"$ne": exceptKid}},
+		bson.M{"$set": bson.M{"active": false, "retired_at": retiredAt, "expires_at": expiresAt}},
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TagRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewTagRepository(db mongo.Database, collection string) domain.ITagRepository {
+	return &TagRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+// Create inserts the tag unless its slug is taken, an upsert so that two posts bringing up the
+// same new tag at once do not create it twice
+func (repo *TagRepository) Create(ctx context.Context, tag *domain.Tag) error {
+	model := mapper.TagFromDomain(tag)
+	result, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx,
+		bson.M{"slug": model.Slug},
+		bson.M{"$setOnInsert": model},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return err
+	}
+	if result.UpsertedCount == 0 {
+		return domain.ErrTagConflict
+	}
+	tag.ID = model.ID.Hex()
+	return nil
+}
+
+func (repo *TagRepository) FindBySlug(ctx context.Context, slug string) (*domain.Tag, error) {
+	var model mapper.TagDB
+	query := bson.M{"$or": bson.A{bson.M{"slug": slug}, bson.M{"synonyms": slug}}}
+	if err := repo.DB.Collection(repo.Collection).FindOne(ctx, query).Decode(&model); err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrTagNotFound
+		}
+		return nil, err
+	}
+	return mapper.TagToDomain(&model), nil
+}
+
+func (repo *TagRepository) Search(ctx context.Context, prefix string, limit int) ([]*domain.Tag, error) {
+	pattern := primitive.Regex{Pattern: "^" + regexp.QuoteMeta(prefix)}
+	return repo.find(ctx, bson.M{"$or": bson.A{bson.M{"slug": pattern}, bson.M{"synonyms": pattern}}}, limit)
+}
+
+func (repo *TagRepository) Popular(ctx context.Context, limit int) ([]*domain.Tag, error) {
+	return repo.find(ctx, bson.M{"usage_count": bson.M{"$gt": 0}}, limit)
+}
+
+// find returns the tags most used first, then by slug
+func (repo *TagRepository) find(ctx context.Context, query bson.M, limit int) ([]*domain.Tag, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "usage_count", Value: -1}, {Key: "slug", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := repo.DB.Collection(repo.Collection).Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.TagDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	tags := make([]*domain.Tag, 0, len(models))
+	for i := range models {
+		tags = append(tags, mapper.TagToDomain(&models[i]))
+	}
+	return tags, nil
+}
+
+func (repo *TagRepository) Update(ctx context.Context, tag *domain.Tag) error {
+	model := mapper.TagFromDomain(tag)
+	result, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx, bson.M{"_id": model.ID}, bson.M{"$set": bson.M{
+		"name":        model.Name,
+		"slug":        model.Slug,
+		"description": model.Description,
+		"synonyms":    model.Synonyms,
+		"updated_at":  model.UpdatedAt,
+	}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrTagNotFound
+	}
+	return nil
+}
+
+func (repo *TagRepository) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrTagNotFound
+	}
+	deleted, err := repo.DB.Collection(repo.Collection).DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domain.ErrTagNotFound
+	}
+	return nil
+}
+
+func (repo *TagRepository) SetUsageCount(ctx context.Context, slug string, count int) error {
+	_, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx, bson.M{"slug": slug}, bson.M{"$set": bson.M{"usage_count": count}})
+	return err
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// the policy collection holds a single document
+const twoFactorPolicyID = "global"
+
+type TwoFactorRepository struct {
+	DB               mongo.Database
+	Collection       string
+	PolicyCollection string
+}
+
+func NewTwoFactorRepository(db mongo.Database, collection, policyCollection string) domain.ITwoFactorRepository {
+	return &TwoFactorRepository{
+		DB:               db,
+		Collection:       collection,
+		PolicyCollection: policyCollection,
+	}
+}
+
+func (r *TwoFactorRepository) Upsert(ctx context.Context, twoFactor *domain.TwoFactor) error {
+	model := mapper.TwoFactorFromDomain(twoFactor)
+	_, err := r.DB.Collection(r.Collection).UpdateOne(
+		ctx,
+		bson.M{"user_id": twoFactor.UserID},
+		bson.M{"$set": model},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+func (r *TwoFactorRepository) FindByUserID(ctx context.Context, userID string) (*domain.TwoFactor, error) {
+	var model mapper.TwoFactorDB
+	err := r.DB.Collection(r.Collection).FindOne(ctx, bson.M{"user_id": userID}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrTwoFactorNotFound
+		}
+		return nil, err
+	}
+	return mapper.TwoFactorToDomain(&model), nil
+}
+
+func (r *TwoFactorRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	_, err := r.DB.Collection(r.Collection).DeleteOne(ctx, bson.M{"user_id": userID})
+	return err
+}
+
+func (r *TwoFactorRepository) GetPolicy(ctx context.Context) (*domain.TwoFactorPolicy, error) {
+	var model mapper.TwoFactorPolicyDB
+	err := r.DB.Collection(r.PolicyCollection).FindOne(ctx, bson.M{"_id": twoFactorPolicyID}).Decode(&model)
+	if err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	return mapper.TwoFactorPolicyToDomain(&model), nil
+}
+
+func (r *TwoFactorRepository) SavePolicy(ctx context.Context, policy *domain.TwoFactorPolicy) error {
+	model := mapper.TwoFactorPolicyFromDomain(twoFactorPolicyID, policy)
+	_, err := r.DB.Collection(r.PolicyCollection).UpdateOne(
+		ctx,
+		bson.M{"_id": twoFactorPolicyID},
+		bson.M{"$set": bson.M{
+			"required_roles": model.RequiredRoles,
+			"updated_by":     model.UpdatedBy,
+			"updated_at":     model.UpdatedAt,
+		}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
@@ -27,10 +27,12 @@ func NewUserRepository(db mongo.Database, collection string) domain.IUserReposit
 func (repo *UserRepository) CreateUser(ctx context.Context, user *domain.User) error {
 	// user.ID = primitive.NewObjectID()
 	usermodel := mapper.UserFromDomain(user)
+	usermodel.ID = primitive.NewObjectID()
 	_, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, usermodel)
 	if err != nil {
 		return err
 	}
+	user.ID = usermodel.ID.Hex()
 	return nil
 }
 
@@ -136,3 +138,17 @@ func (repo *UserRepository) ChangeRole(ctx context.Context, targetUserID string,
 	})
 	return err
 }
+
+func (repo *UserRepository) UpdatePassword(ctx context.Context, userID, hash string) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %v", err)
+	}
+	_, err = repo.DB.Collection(repo.Collection).UpdateOne(ctx, bson.M{"_id": objID}, bson.M{
+		"$set": bson.M{
+			"password":   hash,
+			"updated_at": time.Now(),
+		},
+	})
+	return err
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -210,3 +211,25 @@ func TestChangeRole(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestUpdatePassword(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("only the password is set", func(t *testing.T) {
+		repo, _, mockColl := newRepoWithMocks()
+		mockColl.On("UpdateOne", ctx, mock.Anything, mock.MatchedBy(func(update bson.M) bool {
+			set := update["$set"].(bson.M)
+			_, hasUpdatedAt := set["updated_at"]
+			return len(set) == 2 && set["password"] == "new-hash" && hasUpdatedAt
+		})).Return(&mongo.UpdateResult{}, nil)
+		err := repo.UpdatePassword(ctx, "60c72b2f9b1d8b3a0c8b4567", "new-hash")
+		assert.NoError(t, err)
+		mockColl.AssertExpectations(t)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		repo, _, _ := newRepoWithMocks()
+		err := repo.UpdatePassword(ctx, "invalid", "new-hash")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type WebhookDeliveryRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewWebhookDeliveryRepository(db mongo.Database, collection string) domain.IWebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+func (repo *WebhookDeliveryRepository) Enqueue(ctx context.Context, deliveries []*domain.WebhookDelivery) error {
+	models := make([]*mapper.WebhookDeliveryDB, 0, len(deliveries))
+	documents := make([]any, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		model := mapper.WebhookDeliveryFromDomain(delivery)
+		models = append(models, model)
+		documents = append(documents, model)
+	}
+	if len(documents) == 0 {
+		return nil
+	}
+	if _, err := repo.DB.Collection(repo.Collection).InsertMany(ctx, documents); err != nil {
+		return err
+	}
+	for i, delivery := range deliveries {
+		delivery.ID = models[i].ID.Hex()
+	}
+	return nil
+}
+
+// ClaimNext takes the first due delivery that no other worker claims in the meantime. The
+// claim only succeeds while the delivery is still in the state it was read in.
+func (repo *WebhookDeliveryRepository) ClaimNext(ctx context.Context, now, leaseUntil time.Time) (*domain.WebhookDelivery, error) {
+	collection := repo.DB.Collection(repo.Collection)
+	due := bson.M{
+		"status":          bson.M{"$in": []domain.WebhookDeliveryStatus{domain.WebhookDeliveryPending, domain.WebhookDeliverySending}},
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetLimit(claimCandidates)
+	cursor, err := collection.Find(ctx, due, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.WebhookDeliveryDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	for i := range models {
+		model := &models[i]
+		result, err := collection.UpdateOne(ctx,
+			bson.M{"_id": model.ID, "status": model.Status, "next_attempt_at": model.NextAttemptAt},
+			bson.M{"$set": bson.M{"status": domain.WebhookDeliverySending, "next_attempt_at": leaseUntil, "updated_at": now}},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if result.ModifiedCount == 1 {
+			model.Status = string(domain.WebhookDeliverySending)
+			model.NextAttemptAt = leaseUntil
+			model.UpdatedAt = now
+			return mapper.WebhookDeliveryToDomain(model), nil
+		}
+	}
+	return nil, nil
+}
+
+func (repo *WebhookDeliveryRepository) Update(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	model := mapper.WebhookDeliveryFromDomain(delivery)
+	_, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx, bson.M{"_id": model.ID}, bson.M{"$set": bson.M{
+		"status":          model.Status,
+		"attempts":        model.Attempts,
+		"next_attempt_at": model.NextAttemptAt,
+		"delivered_at":    model.DeliveredAt,
+		"updated_at":      model.UpdatedAt,
+	}})
+	return err
+}
+
+func (repo *WebhookDeliveryRepository) FindByID(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+	var model mapper.WebhookDeliveryDB
+	if err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"_id": objectID}).Decode(&model); err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	return mapper.WebhookDeliveryToDomain(&model), nil
+}
+
+// Find pages through the matching deliveries, newest first
+func (repo *WebhookDeliveryRepository) Find(ctx context.Context, filter domain.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error) {
+	query := bson.M{}
+	if filter.WebhookID != "" {
+		query["webhook_id"] = filter.WebhookID
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if filter.Event != "" {
+		query["event"] = filter.Event
+	}
+
+	collection := repo.DB.Collection(repo.Collection)
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(int64((filter.Page - 1) * filter.PageSize)).
+		SetLimit(int64(filter.PageSize))
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.WebhookDeliveryDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, 0, err
+	}
+	deliveries := make([]*domain.WebhookDelivery, 0, len(models))
+	for i := range models {
+		deliveries = append(deliveries, mapper.WebhookDeliveryToDomain(&models[i]))
+	}
+	return deliveries, total, nil
+}
+
+func (repo *WebhookDeliveryRepository) DeleteByWebhook(ctx context.Context, webhookID string) error {
+	_, err := repo.DB.Collection(repo.Collection).DeleteMany(ctx, bson.M{"webhook_id": webhookID})
+	return err
+}
@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/database/mongo"
+	"g6/blog-api/Infrastructure/database/mongo/mapper"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type WebhookRepository struct {
+	DB         mongo.Database
+	Collection string
+}
+
+func NewWebhookRepository(db mongo.Database, collection string) domain.IWebhookRepository {
+	return &WebhookRepository{
+		DB:         db,
+		Collection: collection,
+	}
+}
+
+func (repo *WebhookRepository) Create(ctx context.Context, webhook *domain.Webhook) error {
+	model := mapper.WebhookFromDomain(webhook)
+	if _, err := repo.DB.Collection(repo.Collection).InsertOne(ctx, model); err != nil {
+		return err
+	}
+	webhook.ID = model.ID.Hex()
+	return nil
+}
+
+func (repo *WebhookRepository) FindByID(ctx context.Context, id string) (*domain.Webhook, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, domain.ErrWebhookNotFound
+	}
+	var model mapper.WebhookDB
+	if err := repo.DB.Collection(repo.Collection).FindOne(ctx, bson.M{"_id": objectID}).Decode(&model); err != nil {
+		if err == mongo.ErrNoDocuments() {
+			return nil, domain.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return mapper.WebhookToDomain(&model), nil
+}
+
+// FindAll returns every webhook, oldest first
+func (repo *WebhookRepository) FindAll(ctx context.Context) ([]*domain.Webhook, error) {
+	return repo.find(ctx, bson.M{})
+}
+
+func (repo *WebhookRepository) FindSubscribed(ctx context.Context, event domain.WebhookEventType) ([]*domain.Webhook, error) {
+	return repo.find(ctx, bson.M{"active": true, "events": event})
+}
+
+func (repo *WebhookRepository) find(ctx context.Context, query bson.M) ([]*domain.Webhook, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := repo.DB.Collection(repo.Collection).Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var models []mapper.WebhookDB
+	if err := cursor.All(ctx, &models); err != nil {
+		return nil, err
+	}
+	webhooks := make([]*domain.Webhook, 0, len(models))
+	for i := range models {
+		webhooks = append(webhooks, mapper.WebhookToDomain(&models[i]))
+	}
+	return webhooks, nil
+}
+
+func (repo *WebhookRepository) Update(ctx context.Context, webhook *domain.Webhook) error {
+	model := mapper.WebhookFromDomain(webhook)
+	result, err := repo.DB.Collection(repo.Collection).UpdateOne(ctx, bson.M{"_id": model.ID}, bson.M{"$set": bson.M{
+		"url":         model.URL,
+		"secret":      model.Secret,
+		"events":      model.Events,
+		"active":      model.Active,
+		"description": model.Description,
+		"updated_at":  model.UpdatedAt,
+	}})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (repo *WebhookRepository) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return domain.ErrWebhookNotFound
+	}
+	deleted, err := repo.DB.Collection(repo.Collection).DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return domain.ErrWebhookNotFound
+	}
+	return nil
+}
@@ -2,7 +2,7 @@ package usecases
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	domain "g6/blog-api/Domain"
 	"g6/blog-api/Infrastructure/database/mongo/mapper"
 	"g6/blog-api/Infrastructure/database/mongo/utils"
@@ -13,6 +13,8 @@ import (
 type blogCommentUsecase struct {
 	commentRepo domain.BlogCommentRepository
 	redisClient redis.RedisClient
+	policy      domain.IPolicy
+	events      domain.IEventBus
 	ctxtimeout  time.Duration
 }
 
@@ -21,7 +23,21 @@ func (b *blogCommentUsecase) CreateComment(ctx context.Context, comment *domain.
 	c, cancel := context.WithTimeout(ctx, b.ctxtimeout)
 	defer cancel()
 
-	return b.commentRepo.Create(c, comment)
+	// the post's author is notified and the watching clients are told by the comment.created
+	// subscribers
+	var created *domain.BlogComment
+	err := inTransaction(c, b.events, func(ctx context.Context) *domain.DomainError {
+		var err *domain.DomainError
+		created, err = b.commentRepo.Create(ctx, comment)
+		if err != nil {
+			return err
+		}
+		return b.publish(ctx, domain.EventCommentCreated, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 // DeleteComment implements domain.BlogCommentUsecase.
@@ -29,20 +45,18 @@ func (b *blogCommentUsecase) DeleteComment(ctx context.Context, id string) *doma
 	c, cancel := context.WithTimeout(ctx, b.ctxtimeout)
 	defer cancel()
 
-	if err := b.commentRepo.Delete(c, id); err != nil {
+	comment, err := b.authorize(c, domain.ActionCommentDelete, id)
+	if err != nil {
 		return err
 	}
 
-	// Invalidate Redis cache for this comment
-	redisService := b.redisClient.Service()
-	redisKey := redisService.GenerateBlogCommentKey(id)
-	if err := b.redisClient.Delete(c, redisKey); err != nil {
-		return &domain.DomainError{
-			Err:  errors.New("failed to delete comment from cache"),
-			Code: 500,
+	// the cached copy is dropped by the comment.deleted subscribers
+	return inTransaction(c, b.events, func(ctx context.Context) *domain.DomainError {
+		if err := b.commentRepo.Delete(ctx, id); err != nil {
+			return err
 		}
-	}
-	return nil
+		return b.publish(ctx, domain.EventCommentDeleted, comment)
+	})
 }
 
 // GetCommentByID implements domain.BlogCommentUsecase.
@@ -92,13 +106,64 @@ func (b *blogCommentUsecase) UpdateComment(ctx context.Context, id string, comme
 	c, cancel := context.WithTimeout(ctx, b.ctxtimeout)
 	defer cancel()
 
-	return b.commentRepo.Update(c, id, comment)
+	if _, err := b.authorize(c, domain.ActionCommentUpdate, id); err != nil {
+		return nil, err
+	}
+
+	var updated *domain.BlogComment
+	err := inTransaction(c, b.events, func(ctx context.Context) *domain.DomainError {
+		var err *domain.DomainError
+		updated, err = b.commentRepo.Update(ctx, id, comment)
+		if err != nil {
+			return err
+		}
+		return b.publish(ctx, domain.EventCommentUpdated, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// publish records the change to the comment in the transaction of ctx
+func (b *blogCommentUsecase) publish(ctx context.Context, eventType domain.DomainEventType, comment *domain.BlogComment) *domain.DomainError {
+	err := b.events.Publish(ctx, eventType, comment.ID, domain.CommentEventData{
+		ID:        comment.ID,
+		BlogID:    comment.BlogID,
+		AuthorID:  comment.AuthorID,
+		Comment:   comment.Comment,
+		CreatedAt: comment.CreatedAt,
+	})
+	if err != nil {
+		return &domain.DomainError{
+			Err:  fmt.Errorf("failed to record %s: %w", eventType, err),
+			Code: 500,
+		}
+	}
+	return nil
+}
+
+// authorize checks with the policy that the caller may perform the action on the comment and returns it
+func (b *blogCommentUsecase) authorize(ctx context.Context, action domain.Action, id string) (*domain.BlogComment, *domain.DomainError) {
+	comment, err := b.commentRepo.GetCommentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !b.policy.Can(ctx, action, domain.Resource{Type: "comment", ID: id, OwnerID: comment.AuthorID}) {
+		return nil, &domain.DomainError{
+			Err:  domain.ErrForbidden,
+			Code: 403,
+		}
+	}
+	return comment, nil
 }
 
-func NewBlogCommentUsecase(commentRepo domain.BlogCommentRepository, redisClient redis.RedisClient, timeout time.Duration) domain.BlogCommentUsecase {
+func NewBlogCommentUsecase(commentRepo domain.BlogCommentRepository, redisClient redis.RedisClient, policy domain.IPolicy, events domain.IEventBus, timeout time.Duration) domain.BlogCommentUsecase {
 	return &blogCommentUsecase{
 		commentRepo: commentRepo,
 		redisClient: redisClient,
+		policy:      policy,
+		events:      events,
 		ctxtimeout:  timeout,
 	}
 }
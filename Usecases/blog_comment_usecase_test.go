@@ -7,6 +7,7 @@ import (
 	domain "g6/blog-api/Domain"
 	domain_mocks "g6/blog-api/Domain/mocks"
 	redis_mocks "g6/blog-api/Infrastructure/redis/mocks"
+	"g6/blog-api/Infrastructure/security"
 	"net/http"
 	"testing"
 	"time"
@@ -21,6 +22,7 @@ type BlogCommentUsecaseSuite struct {
 	blogCommentUsecase domain.BlogCommentUsecase
 	Repo               *domain_mocks.MockBlogCommentRepository
 	Redis              *redis_mocks.MockRedisClient
+	Events             *domain_mocks.MockIEventBus
 	Ctx                context.Context
 	Comment            *domain.BlogComment
 }
@@ -35,14 +37,27 @@ func (s *BlogCommentUsecaseSuite) SetupTest() {
 	s.Comment = &Comment
 	s.Repo = new(domain_mocks.MockBlogCommentRepository)
 	s.Redis = new(redis_mocks.MockRedisClient)
-	s.Ctx = context.Background()
-	s.blogCommentUsecase = NewBlogCommentUsecase(s.Repo, s.Redis, time.Second*2)
+	s.Events = new(domain_mocks.MockIEventBus)
+	// the changes run in the transaction right away
+	s.Events.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Maybe()
+	// the auth middleware puts the caller on the request context
+	s.Ctx = context.WithValue(context.WithValue(context.Background(), "user_id", Comment.AuthorID), "role", string(domain.RoleUser))
+	s.blogCommentUsecase = NewBlogCommentUsecase(s.Repo, s.Redis, security.NewPolicy(security.DefaultRolePermissions), s.Events, time.Second*2)
 
 }
 
 func (s *BlogCommentUsecaseSuite) TestCommentUsecase_Create_Success() {
 
 	s.Repo.On("Create", mock.Anything, s.Comment).Return(s.Comment, nil)
+	s.Events.On("Publish", mock.Anything, domain.EventCommentCreated, s.Comment.ID, domain.CommentEventData{
+		ID:        s.Comment.ID,
+		BlogID:    s.Comment.BlogID,
+		AuthorID:  s.Comment.AuthorID,
+		Comment:   s.Comment.Comment,
+		CreatedAt: s.Comment.CreatedAt,
+	}).Return(nil)
 
 	result, err := s.blogCommentUsecase.CreateComment(s.Ctx, s.Comment)
 
@@ -50,6 +65,18 @@ func (s *BlogCommentUsecaseSuite) TestCommentUsecase_Create_Success() {
 	s.NotNil(result)
 	s.Equal(s.Comment, result)
 	s.Repo.AssertExpectations(s.T())
+	s.Events.AssertExpectations(s.T())
+}
+
+func (s *BlogCommentUsecaseSuite) TestCommentUsecase_Create_PublishFailure() {
+	s.Repo.On("Create", mock.Anything, s.Comment).Return(s.Comment, nil)
+	s.Events.On("Publish", mock.Anything, domain.EventCommentCreated, mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+	result, err := s.blogCommentUsecase.CreateComment(s.Ctx, s.Comment)
+
+	// without its event the comment is rolled back
+	s.Nil(result)
+	s.Equal(http.StatusInternalServerError, err.Code)
 }
 
 func (s *BlogCommentUsecaseSuite) TestCommentUsecase_Create_Error() {
@@ -67,10 +94,13 @@ func (s *BlogCommentUsecaseSuite) TestCommentUsecase_Create_Error() {
 	s.Equal(err, expectedError)
 
 	s.Repo.AssertExpectations(s.T())
+	s.Events.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (s *BlogCommentUsecaseSuite) TestCommentUsecase_Update_Success() {
+	s.Repo.On("GetCommentByID", mock.Anything, "id").Return(s.Comment, nil)
 	s.Repo.On("Update", mock.Anything, "id", s.Comment).Return(s.Comment, nil)
+	s.Events.On("Publish", mock.Anything, domain.EventCommentUpdated, s.Comment.ID, mock.Anything).Return(nil)
 
 	result, err := s.blogCommentUsecase.UpdateComment(s.Ctx, "id", s.Comment)
 
@@ -79,6 +109,7 @@ func (s *BlogCommentUsecaseSuite) TestCommentUsecase_Update_Success() {
 	s.Equal(result, s.Comment)
 
 	s.Repo.AssertExpectations(s.T())
+	s.Events.AssertExpectations(s.T())
 }
 
 func (s *BlogCommentUsecaseSuite) TestBlogCommentUsecase_Update_Error() {
@@ -86,6 +117,7 @@ func (s *BlogCommentUsecaseSuite) TestBlogCommentUsecase_Update_Error() {
 		Err:  fmt.Errorf("invalid id: %w", errors.New("bad request")),
 		Code: http.StatusBadRequest,
 	}
+	s.Repo.On("GetCommentByID", mock.Anything, "").Return(s.Comment, nil)
 	s.Repo.On("Update", mock.Anything, "", s.Comment).Return(nil, expectedError)
 
 	result, err := s.blogCommentUsecase.UpdateComment(s.Ctx, "", s.Comment)
@@ -97,6 +129,41 @@ func (s *BlogCommentUsecaseSuite) TestBlogCommentUsecase_Update_Error() {
 	s.Repo.AssertExpectations(s.T())
 }
 
+func (s *BlogCommentUsecaseSuite) TestCommentUsecase_Update_NotOwner() {
+	s.Repo.On("GetCommentByID", mock.Anything, "id").Return(&domain.BlogComment{AuthorID: "someone-else"}, nil)
+
+	result, err := s.blogCommentUsecase.UpdateComment(s.Ctx, "id", s.Comment)
+
+	s.Nil(result)
+	s.Equal(http.StatusForbidden, err.Code)
+	s.Repo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *BlogCommentUsecaseSuite) TestCommentUsecase_Delete_ModeratorDeletesAnyComment() {
+	ctx := context.WithValue(context.WithValue(context.Background(), "user_id", "moderator"), "role", string(domain.RoleAdmin))
+	s.Repo.On("GetCommentByID", mock.Anything, "id").Return(&domain.BlogComment{ID: "id", BlogID: "post", AuthorID: "someone-else", Comment: "gone"}, nil)
+	s.Repo.On("Delete", mock.Anything, "id").Return(nil)
+	s.Events.On("Publish", mock.Anything, domain.EventCommentDeleted, "id", domain.CommentEventData{ID: "id", BlogID: "post", AuthorID: "someone-else", Comment: "gone"}).Return(nil)
+
+	err := s.blogCommentUsecase.DeleteComment(ctx, "id")
+
+	s.Nil(err)
+	s.Repo.AssertExpectations(s.T())
+	s.Events.AssertExpectations(s.T())
+	// the cached copy is left to the comment.deleted subscribers
+	s.Redis.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func (s *BlogCommentUsecaseSuite) TestCommentUsecase_Delete_TokenWithoutCommentScope() {
+	ctx := context.WithValue(s.Ctx, "token_scopes", []domain.TokenScope{domain.ScopeReadPosts})
+	s.Repo.On("GetCommentByID", mock.Anything, "id").Return(s.Comment, nil)
+
+	err := s.blogCommentUsecase.DeleteComment(ctx, "id")
+
+	s.Equal(http.StatusForbidden, err.Code)
+	s.Repo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+}
+
 func TestBlogCommentUsecaseSuite(t *testing.T) {
 	suite.Run(t, new(BlogCommentUsecaseSuite))
 }
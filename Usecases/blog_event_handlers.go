@@ -0,0 +1,245 @@
+package usecases
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/redis"
+	"net/http"
+	"strings"
+)
+
+// BlogEventHandlers are the side effects of changes to posts, comments, reactions and users. Each
+// runs as its own subscriber, so one that fails is retried without repeating the others.
+type BlogEventHandlers struct {
+	postRepo      domain.BlogPostRepository
+	redisClient   redis.RedisClient
+	notifications domain.INotificationUsecase
+	streams       domain.IStreamUsecase
+	webhooks      domain.IWebhookUsecase
+	tags          domain.ITagUsecase
+}
+
+func NewBlogEventHandlers(postRepo domain.BlogPostRepository, redisClient redis.RedisClient, notifications domain.INotificationUsecase, streams domain.IStreamUsecase, webhooks domain.IWebhookUsecase, tags domain.ITagUsecase) *BlogEventHandlers {
+	return &BlogEventHandlers{
+		postRepo:      postRepo,
+		redisClient:   redisClient,
+		notifications: notifications,
+		streams:       streams,
+		webhooks:      webhooks,
+		tags:          tags,
+	}
+}
+
+// Register subscribes the handlers to bus
+func (h *BlogEventHandlers) Register(bus domain.IEventBus) {
+	bus.Subscribe(domain.EventPostCreated, "tags", h.countTags)
+	bus.Subscribe(domain.EventPostCreated, "webhooks", h.postWebhook(domain.WebhookPostPublished))
+	bus.Subscribe(domain.EventPostUpdated, "cache", h.forgetPost)
+	bus.Subscribe(domain.EventPostUpdated, "tags", h.countTags)
+	bus.Subscribe(domain.EventPostUpdated, "webhooks", h.postWebhook(domain.WebhookPostUpdated))
+	bus.Subscribe(domain.EventPostDeleted, "cache", h.forgetPost)
+	bus.Subscribe(domain.EventPostDeleted, "tags", h.countTags)
+	bus.Subscribe(domain.EventPostDeleted, "webhooks", h.postWebhook(domain.WebhookPostDeleted))
+
+	bus.Subscribe(domain.EventCommentCreated, "counters", h.commentCounters)
+	bus.Subscribe(domain.EventCommentCreated, "notifications", h.notifyComment)
+	bus.Subscribe(domain.EventCommentCreated, "streams", h.streamComment(domain.StreamEventCommentCreated))
+	bus.Subscribe(domain.EventCommentCreated, "webhooks", h.commentWebhook)
+	bus.Subscribe(domain.EventCommentUpdated, "cache", h.forgetComment)
+	bus.Subscribe(domain.EventCommentUpdated, "streams", h.streamComment(domain.StreamEventCommentUpdated))
+	bus.Subscribe(domain.EventCommentDeleted, "cache", h.forgetComment)
+	bus.Subscribe(domain.EventCommentDeleted, "counters", h.commentCounters)
+	bus.Subscribe(domain.EventCommentDeleted, "streams", h.streamComment(domain.StreamEventCommentDeleted))
+
+	bus.Subscribe(domain.EventReactionChanged, "counters", h.reactionCounters)
+	bus.Subscribe(domain.EventReactionChanged, "notifications", h.notifyLike)
+
+	bus.Subscribe(domain.EventUserUpdated, "posts", h.renameAuthor)
+	bus.Subscribe(domain.EventAuthorFollowed, "notifications", h.notifyFollow)
+}
+
+func (h *BlogEventHandlers) postWebhook(event domain.WebhookEventType) domain.DomainEventHandler {
+	return func(ctx context.Context, e *domain.DomainEvent) error {
+		var post domain.PostEventData
+		if err := e.Decode(&post); err != nil {
+			return err
+		}
+		return h.webhooks.Dispatch(event, domain.WebhookPostData{
+			ID:         post.ID,
+			Title:      post.Title,
+			Content:    post.Content,
+			AuthorID:   post.AuthorID,
+			AuthorName: post.AuthorName,
+			Tags:       post.Tags,
+			CreatedAt:  post.CreatedAt,
+			UpdatedAt:  post.UpdatedAt,
+		})
+	}
+}
+
+// countTags recounts the posts of the tags the post has or had
+func (h *BlogEventHandlers) countTags(ctx context.Context, e *domain.DomainEvent) error {
+	var post domain.PostEventData
+	if err := e.Decode(&post); err != nil {
+		return err
+	}
+	return h.tags.RefreshUsage(ctx, append(post.Tags, post.PreviousTags...))
+}
+
+// forgetPost drops the cached copy of the post
+func (h *BlogEventHandlers) forgetPost(ctx context.Context, e *domain.DomainEvent) error {
+	return h.redisClient.Delete(ctx, h.redisClient.Service().GenerateBlogPostKey(e.AggregateID))
+}
+
+func (h *BlogEventHandlers) forgetComment(ctx context.Context, e *domain.DomainEvent) error {
+	return h.redisClient.Delete(ctx, h.redisClient.Service().GenerateBlogCommentKey(e.AggregateID))
+}
+
+// commentCounters recounts the comments of the post, whose cached copy then is stale
+func (h *BlogEventHandlers) commentCounters(ctx context.Context, e *domain.DomainEvent) error {
+	var comment domain.CommentEventData
+	if err := e.Decode(&comment); err != nil {
+		return err
+	}
+	if _, derr := h.postRepo.RefreshCounts(ctx, comment.BlogID); derr != nil {
+		if derr.Code == http.StatusNotFound {
+			return nil
+		}
+		return derr.Err
+	}
+	return h.redisClient.Delete(ctx, h.redisClient.Service().GenerateBlogPostKey(comment.BlogID))
+}
+
+// notifyComment tells the post's author about the comment
+func (h *BlogEventHandlers) notifyComment(ctx context.Context, e *domain.DomainEvent) error {
+	var comment domain.CommentEventData
+	if err := e.Decode(&comment); err != nil {
+		return err
+	}
+	post, derr := h.postRepo.GetBlogByID(ctx, comment.BlogID)
+	if derr != nil {
+		if derr.Code == http.StatusNotFound {
+			return nil
+		}
+		return derr.Err
+	}
+	return h.notifications.Notify(&domain.Notification{
+		RecipientID: post.AuthorID,
+		ActorID:     comment.AuthorID,
+		Type:        domain.NotificationPostComment,
+		TargetType:  "comment",
+		TargetID:    comment.ID,
+		PostID:      comment.BlogID,
+	})
+}
+
+// streamComment sends the change to the clients watching the post, a deleted comment goes out
+// as its IDs only
+func (h *BlogEventHandlers) streamComment(eventType domain.StreamEventType) domain.DomainEventHandler {
+	return func(ctx context.Context, e *domain.DomainEvent) error {
+		var comment domain.CommentEventData
+		if err := e.Decode(&comment); err != nil {
+			return err
+		}
+		data := domain.CommentStreamData{ID: comment.ID, BlogID: comment.BlogID}
+		if eventType != domain.StreamEventCommentDeleted {
+			data.AuthorID = comment.AuthorID
+			data.Comment = comment.Comment
+			data.CreatedAt = comment.CreatedAt
+		}
+		return h.streams.Publish(domain.PostTopic(comment.BlogID), eventType, data)
+	}
+}
+
+func (h *BlogEventHandlers) commentWebhook(ctx context.Context, e *domain.DomainEvent) error {
+	var comment domain.CommentEventData
+	if err := e.Decode(&comment); err != nil {
+		return err
+	}
+	return h.webhooks.Dispatch(domain.WebhookCommentCreated, domain.WebhookCommentData{
+		ID:        comment.ID,
+		BlogID:    comment.BlogID,
+		AuthorID:  comment.AuthorID,
+		Comment:   comment.Comment,
+		CreatedAt: comment.CreatedAt,
+	})
+}
+
+// reactionCounters recounts the likes and dislikes of the post and streams them to the clients
+// watching it
+func (h *BlogEventHandlers) reactionCounters(ctx context.Context, e *domain.DomainEvent) error {
+	var reaction domain.ReactionEventData
+	if err := e.Decode(&reaction); err != nil {
+		return err
+	}
+	post, derr := h.postRepo.RefreshCounts(ctx, reaction.BlogID)
+	if derr != nil {
+		if derr.Code == http.StatusNotFound {
+			return nil
+		}
+		return derr.Err
+	}
+	if err := h.redisClient.Delete(ctx, h.redisClient.Service().GenerateBlogPostKey(post.ID)); err != nil {
+		return err
+	}
+	return h.streams.Publish(domain.PostTopic(post.ID), domain.StreamEventReactions, domain.ReactionsStreamData{
+		BlogID:   post.ID,
+		Likes:    post.Likes,
+		Dislikes: post.Dislikes,
+	})
+}
+
+// notifyLike tells the post's author about a like, dislikes and taken back reactions are not
+// worth it
+func (h *BlogEventHandlers) notifyLike(ctx context.Context, e *domain.DomainEvent) error {
+	var reaction domain.ReactionEventData
+	if err := e.Decode(&reaction); err != nil {
+		return err
+	}
+	if !reaction.IsLike || reaction.Removed {
+		return nil
+	}
+	post, derr := h.postRepo.GetBlogByID(ctx, reaction.BlogID)
+	if derr != nil {
+		if derr.Code == http.StatusNotFound {
+			return nil
+		}
+		return derr.Err
+	}
+	return h.notifications.Notify(&domain.Notification{
+		RecipientID: post.AuthorID,
+		ActorID:     reaction.UserID,
+		Type:        domain.NotificationPostLike,
+		TargetType:  "post",
+		TargetID:    reaction.BlogID,
+		PostID:      reaction.BlogID,
+	})
+}
+
+// notifyFollow tells the author about a reader who follows their posts
+func (h *BlogEventHandlers) notifyFollow(ctx context.Context, e *domain.DomainEvent) error {
+	var follow domain.FollowEventData
+	if err := e.Decode(&follow); err != nil {
+		return err
+	}
+	return h.notifications.Notify(&domain.Notification{
+		RecipientID: follow.AuthorID,
+		ActorID:     follow.FollowerID,
+		Type:        domain.NotificationFollow,
+		TargetType:  "user",
+		TargetID:    follow.AuthorID,
+	})
+}
+
+// renameAuthor keeps the author name stored with the user's posts up to date
+func (h *BlogEventHandlers) renameAuthor(ctx context.Context, e *domain.DomainEvent) error {
+	var user domain.UserEventData
+	if err := e.Decode(&user); err != nil {
+		return err
+	}
+	name := strings.TrimSpace(user.FirstName + " " + user.LastName)
+	if derr := h.postRepo.UpdateAuthorName(ctx, user.ID, name); derr != nil {
+		return derr.Err
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"g6/blog-api/Infrastructure/redis"
+	redis_mocks "g6/blog-api/Infrastructure/redis/mocks"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BlogEventHandlersSuite struct {
+	suite.Suite
+	mockPostRepo      *domain_mocks.MockBlogPostRepository
+	mockRedis         *redis_mocks.MockRedisClient
+	mockNotifications *domain_mocks.MockINotificationUsecase
+	mockStreams       *domain_mocks.MockIStreamUsecase
+	mockWebhooks      *domain_mocks.MockIWebhookUsecase
+	mockTags          *domain_mocks.MockITagUsecase
+	handlers          *BlogEventHandlers
+}
+
+func (s *BlogEventHandlersSuite) SetupTest() {
+	s.mockPostRepo = domain_mocks.NewMockBlogPostRepository(s.T())
+	s.mockRedis = redis_mocks.NewMockRedisClient(s.T())
+	s.mockNotifications = domain_mocks.NewMockINotificationUsecase(s.T())
+	s.mockStreams = domain_mocks.NewMockIStreamUsecase(s.T())
+	s.mockWebhooks = domain_mocks.NewMockIWebhookUsecase(s.T())
+	s.mockTags = domain_mocks.NewMockITagUsecase(s.T())
+	s.handlers = NewBlogEventHandlers(s.mockPostRepo, s.mockRedis, s.mockNotifications, s.mockStreams, s.mockWebhooks, s.mockTags)
+}
+
+func TestBlogEventHandlersSuite(t *testing.T) {
+	suite.Run(t, new(BlogEventHandlersSuite))
+}
+
+// event is what the dispatcher hands the subscribers for data
+func (s *BlogEventHandlersSuite) event(eventType domain.DomainEventType, aggregateID string, data any) *domain.DomainEvent {
+	payload, err := json.Marshal(data)
+	s.Require().NoError(err)
+	return &domain.DomainEvent{ID: "e1", Type: eventType, AggregateID: aggregateID, Payload: string(payload)}
+}
+
+func (s *BlogEventHandlersSuite) TestRegister() {
+	bus := domain_mocks.NewMockIEventBus(s.T())
+	subscribed := map[domain.DomainEventType][]string{}
+	bus.On("Subscribe", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		eventType := args.Get(0).(domain.DomainEventType)
+		subscribed[eventType] = append(subscribed[eventType], args.String(1))
+	})
+
+	s.handlers.Register(bus)
+
+	s.Equal(map[domain.DomainEventType][]string{
+		domain.EventPostCreated:     {"tags", "webhooks"},
+		domain.EventPostUpdated:     {"cache", "tags", "webhooks"},
+		domain.EventPostDeleted:     {"cache", "tags", "webhooks"},
+		domain.EventCommentCreated:  {"counters", "notifications", "streams", "webhooks"},
+		domain.EventCommentUpdated:  {"cache", "streams"},
+		domain.EventCommentDeleted:  {"cache", "counters", "streams"},
+		domain.EventReactionChanged: {"counters", "notifications"},
+		domain.EventUserUpdated:     {"posts"},
+		domain.EventAuthorFollowed:  {"notifications"},
+	}, subscribed)
+}
+
+func (s *BlogEventHandlersSuite) TestPostEvents() {
+	s.Run("CacheDropped", func() {
+		s.SetupTest()
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Delete", mock.Anything, "blogpost:p1").Return(nil)
+
+		err := s.handlers.forgetPost(context.Background(), s.event(domain.EventPostUpdated, "p1", domain.PostEventData{ID: "p1"}))
+
+		s.NoError(err)
+	})
+
+	s.Run("TagsOldAndNewCounted", func() {
+		s.SetupTest()
+		s.mockTags.On("RefreshUsage", mock.Anything, []string{"go", "web", "golang"}).Return(nil)
+
+		err := s.handlers.countTags(context.Background(), s.event(domain.EventPostUpdated, "p1", domain.PostEventData{
+			ID:           "p1",
+			Tags:         []string{"go", "web"},
+			PreviousTags: []string{"golang"},
+		}))
+
+		s.NoError(err)
+	})
+
+	s.Run("Webhook", func() {
+		s.SetupTest()
+		createdAt := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+		s.mockWebhooks.On("Dispatch", domain.WebhookPostDeleted, domain.WebhookPostData{
+			ID:        "p1",
+			Title:     "Hello",
+			AuthorID:  "u1",
+			Tags:      []string{"go"},
+			CreatedAt: createdAt,
+		}).Return(nil)
+
+		err := s.handlers.postWebhook(domain.WebhookPostDeleted)(context.Background(), s.event(domain.EventPostDeleted, "p1", domain.PostEventData{
+			ID:        "p1",
+			Title:     "Hello",
+			AuthorID:  "u1",
+			Tags:      []string{"go"},
+			CreatedAt: createdAt,
+		}))
+
+		s.NoError(err)
+	})
+}
+
+func (s *BlogEventHandlersSuite) TestCommentCreated() {
+	createdAt := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+	comment := domain.CommentEventData{ID: "c1", BlogID: "p1", AuthorID: "u1", Comment: "this is a comment", CreatedAt: createdAt}
+
+	s.Run("Counters", func() {
+		s.SetupTest()
+		s.mockPostRepo.On("RefreshCounts", mock.Anything, "p1").Return(&domain.BlogPost{ID: "p1", CommentCount: 3}, nil)
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Delete", mock.Anything, "blogpost:p1").Return(nil)
+
+		err := s.handlers.commentCounters(context.Background(), s.event(domain.EventCommentCreated, "c1", comment))
+
+		s.NoError(err)
+	})
+
+	s.Run("CountersOfDeletedPost", func() {
+		s.SetupTest()
+		s.mockPostRepo.On("RefreshCounts", mock.Anything, "p1").Return(nil, &domain.DomainError{Err: errors.New("not found"), Code: http.StatusNotFound})
+
+		err := s.handlers.commentCounters(context.Background(), s.event(domain.EventCommentCreated, "c1", comment))
+
+		s.NoError(err)
+	})
+
+	s.Run("NotifiesPostAuthor", func() {
+		s.SetupTest()
+		s.mockPostRepo.On("GetBlogByID", mock.Anything, "p1").Return(&domain.BlogPost{ID: "p1", AuthorID: "post-author"}, nil)
+		s.mockNotifications.On("Notify", &domain.Notification{
+			RecipientID: "post-author",
+			ActorID:     "u1",
+			Type:        domain.NotificationPostComment,
+			TargetType:  "comment",
+			TargetID:    "c1",
+			PostID:      "p1",
+		}).Return(nil)
+
+		err := s.handlers.notifyComment(context.Background(), s.event(domain.EventCommentCreated, "c1", comment))
+
+		s.NoError(err)
+	})
+
+	s.Run("NotifyFailureIsRetried", func() {
+		s.SetupTest()
+		s.mockPostRepo.On("GetBlogByID", mock.Anything, "p1").Return(&domain.BlogPost{ID: "p1", AuthorID: "post-author"}, nil)
+		s.mockNotifications.On("Notify", mock.Anything).Return(errors.New("db error"))
+
+		err := s.handlers.notifyComment(context.Background(), s.event(domain.EventCommentCreated, "c1", comment))
+
+		s.EqualError(err, "db error")
+	})
+
+	s.Run("Streamed", func() {
+		s.SetupTest()
+		s.mockStreams.On("Publish", domain.PostTopic("p1"), domain.StreamEventCommentCreated, domain.CommentStreamData{
+			ID:        "c1",
+			BlogID:    "p1",
+			AuthorID:  "u1",
+			Comment:   "this is a comment",
+			CreatedAt: createdAt,
+		}).Return(nil)
+
+		err := s.handlers.streamComment(domain.StreamEventCommentCreated)(context.Background(), s.event(domain.EventCommentCreated, "c1", comment))
+
+		s.NoError(err)
+	})
+
+	s.Run("Webhook", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("Dispatch", domain.WebhookCommentCreated, domain.WebhookCommentData{
+			ID:        "c1",
+			BlogID:    "p1",
+			AuthorID:  "u1",
+			Comment:   "this is a comment",
+			CreatedAt: createdAt,
+		}).Return(nil)
+
+		err := s.handlers.commentWebhook(context.Background(), s.event(domain.EventCommentCreated, "c1", comment))
+
+		s.NoError(err)
+	})
+}
+
+func (s *BlogEventHandlersSuite) TestCommentDeleted() {
+	comment := domain.CommentEventData{ID: "c1", BlogID: "p1", AuthorID: "u1", Comment: "gone"}
+
+	s.Run("CacheDropped", func() {
+		s.SetupTest()
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Delete", mock.Anything, "blogcomment:c1").Return(nil)
+
+		err := s.handlers.forgetComment(context.Background(), s.event(domain.EventCommentDeleted, "c1", comment))
+
+		s.NoError(err)
+	})
+
+	s.Run("StreamsIDsOnly", func() {
+		s.SetupTest()
+		// the text of a deleted comment is not sent around
+		s.mockStreams.On("Publish", domain.PostTopic("p1"), domain.StreamEventCommentDeleted, domain.CommentStreamData{ID: "c1", BlogID: "p1"}).Return(nil)
+
+		err := s.handlers.streamComment(domain.StreamEventCommentDeleted)(context.Background(), s.event(domain.EventCommentDeleted, "c1", comment))
+
+		s.NoError(err)
+	})
+}
+
+func (s *BlogEventHandlersSuite) TestReactionChanged() {
+	s.Run("CountersStreamed", func() {
+		s.SetupTest()
+		s.mockPostRepo.On("RefreshCounts", mock.Anything, "p1").Return(&domain.BlogPost{ID: "p1", Likes: 4, Dislikes: 1}, nil)
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Delete", mock.Anything, "blogpost:p1").Return(nil)
+		s.mockStreams.On("Publish", domain.PostTopic("p1"), domain.StreamEventReactions, domain.ReactionsStreamData{BlogID: "p1", Likes: 4, Dislikes: 1}).Return(nil)
+
+		err := s.handlers.reactionCounters(context.Background(), s.event(domain.EventReactionChanged, "r1", domain.ReactionEventData{ID: "r1", BlogID: "p1", UserID: "u1", IsLike: true}))
+
+		s.NoError(err)
+	})
+
+	s.Run("CounterFailureIsRetried", func() {
+		s.SetupTest()
+		s.mockPostRepo.On("RefreshCounts", mock.Anything, "p1").Return(nil, &domain.DomainError{Err: errors.New("db error"), Code: http.StatusInternalServerError})
+
+		err := s.handlers.reactionCounters(context.Background(), s.event(domain.EventReactionChanged, "r1", domain.ReactionEventData{ID: "r1", BlogID: "p1"}))
+
+		s.EqualError(err, "db error")
+	})
+
+	s.Run("LikeNotified", func() {
+		s.SetupTest()
+		s.mockPostRepo.On("GetBlogByID", mock.Anything, "p1").Return(&domain.BlogPost{ID: "p1", AuthorID: "post-author"}, nil)
+		s.mockNotifications.On("Notify", &domain.Notification{
+			RecipientID: "post-author",
+			ActorID:     "u1",
+			Type:        domain.NotificationPostLike,
+			TargetType:  "post",
+			TargetID:    "p1",
+			PostID:      "p1",
+		}).Return(nil)
+
+		err := s.handlers.notifyLike(context.Background(), s.event(domain.EventReactionChanged, "r1", domain.ReactionEventData{ID: "r1", BlogID: "p1", UserID: "u1", IsLike: true}))
+
+		s.NoError(err)
+	})
+
+	s.Run("DislikeAndRemovalNotNotified", func() {
+		s.SetupTest()
+
+		s.NoError(s.handlers.notifyLike(context.Background(), s.event(domain.EventReactionChanged, "r1", domain.ReactionEventData{ID: "r1", BlogID: "p1", IsLike: false})))
+		s.NoError(s.handlers.notifyLike(context.Background(), s.event(domain.EventReactionChanged, "r1", domain.ReactionEventData{ID: "r1", BlogID: "p1", IsLike: true, Removed: true})))
+		s.mockNotifications.AssertNotCalled(s.T(), "Notify", mock.Anything)
+	})
+}
+
+func (s *BlogEventHandlersSuite) TestUserUpdated() {
+	s.mockPostRepo.On("UpdateAuthorName", mock.Anything, "u1", "Jane Doe").Return(nil)
+
+	err := s.handlers.renameAuthor(context.Background(), s.event(domain.EventUserUpdated, "u1", domain.UserEventData{ID: "u1", FirstName: "Jane", LastName: "Doe"}))
+
+	s.NoError(err)
+}
+
+func (s *BlogEventHandlersSuite) TestAuthorFollowed() {
+	s.mockNotifications.On("Notify", &domain.Notification{
+		RecipientID: "author",
+		ActorID:     "u1",
+		Type:        domain.NotificationFollow,
+		TargetType:  "user",
+		TargetID:    "author",
+	}).Return(nil)
+
+	err := s.handlers.notifyFollow(context.Background(), s.event(domain.EventAuthorFollowed, "author", domain.FollowEventData{FollowerID: "u1", AuthorID: "author"}))
+
+	s.NoError(err)
+}
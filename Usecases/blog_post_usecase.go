@@ -16,6 +16,10 @@ import (
 type blogPostUsecase struct {
 	blogPostRepo domain.BlogPostRepository
 	redisClient  redis.RedisClient
+	policy       domain.IPolicy
+	events       domain.IEventBus
+	tags         domain.ITagUsecase
+	categories   domain.ICategoryUsecase
 	ctxtimeout   time.Duration
 }
 
@@ -25,7 +29,28 @@ func (b *blogPostUsecase) CreateBlog(ctx context.Context, blog *domain.BlogPost)
 	c, cancel := context.WithTimeout(ctx, b.ctxtimeout)
 	defer cancel()
 
-	return b.blogPostRepo.Create(c, blog)
+	tags, err := b.normalizeTags(c, blog.Tags)
+	if err != nil {
+		return nil, err
+	}
+	blog.Tags = tags
+	if blog.CategoryID, err = b.category(c, blog.CategoryID); err != nil {
+		return nil, err
+	}
+
+	var created *domain.BlogPost
+	err = inTransaction(c, b.events, func(ctx context.Context) *domain.DomainError {
+		var err *domain.DomainError
+		created, err = b.blogPostRepo.Create(ctx, blog)
+		if err != nil {
+			return err
+		}
+		return b.publish(ctx, domain.EventPostCreated, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 // DeleteBlog implements domain.BlogUsecase.
@@ -33,21 +58,18 @@ func (b *blogPostUsecase) DeleteBlog(ctx context.Context, id string) *domain.Dom
 	c, cancel := context.WithTimeout(ctx, b.ctxtimeout)
 	defer cancel()
 
-	// Delete the blog post from the database
-	if err := b.blogPostRepo.Delete(c, id); err != nil {
+	blog, err := b.authorize(c, domain.ActionPostDelete, id)
+	if err != nil {
 		return err
 	}
 
-	// Use the actual Redis service from the usecase to generate key
-	redisKey := b.redisClient.Service().GenerateBlogPostKey(id)
-	if err := b.redisClient.Delete(ctx, redisKey); err != nil {
-		return &domain.DomainError{
-			Err:  errors.New("failed to invalidate blog post cache"),
-			Code: http.StatusInternalServerError,
+	// the cached copy is dropped by the post.deleted subscribers
+	return inTransaction(c, b.events, func(ctx context.Context) *domain.DomainError {
+		if err := b.blogPostRepo.Delete(ctx, id); err != nil {
+			return err
 		}
-	}
-
-	return nil
+		return b.publish(ctx, domain.EventPostDeleted, blog)
+	})
 }
 
 // GetBlogs implements domain.BlogUsecase.
@@ -55,6 +77,18 @@ func (b *blogPostUsecase) GetBlogs(ctx context.Context, filter *domain.BlogPostF
 	c, cancel := context.WithTimeout(ctx, b.ctxtimeout)
 	defer cancel()
 
+	// posts are tagged with the canonical tags, a synonym finds the same posts
+	if len(filter.Tags) > 0 {
+		tags, err := b.tags.Resolve(c, filter.Tags)
+		if err != nil {
+			return nil, &domain.DomainError{
+				Err:  fmt.Errorf("failed to resolve tags: %w", err),
+				Code: http.StatusInternalServerError,
+			}
+		}
+		filter.Tags = tags
+	}
+
 	// Generate the Redis key
 	redis_key := b.redisClient.Service().GenerateRedisKey(filter)
 
@@ -76,6 +110,21 @@ func (b *blogPostUsecase) GetBlogs(ctx context.Context, filter *domain.BlogPostF
 
 	fmt.Println("Cache miss for key:", redis_key)
 
+	if filter.Category != "" {
+		ids, err := b.categories.Resolve(c, filter.Category, filter.IncludeSubcategories)
+		if err != nil {
+			code := http.StatusInternalServerError
+			if err == domain.ErrCategoryNotFound {
+				code = http.StatusNotFound
+			}
+			return nil, &domain.DomainError{
+				Err:  err,
+				Code: code,
+			}
+		}
+		filter.CategoryIDs = ids
+	}
+
 	// If not found in cache, query the database
 	blogPosts, serialized, domErr := b.blogPostRepo.Get(c, filter)
 	if domErr != nil {
@@ -167,21 +216,38 @@ func (b *blogPostUsecase) UpdateBlog(ctx context.Context, id string, blog domain
 	c, cancel := context.WithTimeout(ctx, b.ctxtimeout)
 	defer cancel()
 
-	// Perform the update
-	updated, err := b.blogPostRepo.Update(c, id, blog)
+	previous, err := b.authorize(c, domain.ActionPostUpdate, id)
 	if err != nil {
 		return nil, err
 	}
+	tags, err := b.normalizeTags(c, blog.Tags)
+	if err != nil {
+		return nil, err
+	}
+	blog.Tags = tags
+	if blog.CategoryID, err = b.category(c, blog.CategoryID); err != nil {
+		return nil, err
+	}
 
-	// Invalidate cache for this blog post
-	redisKey := b.redisClient.Service().GenerateBlogPostKey(id)
-	if delErr := b.redisClient.Delete(ctx, redisKey); delErr != nil {
-		return nil, &domain.DomainError{
-			Err:  fmt.Errorf("failed to invalidate blog post cache: %w", delErr),
-			Code: http.StatusInternalServerError,
+	// the cached copy is dropped by the post.updated subscribers
+	var updated *domain.BlogPost
+	err = inTransaction(c, b.events, func(ctx context.Context) *domain.DomainError {
+		var err *domain.DomainError
+		updated, err = b.blogPostRepo.Update(ctx, id, blog)
+		if err != nil {
+			return err
 		}
+		// Update only returns the changed fields, the event carries the whole post
+		post, err := b.blogPostRepo.GetBlogByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		// the usage of the tags taken off the post is counted again too
+		return b.publish(ctx, domain.EventPostUpdated, post, previous.Tags...)
+	})
+	if err != nil {
+		return nil, err
 	}
-
 	return updated, nil
 }
 
@@ -226,11 +292,89 @@ func (b *blogPostUsecase) IncrementViewCountWithLimit(ctx context.Context, user_
 	return nil
 }
 
+// authorize checks with the policy that the caller may perform the action on the blog post and returns it
+func (b *blogPostUsecase) authorize(ctx context.Context, action domain.Action, id string) (*domain.BlogPost, *domain.DomainError) {
+	blog, err := b.blogPostRepo.GetBlogByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !b.policy.Can(ctx, action, domain.Resource{Type: "post", ID: id, OwnerID: blog.AuthorID}) {
+		return nil, &domain.DomainError{
+			Err:  domain.ErrForbidden,
+			Code: http.StatusForbidden,
+		}
+	}
+	return blog, nil
+}
+
+// normalizeTags replaces the tags of a post with their canonical slugs
+func (b *blogPostUsecase) normalizeTags(ctx context.Context, tags []string) ([]string, *domain.DomainError) {
+	normalized, err := b.tags.Normalize(ctx, tags)
+	if err != nil {
+		return nil, &domain.DomainError{
+			Err:  fmt.Errorf("failed to normalize tags: %w", err),
+			Code: http.StatusInternalServerError,
+		}
+	}
+	return normalized, nil
+}
+
+// category returns the ID of the category a post is filed under, given by its ID or slug
+func (b *blogPostUsecase) category(ctx context.Context, ref string) (string, *domain.DomainError) {
+	if ref == "" {
+		return "", &domain.DomainError{
+			Err:  errors.New("a post needs a category"),
+			Code: http.StatusBadRequest,
+		}
+	}
+	ids, err := b.categories.Resolve(ctx, ref, false)
+	if err == domain.ErrCategoryNotFound {
+		return "", &domain.DomainError{
+			Err:  err,
+			Code: http.StatusBadRequest,
+		}
+	}
+	if err != nil {
+		return "", &domain.DomainError{
+			Err:  fmt.Errorf("failed to find the category: %w", err),
+			Code: http.StatusInternalServerError,
+		}
+	}
+	return ids[0], nil
+}
+
+// publish records the change to the post in the transaction of ctx, previousTags are the tags
+// the post had before an update
+func (b *blogPostUsecase) publish(ctx context.Context, eventType domain.DomainEventType, blog *domain.BlogPost, previousTags ...string) *domain.DomainError {
+	err := b.events.Publish(ctx, eventType, blog.ID, domain.PostEventData{
+		ID:           blog.ID,
+		Title:        blog.Title,
+		Content:      blog.Content,
+		AuthorID:     blog.AuthorID,
+		AuthorName:   blog.AuthorName,
+		Tags:         blog.Tags,
+		PreviousTags: previousTags,
+		CreatedAt:    blog.CreatedAt,
+		UpdatedAt:    blog.UpdatedAt,
+	})
+	if err != nil {
+		return &domain.DomainError{
+			Err:  fmt.Errorf("failed to record %s: %w", eventType, err),
+			Code: http.StatusInternalServerError,
+		}
+	}
+	return nil
+}
+
 // NewBlogPostUsecase creates a new instance of blog post usecase.
-func NewBlogPostUsecase(blogPostRepo domain.BlogPostRepository, redisClient redis.RedisClient, timeout time.Duration) domain.BlogPostUsecase {
+func NewBlogPostUsecase(blogPostRepo domain.BlogPostRepository, redisClient redis.RedisClient, policy domain.IPolicy, events domain.IEventBus, tags domain.ITagUsecase, categories domain.ICategoryUsecase, timeout time.Duration) domain.BlogPostUsecase {
 	return &blogPostUsecase{
 		blogPostRepo: blogPostRepo,
 		redisClient:  redisClient,
+		policy:       policy,
+		events:       events,
+		tags:         tags,
+		categories:   categories,
 		ctxtimeout:   timeout,
 	}
 }
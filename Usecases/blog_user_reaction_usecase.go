@@ -2,12 +2,15 @@ package usecases
 
 import (
 	"context"
+	"fmt"
 	domain "g6/blog-api/Domain"
+	"net/http"
 	"time"
 )
 
 type blogUserReactionUsecase struct {
 	blogUserReactionRepo domain.BlogUserReactionRepository
+	events               domain.IEventBus
 	ctxtimeout           time.Duration
 }
 
@@ -15,14 +18,37 @@ func (b *blogUserReactionUsecase) CreateReaction(ctx context.Context, reaction *
 	c, cancel := context.WithTimeout(ctx, b.ctxtimeout)
 	defer cancel()
 
-	return b.blogUserReactionRepo.Create(c, reaction)
+	// the counters, the watching clients and the post's author are updated by the
+	// reaction.changed subscribers
+	var created *domain.BlogUserReaction
+	err := inTransaction(c, b.events, func(ctx context.Context) *domain.DomainError {
+		var err *domain.DomainError
+		created, err = b.blogUserReactionRepo.Create(ctx, reaction)
+		if err != nil {
+			return err
+		}
+		return b.publish(ctx, created, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 func (b *blogUserReactionUsecase) DeleteReaction(ctx context.Context, id string) *domain.DomainError {
 	c, cancel := context.WithTimeout(ctx, b.ctxtimeout)
 	defer cancel()
 
-	return b.blogUserReactionRepo.Delete(c, id)
+	reaction, err := b.blogUserReactionRepo.GetReactionByID(c, id)
+	if err != nil {
+		return err
+	}
+	return inTransaction(c, b.events, func(ctx context.Context) *domain.DomainError {
+		if err := b.blogUserReactionRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return b.publish(ctx, reaction, true)
+	})
 }
 
 func (b *blogUserReactionUsecase) GetUserReaction(ctx context.Context, blogID string, userID string) (*domain.BlogUserReaction, *domain.DomainError) {
@@ -32,9 +58,28 @@ func (b *blogUserReactionUsecase) GetUserReaction(ctx context.Context, blogID st
 	return b.blogUserReactionRepo.GetUserReaction(c, blogID, userID)
 }
 
-func NewBlogUserReactionUsecase(blogUserReactionRepo domain.BlogUserReactionRepository, timeout time.Duration) domain.BlogUserReactionUsecase {
+// publish records the reaction, or that it was taken back, in the transaction of ctx
+func (b *blogUserReactionUsecase) publish(ctx context.Context, reaction *domain.BlogUserReaction, removed bool) *domain.DomainError {
+	err := b.events.Publish(ctx, domain.EventReactionChanged, reaction.ID, domain.ReactionEventData{
+		ID:      reaction.ID,
+		BlogID:  reaction.BlogID,
+		UserID:  reaction.UserID,
+		IsLike:  reaction.IsLike,
+		Removed: removed,
+	})
+	if err != nil {
+		return &domain.DomainError{
+			Err:  fmt.Errorf("failed to record %s: %w", domain.EventReactionChanged, err),
+			Code: http.StatusInternalServerError,
+		}
+	}
+	return nil
+}
+
+func NewBlogUserReactionUsecase(blogUserReactionRepo domain.BlogUserReactionRepository, events domain.IEventBus, timeout time.Duration) domain.BlogUserReactionUsecase {
 	return &blogUserReactionUsecase{
 		blogUserReactionRepo: blogUserReactionRepo,
+		events:               events,
 		ctxtimeout:           timeout,
 	}
 }
@@ -0,0 +1,226 @@
+package usecases
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"slices"
+	"strings"
+	"time"
+)
+
+type CategoryUsecase struct {
+	repo       domain.ICategoryRepository
+	postRepo   domain.BlogPostRepository
+	transactor domain.ITransactor
+	ctxtimeout time.Duration
+}
+
+// NewCategoryUsecase manages the category tree, moving a category updates its subcategories in a
+// transaction of transactor. Categories with posts in postRepo are not deleted.
+func NewCategoryUsecase(repo domain.ICategoryRepository, postRepo domain.BlogPostRepository, transactor domain.ITransactor, timeout time.Duration) domain.ICategoryUsecase {
+	return &CategoryUsecase{
+		repo:       repo,
+		postRepo:   postRepo,
+		transactor: transactor,
+		ctxtimeout: timeout,
+	}
+}
+
+func (uc *CategoryUsecase) Tree() ([]*domain.CategoryNode, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	categories, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make(map[string]*domain.CategoryNode, len(categories))
+	for _, category := range categories {
+		nodes[category.ID] = &domain.CategoryNode{Category: category, Children: []*domain.CategoryNode{}}
+	}
+	roots := []*domain.CategoryNode{}
+	// the list is by name, so are the children
+	for _, category := range categories {
+		node := nodes[category.ID]
+		if parent, ok := nodes[category.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+		roots = append(roots, node)
+	}
+	return roots, nil
+}
+
+func (uc *CategoryUsecase) Get(ref string) (*domain.Category, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.find(ctx, ref)
+}
+
+func (uc *CategoryUsecase) Create(category *domain.Category) (*domain.Category, error) {
+	slug := domain.TagSlug(category.Name)
+	if slug == "" {
+		return nil, domain.ErrInvalidCategoryName
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	now := time.Now()
+	created := &domain.Category{
+		Name:        tagName(category.Name),
+		Slug:        slug,
+		Description: strings.TrimSpace(category.Description),
+		Ancestors:   []string{},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if category.ParentID != "" {
+		parent, err := uc.find(ctx, category.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		created.ParentID = parent.ID
+		created.Ancestors = append(slices.Clone(parent.Ancestors), parent.ID)
+	}
+	if err := uc.repo.Create(ctx, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (uc *CategoryUsecase) Update(ref string, category *domain.Category) (*domain.Category, error) {
+	slug := domain.TagSlug(category.Name)
+	if slug == "" {
+		return nil, domain.ErrInvalidCategoryName
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	current, err := uc.find(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if slug != current.Slug {
+		taken, err := uc.repo.FindBySlug(ctx, slug)
+		if err == nil && taken.ID != current.ID {
+			return nil, domain.ErrCategoryConflict
+		}
+		if err != nil && err != domain.ErrCategoryNotFound {
+			return nil, err
+		}
+	}
+
+	parentID, ancestors := "", []string{}
+	if category.ParentID != "" {
+		parent, err := uc.find(ctx, category.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.ID == current.ID || slices.Contains(parent.Ancestors, current.ID) {
+			return nil, domain.ErrCategoryCycle
+		}
+		parentID, ancestors = parent.ID, append(slices.Clone(parent.Ancestors), parent.ID)
+	}
+	moved := parentID != current.ParentID
+
+	current.Name = tagName(category.Name)
+	current.Slug = slug
+	current.Description = strings.TrimSpace(category.Description)
+	current.ParentID = parentID
+	current.Ancestors = ancestors
+	current.UpdatedAt = time.Now()
+	if !moved {
+		if err := uc.repo.Update(ctx, current); err != nil {
+			return nil, err
+		}
+		return current, nil
+	}
+
+	// the subcategories keep their place under the category, above it their ancestors change
+	descendants, err := uc.repo.Descendants(ctx, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Update(ctx, current); err != nil {
+			return err
+		}
+		for _, descendant := range descendants {
+			below := descendant.Ancestors[slices.Index(descendant.Ancestors, current.ID)+1:]
+			descendant.Ancestors = append(append(slices.Clone(current.Ancestors), current.ID), below...)
+			descendant.UpdatedAt = current.UpdatedAt
+			if err := uc.repo.Update(ctx, descendant); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+func (uc *CategoryUsecase) Delete(ref string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	category, err := uc.find(ctx, ref)
+	if err != nil {
+		return err
+	}
+	descendants, err := uc.repo.Descendants(ctx, category.ID)
+	if err != nil {
+		return err
+	}
+	if len(descendants) > 0 {
+		return domain.ErrCategoryInUse
+	}
+	posts, derr := uc.postRepo.CountByCategory(ctx, category.ID)
+	if derr != nil {
+		return derr.Err
+	}
+	if posts > 0 {
+		return domain.ErrCategoryInUse
+	}
+	return uc.repo.Delete(ctx, category.ID)
+}
+
+func (uc *CategoryUsecase) Resolve(ctx context.Context, ref string, withDescendants bool) ([]string, error) {
+	category, err := uc.find(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{category.ID}
+	if !withDescendants {
+		return ids, nil
+	}
+	descendants, err := uc.repo.Descendants(ctx, category.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, descendant := range descendants {
+		ids = append(ids, descendant.ID)
+	}
+	return ids, nil
+}
+
+// find looks the category up by its ID, then by the slug of ref
+func (uc *CategoryUsecase) find(ctx context.Context, ref string) (*domain.Category, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return nil, domain.ErrCategoryNotFound
+	}
+	category, err := uc.repo.FindByID(ctx, ref)
+	if err != domain.ErrCategoryNotFound {
+		return category, err
+	}
+	slug := domain.TagSlug(ref)
+	if slug == "" {
+		return nil, domain.ErrCategoryNotFound
+	}
+	return uc.repo.FindBySlug(ctx, slug)
+}
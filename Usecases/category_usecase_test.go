@@ -0,0 +1,218 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CategoryUsecaseSuite struct {
+	suite.Suite
+	mockRepo       *domain_mocks.MockICategoryRepository
+	mockPostRepo   *domain_mocks.MockBlogPostRepository
+	mockTransactor *domain_mocks.MockITransactor
+	usecase        domain.ICategoryUsecase
+}
+
+func (s *CategoryUsecaseSuite) SetupTest() {
+	s.mockRepo = domain_mocks.NewMockICategoryRepository(s.T())
+	s.mockPostRepo = domain_mocks.NewMockBlogPostRepository(s.T())
+	s.mockTransactor = domain_mocks.NewMockITransactor(s.T())
+	s.usecase = NewCategoryUsecase(s.mockRepo, s.mockPostRepo, s.mockTransactor, 3*time.Second)
+}
+
+func TestCategoryUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(CategoryUsecaseSuite))
+}
+
+// the tree of the tests: engineering > backend > go, and design
+var (
+	engineering = &domain.Category{ID: "c1", Name: "Engineering", Slug: "engineering", Ancestors: []string{}}
+	backend     = &domain.Category{ID: "c2", Name: "Backend", Slug: "backend", ParentID: "c1", Ancestors: []string{"c1"}}
+	golang      = &domain.Category{ID: "c3", Name: "Go", Slug: "go", ParentID: "c2", Ancestors: []string{"c1", "c2"}}
+	design      = &domain.Category{ID: "c4", Name: "Design", Slug: "design", Ancestors: []string{}}
+)
+
+// category is a copy of c, the usecase changes what it is given
+func category(c *domain.Category) *domain.Category {
+	copied := *c
+	copied.Ancestors = append([]string{}, c.Ancestors...)
+	return &copied
+}
+
+func (s *CategoryUsecaseSuite) TestTree() {
+	s.mockRepo.On("List", mock.Anything).Return([]*domain.Category{category(backend), category(design), category(engineering), category(golang)}, nil)
+
+	tree, err := s.usecase.Tree()
+
+	s.NoError(err)
+	s.Len(tree, 2)
+	s.Equal("design", tree[0].Slug)
+	s.Equal("engineering", tree[1].Slug)
+	s.Equal("backend", tree[1].Children[0].Slug)
+	s.Equal("go", tree[1].Children[0].Children[0].Slug)
+	s.Empty(tree[0].Children)
+}
+
+func (s *CategoryUsecaseSuite) TestCreate() {
+	s.Run("UnderParentSlug", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "backend").Return(nil, domain.ErrCategoryNotFound)
+		s.mockRepo.On("FindBySlug", mock.Anything, "backend").Return(category(backend), nil)
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(c *domain.Category) bool {
+			return c.Slug == "rust" && c.ParentID == "c2"
+		})).Return(nil)
+
+		created, err := s.usecase.Create(&domain.Category{Name: " Rust ", ParentID: "backend"})
+
+		s.NoError(err)
+		s.Equal([]string{"c1", "c2"}, created.Ancestors)
+	})
+
+	s.Run("TopLevel", func() {
+		s.SetupTest()
+		s.mockRepo.On("Create", mock.Anything, mock.Anything).Return(domain.ErrCategoryConflict)
+
+		_, err := s.usecase.Create(&domain.Category{Name: "Design"})
+
+		s.Equal(domain.ErrCategoryConflict, err)
+	})
+
+	s.Run("InvalidName", func() {
+		s.SetupTest()
+
+		_, err := s.usecase.Create(&domain.Category{Name: "--"})
+
+		s.Equal(domain.ErrInvalidCategoryName, err)
+	})
+}
+
+func (s *CategoryUsecaseSuite) TestUpdate() {
+	s.Run("Rename", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "c3").Return(category(golang), nil)
+		s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(nil, domain.ErrCategoryNotFound)
+		s.mockRepo.On("FindByID", mock.Anything, "c2").Return(category(backend), nil)
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(c *domain.Category) bool {
+			return c.ID == "c3" && c.Slug == "golang"
+		})).Return(nil)
+
+		updated, err := s.usecase.Update("c3", &domain.Category{Name: "Golang", ParentID: "c2"})
+
+		s.NoError(err)
+		s.Equal([]string{"c1", "c2"}, updated.Ancestors)
+		s.mockTransactor.AssertNotCalled(s.T(), "WithTransaction", mock.Anything, mock.Anything)
+	})
+
+	s.Run("MoveTakesSubcategories", func() {
+		s.SetupTest()
+		s.mockTransactor.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+		s.mockRepo.On("FindByID", mock.Anything, "c2").Return(category(backend), nil)
+		s.mockRepo.On("FindByID", mock.Anything, "c4").Return(category(design), nil)
+		s.mockRepo.On("Descendants", mock.Anything, "c2").Return([]*domain.Category{category(golang)}, nil)
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(c *domain.Category) bool {
+			return c.ID == "c2" && c.ParentID == "c4"
+		})).Return(nil)
+		var moved []string
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(c *domain.Category) bool { return c.ID == "c3" })).
+			Run(func(args mock.Arguments) { moved = args.Get(1).(*domain.Category).Ancestors }).
+			Return(nil)
+
+		updated, err := s.usecase.Update("c2", &domain.Category{Name: "Backend", ParentID: "c4"})
+
+		s.NoError(err)
+		s.Equal([]string{"c4"}, updated.Ancestors)
+		s.Equal([]string{"c4", "c2"}, moved)
+	})
+
+	s.Run("UnderOwnSubcategory", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "c1").Return(category(engineering), nil)
+		s.mockRepo.On("FindByID", mock.Anything, "c3").Return(category(golang), nil)
+
+		_, err := s.usecase.Update("c1", &domain.Category{Name: "Engineering", ParentID: "c3"})
+
+		s.Equal(domain.ErrCategoryCycle, err)
+	})
+
+	s.Run("SlugTaken", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "c4").Return(category(design), nil)
+		s.mockRepo.On("FindBySlug", mock.Anything, "backend").Return(category(backend), nil)
+
+		_, err := s.usecase.Update("c4", &domain.Category{Name: "Backend"})
+
+		s.Equal(domain.ErrCategoryConflict, err)
+	})
+}
+
+func (s *CategoryUsecaseSuite) TestDelete() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "c4").Return(category(design), nil)
+		s.mockRepo.On("Descendants", mock.Anything, "c4").Return([]*domain.Category{}, nil)
+		s.mockPostRepo.On("CountByCategory", mock.Anything, "c4").Return(0, nil)
+		s.mockRepo.On("Delete", mock.Anything, "c4").Return(nil)
+
+		s.NoError(s.usecase.Delete("c4"))
+	})
+
+	s.Run("HasSubcategories", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "c2").Return(category(backend), nil)
+		s.mockRepo.On("Descendants", mock.Anything, "c2").Return([]*domain.Category{category(golang)}, nil)
+
+		s.Equal(domain.ErrCategoryInUse, s.usecase.Delete("c2"))
+	})
+
+	s.Run("HasPosts", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "c3").Return(category(golang), nil)
+		s.mockRepo.On("Descendants", mock.Anything, "c3").Return([]*domain.Category{}, nil)
+		s.mockPostRepo.On("CountByCategory", mock.Anything, "c3").Return(2, nil)
+
+		s.Equal(domain.ErrCategoryInUse, s.usecase.Delete("c3"))
+		s.mockRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *CategoryUsecaseSuite) TestResolve() {
+	s.Run("WithDescendants", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "Engineering").Return(nil, domain.ErrCategoryNotFound)
+		s.mockRepo.On("FindBySlug", mock.Anything, "engineering").Return(category(engineering), nil)
+		s.mockRepo.On("Descendants", mock.Anything, "c1").Return([]*domain.Category{category(backend), category(golang)}, nil)
+
+		ids, err := s.usecase.Resolve(context.Background(), "Engineering", true)
+
+		s.NoError(err)
+		s.Equal([]string{"c1", "c2", "c3"}, ids)
+	})
+
+	s.Run("Only", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "c1").Return(category(engineering), nil)
+
+		ids, err := s.usecase.Resolve(context.Background(), "c1", false)
+
+		s.NoError(err)
+		s.Equal([]string{"c1"}, ids)
+	})
+
+	s.Run("DBFailure", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "c1").Return(nil, errors.New("db error"))
+
+		_, err := s.usecase.Resolve(context.Background(), "c1", false)
+
+		s.EqualError(err, "db error")
+	})
+}
@@ -0,0 +1,334 @@
+package usecases
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/security"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// digestUnsubscribePurpose keeps unsubscribe tokens from being signed for anything else
+	digestUnsubscribePurpose = "digest-unsubscribe"
+	digestExcerptLength      = 200
+)
+
+// DigestSettings is how digests are sent. Secret signs the unsubscribe links, which open
+// UnsubscribeURL with the token, and posts link to PostURL followed by their ID. A digest lists
+// MaxPosts posts at most, newest first. A subscription whose worker does not report back within
+// Lease is sent again.
+type DigestSettings struct {
+	Secret         string
+	UnsubscribeURL string
+	PostURL        string
+	MaxPosts       int
+	Lease          time.Duration
+	BatchSize      int // subscriptions gone through per SendDue at most
+}
+
+var DefaultDigestSettings = DigestSettings{
+	MaxPosts:  20,
+	Lease:     10 * time.Minute,
+	BatchSize: 50,
+}
+
+type DigestUsecase struct {
+	repo         domain.IDigestRepository
+	postRepo     domain.BlogPostRepository
+	tags         domain.ITagUsecase
+	userRepo     domain.IUserRepository
+	emailService domain.IEmailService
+	events       domain.IEventBus
+	settings     DigestSettings
+	ctxtimeout   time.Duration
+}
+
+// NewDigestUsecase builds digests from postRepo and sends them through emailService, the followed
+// tags are looked up in tags. Newly followed authors are published to events. Unset settings take
+// their default.
+func NewDigestUsecase(repo domain.IDigestRepository, postRepo domain.BlogPostRepository, tags domain.ITagUsecase, userRepo domain.IUserRepository, emailService domain.IEmailService, events domain.IEventBus, settings DigestSettings, timeout time.Duration) domain.IDigestUsecase {
+	if settings.MaxPosts <= 0 {
+		settings.MaxPosts = DefaultDigestSettings.MaxPosts
+	}
+	if settings.Lease <= 0 {
+		settings.Lease = DefaultDigestSettings.Lease
+	}
+	if settings.BatchSize <= 0 {
+		settings.BatchSize = DefaultDigestSettings.BatchSize
+	}
+	return &DigestUsecase{
+		repo:         repo,
+		postRepo:     postRepo,
+		tags:         tags,
+		userRepo:     userRepo,
+		emailService: emailService,
+		events:       events,
+		settings:     settings,
+		ctxtimeout:   timeout,
+	}
+}
+
+func (uc *DigestUsecase) Subscription(userID string) (*domain.DigestSubscription, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	subscription, err := uc.repo.FindByUserID(ctx, userID)
+	if err == domain.ErrNotFound {
+		return &domain.DigestSubscription{UserID: userID, Frequency: domain.DigestOff, Authors: []string{}, Tags: []string{}}, nil
+	}
+	return subscription, err
+}
+
+func (uc *DigestUsecase) UpdateSubscription(subscription *domain.DigestSubscription) (*domain.DigestSubscription, error) {
+	if subscription.Frequency != domain.DigestOff && subscription.Frequency.Period() == 0 {
+		return nil, domain.ErrUnknownDigestFrequency
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	current, err := uc.repo.FindByUserID(ctx, subscription.UserID)
+	if err != nil && err != domain.ErrNotFound {
+		return nil, err
+	}
+
+	now := time.Now()
+	updated := &domain.DigestSubscription{
+		UserID:    subscription.UserID,
+		Frequency: subscription.Frequency,
+		Authors:   uniqueValues(subscription.Authors),
+		Tags:      uniqueValues(subscription.Tags),
+		UpdatedAt: now,
+	}
+	switch {
+	case updated.Frequency == domain.DigestOff:
+		if current != nil {
+			updated.LastSentAt = current.LastSentAt
+		}
+	case current == nil || current.Frequency == domain.DigestOff:
+		// the first digest does not dig up the posts from before the user subscribed
+		updated.LastSentAt = now
+		updated.NextSendAt = now.Add(updated.Frequency.Period())
+	default:
+		updated.LastSentAt = current.LastSentAt
+		updated.NextSendAt = current.LastSentAt.Add(updated.Frequency.Period())
+		if updated.NextSendAt.Before(now) {
+			updated.NextSendAt = now
+		}
+	}
+	// the authors followed from now on are notified by the author.followed subscribers
+	followed := updated.Authors
+	if current != nil {
+		followed = slices.DeleteFunc(slices.Clone(followed), func(author string) bool {
+			return slices.Contains(current.Authors, author)
+		})
+	}
+	err = uc.events.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Save(ctx, updated); err != nil {
+			return err
+		}
+		for _, author := range followed {
+			if err := uc.events.Publish(ctx, domain.EventAuthorFollowed, author, domain.FollowEventData{
+				FollowerID: updated.UserID,
+				AuthorID:   author,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (uc *DigestUsecase) UnsubscribeToken(userID string) string {
+	return security.SignValue(uc.settings.Secret, digestUnsubscribePurpose, userID)
+}
+
+func (uc *DigestUsecase) Unsubscribe(token string) error {
+	userID, ok := security.VerifySignedValue(uc.settings.Secret, digestUnsubscribePurpose, token)
+	if !ok {
+		return domain.ErrInvalidUnsubscribeToken
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	subscription, err := uc.repo.FindByUserID(ctx, userID)
+	if err == domain.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if subscription.Frequency == domain.DigestOff {
+		return nil
+	}
+	subscription.Frequency = domain.DigestOff
+	subscription.NextSendAt = time.Time{}
+	subscription.UpdatedAt = time.Now()
+	return uc.repo.Save(ctx, subscription)
+}
+
+func (uc *DigestUsecase) SendDue(ctx context.Context) (int, error) {
+	processed := 0
+	for processed < uc.settings.BatchSize {
+		now := time.Now()
+		subscription, err := uc.repo.ClaimNext(ctx, now, now.Add(uc.settings.Lease))
+		if err != nil {
+			return processed, err
+		}
+		if subscription == nil {
+			return processed, nil
+		}
+		processed++
+		if err := uc.send(ctx, subscription); err != nil {
+			return processed, err
+		}
+	}
+	return processed, nil
+}
+
+// send queues the digest of the posts created since the last one. Nothing is sent when there
+// are none or the user did not verify their email, the next digest is scheduled either way.
+// On failure the claim runs out and the digest is tried again.
+func (uc *DigestUsecase) send(ctx context.Context, subscription *domain.DigestSubscription) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.ctxtimeout)
+	defer cancel()
+
+	now := time.Now()
+	user, err := uc.userRepo.FindUserByID(ctx, subscription.UserID)
+	if err != nil {
+		return err
+	}
+	if !user.IsVerified {
+		return uc.repo.MarkSent(ctx, subscription.UserID, subscription.LastSentAt, now.Add(subscription.Frequency.Period()))
+	}
+
+	posts, err := uc.newPosts(ctx, subscription)
+	if err != nil {
+		return err
+	}
+	if len(posts) > 0 {
+		items := make([]map[string]any, 0, len(posts))
+		for _, post := range posts {
+			items = append(items, map[string]any{
+				"Title":      post.Title,
+				"AuthorName": post.AuthorName,
+				"Excerpt":    excerpt(post.Content, digestExcerptLength),
+				"URL":        strings.TrimSuffix(uc.settings.PostURL, "/") + "/" + url.PathEscape(post.ID),
+			})
+		}
+		unsubscribeURL := uc.settings.UnsubscribeURL + "?token=" + url.QueryEscape(uc.UnsubscribeToken(subscription.UserID))
+		err := uc.emailService.SendTemplate(ctx, user.Email, domain.EmailTemplateDigest, domain.EmailTemplateData{
+			"Locale":         user.Language,
+			"Name":           user.FirstName + " " + user.LastName,
+			"Frequency":      string(subscription.Frequency),
+			"Posts":          items,
+			"UnsubscribeURL": unsubscribeURL,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return uc.repo.MarkSent(ctx, subscription.UserID, now, now.Add(subscription.Frequency.Period()))
+}
+
+// newPosts are the posts of the followed authors and tags created since the last digest, other
+// than the user's own, newest first
+func (uc *DigestUsecase) newPosts(ctx context.Context, subscription *domain.DigestSubscription) ([]domain.BlogPost, error) {
+	var filters []*domain.BlogPostFilter
+	if len(subscription.Authors) > 0 {
+		filters = append(filters, &domain.BlogPostFilter{AuthorIDs: subscription.Authors})
+	}
+	if len(subscription.Tags) > 0 {
+		// a followed tag may have been renamed or merged since, or be a synonym
+		tags, err := uc.tags.Resolve(ctx, subscription.Tags)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, &domain.BlogPostFilter{Tags: tags})
+	}
+
+	seen := map[string]bool{}
+	var posts []domain.BlogPost
+	for _, filter := range filters {
+		filter.Page = 1
+		filter.PageSize = uc.settings.MaxPosts
+		filter.Recency = domain.RecencyNewest
+		filter.CreatedAfter = subscription.LastSentAt
+		pages, _, derr := uc.postRepo.Get(ctx, filter)
+		if derr != nil {
+			if derr.Code == http.StatusNotFound {
+				continue
+			}
+			return nil, derr.Err
+		}
+		for _, page := range pages {
+			for _, post := range page.Blogs {
+				if seen[post.ID] || post.AuthorID == subscription.UserID {
+					continue
+				}
+				seen[post.ID] = true
+				posts = append(posts, post)
+			}
+		}
+	}
+
+	slices.SortFunc(posts, func(a, b domain.BlogPost) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	if len(posts) > uc.settings.MaxPosts {
+		posts = posts[:uc.settings.MaxPosts]
+	}
+	return posts, nil
+}
+
+// uniqueValues trims the values and drops the empty and repeated ones
+func uniqueValues(values []string) []string {
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && !slices.Contains(unique, value) {
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// excerpt is the start of the content on one line, cut at a word
+func excerpt(content string, length int) string {
+	content = strings.Join(strings.Fields(content), " ")
+	runes := []rune(content)
+	if len(runes) <= length {
+		return content
+	}
+	cut := string(runes[:length])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+	return cut + "…"
+}
+
+// RunDigestScheduler sends the due digests every interval until ctx is done
+func RunDigestScheduler(ctx context.Context, digests domain.IDigestUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := digests.SendDue(ctx); err != nil {
+			log.Printf("digests: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
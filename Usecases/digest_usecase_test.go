@@ -0,0 +1,296 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"g6/blog-api/Infrastructure/security"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DigestUsecaseSuite struct {
+	suite.Suite
+	mockRepo   *domain_mocks.MockIDigestRepository
+	mockPosts  *domain_mocks.MockBlogPostRepository
+	mockTags   *domain_mocks.MockITagUsecase
+	mockUsers  *domain_mocks.MockIUserRepository
+	mockEmails *domain_mocks.MockIEmailService
+	mockEvents *domain_mocks.MockIEventBus
+	usecase    domain.IDigestUsecase
+}
+
+func (s *DigestUsecaseSuite) SetupTest() {
+	s.mockRepo = domain_mocks.NewMockIDigestRepository(s.T())
+	s.mockPosts = domain_mocks.NewMockBlogPostRepository(s.T())
+	s.mockTags = domain_mocks.NewMockITagUsecase(s.T())
+	s.mockUsers = domain_mocks.NewMockIUserRepository(s.T())
+	s.mockEmails = domain_mocks.NewMockIEmailService(s.T())
+	s.mockEvents = domain_mocks.NewMockIEventBus(s.T())
+	s.mockEvents.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Maybe()
+	s.usecase = NewDigestUsecase(s.mockRepo, s.mockPosts, s.mockTags, s.mockUsers, s.mockEmails, s.mockEvents, DigestSettings{
+		Secret:         "digest-secret",
+		UnsubscribeURL: "http://localhost:8080/api/digests/unsubscribe",
+		PostURL:        "http://localhost:3000/posts/",
+		MaxPosts:       2,
+		BatchSize:      5,
+	}, 3*time.Second)
+}
+
+func TestDigestUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(DigestUsecaseSuite))
+}
+
+func (s *DigestUsecaseSuite) TestSubscription() {
+	s.Run("NeverSet", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByUserID", mock.Anything, "u1").Return(nil, domain.ErrNotFound)
+
+		subscription, err := s.usecase.Subscription("u1")
+
+		s.NoError(err)
+		s.Equal(domain.DigestOff, subscription.Frequency)
+		s.Empty(subscription.Tags)
+	})
+
+	s.Run("DBFailure", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByUserID", mock.Anything, "u1").Return(nil, errors.New("db error"))
+
+		_, err := s.usecase.Subscription("u1")
+
+		s.Error(err)
+	})
+}
+
+func (s *DigestUsecaseSuite) TestUpdateSubscription() {
+	s.Run("TurnOn", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByUserID", mock.Anything, "u1").Return(nil, domain.ErrNotFound)
+		s.mockRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+		subscription, err := s.usecase.UpdateSubscription(&domain.DigestSubscription{
+			UserID:    "u1",
+			Frequency: domain.DigestDaily,
+			Tags:      []string{"go", " go ", "", "rust"},
+		})
+
+		s.NoError(err)
+		s.Equal([]string{"go", "rust"}, subscription.Tags)
+		s.WithinDuration(time.Now(), subscription.LastSentAt, time.Second)
+		s.WithinDuration(time.Now().Add(24*time.Hour), subscription.NextSendAt, time.Second)
+	})
+
+	s.Run("ChangeFrequencyKeepsLastDigest", func() {
+		s.SetupTest()
+		lastSent := time.Now().Add(-3 * 24 * time.Hour)
+		s.mockRepo.On("FindByUserID", mock.Anything, "u1").Return(&domain.DigestSubscription{UserID: "u1", Frequency: domain.DigestWeekly, LastSentAt: lastSent}, nil)
+		s.mockRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+		subscription, err := s.usecase.UpdateSubscription(&domain.DigestSubscription{UserID: "u1", Frequency: domain.DigestDaily})
+
+		s.NoError(err)
+		s.Equal(lastSent, subscription.LastSentAt)
+		s.WithinDuration(time.Now(), subscription.NextSendAt, time.Second)
+	})
+
+	s.Run("TurnOff", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByUserID", mock.Anything, "u1").Return(&domain.DigestSubscription{UserID: "u1", Frequency: domain.DigestWeekly, NextSendAt: time.Now()}, nil)
+		s.mockRepo.On("Save", mock.Anything, mock.MatchedBy(func(subscription *domain.DigestSubscription) bool {
+			return subscription.Frequency == domain.DigestOff && subscription.NextSendAt.IsZero()
+		})).Return(nil)
+
+		_, err := s.usecase.UpdateSubscription(&domain.DigestSubscription{UserID: "u1", Frequency: domain.DigestOff})
+
+		s.NoError(err)
+	})
+
+	s.Run("NewAuthorsFollowed", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByUserID", mock.Anything, "u1").Return(&domain.DigestSubscription{UserID: "u1", Frequency: domain.DigestDaily, Authors: []string{"a1"}}, nil)
+		s.mockRepo.On("Save", mock.Anything, mock.Anything).Return(nil)
+		s.mockEvents.On("Publish", mock.Anything, domain.EventAuthorFollowed, "a2", domain.FollowEventData{FollowerID: "u1", AuthorID: "a2"}).Return(nil).Once()
+
+		subscription, err := s.usecase.UpdateSubscription(&domain.DigestSubscription{UserID: "u1", Frequency: domain.DigestDaily, Authors: []string{"a1", "a2", "a2"}})
+
+		s.NoError(err)
+		s.Equal([]string{"a1", "a2"}, subscription.Authors)
+	})
+
+	s.Run("FollowNotPublishedWhenSaveFails", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByUserID", mock.Anything, "u1").Return(nil, domain.ErrNotFound)
+		s.mockRepo.On("Save", mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+		_, err := s.usecase.UpdateSubscription(&domain.DigestSubscription{UserID: "u1", Frequency: domain.DigestDaily, Authors: []string{"a1"}})
+
+		s.EqualError(err, "db error")
+		s.mockEvents.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("UnknownFrequency", func() {
+		s.SetupTest()
+
+		_, err := s.usecase.UpdateSubscription(&domain.DigestSubscription{UserID: "u1", Frequency: "hourly"})
+
+		s.Equal(domain.ErrUnknownDigestFrequency, err)
+	})
+}
+
+func (s *DigestUsecaseSuite) TestUnsubscribe() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByUserID", mock.Anything, "u1").Return(&domain.DigestSubscription{UserID: "u1", Frequency: domain.DigestDaily, NextSendAt: time.Now()}, nil)
+		s.mockRepo.On("Save", mock.Anything, mock.MatchedBy(func(subscription *domain.DigestSubscription) bool {
+			return subscription.UserID == "u1" && subscription.Frequency == domain.DigestOff
+		})).Return(nil)
+
+		err := s.usecase.Unsubscribe(s.usecase.UnsubscribeToken("u1"))
+
+		s.NoError(err)
+	})
+
+	s.Run("AlreadyOff", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByUserID", mock.Anything, "u1").Return(&domain.DigestSubscription{UserID: "u1", Frequency: domain.DigestOff}, nil)
+
+		err := s.usecase.Unsubscribe(s.usecase.UnsubscribeToken("u1"))
+
+		s.NoError(err)
+	})
+
+	s.Run("TamperedToken", func() {
+		s.SetupTest()
+		_, signature, _ := strings.Cut(s.usecase.UnsubscribeToken("u1"), ".")
+
+		err := s.usecase.Unsubscribe("u2." + signature)
+
+		s.Equal(domain.ErrInvalidUnsubscribeToken, err)
+	})
+
+	s.Run("OtherSecret", func() {
+		s.SetupTest()
+
+		err := s.usecase.Unsubscribe(security.SignValue("other-secret", digestUnsubscribePurpose, "u1"))
+
+		s.Equal(domain.ErrInvalidUnsubscribeToken, err)
+	})
+}
+
+func (s *DigestUsecaseSuite) TestSendDue() {
+	lastSent := time.Now().Add(-24 * time.Hour)
+	subscription := func() *domain.DigestSubscription {
+		return &domain.DigestSubscription{
+			UserID:     "u1",
+			Frequency:  domain.DigestDaily,
+			Authors:    []string{"a1"},
+			Tags:       []string{"golang"},
+			LastSentAt: lastSent,
+		}
+	}
+	user := &domain.User{ID: "u1", Email: "jane@example.com", FirstName: "Jane", LastName: "Doe", Language: "fr", IsVerified: true}
+	page := func(posts ...domain.BlogPost) []domain.BlogPostsPage {
+		return []domain.BlogPostsPage{{Blogs: posts, PageNumber: 1, PageSize: len(posts)}}
+	}
+	now := time.Now()
+
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(subscription(), nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockUsers.On("FindUserByID", mock.Anything, "u1").Return(user, nil)
+		s.mockTags.On("Resolve", mock.Anything, []string{"golang"}).Return([]string{"go"}, nil)
+		s.mockPosts.On("Get", mock.Anything, mock.MatchedBy(func(filter *domain.BlogPostFilter) bool {
+			return len(filter.AuthorIDs) == 1 && filter.CreatedAfter.Equal(lastSent) && filter.PageSize == 2
+		})).Return(page(
+			domain.BlogPost{ID: "p1", Title: "Older", AuthorID: "a1", AuthorName: "Ann", Content: "first", CreatedAt: now.Add(-2 * time.Hour)},
+			domain.BlogPost{ID: "p2", Title: "Shared", AuthorID: "a1", AuthorName: "Ann", Content: "second", CreatedAt: now.Add(-time.Hour)},
+		), nil, nil)
+		s.mockPosts.On("Get", mock.Anything, mock.MatchedBy(func(filter *domain.BlogPostFilter) bool {
+			return len(filter.Tags) == 1 && filter.Tags[0] == "go" && filter.CreatedAfter.Equal(lastSent)
+		})).Return(page(
+			domain.BlogPost{ID: "p2", Title: "Shared", AuthorID: "a1", AuthorName: "Ann", Content: "second", CreatedAt: now.Add(-time.Hour)},
+			domain.BlogPost{ID: "p3", Title: "Mine", AuthorID: "u1", Content: "own", CreatedAt: now},
+			domain.BlogPost{ID: "p4", Title: "Newest", AuthorID: "b2", AuthorName: "Bob", Content: "third", CreatedAt: now.Add(-time.Minute)},
+		), nil, nil)
+		s.mockEmails.On("SendTemplate", mock.Anything, "jane@example.com", domain.EmailTemplateDigest, mock.MatchedBy(func(data domain.EmailTemplateData) bool {
+			posts := data["Posts"].([]map[string]any)
+			unsubscribeURL := data["UnsubscribeURL"].(string)
+			return data["Locale"] == "fr" && data["Frequency"] == "daily" &&
+				len(posts) == 2 && posts[0]["Title"] == "Newest" && posts[1]["Title"] == "Shared" &&
+				posts[0]["URL"] == "http://localhost:3000/posts/p4" &&
+				strings.HasPrefix(unsubscribeURL, "http://localhost:8080/api/digests/unsubscribe?token=u1.")
+		})).Return(nil)
+		s.mockRepo.On("MarkSent", mock.Anything, "u1", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			sentAt, nextSendAt := args.Get(2).(time.Time), args.Get(3).(time.Time)
+			s.WithinDuration(time.Now(), sentAt, time.Second)
+			s.Equal(24*time.Hour, nextSendAt.Sub(sentAt))
+		})
+
+		processed, err := s.usecase.SendDue(context.Background())
+
+		s.NoError(err)
+		s.Equal(1, processed)
+	})
+
+	s.Run("NoNewPosts", func() {
+		s.SetupTest()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(subscription(), nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockUsers.On("FindUserByID", mock.Anything, "u1").Return(user, nil)
+		s.mockTags.On("Resolve", mock.Anything, []string{"golang"}).Return([]string{"go"}, nil)
+		s.mockPosts.On("Get", mock.Anything, mock.Anything).Return(nil, nil, &domain.DomainError{Err: errors.New("no blog posts found"), Code: http.StatusNotFound})
+		s.mockRepo.On("MarkSent", mock.Anything, "u1", mock.Anything, mock.Anything).Return(nil)
+
+		processed, err := s.usecase.SendDue(context.Background())
+
+		s.NoError(err)
+		s.Equal(1, processed)
+		s.mockEmails.AssertNotCalled(s.T(), "SendTemplate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("UnverifiedUser", func() {
+		s.SetupTest()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(subscription(), nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockUsers.On("FindUserByID", mock.Anything, "u1").Return(&domain.User{ID: "u1", Email: "jane@example.com"}, nil)
+		s.mockRepo.On("MarkSent", mock.Anything, "u1", lastSent, mock.Anything).Return(nil)
+
+		_, err := s.usecase.SendDue(context.Background())
+
+		s.NoError(err)
+		s.mockPosts.AssertNotCalled(s.T(), "Get", mock.Anything, mock.Anything)
+	})
+
+	s.Run("EmailFailure", func() {
+		s.SetupTest()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(subscription(), nil).Once()
+		s.mockUsers.On("FindUserByID", mock.Anything, "u1").Return(user, nil)
+		s.mockTags.On("Resolve", mock.Anything, []string{"golang"}).Return([]string{"go"}, nil)
+		s.mockPosts.On("Get", mock.Anything, mock.Anything).Return(page(domain.BlogPost{ID: "p1", AuthorID: "a1", CreatedAt: now}), nil, nil)
+		s.mockEmails.On("SendTemplate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("outbox down"))
+
+		_, err := s.usecase.SendDue(context.Background())
+
+		s.Error(err)
+		s.mockRepo.AssertNotCalled(s.T(), "MarkSent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestExcerpt(t *testing.T) {
+	if got := excerpt("short\n\n text", 20); got != "short text" {
+		t.Errorf("excerpt = %q", got)
+	}
+	if got := excerpt("a few words that run long", 12); got != "a few words…" {
+		t.Errorf("excerpt = %q", got)
+	}
+}
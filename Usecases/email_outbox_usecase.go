@@ -0,0 +1,232 @@
+package usecases
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"log"
+	"time"
+)
+
+const (
+	defaultOutboxPageSize = 20
+	maxOutboxPageSize     = 100
+)
+
+// EmailOutboxSettings is how the outbox retries. The n-th failed attempt waits RetryBase
+// times 2^(n-1), at most RetryMax, and after MaxAttempts attempts the email is dead.
+// An email whose worker does not report back within Lease is sent again.
+type EmailOutboxSettings struct {
+	MaxAttempts int
+	RetryBase   time.Duration
+	RetryMax    time.Duration
+	Lease       time.Duration
+	BatchSize   int // emails sent per ProcessDue at most
+}
+
+var DefaultEmailOutboxSettings = EmailOutboxSettings{
+	MaxAttempts: 8,
+	RetryBase:   30 * time.Second,
+	RetryMax:    time.Hour,
+	Lease:       2 * time.Minute,
+	BatchSize:   50,
+}
+
+type EmailOutboxUsecase struct {
+	repo       domain.IEmailOutboxRepository
+	transport  domain.IEmailService
+	settings   EmailOutboxSettings
+	wake       chan struct{}
+	ctxtimeout time.Duration
+}
+
+// NewEmailOutboxUsecase queues emails in repo and sends them through transport, unset settings
+// take their default
+func NewEmailOutboxUsecase(repo domain.IEmailOutboxRepository, transport domain.IEmailService, settings EmailOutboxSettings, timeout time.Duration) domain.IEmailOutboxUsecase {
+	if settings.MaxAttempts <= 0 {
+		settings.MaxAttempts = DefaultEmailOutboxSettings.MaxAttempts
+	}
+	if settings.RetryBase <= 0 {
+		settings.RetryBase = DefaultEmailOutboxSettings.RetryBase
+	}
+	if settings.RetryMax <= 0 {
+		settings.RetryMax = DefaultEmailOutboxSettings.RetryMax
+	}
+	if settings.Lease <= 0 {
+		settings.Lease = DefaultEmailOutboxSettings.Lease
+	}
+	if settings.BatchSize <= 0 {
+		settings.BatchSize = DefaultEmailOutboxSettings.BatchSize
+	}
+	return &EmailOutboxUsecase{
+		repo:       repo,
+		transport:  transport,
+		settings:   settings,
+		wake:       make(chan struct{}, 1),
+		ctxtimeout: timeout,
+	}
+}
+
+// SendEmail queues an HTML email, it is sent by the worker
+func (uc *EmailOutboxUsecase) SendEmail(ctx context.Context, to, subject, body string) error {
+	return uc.enqueue(ctx, &domain.OutboxEmail{To: to, Subject: subject, Body: body})
+}
+
+// SendTemplate queues a templated email, it is rendered when it is sent
+func (uc *EmailOutboxUsecase) SendTemplate(ctx context.Context, to, templateName string, data domain.EmailTemplateData) error {
+	return uc.enqueue(ctx, &domain.OutboxEmail{To: to, Template: templateName, Data: data})
+}
+
+func (uc *EmailOutboxUsecase) enqueue(ctx context.Context, email *domain.OutboxEmail) error {
+	now := time.Now()
+	email.Status = domain.OutboxEmailPending
+	email.NextAttemptAt = now
+	email.CreatedAt = now
+	email.UpdatedAt = now
+	if err := uc.repo.Enqueue(ctx, email); err != nil {
+		return err
+	}
+	// a worker that is waiting picks it up right away, one wake-up covers any number of emails
+	select {
+	case uc.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (uc *EmailOutboxUsecase) Wake() <-chan struct{} {
+	return uc.wake
+}
+
+func (uc *EmailOutboxUsecase) ProcessDue(ctx context.Context) (int, error) {
+	processed := 0
+	for processed < uc.settings.BatchSize {
+		now := time.Now()
+		email, err := uc.repo.ClaimNext(ctx, now, now.Add(uc.settings.Lease))
+		if err != nil {
+			return processed, err
+		}
+		if email == nil {
+			return processed, nil
+		}
+		processed++
+		if err := uc.send(ctx, email); err != nil {
+			return processed, err
+		}
+	}
+	return processed, nil
+}
+
+// send makes one attempt and records its outcome
+func (uc *EmailOutboxUsecase) send(ctx context.Context, email *domain.OutboxEmail) error {
+	sendCtx, cancel := context.WithTimeout(ctx, uc.ctxtimeout)
+	var err error
+	if email.Template != "" {
+		err = uc.transport.SendTemplate(sendCtx, email.To, email.Template, email.Data)
+	} else {
+		err = uc.transport.SendEmail(sendCtx, email.To, email.Subject, email.Body)
+	}
+	cancel()
+
+	now := time.Now()
+	email.Attempts++
+	email.UpdatedAt = now
+	switch {
+	case err == nil:
+		email.Status = domain.OutboxEmailSent
+		email.SentAt = now
+		email.LastError = ""
+		email.Body = ""
+		email.Data = nil
+	case email.Attempts >= uc.settings.MaxAttempts:
+		email.Status = domain.OutboxEmailDead
+		email.LastError = err.Error()
+	default:
+		email.Status = domain.OutboxEmailPending
+		email.LastError = err.Error()
+		email.NextAttemptAt = now.Add(uc.backoff(email.Attempts))
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, uc.ctxtimeout)
+	defer cancel()
+	return uc.repo.Update(updateCtx, email)
+}
+
+// backoff is the wait after the given number of failed attempts
+func (uc *EmailOutboxUsecase) backoff(attempts int) time.Duration {
+	return retryDelay(uc.settings.RetryBase, uc.settings.RetryMax, attempts)
+}
+
+// retryDelay doubles base with every failed attempt after the first, up to limit
+func retryDelay(base, limit time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < limit; i++ {
+		delay *= 2
+	}
+	return min(delay, limit)
+}
+
+func (uc *EmailOutboxUsecase) List(filter domain.OutboxEmailFilter) ([]*domain.OutboxEmail, int64, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = defaultOutboxPageSize
+	}
+	filter.PageSize = min(filter.PageSize, maxOutboxPageSize)
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.repo.Find(ctx, filter)
+}
+
+func (uc *EmailOutboxUsecase) FindByID(id string) (*domain.OutboxEmail, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.repo.FindByID(ctx, id)
+}
+
+func (uc *EmailOutboxUsecase) Retry(id string) (*domain.OutboxEmail, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	email, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if email.Status != domain.OutboxEmailDead {
+		return nil, domain.ErrOutboxEmailNotDead
+	}
+	now := time.Now()
+	email.Status = domain.OutboxEmailPending
+	email.Attempts = 0
+	email.NextAttemptAt = now
+	email.UpdatedAt = now
+	if err := uc.repo.Update(ctx, email); err != nil {
+		return nil, err
+	}
+	select {
+	case uc.wake <- struct{}{}:
+	default:
+	}
+	return email, nil
+}
+
+// RunEmailOutboxWorker sends due emails every interval, and as soon as one is queued, until
+// ctx is done. Several servers may run it at once, each email is claimed by one of them.
+func RunEmailOutboxWorker(ctx context.Context, outbox domain.IEmailOutboxUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := outbox.ProcessDue(ctx); err != nil {
+			log.Printf("email outbox: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-outbox.Wake():
+		}
+	}
+}
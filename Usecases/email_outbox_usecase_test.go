@@ -0,0 +1,188 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EmailOutboxUsecaseSuite struct {
+	suite.Suite
+	mockRepo      *domain_mocks.MockIEmailOutboxRepository
+	mockTransport *domain_mocks.MockIEmailService
+	usecase       domain.IEmailOutboxUsecase
+}
+
+func (s *EmailOutboxUsecaseSuite) SetupTest() {
+	s.mockRepo = domain_mocks.NewMockIEmailOutboxRepository(s.T())
+	s.mockTransport = domain_mocks.NewMockIEmailService(s.T())
+	s.usecase = NewEmailOutboxUsecase(s.mockRepo, s.mockTransport, EmailOutboxSettings{
+		MaxAttempts: 3,
+		RetryBase:   time.Minute,
+		RetryMax:    90 * time.Second,
+	}, 3*time.Second)
+}
+
+func TestEmailOutboxUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(EmailOutboxUsecaseSuite))
+}
+
+func (s *EmailOutboxUsecaseSuite) TestSendTemplate() {
+	data := domain.EmailTemplateData{"Code": "123456"}
+	s.mockRepo.On("Enqueue", mock.Anything, mock.MatchedBy(func(email *domain.OutboxEmail) bool {
+		return email.To == "a@example.com" &&
+			email.Template == domain.EmailTemplateOTP &&
+			email.Data["Code"] == "123456" &&
+			email.Status == domain.OutboxEmailPending &&
+			time.Since(email.NextAttemptAt) < time.Second
+	})).Return(nil)
+
+	err := s.usecase.SendTemplate(context.Background(), "a@example.com", domain.EmailTemplateOTP, data)
+
+	s.NoError(err)
+	s.mockTransport.AssertNotCalled(s.T(), "SendTemplate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	select {
+	case <-s.usecase.Wake():
+	default:
+		s.Fail("the worker was not woken up")
+	}
+}
+
+func (s *EmailOutboxUsecaseSuite) TestProcessDue() {
+	s.Run("SentClearsData", func() {
+		s.SetupTest()
+		email := &domain.OutboxEmail{ID: "e1", To: "a@example.com", Template: domain.EmailTemplateOTP, Data: domain.EmailTemplateData{"Code": "123456"}, Status: domain.OutboxEmailSending}
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(email, nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockTransport.On("SendTemplate", mock.Anything, "a@example.com", domain.EmailTemplateOTP, domain.EmailTemplateData{"Code": "123456"}).Return(nil)
+		s.mockRepo.On("Update", mock.Anything, email).Return(nil)
+
+		processed, err := s.usecase.ProcessDue(context.Background())
+
+		s.NoError(err)
+		s.Equal(1, processed)
+		s.Equal(domain.OutboxEmailSent, email.Status)
+		s.Equal(1, email.Attempts)
+		s.Nil(email.Data)
+		s.WithinDuration(time.Now(), email.SentAt, time.Second)
+	})
+
+	s.Run("HTMLEmail", func() {
+		s.SetupTest()
+		email := &domain.OutboxEmail{ID: "e1", To: "a@example.com", Subject: "Hello", Body: "<p>Hi</p>"}
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(email, nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockTransport.On("SendEmail", mock.Anything, "a@example.com", "Hello", "<p>Hi</p>").Return(nil)
+		s.mockRepo.On("Update", mock.Anything, email).Return(nil)
+
+		_, err := s.usecase.ProcessDue(context.Background())
+
+		s.NoError(err)
+		s.Equal(domain.OutboxEmailSent, email.Status)
+		s.Empty(email.Body)
+	})
+
+	s.Run("BacksOff", func() {
+		s.SetupTest()
+		email := &domain.OutboxEmail{ID: "e1", To: "a@example.com", Template: domain.EmailTemplateOTP, Attempts: 1}
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(email, nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockTransport.On("SendTemplate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+		s.mockRepo.On("Update", mock.Anything, email).Return(nil)
+
+		_, err := s.usecase.ProcessDue(context.Background())
+
+		s.NoError(err)
+		s.Equal(domain.OutboxEmailPending, email.Status)
+		s.Equal(2, email.Attempts)
+		s.Equal("connection refused", email.LastError)
+		// two minutes after the second failure, capped at ninety seconds
+		s.WithinDuration(time.Now().Add(90*time.Second), email.NextAttemptAt, time.Second)
+	})
+
+	s.Run("DeadAfterMaxAttempts", func() {
+		s.SetupTest()
+		email := &domain.OutboxEmail{ID: "e1", To: "a@example.com", Template: domain.EmailTemplateOTP, Data: domain.EmailTemplateData{"Code": "123456"}, Attempts: 2}
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(email, nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockTransport.On("SendTemplate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("mailbox unavailable"))
+		s.mockRepo.On("Update", mock.Anything, email).Return(nil)
+
+		_, err := s.usecase.ProcessDue(context.Background())
+
+		s.NoError(err)
+		s.Equal(domain.OutboxEmailDead, email.Status)
+		s.Equal(3, email.Attempts)
+		// kept so the email can be retried
+		s.Equal("123456", email.Data["Code"])
+	})
+
+	s.Run("ClaimFails", func() {
+		s.SetupTest()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+
+		processed, err := s.usecase.ProcessDue(context.Background())
+
+		s.Error(err)
+		s.Equal(0, processed)
+	})
+}
+
+func (s *EmailOutboxUsecaseSuite) TestList() {
+	s.Run("DefaultsPaging", func() {
+		s.SetupTest()
+		s.mockRepo.On("Find", mock.Anything, domain.OutboxEmailFilter{Status: domain.OutboxEmailDead, Page: 1, PageSize: 20}).Return([]*domain.OutboxEmail{}, int64(0), nil)
+
+		_, _, err := s.usecase.List(domain.OutboxEmailFilter{Status: domain.OutboxEmailDead})
+		s.NoError(err)
+	})
+
+	s.Run("CapsPageSize", func() {
+		s.SetupTest()
+		s.mockRepo.On("Find", mock.Anything, domain.OutboxEmailFilter{Page: 2, PageSize: 100}).Return([]*domain.OutboxEmail{}, int64(0), nil)
+
+		_, _, err := s.usecase.List(domain.OutboxEmailFilter{Page: 2, PageSize: 500})
+		s.NoError(err)
+	})
+}
+
+func (s *EmailOutboxUsecaseSuite) TestRetry() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		email := &domain.OutboxEmail{ID: "e1", Status: domain.OutboxEmailDead, Attempts: 3, LastError: "mailbox unavailable"}
+		s.mockRepo.On("FindByID", mock.Anything, "e1").Return(email, nil)
+		s.mockRepo.On("Update", mock.Anything, email).Return(nil)
+
+		result, err := s.usecase.Retry("e1")
+
+		s.NoError(err)
+		s.Equal(domain.OutboxEmailPending, result.Status)
+		s.Equal(0, result.Attempts)
+		s.WithinDuration(time.Now(), result.NextAttemptAt, time.Second)
+	})
+
+	s.Run("NotDead", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "e1").Return(&domain.OutboxEmail{ID: "e1", Status: domain.OutboxEmailSent}, nil)
+
+		_, err := s.usecase.Retry("e1")
+
+		s.ErrorIs(err, domain.ErrOutboxEmailNotDead)
+		s.mockRepo.AssertNotCalled(s.T(), "Update", mock.Anything, mock.Anything)
+	})
+
+	s.Run("NotFound", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindByID", mock.Anything, "e1").Return(nil, domain.ErrOutboxEmailNotFound)
+
+		_, err := s.usecase.Retry("e1")
+
+		s.ErrorIs(err, domain.ErrOutboxEmailNotFound)
+	})
+}
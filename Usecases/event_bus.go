@@ -0,0 +1,250 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	domain "g6/blog-api/Domain"
+	"log"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// EventBusSettings is how the dispatcher retries. An event whose subscribers failed is tried
+// again after RetryBase times 2^(n-1), at most RetryMax, and after MaxAttempts attempts it is
+// dead. An event whose dispatcher does not report back within Lease is dispatched again.
+type EventBusSettings struct {
+	MaxAttempts int
+	RetryBase   time.Duration
+	RetryMax    time.Duration
+	Lease       time.Duration
+	BatchSize   int // events dispatched per ProcessDue at most
+}
+
+var DefaultEventBusSettings = EventBusSettings{
+	MaxAttempts: 10,
+	RetryBase:   5 * time.Second,
+	RetryMax:    10 * time.Minute,
+	Lease:       time.Minute,
+	BatchSize:   100,
+}
+
+type eventSubscriber struct {
+	name    string
+	handler domain.DomainEventHandler
+}
+
+type EventBus struct {
+	repo        domain.IEventOutboxRepository
+	transactor  domain.ITransactor
+	settings    EventBusSettings
+	mu          sync.RWMutex
+	subscribers map[domain.DomainEventType][]eventSubscriber
+	wake        chan struct{}
+	ctxtimeout  time.Duration
+}
+
+// NewEventBus stores events in repo within the transactions of transactor, unset settings take
+// their default
+func NewEventBus(repo domain.IEventOutboxRepository, transactor domain.ITransactor, settings EventBusSettings, timeout time.Duration) domain.IEventBus {
+	if settings.MaxAttempts <= 0 {
+		settings.MaxAttempts = DefaultEventBusSettings.MaxAttempts
+	}
+	if settings.RetryBase <= 0 {
+		settings.RetryBase = DefaultEventBusSettings.RetryBase
+	}
+	if settings.RetryMax <= 0 {
+		settings.RetryMax = DefaultEventBusSettings.RetryMax
+	}
+	if settings.Lease <= 0 {
+		settings.Lease = DefaultEventBusSettings.Lease
+	}
+	if settings.BatchSize <= 0 {
+		settings.BatchSize = DefaultEventBusSettings.BatchSize
+	}
+	return &EventBus{
+		repo:        repo,
+		transactor:  transactor,
+		settings:    settings,
+		subscribers: map[domain.DomainEventType][]eventSubscriber{},
+		wake:        make(chan struct{}, 1),
+		ctxtimeout:  timeout,
+	}
+}
+
+// Subscribe replaces the handler of a name that is already subscribed to the type
+func (bus *EventBus) Subscribe(eventType domain.DomainEventType, name string, handler domain.DomainEventHandler) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	subscribers := bus.subscribers[eventType]
+	for i := range subscribers {
+		if subscribers[i].name == name {
+			subscribers[i].handler = handler
+			return
+		}
+	}
+	bus.subscribers[eventType] = append(subscribers, eventSubscriber{name: name, handler: handler})
+}
+
+func (bus *EventBus) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := bus.transactor.WithTransaction(ctx, fn); err != nil {
+		return err
+	}
+	bus.signal()
+	return nil
+}
+
+func (bus *EventBus) Publish(ctx context.Context, eventType domain.DomainEventType, aggregateID string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	event := &domain.DomainEvent{
+		Type:          eventType,
+		AggregateID:   aggregateID,
+		Payload:       string(payload),
+		Status:        domain.DomainEventPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := bus.repo.Append(ctx, event); err != nil {
+		return err
+	}
+	// inside a transaction the dispatcher only sees the event once Transaction commits and
+	// signals again, the poll picks up whatever a wake-up misses
+	bus.signal()
+	return nil
+}
+
+// signal wakes a waiting dispatcher, one wake-up covers any number of events
+func (bus *EventBus) signal() {
+	select {
+	case bus.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (bus *EventBus) Wake() <-chan struct{} {
+	return bus.wake
+}
+
+func (bus *EventBus) ProcessDue(ctx context.Context) (int, error) {
+	processed := 0
+	for processed < bus.settings.BatchSize {
+		now := time.Now()
+		event, err := bus.repo.ClaimNext(ctx, now, now.Add(bus.settings.Lease))
+		if err != nil {
+			return processed, err
+		}
+		if event == nil {
+			return processed, nil
+		}
+		processed++
+		if err := bus.dispatch(ctx, event); err != nil {
+			return processed, err
+		}
+	}
+	return processed, nil
+}
+
+// dispatch runs the subscribers that have not handled the event yet and records the outcome
+func (bus *EventBus) dispatch(ctx context.Context, event *domain.DomainEvent) error {
+	bus.mu.RLock()
+	subscribers := slices.Clone(bus.subscribers[event.Type])
+	bus.mu.RUnlock()
+
+	var errs []error
+	for _, subscriber := range subscribers {
+		if slices.Contains(event.Delivered, subscriber.name) {
+			continue
+		}
+		if err := bus.handle(ctx, subscriber, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", subscriber.name, err))
+			continue
+		}
+		event.Delivered = append(event.Delivered, subscriber.name)
+	}
+
+	now := time.Now()
+	event.Attempts++
+	event.UpdatedAt = now
+	switch {
+	case len(errs) == 0:
+		event.Status = domain.DomainEventDone
+		event.LastError = ""
+	case event.Attempts >= bus.settings.MaxAttempts:
+		event.Status = domain.DomainEventDead
+		event.LastError = errors.Join(errs...).Error()
+		log.Printf("events: %s %s is dead: %s", event.Type, event.ID, event.LastError)
+	default:
+		event.Status = domain.DomainEventPending
+		event.LastError = errors.Join(errs...).Error()
+		event.NextAttemptAt = now.Add(retryDelay(bus.settings.RetryBase, bus.settings.RetryMax, event.Attempts))
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, bus.ctxtimeout)
+	defer cancel()
+	return bus.repo.Update(updateCtx, event)
+}
+
+// handle runs one subscriber, a panic counts as a failure instead of stopping the dispatcher
+func (bus *EventBus) handle(ctx context.Context, subscriber eventSubscriber, event *domain.DomainEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	handlerCtx, cancel := context.WithTimeout(ctx, bus.ctxtimeout)
+	defer cancel()
+	return subscriber.handler(handlerCtx, event)
+}
+
+// inTransaction runs fn in a transaction of events. A DomainError from fn rolls back and is
+// returned as is, any other failure is a 500.
+func inTransaction(ctx context.Context, events domain.IEventBus, fn func(ctx context.Context) *domain.DomainError) *domain.DomainError {
+	var derr *domain.DomainError
+	err := events.Transaction(ctx, func(ctx context.Context) error {
+		derr = fn(ctx)
+		if derr != nil {
+			return errors.Join(derr.Err, errRollback)
+		}
+		return nil
+	})
+	if derr != nil {
+		return derr
+	}
+	if err != nil {
+		return &domain.DomainError{
+			Err:  err,
+			Code: http.StatusInternalServerError,
+		}
+	}
+	return nil
+}
+
+// errRollback makes sure a DomainError without Err still rolls the transaction back
+var errRollback = errors.New("rolled back")
+
+// RunEventDispatcher dispatches due events until ctx is done. It runs when events are committed
+// and every interval for retries.
+func RunEventDispatcher(ctx context.Context, bus domain.IEventBus, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := bus.ProcessDue(ctx); err != nil {
+			log.Printf("events: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-bus.Wake():
+		}
+	}
+}
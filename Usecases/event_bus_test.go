@@ -0,0 +1,206 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventBusSuite struct {
+	suite.Suite
+	mockRepo       *domain_mocks.MockIEventOutboxRepository
+	mockTransactor *domain_mocks.MockITransactor
+	bus            domain.IEventBus
+}
+
+func (s *EventBusSuite) SetupTest() {
+	s.mockRepo = domain_mocks.NewMockIEventOutboxRepository(s.T())
+	s.mockTransactor = domain_mocks.NewMockITransactor(s.T())
+	s.bus = NewEventBus(s.mockRepo, s.mockTransactor, EventBusSettings{
+		MaxAttempts: 3,
+		RetryBase:   time.Minute,
+		RetryMax:    90 * time.Second,
+	}, 3*time.Second)
+}
+
+func TestEventBusSuite(t *testing.T) {
+	suite.Run(t, new(EventBusSuite))
+}
+
+func (s *EventBusSuite) TestPublish() {
+	s.mockRepo.On("Append", mock.Anything, mock.MatchedBy(func(event *domain.DomainEvent) bool {
+		return event.Type == domain.EventPostCreated &&
+			event.AggregateID == "p1" &&
+			event.Payload == `{"id":"p1"}` &&
+			event.Status == domain.DomainEventPending &&
+			time.Since(event.NextAttemptAt) < time.Second
+	})).Return(nil)
+
+	err := s.bus.Publish(context.Background(), domain.EventPostCreated, "p1", map[string]string{"id": "p1"})
+
+	s.NoError(err)
+}
+
+func (s *EventBusSuite) TestTransaction() {
+	s.Run("CommitWakesDispatcher", func() {
+		s.SetupTest()
+		s.mockTransactor.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+
+		err := s.bus.Transaction(context.Background(), func(ctx context.Context) error { return nil })
+
+		s.NoError(err)
+		select {
+		case <-s.bus.Wake():
+		default:
+			s.Fail("the dispatcher was not woken up")
+		}
+	})
+
+	s.Run("Rollback", func() {
+		s.SetupTest()
+		s.mockTransactor.On("WithTransaction", mock.Anything, mock.Anything).Return(errors.New("write conflict"))
+
+		err := s.bus.Transaction(context.Background(), func(ctx context.Context) error { return nil })
+
+		s.EqualError(err, "write conflict")
+		select {
+		case <-s.bus.Wake():
+			s.Fail("the dispatcher was woken up for nothing")
+		default:
+		}
+	})
+
+	s.Run("DomainErrorIsKept", func() {
+		s.SetupTest()
+		s.mockTransactor.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+		notFound := &domain.DomainError{Err: errors.New("not found"), Code: http.StatusNotFound}
+
+		derr := inTransaction(context.Background(), s.bus, func(ctx context.Context) *domain.DomainError { return notFound })
+
+		s.Same(notFound, derr)
+	})
+}
+
+func (s *EventBusSuite) TestProcessDue() {
+	s.Run("RunsSubscribersInOrder", func() {
+		s.SetupTest()
+		var calls []string
+		s.bus.Subscribe(domain.EventCommentCreated, "counters", func(ctx context.Context, event *domain.DomainEvent) error {
+			var data domain.CommentEventData
+			s.NoError(event.Decode(&data))
+			calls = append(calls, "counters:"+data.BlogID)
+			return nil
+		})
+		s.bus.Subscribe(domain.EventCommentCreated, "streams", func(ctx context.Context, event *domain.DomainEvent) error {
+			calls = append(calls, "streams")
+			return nil
+		})
+		s.bus.Subscribe(domain.EventPostCreated, "webhooks", func(ctx context.Context, event *domain.DomainEvent) error {
+			calls = append(calls, "other type")
+			return nil
+		})
+		event := &domain.DomainEvent{ID: "e1", Type: domain.EventCommentCreated, Payload: `{"blog_id":"p1"}`, Status: domain.DomainEventDispatching}
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(event, nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockRepo.On("Update", mock.Anything, event).Return(nil)
+
+		processed, err := s.bus.ProcessDue(context.Background())
+
+		s.NoError(err)
+		s.Equal(1, processed)
+		s.Equal([]string{"counters:p1", "streams"}, calls)
+		s.Equal(domain.DomainEventDone, event.Status)
+		s.Equal([]string{"counters", "streams"}, event.Delivered)
+		s.Equal(1, event.Attempts)
+	})
+
+	s.Run("RetriesOnlyFailedSubscribers", func() {
+		s.SetupTest()
+		var calls []string
+		s.bus.Subscribe(domain.EventPostUpdated, "cache", func(ctx context.Context, event *domain.DomainEvent) error {
+			calls = append(calls, "cache")
+			return nil
+		})
+		s.bus.Subscribe(domain.EventPostUpdated, "webhooks", func(ctx context.Context, event *domain.DomainEvent) error {
+			calls = append(calls, "webhooks")
+			return errors.New("db error")
+		})
+		s.bus.Subscribe(domain.EventPostUpdated, "broken", func(ctx context.Context, event *domain.DomainEvent) error {
+			panic("nil map")
+		})
+		// the cache was dropped by an earlier attempt
+		event := &domain.DomainEvent{ID: "e1", Type: domain.EventPostUpdated, Payload: `{}`, Delivered: []string{"cache"}, Attempts: 1}
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(event, nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockRepo.On("Update", mock.Anything, event).Return(nil)
+
+		_, err := s.bus.ProcessDue(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"webhooks"}, calls)
+		s.Equal(domain.DomainEventPending, event.Status)
+		s.Equal([]string{"cache"}, event.Delivered)
+		s.Equal(2, event.Attempts)
+		s.Equal("webhooks: db error\nbroken: panic: nil map", event.LastError)
+		// two minutes after the second failure, capped at ninety seconds
+		s.WithinDuration(time.Now().Add(90*time.Second), event.NextAttemptAt, time.Second)
+	})
+
+	s.Run("DeadAfterMaxAttempts", func() {
+		s.SetupTest()
+		s.bus.Subscribe(domain.EventUserUpdated, "posts", func(ctx context.Context, event *domain.DomainEvent) error {
+			return errors.New("db error")
+		})
+		event := &domain.DomainEvent{ID: "e1", Type: domain.EventUserUpdated, Payload: `{}`, Attempts: 2}
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(event, nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockRepo.On("Update", mock.Anything, event).Return(nil)
+
+		_, err := s.bus.ProcessDue(context.Background())
+
+		s.NoError(err)
+		s.Equal(domain.DomainEventDead, event.Status)
+		s.Equal(3, event.Attempts)
+	})
+
+	s.Run("ResubscribeReplacesHandler", func() {
+		s.SetupTest()
+		var calls []string
+		s.bus.Subscribe(domain.EventPostDeleted, "cache", func(ctx context.Context, event *domain.DomainEvent) error {
+			calls = append(calls, "old")
+			return nil
+		})
+		s.bus.Subscribe(domain.EventPostDeleted, "cache", func(ctx context.Context, event *domain.DomainEvent) error {
+			calls = append(calls, "new")
+			return nil
+		})
+		event := &domain.DomainEvent{ID: "e1", Type: domain.EventPostDeleted, Payload: `{}`}
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(event, nil).Once()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockRepo.On("Update", mock.Anything, event).Return(nil)
+
+		_, err := s.bus.ProcessDue(context.Background())
+
+		s.NoError(err)
+		s.Equal([]string{"new"}, calls)
+	})
+
+	s.Run("ClaimFailure", func() {
+		s.SetupTest()
+		s.mockRepo.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+
+		processed, err := s.bus.ProcessDue(context.Background())
+
+		s.Error(err)
+		s.Equal(0, processed)
+	})
+}
@@ -0,0 +1,443 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/redis"
+	"g6/blog-api/Infrastructure/security"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LoginAttemptLimits are the brute-force thresholds, zero values fall back to DefaultLoginAttemptLimits
+type LoginAttemptLimits struct {
+	MaxFailures   int64         // failures per identifier before it is locked
+	IPMaxFailures int64         // failures from one IP before the IP is blocked
+	Window        time.Duration // failures older than this are forgotten
+	Lockout       time.Duration // how long a locked identifier or blocked IP waits
+	DelayAfter    int64         // failures per identifier before delays start
+	BaseDelay     time.Duration // first delay, doubled with every further failure
+	MaxDelay      time.Duration
+	// wrong two-factor codes per user, after the password step, before the user is locked
+	SecondFactorMaxFailures int64
+}
+
+var DefaultLoginAttemptLimits = LoginAttemptLimits{
+	MaxFailures:   10,
+	IPMaxFailures: 100,
+	Window:        15 * time.Minute,
+	Lockout:       30 * time.Minute,
+	DelayAfter:    3,
+	BaseDelay:     time.Second,
+	MaxDelay:      time.Minute,
+
+	SecondFactorMaxFailures: 5,
+}
+
+type LoginAttemptUsecase struct {
+	redisClient  redis.RedisClient
+	userRepo     domain.IUserRepository
+	eventRepo    domain.ISecurityEventRepository
+	emailService domain.IEmailService
+	limits       LoginAttemptLimits
+	unlockURL    string
+	ctxtimeout   time.Duration
+}
+
+func NewLoginAttemptUsecase(redisClient redis.RedisClient, userRepo domain.IUserRepository, eventRepo domain.ISecurityEventRepository, emailService domain.IEmailService, limits LoginAttemptLimits, unlockURL string, timeout time.Duration) domain.ILoginAttemptUsecase {
+	defaults := DefaultLoginAttemptLimits
+	if limits.MaxFailures <= 0 {
+		limits.MaxFailures = defaults.MaxFailures
+	}
+	if limits.IPMaxFailures <= 0 {
+		limits.IPMaxFailures = defaults.IPMaxFailures
+	}
+	if limits.Window <= 0 {
+		limits.Window = defaults.Window
+	}
+	if limits.Lockout <= 0 {
+		limits.Lockout = defaults.Lockout
+	}
+	if limits.DelayAfter <= 0 {
+		limits.DelayAfter = defaults.DelayAfter
+	}
+	if limits.BaseDelay <= 0 {
+		limits.BaseDelay = defaults.BaseDelay
+	}
+	if limits.MaxDelay < limits.BaseDelay {
+		limits.MaxDelay = max(defaults.MaxDelay, limits.BaseDelay)
+	}
+	if limits.SecondFactorMaxFailures <= 0 {
+		limits.SecondFactorMaxFailures = defaults.SecondFactorMaxFailures
+	}
+	return &LoginAttemptUsecase{
+		redisClient:  redisClient,
+		userRepo:     userRepo,
+		eventRepo:    eventRepo,
+		emailService: emailService,
+		limits:       limits,
+		unlockURL:    unlockURL,
+		ctxtimeout:   timeout,
+	}
+}
+
+// identifiers are counted case-insensitively, Jane and jane are the same login
+func normalizeIdentifier(identifier string) string {
+	return strings.ToLower(strings.TrimSpace(identifier))
+}
+
+func (uc *LoginAttemptUsecase) Check(identifier, ip string) (time.Duration, error) {
+	identifier = normalizeIdentifier(identifier)
+	keys := uc.redisClient.Service()
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	wait, err := uc.redisClient.TTL(ctx, keys.GenerateLoginLockKey(identifier))
+	if err != nil {
+		return 0, err
+	}
+	if wait > 0 {
+		return wait, domain.ErrAccountLocked
+	}
+	for _, key := range []string{keys.GenerateLoginBlockedIPKey(ip), keys.GenerateLoginDelayKey(identifier)} {
+		wait, err := uc.redisClient.TTL(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+		if wait > 0 {
+			return wait, domain.ErrLoginThrottled
+		}
+	}
+	return 0, nil
+}
+
+func (uc *LoginAttemptUsecase) RecordFailure(identifier, ip string) error {
+	identifier = normalizeIdentifier(identifier)
+	keys := uc.redisClient.Service()
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	ipFailures, err := uc.countFailure(ctx, keys.GenerateLoginFailuresKey("ip", ip))
+	if err != nil {
+		return err
+	}
+	if ipFailures >= uc.limits.IPMaxFailures {
+		// one address trying many identifiers, nobody is emailed about it
+		if err := uc.redisClient.Set(ctx, keys.GenerateLoginBlockedIPKey(ip), ipFailures, uc.limits.Lockout); err != nil {
+			return err
+		}
+	}
+
+	failures, err := uc.countFailure(ctx, keys.GenerateLoginFailuresKey("id", identifier))
+	if err != nil {
+		return err
+	}
+	if failures >= uc.limits.MaxFailures {
+		return uc.lock(ctx, identifier, ip, failures)
+	}
+	if failures >= uc.limits.DelayAfter {
+		return uc.redisClient.Set(ctx, keys.GenerateLoginDelayKey(identifier), failures, uc.delay(failures))
+	}
+	return nil
+}
+
+// countFailure increments a failure counter, the window starts with the first failure
+func (uc *LoginAttemptUsecase) countFailure(ctx context.Context, key string) (int64, error) {
+	count, err := uc.redisClient.Increment(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 {
+		if err := uc.redisClient.Expire(ctx, key, uc.limits.Window); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+// delay doubles from BaseDelay with every failure past DelayAfter, up to MaxDelay
+func (uc *LoginAttemptUsecase) delay(failures int64) time.Duration {
+	delay := uc.limits.BaseDelay
+	for i := uc.limits.DelayAfter; i < failures && delay < uc.limits.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, uc.limits.MaxDelay)
+}
+
+func (uc *LoginAttemptUsecase) lock(ctx context.Context, identifier, ip string, failures int64) error {
+	user, err := uc.userRepo.FindByUsernameOrEmail(ctx, identifier)
+	known := err == nil && user.ID != "" && (strings.EqualFold(user.Email, identifier) || strings.EqualFold(user.Username, identifier))
+	if !known {
+		return uc.lockIdentifiers(ctx, nil, []string{identifier}, ip, failures, "")
+	}
+	return uc.lockIdentifiers(ctx, &user, []string{identifier}, ip, failures, fmt.Sprintf("failed logins as %q", identifier))
+}
+
+// lockIdentifiers locks each identifier for the lockout time. Locks of a known user are recorded
+// and the user gets one unlock link for all of them, reason tells what the failures were.
+func (uc *LoginAttemptUsecase) lockIdentifiers(ctx context.Context, user *domain.User, identifiers []string, ip string, failures int64, reason string) error {
+	keys := uc.redisClient.Service()
+	now := time.Now()
+	lock := &domain.AccountLock{
+		Failures:    failures,
+		IP:          ip,
+		LockedAt:    now,
+		LockedUntil: now.Add(uc.limits.Lockout),
+	}
+	if user != nil {
+		lock.UserID = user.ID
+	}
+	for _, identifier := range identifiers {
+		lock.Identifier = identifier
+		value, err := json.Marshal(lock)
+		if err != nil {
+			return err
+		}
+		if err := uc.redisClient.Set(ctx, keys.GenerateLoginLockKey(identifier), value, uc.limits.Lockout); err != nil {
+			return err
+		}
+		if err := uc.redisClient.AddToSet(ctx, keys.GenerateLoginLocksKey(), identifier); err != nil {
+			return err
+		}
+		// the lock replaces the counter, once it is lifted the identifier starts over
+		if err := uc.redisClient.Delete(ctx, keys.GenerateLoginFailuresKey("id", identifier)); err != nil {
+			return err
+		}
+	}
+	if user == nil {
+		return nil
+	}
+
+	// recording and notifying must not keep the lock from being set
+	_ = uc.eventRepo.Record(ctx, &domain.SecurityEvent{
+		Type:      domain.SecurityEventAccountLocked,
+		UserID:    user.ID,
+		Outcome:   domain.SecurityEventFailure,
+		IP:        ip,
+		Details:   fmt.Sprintf("locked for %s after %d %s", uc.limits.Lockout, failures, reason),
+		CreatedAt: now,
+	})
+	_ = uc.sendUnlockEmail(ctx, identifiers, user, lock)
+	return nil
+}
+
+// sendUnlockEmail emails a link that lifts the locks of all identifiers at once
+func (uc *LoginAttemptUsecase) sendUnlockEmail(ctx context.Context, identifiers []string, user *domain.User, lock *domain.AccountLock) error {
+	token, err := security.GenerateOpaqueToken("")
+	if err != nil {
+		return err
+	}
+	tokenHash, _ := security.HashToken(token)
+	if err := uc.redisClient.Set(ctx, uc.redisClient.Service().GenerateLoginUnlockKey(tokenHash), strings.Join(identifiers, "\n"), uc.limits.Lockout); err != nil {
+		return err
+	}
+
+	unlockURL := uc.unlockURL + "?token=" + url.QueryEscape(token)
+	return uc.emailService.SendTemplate(ctx, user.Email, domain.EmailTemplateAccountLocked, domain.EmailTemplateData{
+		"Locale":      user.Language,
+		"Name":        user.FirstName + " " + user.LastName,
+		"Failures":    lock.Failures,
+		"IP":          lock.IP,
+		"LockedUntil": lock.LockedUntil.UTC().Format(time.RFC1123),
+		"URL":         unlockURL,
+	})
+}
+
+func (uc *LoginAttemptUsecase) RecordSuccess(identifier string) error {
+	identifier = normalizeIdentifier(identifier)
+	keys := uc.redisClient.Service()
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	if err := uc.redisClient.Delete(ctx, keys.GenerateLoginFailuresKey("id", identifier)); err != nil {
+		return err
+	}
+	return uc.redisClient.Delete(ctx, keys.GenerateLoginDelayKey(identifier))
+}
+
+// CheckUser is called before a second factor of the user is verified. While any login identifier
+// of the user is locked it fails with ErrAccountLocked, so pre-auth tokens issued before the lock are refused.
+func (uc *LoginAttemptUsecase) CheckUser(userID string) (time.Duration, error) {
+	keys := uc.redisClient.Service()
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	user, err := uc.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	for _, identifier := range userIdentifiers(user) {
+		wait, err := uc.redisClient.TTL(ctx, keys.GenerateLoginLockKey(identifier))
+		if err != nil {
+			return 0, err
+		}
+		if wait > 0 {
+			return wait, domain.ErrAccountLocked
+		}
+	}
+	return 0, nil
+}
+
+// RecordSecondFactorFailure counts a wrong two-factor code per user. At the limit the email and the
+// username of the user are locked like after failed passwords and ErrAccountLocked is returned.
+func (uc *LoginAttemptUsecase) RecordSecondFactorFailure(userID, ip string) error {
+	keys := uc.redisClient.Service()
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	counter := keys.GenerateLoginFailuresKey("user", userID)
+	failures, err := uc.countFailure(ctx, counter)
+	if err != nil {
+		return err
+	}
+	if failures < uc.limits.SecondFactorMaxFailures {
+		return nil
+	}
+	user, err := uc.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := uc.lockIdentifiers(ctx, user, userIdentifiers(user), ip, failures, "wrong two-factor codes"); err != nil {
+		return err
+	}
+	if err := uc.redisClient.Delete(ctx, counter); err != nil {
+		return err
+	}
+	return domain.ErrAccountLocked
+}
+
+func (uc *LoginAttemptUsecase) RecordSecondFactorSuccess(userID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.redisClient.Delete(ctx, uc.redisClient.Service().GenerateLoginFailuresKey("user", userID))
+}
+
+// userIdentifiers are the identifiers the user can log in with
+func userIdentifiers(user *domain.User) []string {
+	identifiers := []string{}
+	for _, identifier := range []string{user.Email, user.Username} {
+		identifier = normalizeIdentifier(identifier)
+		if identifier != "" && !slices.Contains(identifiers, identifier) {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	return identifiers
+}
+
+func (uc *LoginAttemptUsecase) Unlock(token string) error {
+	if token == "" {
+		return domain.ErrUnlockTokenInvalid
+	}
+	keys := uc.redisClient.Service()
+	tokenHash, _ := security.HashToken(token)
+	unlockKey := keys.GenerateLoginUnlockKey(tokenHash)
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	exists, err := uc.redisClient.Exists(ctx, unlockKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrUnlockTokenInvalid
+	}
+	identifiers, err := uc.redisClient.Get(ctx, unlockKey)
+	if err != nil {
+		return domain.ErrUnlockTokenInvalid
+	}
+	if err := uc.redisClient.Delete(ctx, unlockKey); err != nil {
+		return err
+	}
+	for _, identifier := range strings.Split(identifiers, "\n") {
+		if err := uc.clearLock(ctx, identifier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListLocks returns the current locks, newest first. Expired entries are dropped from the set on the way.
+func (uc *LoginAttemptUsecase) ListLocks() ([]*domain.AccountLock, error) {
+	keys := uc.redisClient.Service()
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	identifiers, err := uc.redisClient.SetMembers(ctx, keys.GenerateLoginLocksKey())
+	if err != nil {
+		return nil, err
+	}
+	locks := make([]*domain.AccountLock, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		lock, err := uc.findLock(ctx, identifier)
+		if err == domain.ErrAccountLockNotFound {
+			_ = uc.redisClient.RemoveFromSet(ctx, keys.GenerateLoginLocksKey(), identifier)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, lock)
+	}
+	sort.Slice(locks, func(i, j int) bool { return locks[i].LockedAt.After(locks[j].LockedAt) })
+	return locks, nil
+}
+
+func (uc *LoginAttemptUsecase) UnlockIdentifier(identifier string) error {
+	identifier = normalizeIdentifier(identifier)
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	if _, err := uc.findLock(ctx, identifier); err != nil {
+		return err
+	}
+	return uc.clearLock(ctx, identifier)
+}
+
+func (uc *LoginAttemptUsecase) findLock(ctx context.Context, identifier string) (*domain.AccountLock, error) {
+	key := uc.redisClient.Service().GenerateLoginLockKey(identifier)
+	exists, err := uc.redisClient.Exists(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrAccountLockNotFound
+	}
+	value, err := uc.redisClient.Get(ctx, key)
+	if err != nil {
+		// expired between the two calls
+		return nil, domain.ErrAccountLockNotFound
+	}
+	var lock domain.AccountLock
+	if err := json.Unmarshal([]byte(value), &lock); err != nil {
+		return nil, err
+	}
+	return &lock, nil
+}
+
+func (uc *LoginAttemptUsecase) clearLock(ctx context.Context, identifier string) error {
+	keys := uc.redisClient.Service()
+	for _, key := range []string{
+		keys.GenerateLoginLockKey(identifier),
+		keys.GenerateLoginFailuresKey("id", identifier),
+		keys.GenerateLoginDelayKey(identifier),
+	} {
+		if err := uc.redisClient.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return uc.redisClient.RemoveFromSet(ctx, keys.GenerateLoginLocksKey(), identifier)
+}
@@ -0,0 +1,319 @@
+package usecases
+
+import (
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"g6/blog-api/Infrastructure/redis"
+	redis_mocks "g6/blog-api/Infrastructure/redis/mocks"
+	"g6/blog-api/Infrastructure/security"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type LoginAttemptUsecaseSuite struct {
+	suite.Suite
+	mockRedis        *redis_mocks.MockRedisClient
+	mockUserRepo     *domain_mocks.MockIUserRepository
+	mockEventRepo    *domain_mocks.MockISecurityEventRepository
+	mockEmailService *domain_mocks.MockIEmailService
+	usecase          *LoginAttemptUsecase
+}
+
+var testLoginLimits = LoginAttemptLimits{
+	MaxFailures:   5,
+	IPMaxFailures: 50,
+	Window:        15 * time.Minute,
+	Lockout:       30 * time.Minute,
+	DelayAfter:    3,
+	BaseDelay:     time.Second,
+	MaxDelay:      8 * time.Second,
+
+	SecondFactorMaxFailures: 3,
+}
+
+func (s *LoginAttemptUsecaseSuite) SetupTest() {
+	s.mockRedis = redis_mocks.NewMockRedisClient(s.T())
+	s.mockUserRepo = domain_mocks.NewMockIUserRepository(s.T())
+	s.mockEventRepo = domain_mocks.NewMockISecurityEventRepository(s.T())
+	s.mockEmailService = domain_mocks.NewMockIEmailService(s.T())
+	s.usecase = NewLoginAttemptUsecase(s.mockRedis, s.mockUserRepo, s.mockEventRepo, s.mockEmailService, testLoginLimits, "http://localhost:3000/auth/unlock", 3*time.Second).(*LoginAttemptUsecase)
+}
+
+func TestLoginAttemptUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(LoginAttemptUsecaseSuite))
+}
+
+func (s *LoginAttemptUsecaseSuite) TestDefaults() {
+	uc := NewLoginAttemptUsecase(nil, nil, nil, nil, LoginAttemptLimits{}, "", time.Second).(*LoginAttemptUsecase)
+	s.Equal(DefaultLoginAttemptLimits, uc.limits)
+}
+
+func (s *LoginAttemptUsecaseSuite) TestDelay() {
+	s.Equal(time.Second, s.usecase.delay(3))
+	s.Equal(2*time.Second, s.usecase.delay(4))
+	s.Equal(4*time.Second, s.usecase.delay(5))
+	s.Equal(8*time.Second, s.usecase.delay(9), "capped at MaxDelay")
+}
+
+func (s *LoginAttemptUsecaseSuite) TestCheck() {
+	s.Run("Locked", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("TTL", mock.Anything, "login:lock:jane").Return(10*time.Minute, nil)
+
+		wait, err := s.usecase.Check(" Jane ", "10.0.0.1")
+
+		s.Equal(domain.ErrAccountLocked, err)
+		s.Equal(10*time.Minute, wait)
+		s.resetMocks()
+	})
+
+	s.Run("IPBlocked", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("TTL", mock.Anything, "login:lock:jane").Return(time.Duration(0), nil)
+		s.mockRedis.On("TTL", mock.Anything, "login:blocked:ip:10.0.0.1").Return(time.Minute, nil)
+
+		wait, err := s.usecase.Check("jane", "10.0.0.1")
+
+		s.Equal(domain.ErrLoginThrottled, err)
+		s.Equal(time.Minute, wait)
+		s.resetMocks()
+	})
+
+	s.Run("Delayed", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("TTL", mock.Anything, "login:lock:jane").Return(time.Duration(0), nil)
+		s.mockRedis.On("TTL", mock.Anything, "login:blocked:ip:10.0.0.1").Return(time.Duration(0), nil)
+		s.mockRedis.On("TTL", mock.Anything, "login:delay:jane").Return(2*time.Second, nil)
+
+		wait, err := s.usecase.Check("jane", "10.0.0.1")
+
+		s.Equal(domain.ErrLoginThrottled, err)
+		s.Equal(2*time.Second, wait)
+		s.resetMocks()
+	})
+
+	s.Run("Allowed", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("TTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+
+		wait, err := s.usecase.Check("jane", "10.0.0.1")
+
+		s.NoError(err)
+		s.Zero(wait)
+		s.resetMocks()
+	})
+}
+
+func (s *LoginAttemptUsecaseSuite) TestRecordFailure() {
+	s.Run("FirstFailureStartsWindow", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:ip:10.0.0.1").Return(int64(1), nil)
+		s.mockRedis.On("Expire", mock.Anything, "login:failures:ip:10.0.0.1", 15*time.Minute).Return(nil)
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:id:jane").Return(int64(1), nil)
+		s.mockRedis.On("Expire", mock.Anything, "login:failures:id:jane", 15*time.Minute).Return(nil)
+
+		s.NoError(s.usecase.RecordFailure("Jane", "10.0.0.1"))
+		s.mockRedis.AssertNotCalled(s.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("DelaysAfterThreshold", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:ip:10.0.0.1").Return(int64(7), nil)
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:id:jane").Return(int64(4), nil)
+		s.mockRedis.On("Set", mock.Anything, "login:delay:jane", int64(4), 2*time.Second).Return(nil)
+
+		s.NoError(s.usecase.RecordFailure("jane", "10.0.0.1"))
+		s.resetMocks()
+	})
+
+	s.Run("BlocksIP", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:ip:10.0.0.1").Return(int64(50), nil)
+		s.mockRedis.On("Set", mock.Anything, "login:blocked:ip:10.0.0.1", int64(50), 30*time.Minute).Return(nil)
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:id:jane").Return(int64(2), nil)
+
+		s.NoError(s.usecase.RecordFailure("jane", "10.0.0.1"))
+		s.resetMocks()
+	})
+
+	s.Run("LocksKnownUserAndEmailsUnlockLink", func() {
+		user := domain.User{ID: "1", Username: "jane", Email: "jane@example.com"}
+		var lock domain.AccountLock
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:ip:10.0.0.1").Return(int64(9), nil)
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:id:jane").Return(int64(5), nil)
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, "jane").Return(user, nil)
+		s.mockRedis.On("Set", mock.Anything, "login:lock:jane", mock.Anything, 30*time.Minute).Run(func(args mock.Arguments) {
+			json.Unmarshal(args.Get(2).([]byte), &lock)
+		}).Return(nil)
+		s.mockRedis.On("AddToSet", mock.Anything, "login:locks", []string{"jane"}).Return(nil)
+		s.mockRedis.On("Delete", mock.Anything, "login:failures:id:jane").Return(nil)
+		s.mockEventRepo.On("Record", mock.Anything, mock.MatchedBy(func(e *domain.SecurityEvent) bool {
+			return e.Type == domain.SecurityEventAccountLocked && e.UserID == "1" && e.IP == "10.0.0.1"
+		})).Return(nil)
+		s.mockRedis.On("Set", mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "login:unlock:")
+		}), "jane", 30*time.Minute).Return(nil)
+		s.mockEmailService.On("SendTemplate", mock.Anything, "jane@example.com", domain.EmailTemplateAccountLocked, mock.Anything).Return(nil)
+
+		s.NoError(s.usecase.RecordFailure("jane", "10.0.0.1"))
+		s.Equal("1", lock.UserID)
+		s.Equal(int64(5), lock.Failures)
+		s.WithinDuration(time.Now().Add(30*time.Minute), lock.LockedUntil, time.Second)
+		s.resetMocks()
+	})
+
+	s.Run("LocksUnknownIdentifierSilently", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:ip:10.0.0.1").Return(int64(9), nil)
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:id:ghost").Return(int64(5), nil)
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, "ghost").Return(domain.User{}, errors.New("not found"))
+		s.mockRedis.On("Set", mock.Anything, "login:lock:ghost", mock.Anything, 30*time.Minute).Return(nil)
+		s.mockRedis.On("AddToSet", mock.Anything, "login:locks", []string{"ghost"}).Return(nil)
+		s.mockRedis.On("Delete", mock.Anything, "login:failures:id:ghost").Return(nil)
+
+		s.NoError(s.usecase.RecordFailure("ghost", "10.0.0.1"))
+		s.mockEmailService.AssertNotCalled(s.T(), "SendTemplate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		s.mockEventRepo.AssertNotCalled(s.T(), "Record", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+}
+
+func (s *LoginAttemptUsecaseSuite) TestRecordSecondFactorFailure() {
+	user := &domain.User{ID: "1", Username: "jane", Email: "Jane@example.com"}
+
+	s.Run("CountsPerUser", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:user:1").Return(int64(1), nil)
+		s.mockRedis.On("Expire", mock.Anything, "login:failures:user:1", 15*time.Minute).Return(nil)
+
+		s.NoError(s.usecase.RecordSecondFactorFailure("1", "10.0.0.1"))
+		s.mockRedis.AssertNotCalled(s.T(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("LocksEveryIdentifierAtLimit", func() {
+		locked := []string{}
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Increment", mock.Anything, "login:failures:user:1").Return(int64(3), nil)
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+		s.mockRedis.On("Set", mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "login:lock:")
+		}), mock.Anything, 30*time.Minute).Run(func(args mock.Arguments) {
+			locked = append(locked, args.String(1))
+		}).Return(nil)
+		s.mockRedis.On("AddToSet", mock.Anything, "login:locks", mock.Anything).Return(nil)
+		s.mockRedis.On("Delete", mock.Anything, mock.Anything).Return(nil)
+		s.mockEventRepo.On("Record", mock.Anything, mock.MatchedBy(func(e *domain.SecurityEvent) bool {
+			return e.Type == domain.SecurityEventAccountLocked && strings.Contains(e.Details, "wrong two-factor codes")
+		})).Return(nil)
+		// one link lifts both locks
+		s.mockRedis.On("Set", mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "login:unlock:")
+		}), "jane@example.com\njane", 30*time.Minute).Return(nil)
+		s.mockEmailService.On("SendTemplate", mock.Anything, "Jane@example.com", domain.EmailTemplateAccountLocked, mock.Anything).Return(nil).Once()
+
+		s.Equal(domain.ErrAccountLocked, s.usecase.RecordSecondFactorFailure("1", "10.0.0.1"))
+		s.Equal([]string{"login:lock:jane@example.com", "login:lock:jane"}, locked)
+		s.mockRedis.AssertCalled(s.T(), "Delete", mock.Anything, "login:failures:user:1")
+		s.resetMocks()
+	})
+}
+
+func (s *LoginAttemptUsecaseSuite) TestCheckUser() {
+	user := &domain.User{ID: "1", Username: "jane", Email: "jane@example.com"}
+	s.mockRedis.On("Service").Return(&redis.RedisService{})
+	s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+	s.mockRedis.On("TTL", mock.Anything, "login:lock:jane@example.com").Return(time.Duration(0), nil)
+	s.mockRedis.On("TTL", mock.Anything, "login:lock:jane").Return(20*time.Minute, nil)
+
+	wait, err := s.usecase.CheckUser("1")
+
+	s.Equal(domain.ErrAccountLocked, err)
+	s.Equal(20*time.Minute, wait)
+}
+
+func (s *LoginAttemptUsecaseSuite) TestUnlock() {
+	tokenHash, _ := security.HashToken("unlock-token")
+	unlockKey := "login:unlock:" + tokenHash
+
+	s.Run("Success", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Exists", mock.Anything, unlockKey).Return(true, nil)
+		s.mockRedis.On("Get", mock.Anything, unlockKey).Return("jane", nil)
+		s.mockRedis.On("Delete", mock.Anything, unlockKey).Return(nil)
+		s.mockRedis.On("Delete", mock.Anything, "login:lock:jane").Return(nil)
+		s.mockRedis.On("Delete", mock.Anything, "login:failures:id:jane").Return(nil)
+		s.mockRedis.On("Delete", mock.Anything, "login:delay:jane").Return(nil)
+		s.mockRedis.On("RemoveFromSet", mock.Anything, "login:locks", []string{"jane"}).Return(nil)
+
+		s.NoError(s.usecase.Unlock("unlock-token"))
+		s.resetMocks()
+	})
+
+	s.Run("EveryIdentifierOfTheLink", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Exists", mock.Anything, unlockKey).Return(true, nil)
+		s.mockRedis.On("Get", mock.Anything, unlockKey).Return("jane@example.com\njane", nil)
+		s.mockRedis.On("Delete", mock.Anything, mock.Anything).Return(nil)
+		s.mockRedis.On("RemoveFromSet", mock.Anything, "login:locks", mock.Anything).Return(nil)
+
+		s.NoError(s.usecase.Unlock("unlock-token"))
+		s.mockRedis.AssertCalled(s.T(), "Delete", mock.Anything, "login:lock:jane@example.com")
+		s.mockRedis.AssertCalled(s.T(), "Delete", mock.Anything, "login:lock:jane")
+		s.resetMocks()
+	})
+
+	s.Run("UnknownToken", func() {
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Exists", mock.Anything, unlockKey).Return(false, nil)
+
+		s.Equal(domain.ErrUnlockTokenInvalid, s.usecase.Unlock("unlock-token"))
+		s.resetMocks()
+	})
+}
+
+func (s *LoginAttemptUsecaseSuite) TestListLocks() {
+	older, _ := json.Marshal(domain.AccountLock{Identifier: "jane", LockedAt: time.Now().Add(-time.Minute)})
+	newer, _ := json.Marshal(domain.AccountLock{Identifier: "bob", LockedAt: time.Now()})
+	s.mockRedis.On("Service").Return(&redis.RedisService{})
+	s.mockRedis.On("SetMembers", mock.Anything, "login:locks").Return([]string{"jane", "expired", "bob"}, nil)
+	s.mockRedis.On("Exists", mock.Anything, "login:lock:jane").Return(true, nil)
+	s.mockRedis.On("Get", mock.Anything, "login:lock:jane").Return(string(older), nil)
+	s.mockRedis.On("Exists", mock.Anything, "login:lock:expired").Return(false, nil)
+	s.mockRedis.On("RemoveFromSet", mock.Anything, "login:locks", []string{"expired"}).Return(nil)
+	s.mockRedis.On("Exists", mock.Anything, "login:lock:bob").Return(true, nil)
+	s.mockRedis.On("Get", mock.Anything, "login:lock:bob").Return(string(newer), nil)
+
+	locks, err := s.usecase.ListLocks()
+
+	s.NoError(err)
+	s.Require().Len(locks, 2)
+	s.Equal("bob", locks[0].Identifier)
+	s.Equal("jane", locks[1].Identifier)
+}
+
+func (s *LoginAttemptUsecaseSuite) TestUnlockIdentifierNotLocked() {
+	s.mockRedis.On("Service").Return(&redis.RedisService{})
+	s.mockRedis.On("Exists", mock.Anything, "login:lock:jane").Return(false, nil)
+
+	s.Equal(domain.ErrAccountLockNotFound, s.usecase.UnlockIdentifier("Jane"))
+}
+
+func (s *LoginAttemptUsecaseSuite) resetMocks() {
+	s.mockRedis.ExpectedCalls = nil
+	s.mockRedis.Calls = nil
+	s.mockUserRepo.ExpectedCalls = nil
+	s.mockUserRepo.Calls = nil
+	s.mockEventRepo.ExpectedCalls = nil
+	s.mockEventRepo.Calls = nil
+	s.mockEmailService.ExpectedCalls = nil
+	s.mockEmailService.Calls = nil
+}
@@ -0,0 +1,139 @@
+package usecases
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/security"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// requests allowed per window, for one address and for one IP
+	magicLinkEmailLimit = 5
+	magicLinkIPLimit    = 20
+	magicLinkRateWindow = time.Hour
+)
+
+type MagicLinkUsecase struct {
+	repo         domain.IMagicLinkRepository
+	userRepo     domain.IUserRepository
+	emailService domain.IEmailService
+	linkURL      string
+	expiry       time.Duration
+	ctxtimeout   time.Duration
+}
+
+func NewMagicLinkUsecase(repo domain.IMagicLinkRepository, userRepo domain.IUserRepository, emailService domain.IEmailService, linkURL string, expiry, timeout time.Duration) domain.IMagicLinkUsecase {
+	return &MagicLinkUsecase{
+		repo:         repo,
+		userRepo:     userRepo,
+		emailService: emailService,
+		linkURL:      linkURL,
+		expiry:       expiry,
+		ctxtimeout:   timeout,
+	}
+}
+
+func (uc *MagicLinkUsecase) RequestLink(email, ip string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return "", domain.ErrInvalidInput
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	since := time.Now().Add(-magicLinkRateWindow)
+	count, err := uc.repo.CountByEmailSince(ctx, email, since)
+	if err != nil {
+		return "", err
+	}
+	if count >= magicLinkEmailLimit {
+		return "", domain.ErrMagicLinkRateLimited
+	}
+	count, err = uc.repo.CountByIPSince(ctx, ip, since)
+	if err != nil {
+		return "", err
+	}
+	if count >= magicLinkIPLimit {
+		return "", domain.ErrMagicLinkRateLimited
+	}
+
+	token, err := security.GenerateOpaqueToken("")
+	if err != nil {
+		return "", err
+	}
+	binding, err := security.GenerateOpaqueToken("")
+	if err != nil {
+		return "", err
+	}
+	tokenHash, _ := security.HashToken(token)
+	bindingHash, _ := security.HashToken(binding)
+
+	// unknown addresses are recorded as well, so they hit the rate limit exactly like real ones
+	user, err := uc.userRepo.FindByUsernameOrEmail(ctx, email)
+	known := err == nil && user.ID != "" && strings.EqualFold(user.Email, email)
+
+	now := time.Now()
+	link := &domain.MagicLink{
+		Email:       email,
+		TokenHash:   tokenHash,
+		BindingHash: bindingHash,
+		IP:          ip,
+		ExpiresAt:   now.Add(uc.expiry),
+		CreatedAt:   now,
+	}
+	if known {
+		link.UserID = user.ID
+	}
+	if err := uc.repo.Create(ctx, link); err != nil {
+		return "", err
+	}
+	if !known {
+		return binding, nil
+	}
+
+	loginURL := uc.linkURL + "?token=" + url.QueryEscape(token)
+	if err := uc.emailService.SendTemplate(ctx, user.Email, domain.EmailTemplateMagicLink, domain.EmailTemplateData{
+		"Locale":    user.Language,
+		"Name":      user.FirstName + " " + user.LastName,
+		"URL":       loginURL,
+		"ExpiresIn": uc.expiry.String(),
+	}); err != nil {
+		return "", err
+	}
+	return binding, nil
+}
+
+func (uc *MagicLinkUsecase) Redeem(token, binding string) (*domain.User, error) {
+	if token == "" || binding == "" {
+		return nil, domain.ErrMagicLinkInvalid
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	tokenHash, _ := security.HashToken(token)
+	link, err := uc.repo.FindByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if link.UserID == "" || time.Now().After(link.ExpiresAt) {
+		return nil, domain.ErrMagicLinkInvalid
+	}
+	// checked before consuming, so a link opened by a mail scanner or another browser stays usable
+	if ok, _ := security.ValidateTokenHash(link.BindingHash, binding); !ok {
+		return nil, domain.ErrMagicLinkInvalid
+	}
+	if err := uc.repo.Consume(ctx, link.ID); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindUserByID(ctx, link.UserID)
+	if err != nil {
+		return nil, domain.ErrMagicLinkInvalid
+	}
+	return user, nil
+}
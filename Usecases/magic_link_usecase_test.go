@@ -0,0 +1,184 @@
+package usecases
+
+import (
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"g6/blog-api/Infrastructure/security"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MagicLinkUsecaseSuite struct {
+	suite.Suite
+	mockRepo         *domain_mocks.MockIMagicLinkRepository
+	mockUserRepo     *domain_mocks.MockIUserRepository
+	mockEmailService *domain_mocks.MockIEmailService
+	usecase          domain.IMagicLinkUsecase
+}
+
+func (s *MagicLinkUsecaseSuite) SetupTest() {
+	s.mockRepo = domain_mocks.NewMockIMagicLinkRepository(s.T())
+	s.mockUserRepo = domain_mocks.NewMockIUserRepository(s.T())
+	s.mockEmailService = domain_mocks.NewMockIEmailService(s.T())
+	s.usecase = NewMagicLinkUsecase(s.mockRepo, s.mockUserRepo, s.mockEmailService, "http://localhost:3000/auth/magic-link", 15*time.Minute, 3*time.Second)
+}
+
+func TestMagicLinkUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(MagicLinkUsecaseSuite))
+}
+
+var magicLinkToken = regexp.MustCompile(`token=([^"]+)`)
+
+func (s *MagicLinkUsecaseSuite) TestRequestLink() {
+	s.Run("SendsLinkToKnownEmail", func() {
+		var saved *domain.MagicLink
+		var data domain.EmailTemplateData
+		s.mockRepo.On("CountByEmailSince", mock.Anything, "jane@example.com", mock.Anything).Return(int64(0), nil)
+		s.mockRepo.On("CountByIPSince", mock.Anything, "10.0.0.1", mock.Anything).Return(int64(0), nil)
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, "jane@example.com").Return(domain.User{ID: "1", Email: "jane@example.com"}, nil)
+		s.mockRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*domain.MagicLink)
+		}).Return(nil)
+		s.mockEmailService.On("SendTemplate", mock.Anything, "jane@example.com", domain.EmailTemplateMagicLink, mock.Anything).Run(func(args mock.Arguments) {
+			data = args.Get(3).(domain.EmailTemplateData)
+		}).Return(nil)
+
+		binding, err := s.usecase.RequestLink(" Jane@Example.com ", "10.0.0.1")
+
+		s.NoError(err)
+		s.Equal("1", saved.UserID)
+		s.WithinDuration(time.Now().Add(15*time.Minute), saved.ExpiresAt, time.Second)
+		// only hashes are stored
+		bindingOK, _ := security.ValidateTokenHash(saved.BindingHash, binding)
+		s.True(bindingOK)
+		match := magicLinkToken.FindStringSubmatch(data["URL"].(string))
+		s.Require().Len(match, 2)
+		token, _ := url.QueryUnescape(match[1])
+		tokenOK, _ := security.ValidateTokenHash(saved.TokenHash, token)
+		s.True(tokenOK)
+		s.NotContains(saved.TokenHash, token)
+		s.resetMocks()
+	})
+
+	s.Run("UnknownEmailIsRecordedButNotSent", func() {
+		s.mockRepo.On("CountByEmailSince", mock.Anything, "nobody@example.com", mock.Anything).Return(int64(0), nil)
+		s.mockRepo.On("CountByIPSince", mock.Anything, "10.0.0.1", mock.Anything).Return(int64(0), nil)
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, "nobody@example.com").Return(domain.User{}, errors.New("not found"))
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(l *domain.MagicLink) bool {
+			return l.UserID == "" && l.Email == "nobody@example.com"
+		})).Return(nil)
+
+		binding, err := s.usecase.RequestLink("nobody@example.com", "10.0.0.1")
+
+		s.NoError(err)
+		s.NotEmpty(binding)
+		s.mockEmailService.AssertNotCalled(s.T(), "SendTemplate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("RateLimitedPerEmail", func() {
+		s.mockRepo.On("CountByEmailSince", mock.Anything, "jane@example.com", mock.Anything).Return(int64(magicLinkEmailLimit), nil)
+
+		_, err := s.usecase.RequestLink("jane@example.com", "10.0.0.1")
+
+		s.Equal(domain.ErrMagicLinkRateLimited, err)
+		s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("RateLimitedPerIP", func() {
+		s.mockRepo.On("CountByEmailSince", mock.Anything, "jane@example.com", mock.Anything).Return(int64(0), nil)
+		s.mockRepo.On("CountByIPSince", mock.Anything, "10.0.0.1", mock.Anything).Return(int64(magicLinkIPLimit), nil)
+
+		_, err := s.usecase.RequestLink("jane@example.com", "10.0.0.1")
+
+		s.Equal(domain.ErrMagicLinkRateLimited, err)
+		s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+}
+
+func (s *MagicLinkUsecaseSuite) TestRedeem() {
+	tokenHash, _ := security.HashToken("link-token")
+	bindingHash, _ := security.HashToken("browser-binding")
+	link := func() *domain.MagicLink {
+		return &domain.MagicLink{ID: "l1", UserID: "1", TokenHash: tokenHash, BindingHash: bindingHash, ExpiresAt: time.Now().Add(time.Minute)}
+	}
+
+	s.Run("Success", func() {
+		s.mockRepo.On("FindByTokenHash", mock.Anything, tokenHash).Return(link(), nil)
+		s.mockRepo.On("Consume", mock.Anything, "l1").Return(nil)
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1"}, nil)
+
+		user, err := s.usecase.Redeem("link-token", "browser-binding")
+
+		s.NoError(err)
+		s.Equal("1", user.ID)
+		s.resetMocks()
+	})
+
+	s.Run("OtherBrowserDoesNotConsume", func() {
+		s.mockRepo.On("FindByTokenHash", mock.Anything, tokenHash).Return(link(), nil)
+
+		_, err := s.usecase.Redeem("link-token", "another-binding")
+
+		s.Equal(domain.ErrMagicLinkInvalid, err)
+		s.mockRepo.AssertNotCalled(s.T(), "Consume", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("AlreadyUsed", func() {
+		s.mockRepo.On("FindByTokenHash", mock.Anything, tokenHash).Return(link(), nil)
+		s.mockRepo.On("Consume", mock.Anything, "l1").Return(domain.ErrMagicLinkInvalid)
+
+		_, err := s.usecase.Redeem("link-token", "browser-binding")
+
+		s.Equal(domain.ErrMagicLinkInvalid, err)
+		s.mockUserRepo.AssertNotCalled(s.T(), "FindUserByID", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("Expired", func() {
+		expired := link()
+		expired.ExpiresAt = time.Now().Add(-time.Second)
+		s.mockRepo.On("FindByTokenHash", mock.Anything, tokenHash).Return(expired, nil)
+
+		_, err := s.usecase.Redeem("link-token", "browser-binding")
+
+		s.Equal(domain.ErrMagicLinkInvalid, err)
+		s.resetMocks()
+	})
+
+	s.Run("RecordForUnknownEmail", func() {
+		unknown := link()
+		unknown.UserID = ""
+		s.mockRepo.On("FindByTokenHash", mock.Anything, tokenHash).Return(unknown, nil)
+
+		_, err := s.usecase.Redeem("link-token", "browser-binding")
+
+		s.Equal(domain.ErrMagicLinkInvalid, err)
+		s.resetMocks()
+	})
+
+	s.Run("MissingBinding", func() {
+		_, err := s.usecase.Redeem("link-token", "")
+
+		s.Equal(domain.ErrMagicLinkInvalid, err)
+		s.resetMocks()
+	})
+}
+
+func (s *MagicLinkUsecaseSuite) resetMocks() {
+	s.mockRepo.ExpectedCalls = nil
+	s.mockRepo.Calls = nil
+	s.mockUserRepo.ExpectedCalls = nil
+	s.mockUserRepo.Calls = nil
+	s.mockEmailService.ExpectedCalls = nil
+	s.mockEmailService.Calls = nil
+}
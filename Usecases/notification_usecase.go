@@ -0,0 +1,197 @@
+package usecases
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/redis"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultNotificationPageSize = 20
+	maxNotificationPageSize     = 100
+)
+
+type NotificationUsecase struct {
+	repo        domain.INotificationRepository
+	redisClient redis.RedisClient
+	streams     domain.IStreamUsecase
+	ctxtimeout  time.Duration
+}
+
+func NewNotificationUsecase(repo domain.INotificationRepository, redisClient redis.RedisClient, streams domain.IStreamUsecase, timeout time.Duration) domain.INotificationUsecase {
+	return &NotificationUsecase{
+		repo:        repo,
+		redisClient: redisClient,
+		streams:     streams,
+		ctxtimeout:  timeout,
+	}
+}
+
+func (uc *NotificationUsecase) Notify(notification *domain.Notification) error {
+	if notification.RecipientID == "" || notification.RecipientID == notification.ActorID {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	preferences, err := uc.preferences(ctx, notification.RecipientID)
+	if err != nil {
+		return err
+	}
+	if !preferences[notification.Type] {
+		return nil
+	}
+
+	notification.Read = false
+	notification.CreatedAt = time.Now()
+	if err := uc.repo.Create(ctx, notification); err != nil {
+		return err
+	}
+	uc.forgetUnreadCount(ctx, notification.RecipientID)
+
+	// the recipient's open pages show it right away, the list still has it when this fails
+	_ = uc.streams.Publish(domain.NotificationTopic(notification.RecipientID), domain.StreamEventNotification, domain.NotificationStreamData{
+		ID:         notification.ID,
+		Type:       notification.Type,
+		ActorID:    notification.ActorID,
+		TargetType: notification.TargetType,
+		TargetID:   notification.TargetID,
+		PostID:     notification.PostID,
+		CreatedAt:  notification.CreatedAt,
+	})
+	return nil
+}
+
+func (uc *NotificationUsecase) List(filter domain.NotificationFilter) ([]*domain.Notification, int64, error) {
+	if filter.RecipientID == "" {
+		return nil, 0, domain.ErrInvalidInput
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = defaultNotificationPageSize
+	}
+	filter.PageSize = min(filter.PageSize, maxNotificationPageSize)
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.repo.Find(ctx, filter)
+}
+
+// UnreadCount is read from Redis, and counted in the database when it is not cached
+func (uc *NotificationUsecase) UnreadCount(userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	key := uc.redisClient.Service().GenerateUnreadNotificationsKey(userID)
+	if cached, err := uc.redisClient.Get(ctx, key); err == nil {
+		if count, err := strconv.ParseInt(cached, 10, 64); err == nil {
+			return count, nil
+		}
+	}
+
+	count, err := uc.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	_ = uc.redisClient.Set(ctx, key, count, uc.redisClient.GetCacheExpiry())
+	return count, nil
+}
+
+func (uc *NotificationUsecase) MarkRead(userID, id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	if err := uc.repo.MarkRead(ctx, userID, id, time.Now()); err != nil {
+		return err
+	}
+	uc.forgetUnreadCount(ctx, userID)
+	return nil
+}
+
+func (uc *NotificationUsecase) MarkAllRead(userID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	marked, err := uc.repo.MarkAllRead(ctx, userID, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	uc.forgetUnreadCount(ctx, userID)
+	return marked, nil
+}
+
+func (uc *NotificationUsecase) Preferences(userID string) (map[domain.NotificationType]bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.preferences(ctx, userID)
+}
+
+func (uc *NotificationUsecase) UpdatePreferences(userID string, enabled map[domain.NotificationType]bool) (map[domain.NotificationType]bool, error) {
+	for t := range enabled {
+		if !slices.Contains(domain.NotificationTypes, t) {
+			return nil, domain.ErrUnknownNotificationType
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	preferences, err := uc.preferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	disabled := []domain.NotificationType{}
+	for _, t := range domain.NotificationTypes {
+		if on, ok := enabled[t]; ok {
+			preferences[t] = on
+		}
+		if !preferences[t] {
+			disabled = append(disabled, t)
+		}
+	}
+	err = uc.repo.SavePreferences(ctx, &domain.NotificationPreferences{
+		UserID:    userID,
+		Disabled:  disabled,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// preferences tells for every type whether the user gets it, all of them until the user
+// turns some off
+func (uc *NotificationUsecase) preferences(ctx context.Context, userID string) (map[domain.NotificationType]bool, error) {
+	enabled := map[domain.NotificationType]bool{}
+	for _, t := range domain.NotificationTypes {
+		enabled[t] = true
+	}
+	stored, err := uc.repo.FindPreferences(ctx, userID)
+	if err == domain.ErrNotFound {
+		return enabled, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range stored.Disabled {
+		if _, known := enabled[t]; known {
+			enabled[t] = false
+		}
+	}
+	return enabled, nil
+}
+
+// forgetUnreadCount drops the cached count, the next read counts again. A failure leaves a
+// stale count until the cache expires.
+func (uc *NotificationUsecase) forgetUnreadCount(ctx context.Context, userID string) {
+	_ = uc.redisClient.Delete(ctx, uc.redisClient.Service().GenerateUnreadNotificationsKey(userID))
+}
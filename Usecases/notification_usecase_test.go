@@ -0,0 +1,205 @@
+package usecases
+
+import (
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"g6/blog-api/Infrastructure/redis"
+	redis_mocks "g6/blog-api/Infrastructure/redis/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationUsecaseSuite struct {
+	suite.Suite
+	mockRepo    *domain_mocks.MockINotificationRepository
+	mockRedis   *redis_mocks.MockRedisClient
+	mockStreams *domain_mocks.MockIStreamUsecase
+	usecase     domain.INotificationUsecase
+}
+
+func (s *NotificationUsecaseSuite) SetupTest() {
+	s.mockRepo = domain_mocks.NewMockINotificationRepository(s.T())
+	s.mockRedis = redis_mocks.NewMockRedisClient(s.T())
+	s.mockStreams = domain_mocks.NewMockIStreamUsecase(s.T())
+	s.usecase = NewNotificationUsecase(s.mockRepo, s.mockRedis, s.mockStreams, 3*time.Second)
+}
+
+func TestNotificationUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(NotificationUsecaseSuite))
+}
+
+func (s *NotificationUsecaseSuite) TestNotify() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		notification := &domain.Notification{RecipientID: "author", ActorID: "reader", Type: domain.NotificationPostComment, TargetType: "comment", TargetID: "c1", PostID: "p1"}
+		s.mockRepo.On("FindPreferences", mock.Anything, "author").Return(nil, domain.ErrNotFound)
+		s.mockRepo.On("Create", mock.Anything, notification).Return(nil)
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Delete", mock.Anything, "notifications:unread:author").Return(nil)
+		s.mockStreams.On("Publish", "user:author:notifications", domain.StreamEventNotification, mock.MatchedBy(func(data domain.NotificationStreamData) bool {
+			return data.Type == domain.NotificationPostComment && data.TargetID == "c1" && data.ActorID == "reader"
+		})).Return(nil)
+
+		err := s.usecase.Notify(notification)
+
+		s.NoError(err)
+		s.False(notification.Read)
+		s.WithinDuration(time.Now(), notification.CreatedAt, time.Second)
+	})
+
+	s.Run("OwnAction", func() {
+		s.SetupTest()
+
+		err := s.usecase.Notify(&domain.Notification{RecipientID: "author", ActorID: "author", Type: domain.NotificationPostLike})
+
+		s.NoError(err)
+		s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	})
+
+	s.Run("TypeTurnedOff", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindPreferences", mock.Anything, "author").Return(&domain.NotificationPreferences{UserID: "author", Disabled: []domain.NotificationType{domain.NotificationPostLike}}, nil)
+
+		err := s.usecase.Notify(&domain.Notification{RecipientID: "author", ActorID: "reader", Type: domain.NotificationPostLike})
+
+		s.NoError(err)
+		s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+	})
+
+	s.Run("PreferencesFail", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindPreferences", mock.Anything, "author").Return(nil, errors.New("db error"))
+
+		err := s.usecase.Notify(&domain.Notification{RecipientID: "author", ActorID: "reader", Type: domain.NotificationPostLike})
+
+		s.Error(err)
+	})
+}
+
+func (s *NotificationUsecaseSuite) TestList() {
+	s.Run("DefaultsPaging", func() {
+		s.SetupTest()
+		s.mockRepo.On("Find", mock.Anything, domain.NotificationFilter{RecipientID: "1", UnreadOnly: true, Page: 1, PageSize: 20}).Return([]*domain.Notification{}, int64(0), nil)
+
+		_, _, err := s.usecase.List(domain.NotificationFilter{RecipientID: "1", UnreadOnly: true})
+		s.NoError(err)
+	})
+
+	s.Run("CapsPageSize", func() {
+		s.SetupTest()
+		s.mockRepo.On("Find", mock.Anything, domain.NotificationFilter{RecipientID: "1", Page: 2, PageSize: 100}).Return([]*domain.Notification{}, int64(0), nil)
+
+		_, _, err := s.usecase.List(domain.NotificationFilter{RecipientID: "1", Page: 2, PageSize: 1000})
+		s.NoError(err)
+	})
+
+	s.Run("RequiresRecipient", func() {
+		s.SetupTest()
+
+		_, _, err := s.usecase.List(domain.NotificationFilter{})
+		s.ErrorIs(err, domain.ErrInvalidInput)
+	})
+}
+
+func (s *NotificationUsecaseSuite) TestUnreadCount() {
+	s.Run("Cached", func() {
+		s.SetupTest()
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Get", mock.Anything, "notifications:unread:1").Return("4", nil)
+
+		count, err := s.usecase.UnreadCount("1")
+
+		s.NoError(err)
+		s.Equal(int64(4), count)
+		s.mockRepo.AssertNotCalled(s.T(), "CountUnread", mock.Anything, mock.Anything)
+	})
+
+	s.Run("NotCached", func() {
+		s.SetupTest()
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Get", mock.Anything, "notifications:unread:1").Return("", errors.New("key does not exist"))
+		s.mockRepo.On("CountUnread", mock.Anything, "1").Return(int64(7), nil)
+		s.mockRedis.On("GetCacheExpiry").Return(time.Hour)
+		s.mockRedis.On("Set", mock.Anything, "notifications:unread:1", int64(7), time.Hour).Return(nil)
+
+		count, err := s.usecase.UnreadCount("1")
+
+		s.NoError(err)
+		s.Equal(int64(7), count)
+	})
+}
+
+func (s *NotificationUsecaseSuite) TestMarkRead() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockRepo.On("MarkRead", mock.Anything, "1", "n1", mock.AnythingOfType("time.Time")).Return(nil)
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("Delete", mock.Anything, "notifications:unread:1").Return(nil)
+
+		s.NoError(s.usecase.MarkRead("1", "n1"))
+	})
+
+	s.Run("NotFound", func() {
+		s.SetupTest()
+		s.mockRepo.On("MarkRead", mock.Anything, "1", "n1", mock.AnythingOfType("time.Time")).Return(domain.ErrNotificationNotFound)
+
+		s.ErrorIs(s.usecase.MarkRead("1", "n1"), domain.ErrNotificationNotFound)
+		s.mockRedis.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *NotificationUsecaseSuite) TestMarkAllRead() {
+	s.mockRepo.On("MarkAllRead", mock.Anything, "1", mock.AnythingOfType("time.Time")).Return(int64(3), nil)
+	s.mockRedis.On("Service").Return(&redis.RedisService{})
+	s.mockRedis.On("Delete", mock.Anything, "notifications:unread:1").Return(nil)
+
+	marked, err := s.usecase.MarkAllRead("1")
+
+	s.NoError(err)
+	s.Equal(int64(3), marked)
+}
+
+func (s *NotificationUsecaseSuite) TestPreferences() {
+	s.Run("AllOnByDefault", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindPreferences", mock.Anything, "1").Return(nil, domain.ErrNotFound)
+
+		preferences, err := s.usecase.Preferences("1")
+
+		s.NoError(err)
+		s.Equal(map[domain.NotificationType]bool{
+			domain.NotificationPostComment: true,
+			domain.NotificationPostLike:    true,
+			domain.NotificationFollow:      true,
+		}, preferences)
+	})
+
+	s.Run("Update", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindPreferences", mock.Anything, "1").Return(&domain.NotificationPreferences{UserID: "1", Disabled: []domain.NotificationType{domain.NotificationPostComment}}, nil)
+		s.mockRepo.On("SavePreferences", mock.Anything, mock.MatchedBy(func(p *domain.NotificationPreferences) bool {
+			return p.UserID == "1" && len(p.Disabled) == 1 && p.Disabled[0] == domain.NotificationPostLike
+		})).Return(nil)
+
+		preferences, err := s.usecase.UpdatePreferences("1", map[domain.NotificationType]bool{
+			domain.NotificationPostComment: true,
+			domain.NotificationPostLike:    false,
+		})
+
+		s.NoError(err)
+		s.True(preferences[domain.NotificationPostComment])
+		s.False(preferences[domain.NotificationPostLike])
+	})
+
+	s.Run("UnknownType", func() {
+		s.SetupTest()
+
+		_, err := s.usecase.UpdatePreferences("1", map[domain.NotificationType]bool{"reply": false})
+
+		s.ErrorIs(err, domain.ErrUnknownNotificationType)
+	})
+}
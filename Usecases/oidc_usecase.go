@@ -0,0 +1,439 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/security"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// how long the user has to finish the login at the provider
+const oidcStateLifetime = 10 * time.Minute
+
+var usernameDisallowed = regexp.MustCompile(`[^a-z0-9_.-]+`)
+
+type OIDCUsecase struct {
+	providers    map[string]domain.IOIDCProvider
+	stateRepo    domain.IOIDCStateRepository
+	identityRepo domain.ILinkedIdentityRepository
+	userRepo     domain.IUserRepository
+	ctxtimeout   time.Duration
+}
+
+func NewOIDCUsecase(providers map[string]domain.IOIDCProvider, stateRepo domain.IOIDCStateRepository, identityRepo domain.ILinkedIdentityRepository, userRepo domain.IUserRepository, timeout time.Duration) domain.IOIDCUsecase {
+	return &OIDCUsecase{
+		providers:    providers,
+		stateRepo:    stateRepo,
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		ctxtimeout:   timeout,
+	}
+}
+
+// Providers lists the configured provider names in a stable order
+func (uc *OIDCUsecase) Providers() []string {
+	names := make([]string, 0, len(uc.providers))
+	for name := range uc.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (uc *OIDCUsecase) HasProvider(name string) bool {
+	_, ok := uc.providers[name]
+	return ok
+}
+
+func (uc *OIDCUsecase) Begin(provider string) (string, string, error) {
+	return uc.begin(provider, "", "", "")
+}
+
+func (uc *OIDCUsecase) begin(provider, linkUserID, linkProvider, unlinkProvider string) (string, string, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return "", "", domain.ErrOIDCProviderNotFound
+	}
+
+	state, err := security.GenerateOpaqueToken("")
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := security.GenerateOpaqueToken("")
+	if err != nil {
+		return "", "", err
+	}
+	// 32 random bytes in base64url are a valid PKCE verifier, RFC 7636 section 4.1
+	verifier, err := security.GenerateOpaqueToken("")
+	if err != nil {
+		return "", "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %v", domain.ErrOIDCLoginFailed, err)
+	}
+
+	now := time.Now()
+	err = uc.stateRepo.Save(ctx, &domain.OIDCLoginState{
+		State:          state,
+		Provider:       provider,
+		Nonce:          nonce,
+		CodeVerifier:   verifier,
+		LinkUserID:     linkUserID,
+		LinkProvider:   linkProvider,
+		UnlinkProvider: unlinkProvider,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(oidcStateLifetime),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return authURL, state, nil
+}
+
+func (uc *OIDCUsecase) Complete(provider, state, code string) (*domain.OIDCLoginResult, error) {
+	p, ok := uc.providers[provider]
+	if !ok {
+		return nil, domain.ErrOIDCProviderNotFound
+	}
+	if state == "" || code == "" {
+		return nil, domain.ErrOIDCInvalidState
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	// the state is consumed before anything else, a failed callback cannot be retried with it
+	loginState, err := uc.stateRepo.Consume(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	if loginState.Provider != provider || time.Now().After(loginState.ExpiresAt) {
+		return nil, domain.ErrOIDCInvalidState
+	}
+
+	identity, err := p.Exchange(ctx, code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrOIDCLoginFailed, err)
+	}
+	if loginState.UnlinkProvider != "" {
+		return uc.unlinkConfirmed(ctx, loginState.LinkUserID, loginState.UnlinkProvider, identity)
+	}
+	if loginState.LinkProvider != "" {
+		return uc.linkConfirmed(ctx, loginState.LinkUserID, loginState.LinkProvider, identity)
+	}
+	if loginState.LinkUserID != "" {
+		return uc.link(ctx, loginState.LinkUserID, identity)
+	}
+
+	// a linked identity is found by the provider's subject, the email may have changed since linking
+	linked, err := uc.identityRepo.FindByProviderSubject(ctx, provider, identity.Subject)
+	if err == nil {
+		user, err := uc.userRepo.FindUserByID(ctx, linked.UserID)
+		if err != nil {
+			return nil, domain.ErrUserNotFound
+		}
+		// failing to record the login must not fail it
+		_ = uc.identityRepo.UpdateLastLogin(ctx, linked.ID, time.Now())
+		return &domain.OIDCLoginResult{User: user}, nil
+	}
+	if err != domain.ErrIdentityNotFound {
+		return nil, err
+	}
+
+	// an unverified email proves nothing, registering with it could block the real owner
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, domain.ErrOIDCEmailNotVerified
+	}
+
+	existing, err := uc.userRepo.FindByUsernameOrEmail(ctx, identity.Email)
+	if err == nil && existing.ID != "" {
+		// an account that exists with this email is never taken over silently, its owner links the provider.
+		// Only accounts this provider created before identities were stored are linked on their next login.
+		if existing.Provider != provider {
+			return nil, domain.ErrOIDCAccountExists
+		}
+		if err := uc.identityRepo.Create(ctx, newLinkedIdentity(existing.ID, identity)); err != nil {
+			return nil, err
+		}
+		return &domain.OIDCLoginResult{User: &existing}, nil
+	}
+
+	username, err := uc.availableUsername(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	user := &domain.User{
+		Username:   username,
+		Email:      identity.Email,
+		FirstName:  identity.GivenName,
+		LastName:   identity.FamilyName,
+		Role:       domain.RoleUser,
+		AvatarURL:  identity.Picture,
+		IsVerified: true,
+		Provider:   provider,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	// no password is stored, the account can only log in through the provider until one is set
+	if err := uc.userRepo.CreateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := uc.identityRepo.Create(ctx, newLinkedIdentity(user.ID, identity)); err != nil {
+		return nil, err
+	}
+	return &domain.OIDCLoginResult{User: user, Created: true}, nil
+}
+
+// link attaches the provider account to the user who started the link
+func (uc *OIDCUsecase) link(ctx context.Context, userID string, identity *domain.OIDCIdentity) (*domain.OIDCLoginResult, error) {
+	user, err := uc.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	linked, err := uc.identityRepo.FindByProviderSubject(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		if linked.UserID != userID {
+			return nil, domain.ErrIdentityAlreadyLinked
+		}
+		return &domain.OIDCLoginResult{User: user, Linked: linked}, nil
+	}
+	if err != domain.ErrIdentityNotFound {
+		return nil, err
+	}
+
+	// one account per provider, otherwise unlinking by provider name would be ambiguous
+	identities, err := uc.identityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if findIdentity(identities, identity.Provider) != nil {
+		return nil, domain.ErrIdentityAlreadyLinked
+	}
+
+	linked = newLinkedIdentity(userID, identity)
+	if err := uc.identityRepo.Create(ctx, linked); err != nil {
+		return nil, err
+	}
+	return &domain.OIDCLoginResult{User: user, Linked: linked}, nil
+}
+
+func (uc *OIDCUsecase) LoginMethods(userID string) (*domain.LoginMethods, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	user, err := uc.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+	identities, err := uc.identityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.LoginMethods{HasPassword: hasUsablePassword(user), Identities: identities}, nil
+}
+
+func (uc *OIDCUsecase) BeginLink(userID, provider, password, confirmWith string) (string, string, error) {
+	if !uc.HasProvider(provider) || (confirmWith != "" && !uc.HasProvider(confirmWith)) {
+		return "", "", domain.ErrOIDCProviderNotFound
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	user, err := uc.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return "", "", domain.ErrUserNotFound
+	}
+	if confirmWith == "" {
+		if err := confirmPassword(user, password); err != nil {
+			return "", "", err
+		}
+	}
+	identities, err := uc.identityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	if findIdentity(identities, provider) != nil {
+		return "", "", domain.ErrIdentityAlreadyLinked
+	}
+	if confirmWith == "" {
+		return uc.begin(provider, userID, "", "")
+	}
+	// only a provider already linked to the account can vouch for the user
+	if confirmWith == provider || findIdentity(identities, confirmWith) == nil {
+		return "", "", domain.ErrProviderConfirmation
+	}
+	return uc.begin(confirmWith, userID, provider, "")
+}
+
+// linkConfirmed starts the login at the provider to link once the user logged in with another
+// provider linked to their account
+func (uc *OIDCUsecase) linkConfirmed(ctx context.Context, userID, provider string, identity *domain.OIDCIdentity) (*domain.OIDCLoginResult, error) {
+	if err := uc.confirmedBy(ctx, userID, identity); err != nil {
+		return nil, err
+	}
+	user, err := uc.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+	authURL, state, err := uc.begin(provider, userID, "", "")
+	if err != nil {
+		return nil, err
+	}
+	return &domain.OIDCLoginResult{User: user, LinkURL: authURL, LinkState: state}, nil
+}
+
+func (uc *OIDCUsecase) Unlink(userID, provider, password string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	user, _, err := uc.unlinkable(ctx, userID, provider)
+	if err != nil {
+		return err
+	}
+	// without a password the user confirms through another provider, see BeginUnlink
+	if !hasUsablePassword(user) {
+		return domain.ErrProviderConfirmation
+	}
+	if err := confirmPassword(user, password); err != nil {
+		return err
+	}
+	return uc.identityRepo.Delete(ctx, userID, provider)
+}
+
+func (uc *OIDCUsecase) BeginUnlink(userID, provider, confirmWith string) (string, string, error) {
+	if !uc.HasProvider(confirmWith) {
+		return "", "", domain.ErrOIDCProviderNotFound
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	_, identities, err := uc.unlinkable(ctx, userID, provider)
+	if err != nil {
+		return "", "", err
+	}
+	// the provider that goes away cannot vouch for the user
+	if confirmWith == provider || findIdentity(identities, confirmWith) == nil {
+		return "", "", domain.ErrProviderConfirmation
+	}
+	return uc.begin(confirmWith, userID, "", provider)
+}
+
+// unlinkable checks that the provider is linked to the user and is not their last way to log in
+func (uc *OIDCUsecase) unlinkable(ctx context.Context, userID, provider string) (*domain.User, []*domain.LinkedIdentity, error) {
+	user, err := uc.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, nil, domain.ErrUserNotFound
+	}
+	identities, err := uc.identityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if findIdentity(identities, provider) == nil {
+		return nil, nil, domain.ErrIdentityNotFound
+	}
+
+	methods := len(identities)
+	if hasUsablePassword(user) {
+		methods++
+	}
+	if methods <= 1 {
+		return nil, nil, domain.ErrLastLoginMethod
+	}
+	return user, identities, nil
+}
+
+// unlinkConfirmed unlinks the provider once the user logged in with another provider linked to their account
+func (uc *OIDCUsecase) unlinkConfirmed(ctx context.Context, userID, provider string, identity *domain.OIDCIdentity) (*domain.OIDCLoginResult, error) {
+	if err := uc.confirmedBy(ctx, userID, identity); err != nil {
+		return nil, err
+	}
+
+	// the login methods may have changed while the user was at the provider
+	user, _, err := uc.unlinkable(ctx, userID, provider)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.identityRepo.Delete(ctx, userID, provider); err != nil {
+		return nil, err
+	}
+	return &domain.OIDCLoginResult{User: user, Unlinked: provider}, nil
+}
+
+// confirmedBy checks that the provider account the user logged in with is linked to their account
+func (uc *OIDCUsecase) confirmedBy(ctx context.Context, userID string, identity *domain.OIDCIdentity) error {
+	linked, err := uc.identityRepo.FindByProviderSubject(ctx, identity.Provider, identity.Subject)
+	if err == domain.ErrIdentityNotFound || (err == nil && linked.UserID != userID) {
+		return domain.ErrProviderConfirmation
+	}
+	return err
+}
+
+func newLinkedIdentity(userID string, identity *domain.OIDCIdentity) *domain.LinkedIdentity {
+	now := time.Now()
+	return &domain.LinkedIdentity{
+		UserID:      userID,
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		Email:       identity.Email,
+		CreatedAt:   now,
+		LastLoginAt: now,
+	}
+}
+
+func findIdentity(identities []*domain.LinkedIdentity, provider string) *domain.LinkedIdentity {
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			return identity
+		}
+	}
+	return nil
+}
+
+// hasUsablePassword is false for provider accounts, including old Google accounts that were stored with a hash of ""
+func hasUsablePassword(user *domain.User) bool {
+	return user.Password != "" && security.ValidatePassword(user.Password, "") != nil
+}
+
+// confirmPassword re-confirms the user before their login methods change
+func confirmPassword(user *domain.User, password string) error {
+	if !hasUsablePassword(user) {
+		return domain.ErrPasswordRequired
+	}
+	if password == "" || security.ValidatePassword(user.Password, password) != nil {
+		return domain.ErrInvalidPassword
+	}
+	return nil
+}
+
+// availableUsername derives a username from the email, adding a random suffix when it is taken
+func (uc *OIDCUsecase) availableUsername(ctx context.Context, identity *domain.OIDCIdentity) (string, error) {
+	base := usernameDisallowed.ReplaceAllString(strings.ToLower(strings.Split(identity.Email, "@")[0]), "")
+	if base == "" {
+		base = "user"
+	}
+	username := base
+	for attempt := 0; attempt < 5; attempt++ {
+		if existing, err := uc.userRepo.FindByUsernameOrEmail(ctx, username); err != nil || existing.ID == "" {
+			return username, nil
+		}
+		suffix, err := security.GenerateOpaqueToken("")
+		if err != nil {
+			return "", err
+		}
+		username = base + "-" + strings.ToLower(usernameDisallowed.ReplaceAllString(suffix, ""))[:6]
+	}
+	return "", fmt.Errorf("%w: no free username", domain.ErrOIDCLoginFailed)
+}
@@ -0,0 +1,656 @@
+package usecases
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"g6/blog-api/Infrastructure/oauth"
+	"g6/blog-api/Infrastructure/security"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	stubClientID = "blog-client"
+	stubCode     = "authorization-code"
+	stubKeyID    = "stub-key"
+)
+
+// stubIssuer is a minimal OpenID Connect provider: discovery, JWKS and a token endpoint
+// that checks the PKCE verifier and returns an RS256 signed ID token
+type stubIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	// taken from the authorization URL, like a real provider would remember them
+	challenge string
+	nonce     string
+
+	// per test changes to the ID token
+	claims  jwt.MapClaims
+	signKey *rsa.PrivateKey
+}
+
+func newStubIssuer() *stubIssuer {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	issuer := &stubIssuer{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer.server.URL,
+			"authorization_endpoint": issuer.server.URL + "/authorize",
+			"token_endpoint":         issuer.server.URL + "/token",
+			"jwks_uri":               issuer.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": stubKeyID,
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", issuer.token)
+	issuer.server = httptest.NewServer(mux)
+	return issuer
+}
+
+func (i *stubIssuer) token(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if r.PostForm.Get("code") != stubCode || base64.RawURLEncoding.EncodeToString(sum[:]) != i.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss":            i.server.URL,
+		"aud":            stubClientID,
+		"sub":            "subject-1",
+		"email":          "jane@example.com",
+		"email_verified": true,
+		"given_name":     "Jane",
+		"family_name":    "Doe",
+		"nonce":          i.nonce,
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(5 * time.Minute).Unix(),
+	}
+	for name, value := range i.claims {
+		claims[name] = value
+	}
+	signKey := i.key
+	if i.signKey != nil {
+		signKey = i.signKey
+	}
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	idToken.Header["kid"] = stubKeyID
+	signed, _ := idToken.SignedString(signKey)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": "stub-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     signed,
+	})
+}
+
+type OIDCUsecaseSuite struct {
+	suite.Suite
+	issuer           *stubIssuer
+	mockStateRepo    *domain_mocks.MockIOIDCStateRepository
+	mockIdentityRepo *domain_mocks.MockILinkedIdentityRepository
+	mockUserRepo     *domain_mocks.MockIUserRepository
+	usecase          domain.IOIDCUsecase
+}
+
+func (s *OIDCUsecaseSuite) SetupTest() {
+	s.issuer = newStubIssuer()
+	s.mockStateRepo = domain_mocks.NewMockIOIDCStateRepository(s.T())
+	s.mockIdentityRepo = domain_mocks.NewMockILinkedIdentityRepository(s.T())
+	s.mockUserRepo = domain_mocks.NewMockIUserRepository(s.T())
+	provider := oauth.NewOIDCProvider(oauth.ProviderConfig{
+		Name:         "stub",
+		Issuer:       s.issuer.server.URL,
+		ClientID:     stubClientID,
+		ClientSecret: "stub-secret",
+		RedirectURL:  "http://localhost:8080/api/auth/oidc/stub/callback",
+	}, s.issuer.server.Client())
+	// a second provider at the same issuer, for accounts that confirm with one provider to link another
+	other := oauth.NewOIDCProvider(oauth.ProviderConfig{
+		Name:         "other",
+		Issuer:       s.issuer.server.URL,
+		ClientID:     stubClientID,
+		ClientSecret: "stub-secret",
+		RedirectURL:  "http://localhost:8080/api/auth/oidc/other/callback",
+	}, s.issuer.server.Client())
+	s.usecase = NewOIDCUsecase(map[string]domain.IOIDCProvider{"stub": provider, "other": other}, s.mockStateRepo, s.mockIdentityRepo, s.mockUserRepo, 5*time.Second)
+}
+
+func (s *OIDCUsecaseSuite) TearDownTest() {
+	s.issuer.server.Close()
+}
+
+func TestOIDCUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(OIDCUsecaseSuite))
+}
+
+// begin starts a login and hands the challenge and nonce from the URL to the stub issuer
+func (s *OIDCUsecaseSuite) begin() (*domain.OIDCLoginState, url.Values) {
+	return s.startedWith(func() (string, string, error) { return s.usecase.Begin("stub") })
+}
+
+func (s *OIDCUsecaseSuite) startedWith(start func() (string, string, error)) (*domain.OIDCLoginState, url.Values) {
+	var saved *domain.OIDCLoginState
+	s.mockStateRepo.On("Save", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*domain.OIDCLoginState)
+	}).Return(nil).Once()
+
+	authURL, state, err := start()
+	s.Require().NoError(err)
+	s.Require().Equal(saved.State, state)
+
+	parsed, err := url.Parse(authURL)
+	s.Require().NoError(err)
+	query := parsed.Query()
+	s.issuer.challenge = query.Get("code_challenge")
+	s.issuer.nonce = query.Get("nonce")
+	return saved, query
+}
+
+func (s *OIDCUsecaseSuite) complete(saved *domain.OIDCLoginState) (*domain.OIDCLoginResult, error) {
+	s.mockStateRepo.On("Consume", mock.Anything, saved.State).Return(saved, nil).Once()
+	return s.usecase.Complete("stub", saved.State, stubCode)
+}
+
+func (s *OIDCUsecaseSuite) TestBegin() {
+	s.Run("AuthorizationURL", func() {
+		saved, query := s.begin()
+
+		s.Equal("code", query.Get("response_type"))
+		s.Equal(stubClientID, query.Get("client_id"))
+		s.Equal("openid email profile", query.Get("scope"))
+		s.Equal(saved.State, query.Get("state"))
+		s.Equal(saved.Nonce, query.Get("nonce"))
+		s.Equal("S256", query.Get("code_challenge_method"))
+		s.NotEqual(saved.CodeVerifier, query.Get("code_challenge"))
+		s.Equal("stub", saved.Provider)
+		s.Empty(saved.LinkUserID)
+		s.WithinDuration(time.Now().Add(oidcStateLifetime), saved.ExpiresAt, time.Second)
+		s.resetMocks()
+	})
+
+	s.Run("UnknownProvider", func() {
+		_, _, err := s.usecase.Begin("nope")
+
+		s.Equal(domain.ErrOIDCProviderNotFound, err)
+		s.resetMocks()
+	})
+}
+
+func (s *OIDCUsecaseSuite) TestComplete() {
+	s.Run("RegistersNewUser", func() {
+		saved, _ := s.begin()
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(nil, domain.ErrIdentityNotFound)
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, "jane@example.com").Return(domain.User{}, errors.New("not found"))
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, "jane").Return(domain.User{}, errors.New("not found"))
+		s.mockUserRepo.On("CreateUser", mock.Anything, mock.MatchedBy(func(u *domain.User) bool {
+			return u.Email == "jane@example.com" && u.Username == "jane" && u.FirstName == "Jane" &&
+				u.IsVerified && u.Provider == "stub" && u.Role == domain.RoleUser && u.Password == ""
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*domain.User).ID = "1"
+		}).Return(nil)
+		s.mockIdentityRepo.On("Create", mock.Anything, mock.MatchedBy(func(i *domain.LinkedIdentity) bool {
+			return i.UserID == "1" && i.Provider == "stub" && i.Subject == "subject-1"
+		})).Return(nil)
+
+		result, err := s.complete(saved)
+
+		s.NoError(err)
+		s.True(result.Created)
+		s.Nil(result.Linked)
+		s.Equal("1", result.User.ID)
+		s.resetMocks()
+	})
+
+	s.Run("LinkedIdentityLogsIn", func() {
+		saved, _ := s.begin()
+		// the subject decides, the email at the provider may have changed or be unverified
+		s.issuer.claims = jwt.MapClaims{"email": "new@example.com", "email_verified": false}
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(&domain.LinkedIdentity{ID: "i1", UserID: "1"}, nil)
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1", Email: "jane@example.com"}, nil)
+		s.mockIdentityRepo.On("UpdateLastLogin", mock.Anything, "i1", mock.Anything).Return(nil)
+
+		result, err := s.complete(saved)
+
+		s.NoError(err)
+		s.False(result.Created)
+		s.Equal("1", result.User.ID)
+		s.mockUserRepo.AssertNotCalled(s.T(), "CreateUser", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("EmailBelongsToAnotherAccount", func() {
+		saved, _ := s.begin()
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(nil, domain.ErrIdentityNotFound)
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, "jane@example.com").Return(domain.User{ID: "1", Email: "jane@example.com", Provider: "manual"}, nil)
+
+		result, err := s.complete(saved)
+
+		s.Nil(result)
+		s.Equal(domain.ErrOIDCAccountExists, err)
+		s.mockIdentityRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("AccountCreatedByProviderIsLinked", func() {
+		saved, _ := s.begin()
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(nil, domain.ErrIdentityNotFound)
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, "jane@example.com").Return(domain.User{ID: "1", Email: "jane@example.com", Provider: "stub"}, nil)
+		s.mockIdentityRepo.On("Create", mock.Anything, mock.MatchedBy(func(i *domain.LinkedIdentity) bool {
+			return i.UserID == "1" && i.Subject == "subject-1"
+		})).Return(nil)
+
+		result, err := s.complete(saved)
+
+		s.NoError(err)
+		s.False(result.Created)
+		s.Equal("1", result.User.ID)
+		s.resetMocks()
+	})
+
+	s.Run("UsernameTaken", func() {
+		saved, _ := s.begin()
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(nil, domain.ErrIdentityNotFound)
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, "jane@example.com").Return(domain.User{}, errors.New("not found"))
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, "jane").Return(domain.User{ID: "2", Username: "jane"}, nil)
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, mock.Anything).Return(domain.User{}, errors.New("not found"))
+		s.mockUserRepo.On("CreateUser", mock.Anything, mock.MatchedBy(func(u *domain.User) bool {
+			return len(u.Username) == len("jane-")+6 && u.Username[:5] == "jane-"
+		})).Return(nil)
+		s.mockIdentityRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+		result, err := s.complete(saved)
+
+		s.NoError(err)
+		s.True(result.Created)
+		s.resetMocks()
+	})
+
+	s.Run("UnverifiedEmail", func() {
+		saved, _ := s.begin()
+		s.issuer.claims = jwt.MapClaims{"email_verified": false}
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(nil, domain.ErrIdentityNotFound)
+
+		result, err := s.complete(saved)
+
+		s.Nil(result)
+		s.Equal(domain.ErrOIDCEmailNotVerified, err)
+		s.resetMocks()
+	})
+
+	s.Run("NonceMismatch", func() {
+		saved, _ := s.begin()
+		s.issuer.claims = jwt.MapClaims{"nonce": "replayed-nonce"}
+
+		_, err := s.complete(saved)
+
+		s.ErrorIs(err, domain.ErrOIDCLoginFailed)
+		s.resetMocks()
+	})
+
+	s.Run("WrongAudience", func() {
+		saved, _ := s.begin()
+		s.issuer.claims = jwt.MapClaims{"aud": "another-client"}
+
+		_, err := s.complete(saved)
+
+		s.ErrorIs(err, domain.ErrOIDCLoginFailed)
+		s.resetMocks()
+	})
+
+	s.Run("WrongIssuer", func() {
+		saved, _ := s.begin()
+		s.issuer.claims = jwt.MapClaims{"iss": "https://evil.example.com"}
+
+		_, err := s.complete(saved)
+
+		s.ErrorIs(err, domain.ErrOIDCLoginFailed)
+		s.resetMocks()
+	})
+
+	s.Run("ExpiredIDToken", func() {
+		saved, _ := s.begin()
+		s.issuer.claims = jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}
+
+		_, err := s.complete(saved)
+
+		s.ErrorIs(err, domain.ErrOIDCLoginFailed)
+		s.resetMocks()
+	})
+
+	s.Run("ForgedSignature", func() {
+		saved, _ := s.begin()
+		s.issuer.signKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+
+		_, err := s.complete(saved)
+
+		s.ErrorIs(err, domain.ErrOIDCLoginFailed)
+		s.resetMocks()
+	})
+
+	s.Run("WrongCodeVerifier", func() {
+		saved, _ := s.begin()
+		saved.CodeVerifier = "not-the-verifier-the-challenge-was-made-from"
+
+		_, err := s.complete(saved)
+
+		s.ErrorIs(err, domain.ErrOIDCLoginFailed)
+		s.resetMocks()
+	})
+
+	s.Run("UnknownState", func() {
+		s.mockStateRepo.On("Consume", mock.Anything, "unknown").Return(nil, domain.ErrOIDCInvalidState)
+
+		_, err := s.usecase.Complete("stub", "unknown", stubCode)
+
+		s.Equal(domain.ErrOIDCInvalidState, err)
+		s.resetMocks()
+	})
+
+	s.Run("ExpiredState", func() {
+		saved, _ := s.begin()
+		saved.ExpiresAt = time.Now().Add(-time.Second)
+
+		_, err := s.complete(saved)
+
+		s.Equal(domain.ErrOIDCInvalidState, err)
+		s.resetMocks()
+	})
+
+	s.Run("StateFromAnotherProvider", func() {
+		saved, _ := s.begin()
+		saved.Provider = "google"
+
+		_, err := s.complete(saved)
+
+		s.Equal(domain.ErrOIDCInvalidState, err)
+		s.resetMocks()
+	})
+}
+
+func (s *OIDCUsecaseSuite) TestLinking() {
+	password, _ := security.HashPassword("password123")
+	user := &domain.User{ID: "1", Email: "jane@example.com", Password: password, Provider: "manual"}
+
+	s.Run("BeginLinkConfirmsPassword", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{}, nil)
+
+		saved, _ := s.startedWith(func() (string, string, error) { return s.usecase.BeginLink("1", "stub", "password123", "") })
+
+		s.Equal("1", saved.LinkUserID)
+		s.resetMocks()
+	})
+
+	s.Run("BeginLinkWrongPassword", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+
+		_, _, err := s.usecase.BeginLink("1", "stub", "wrong", "")
+
+		s.Equal(domain.ErrInvalidPassword, err)
+		s.mockStateRepo.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("BeginLinkWithoutPassword", func() {
+		// accounts created through the old Google login were stored with a hash of ""
+		emptyHash, _ := security.HashPassword("")
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1", Password: emptyHash}, nil)
+
+		_, _, err := s.usecase.BeginLink("1", "stub", "", "")
+
+		s.Equal(domain.ErrPasswordRequired, err)
+		s.resetMocks()
+	})
+
+	s.Run("BeginLinkAlreadyLinked", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{{Provider: "stub"}}, nil)
+
+		_, _, err := s.usecase.BeginLink("1", "stub", "password123", "")
+
+		s.Equal(domain.ErrIdentityAlreadyLinked, err)
+		s.resetMocks()
+	})
+
+	s.Run("CallbackLinksIdentity", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{}, nil)
+		saved, _ := s.startedWith(func() (string, string, error) { return s.usecase.BeginLink("1", "stub", "password123", "") })
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(nil, domain.ErrIdentityNotFound)
+		s.mockIdentityRepo.On("Create", mock.Anything, mock.MatchedBy(func(i *domain.LinkedIdentity) bool {
+			return i.UserID == "1" && i.Provider == "stub" && i.Subject == "subject-1"
+		})).Return(nil)
+
+		result, err := s.complete(saved)
+
+		s.NoError(err)
+		s.NotNil(result.Linked)
+		s.False(result.Created)
+		s.resetMocks()
+	})
+
+	s.Run("CallbackIdentityOwnedByAnotherUser", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{}, nil)
+		saved, _ := s.startedWith(func() (string, string, error) { return s.usecase.BeginLink("1", "stub", "password123", "") })
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(&domain.LinkedIdentity{UserID: "2"}, nil)
+
+		result, err := s.complete(saved)
+
+		s.Nil(result)
+		s.Equal(domain.ErrIdentityAlreadyLinked, err)
+		s.mockIdentityRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("Unlink", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{{Provider: "stub"}}, nil)
+		s.mockIdentityRepo.On("Delete", mock.Anything, "1", "stub").Return(nil)
+
+		s.NoError(s.usecase.Unlink("1", "stub", "password123"))
+		s.resetMocks()
+	})
+
+	s.Run("UnlinkWrongPassword", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{{Provider: "stub"}}, nil)
+
+		s.Equal(domain.ErrInvalidPassword, s.usecase.Unlink("1", "stub", "wrong"))
+		s.mockIdentityRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("UnlinkLastLoginMethod", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1"}, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{{Provider: "stub"}}, nil)
+
+		s.Equal(domain.ErrLastLoginMethod, s.usecase.Unlink("1", "stub", ""))
+		s.mockIdentityRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("UnlinkNotLinked", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{}, nil)
+
+		s.Equal(domain.ErrIdentityNotFound, s.usecase.Unlink("1", "stub", "password123"))
+		s.resetMocks()
+	})
+
+	// an account registered through providers has no password to confirm with
+	emptyHash, _ := security.HashPassword("")
+	providerOnly := &domain.User{ID: "1", Email: "jane@example.com", Password: emptyHash, Provider: "stub"}
+	providerIdentities := []*domain.LinkedIdentity{{UserID: "1", Provider: "stub"}, {UserID: "1", Provider: "google"}}
+
+	s.Run("UnlinkWithoutPassword", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(providerOnly, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return(providerIdentities, nil)
+
+		s.Equal(domain.ErrProviderConfirmation, s.usecase.Unlink("1", "google", ""))
+		s.mockIdentityRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("UnlinkConfirmedByRemainingProvider", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(providerOnly, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return(providerIdentities, nil)
+		saved, _ := s.startedWith(func() (string, string, error) { return s.usecase.BeginUnlink("1", "google", "stub") })
+		s.Equal("stub", saved.Provider)
+		s.Equal("1", saved.LinkUserID)
+		s.Equal("google", saved.UnlinkProvider)
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(&domain.LinkedIdentity{UserID: "1", Provider: "stub"}, nil)
+		s.mockIdentityRepo.On("Delete", mock.Anything, "1", "google").Return(nil)
+
+		result, err := s.complete(saved)
+
+		s.NoError(err)
+		s.Equal("google", result.Unlinked)
+		s.Nil(result.Linked)
+		s.resetMocks()
+	})
+
+	s.Run("UnlinkConfirmedByAnotherAccount", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(providerOnly, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return(providerIdentities, nil)
+		saved, _ := s.startedWith(func() (string, string, error) { return s.usecase.BeginUnlink("1", "google", "stub") })
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(&domain.LinkedIdentity{UserID: "2", Provider: "stub"}, nil)
+
+		result, err := s.complete(saved)
+
+		s.Nil(result)
+		s.Equal(domain.ErrProviderConfirmation, err)
+		s.mockIdentityRepo.AssertNotCalled(s.T(), "Delete", mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("LinkConfirmedByLinkedProvider", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(providerOnly, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{{UserID: "1", Provider: "stub"}}, nil)
+		confirm, _ := s.startedWith(func() (string, string, error) { return s.usecase.BeginLink("1", "other", "", "stub") })
+		s.Equal("stub", confirm.Provider)
+		s.Equal("1", confirm.LinkUserID)
+		s.Equal("other", confirm.LinkProvider)
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(&domain.LinkedIdentity{UserID: "1", Provider: "stub"}, nil)
+
+		// the confirming callback starts the login at the provider to link
+		link, _ := s.startedWith(func() (string, string, error) {
+			result, err := s.complete(confirm)
+			if err != nil {
+				return "", "", err
+			}
+			s.Nil(result.Linked)
+			return result.LinkURL, result.LinkState, nil
+		})
+		s.Equal("other", link.Provider)
+		s.Equal("1", link.LinkUserID)
+		s.Empty(link.LinkProvider)
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "other", "subject-1").Return(nil, domain.ErrIdentityNotFound)
+		s.mockIdentityRepo.On("Create", mock.Anything, mock.MatchedBy(func(i *domain.LinkedIdentity) bool {
+			return i.UserID == "1" && i.Provider == "other" && i.Subject == "subject-1"
+		})).Return(nil)
+		s.mockStateRepo.On("Consume", mock.Anything, link.State).Return(link, nil).Once()
+
+		result, err := s.usecase.Complete("other", link.State, stubCode)
+
+		s.NoError(err)
+		s.Equal("other", result.Linked.Provider)
+		s.resetMocks()
+	})
+
+	s.Run("LinkConfirmedByAnotherAccount", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(providerOnly, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{{UserID: "1", Provider: "stub"}}, nil)
+		confirm, _ := s.startedWith(func() (string, string, error) { return s.usecase.BeginLink("1", "other", "", "stub") })
+		s.mockIdentityRepo.On("FindByProviderSubject", mock.Anything, "stub", "subject-1").Return(&domain.LinkedIdentity{UserID: "2", Provider: "stub"}, nil)
+
+		result, err := s.complete(confirm)
+
+		s.Nil(result)
+		s.Equal(domain.ErrProviderConfirmation, err)
+		s.mockStateRepo.AssertNumberOfCalls(s.T(), "Save", 1)
+		s.resetMocks()
+	})
+
+	s.Run("BeginLinkConfirmWithUnlinkedProvider", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(providerOnly, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{{UserID: "1", Provider: "stub"}}, nil)
+
+		// neither a provider missing from the account nor the provider being linked can vouch for the user
+		_, _, err := s.usecase.BeginLink("1", "other", "", "other")
+		s.Equal(domain.ErrProviderConfirmation, err)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "2").Return([]*domain.LinkedIdentity{}, nil)
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "2").Return(&domain.User{ID: "2"}, nil)
+		_, _, err = s.usecase.BeginLink("2", "other", "", "stub")
+		s.Equal(domain.ErrProviderConfirmation, err)
+		s.mockStateRepo.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("BeginUnlinkWithTheUnlinkedProvider", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(providerOnly, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{{Provider: "stub"}, {Provider: "google"}}, nil)
+
+		_, _, err := s.usecase.BeginUnlink("1", "stub", "stub")
+
+		s.Equal(domain.ErrProviderConfirmation, err)
+		s.mockStateRepo.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("LoginMethods", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(user, nil)
+		s.mockIdentityRepo.On("FindByUserID", mock.Anything, "1").Return([]*domain.LinkedIdentity{{Provider: "stub"}}, nil)
+
+		methods, err := s.usecase.LoginMethods("1")
+
+		s.NoError(err)
+		s.True(methods.HasPassword)
+		s.Len(methods.Identities, 1)
+		s.resetMocks()
+	})
+}
+
+func (s *OIDCUsecaseSuite) resetMocks() {
+	s.mockStateRepo.ExpectedCalls = nil
+	s.mockStateRepo.Calls = nil
+	s.mockIdentityRepo.ExpectedCalls = nil
+	s.mockIdentityRepo.Calls = nil
+	s.mockUserRepo.ExpectedCalls = nil
+	s.mockUserRepo.Calls = nil
+	s.issuer.claims = nil
+	s.issuer.signKey = nil
+}
@@ -5,59 +5,75 @@ import (
 	"fmt"
 	domain "g6/blog-api/Domain"
 	"g6/blog-api/Infrastructure/security"
-	"math/rand"
 	"time"
 )
 
+// OTPPolicy is how long the codes of one purpose stay valid, how many may be sent per 24 hours
+// and how many wrong guesses a code survives
+type OTPPolicy struct {
+	Expiration        time.Duration
+	MaxAttempts       int
+	MaxFailedAttempts int
+}
+
+var DefaultOTPPolicy = OTPPolicy{
+	Expiration:        10 * time.Minute,
+	MaxAttempts:       5,
+	MaxFailedAttempts: 5,
+}
+
 type OTPUsecase struct {
-	OTPRepo            domain.IOTPRepository
-	EmailService       domain.IEmailService
-	ctxtimeout         time.Duration
-	otpExpiration      time.Duration
-	otpMaximumAttempts int
-	secretSalt         string
+	OTPRepo      domain.IOTPRepository
+	EmailService domain.IEmailService
+	ctxtimeout   time.Duration
+	policies     map[domain.OTPPurpose]OTPPolicy
+	secretSalt   string
 }
 
-func NewOTPUsecase(repo domain.IOTPRepository, emailService domain.IEmailService, timeout time.Duration, expiration time.Duration, maxAttempts int, secretSalt string) domain.IOTPUsecase {
+func NewOTPUsecase(repo domain.IOTPRepository, emailService domain.IEmailService, timeout time.Duration, policies map[domain.OTPPurpose]OTPPolicy, secretSalt string) domain.IOTPUsecase {
 	return &OTPUsecase{
-		OTPRepo:            repo,
-		ctxtimeout:         timeout,
-		EmailService:       emailService,
-		otpExpiration:      expiration,
-		otpMaximumAttempts: maxAttempts,
-		secretSalt:         secretSalt,
+		OTPRepo:      repo,
+		ctxtimeout:   timeout,
+		EmailService: emailService,
+		policies:     policies,
+		secretSalt:   secretSalt,
+	}
+}
+
+// policy returns the policy of a purpose, purposes without one cannot be used
+func (otpuc *OTPUsecase) policy(purpose domain.OTPPurpose) (OTPPolicy, error) {
+	policy, ok := otpuc.policies[purpose]
+	if !ok {
+		return OTPPolicy{}, domain.ErrOTPUnknownPurpose
 	}
+	return policy, nil
 }
 
 // RequestOTP
-func (otpuc *OTPUsecase) RequestOTP(email string) error {
+func (otpuc *OTPUsecase) RequestOTP(email string, purpose domain.OTPPurpose) error {
+	policy, err := otpuc.policy(purpose)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), otpuc.ctxtimeout)
 	defer cancel()
 
 	otp := &domain.OTP{
-		Email: email,
+		Email:   email,
+		Purpose: purpose,
 	}
-	code, otpExist, err := otpuc.generateRegistrationOTP(ctx, otp)
+	code, otpExist, err := otpuc.generateOTP(ctx, otp, policy)
 	if err != nil {
 		return err
 	}
 
 	// send otp for email
-	body := fmt.Sprintf(`
-		<html>
-		<body>
-			<div style="font-family: Arial, sans-serif; max-width: 600px; margin: 0 auto; padding: 20px; border: 1px solid #ddd; border-radius: 5px; background-color: #f9f9f9;">
-				<h2 style="color: #333;">Your OTP Code</h2>
-				<p>Use the following OTP code to complete your registration:</p>
-				<h1 style="color: #007BFF; text-align: center;">%s</h1>
-				<p>This code will expire in %d minutes.</p>
-				<p style="color: #666; text-align: center;">If you did not request this code, please ignore this email.</p>
-			</div>
-		</body>
-		</html>
-	`, code, int(otpuc.otpExpiration.Minutes()))
-
-	err = otpuc.EmailService.SendEmail(ctx, email, "Your OTP Code", body)
+	err = otpuc.EmailService.SendTemplate(ctx, email, domain.EmailTemplateOTP, domain.EmailTemplateData{
+		"Code":             code,
+		"Purpose":          string(purpose),
+		"ExpiresInMinutes": int(policy.Expiration.Minutes()),
+	})
 	if err != nil {
 		return fmt.Errorf("failed to send OTP email: %w", err)
 	}
@@ -76,13 +92,14 @@ func (otpuc *OTPUsecase) RequestOTP(email string) error {
 	return nil
 }
 
-// GenerateOTP generates a new OTP for the given email and purpose
-func (otpuc *OTPUsecase) generateRegistrationOTP(ctx context.Context, otp *domain.OTP) (string, bool, error) {
+// generateOTP generates a new OTP for the email and purpose of otp, replacing the pending one
+// once it expired or was guessed wrong too often
+func (otpuc *OTPUsecase) generateOTP(ctx context.Context, otp *domain.OTP, policy OTPPolicy) (string, bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, otpuc.ctxtimeout)
 	defer cancel()
 
 	// Check if the user already requested an OTP
-	existingOTP, err := otpuc.OTPRepo.FindOTPByEmail(ctx, otp.Email)
+	existingOTP, err := otpuc.OTPRepo.FindOTPByEmailAndPurpose(ctx, otp.Email, otp.Purpose)
 	if err != nil && err != domain.ErrOTPNotFound {
 		return "", existingOTP != nil, err
 	}
@@ -90,34 +107,38 @@ func (otpuc *OTPUsecase) generateRegistrationOTP(ctx context.Context, otp *domai
 	if existingOTP != nil {
 		otp.ID = existingOTP.ID
 		// Check if the OTP is still valid
-		if time.Now().Before(existingOTP.ExpiresAt) {
+		if time.Now().Before(existingOTP.ExpiresAt) && existingOTP.FailedAttempts < policy.MaxFailedAttempts {
 			return "", existingOTP != nil, domain.ErrOTPStillValid
 		}
 
-		// Check if the user has reached the maximum attempts
-		if existingOTP.Attempts >= otpuc.otpMaximumAttempts {
-			if time.Since(existingOTP.CreatedAt) < 24*time.Hour {
-				return "", existingOTP != nil, domain.ErrOTPMaxAttempts
-			}
-			// Reset attempts after 24 hours
+		// Reset attempts after 24 hours
+		if time.Since(existingOTP.CreatedAt) >= 24*time.Hour {
 			existingOTP.Attempts = 0
 		}
+		// Check if the user has reached the maximum attempts
+		if existingOTP.Attempts >= policy.MaxAttempts {
+			return "", existingOTP != nil, domain.ErrOTPMaxAttempts
+		}
 	}
 
+	code, err := security.GenerateOTPCode()
+	if err != nil {
+		return "", existingOTP != nil, err
+	}
 	// Set the expiration time for the new OTP
-	otpRandCode := fmt.Sprintf("%06d", rand.Intn(1000000))
-	code := otpRandCode + otpuc.secretSalt
-	otp.ExpiresAt = time.Now().Add(otpuc.otpExpiration)
-	otp.CreatedAt = time.Now()
-	otp.CodeHash = security.HashOTPCode(code)
+	otp.ExpiresAt = time.Now().Add(policy.Expiration)
+	otp.CodeHash = security.HashOTPCode(code, otpuc.secretSalt)
+	otp.FailedAttempts = 0
 
-	// Increment the attempts
-	if existingOTP != nil {
+	// Increment the attempts, the 24 hours are counted from the first code sent in them
+	if existingOTP != nil && existingOTP.Attempts > 0 {
 		otp.Attempts = existingOTP.Attempts + 1
+		otp.CreatedAt = existingOTP.CreatedAt
 	} else {
 		otp.Attempts = 1
+		otp.CreatedAt = time.Now()
 	}
-	return otpRandCode, existingOTP != nil, nil
+	return code, existingOTP != nil, nil
 }
 
 // delete by id
@@ -128,12 +149,12 @@ func (otpuc *OTPUsecase) DeleteByID(id string) error {
 	return otpuc.OTPRepo.DeleteOTPByID(ctx, id)
 }
 
-// find by email
-func (otpuc *OTPUsecase) findByEmail(ctx context.Context, email string) (*domain.OTP, error) {
+// find by email and purpose
+func (otpuc *OTPUsecase) findOTP(ctx context.Context, email string, purpose domain.OTPPurpose) (*domain.OTP, error) {
 	ctx, cancel := context.WithTimeout(ctx, otpuc.ctxtimeout)
 	defer cancel()
 
-	otp, err := otpuc.OTPRepo.FindOTPByEmail(ctx, email)
+	otp, err := otpuc.OTPRepo.FindOTPByEmailAndPurpose(ctx, email, purpose)
 	if err != nil {
 		return nil, err
 	}
@@ -145,26 +166,45 @@ func (otpuc *OTPUsecase) findByEmail(ctx context.Context, email string) (*domain
 	return otp, nil
 }
 
-// VerifyOTP verifies the OTP for the given email
-func (otpuc *OTPUsecase) VerifyOTP(email, code string) (*domain.OTP, error) {
+// VerifyOTP verifies the OTP sent to the email for the purpose and consumes it, a code redeems
+// once. A code that was guessed wrong MaxFailedAttempts times is refused even if correct, until a
+// new one is requested.
+func (otpuc *OTPUsecase) VerifyOTP(email string, purpose domain.OTPPurpose, code string) (*domain.OTP, error) {
+	policy, err := otpuc.policy(purpose)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), otpuc.ctxtimeout)
 	defer cancel()
 
-	otp, err := otpuc.findByEmail(ctx, email)
+	otp, err := otpuc.findOTP(ctx, email, purpose)
 	if err != nil {
 		return nil, domain.ErrOTPNotFound
 	}
 
 	if time.Now().After(otp.ExpiresAt) {
-		if err := otpuc.DeleteByID(otp.ID); err != nil {
+		if err := otpuc.OTPRepo.DeleteOTPByID(ctx, otp.ID); err != nil && err != domain.ErrOTPNotFound {
 			return nil, fmt.Errorf("failed to delete expired OTP: %w", err)
 		}
 		return nil, domain.ErrOTPExpired
 	}
 
-	if security.VerifyOTPCode(otp.CodeHash, code+otpuc.secretSalt) {
+	if otp.FailedAttempts >= policy.MaxFailedAttempts {
+		return nil, domain.ErrOTPMaxAttempts
+	}
+
+	if security.VerifyOTPCode(otp.CodeHash, code, otpuc.secretSalt) {
+		// only one of two requests redeeming the same code deletes it
+		if err := otpuc.OTPRepo.DeleteOTPByID(ctx, otp.ID); err != nil {
+			return nil, err
+		}
 		return otp, nil
 	}
 
+	otp.FailedAttempts++
+	if err := otpuc.OTPRepo.UpdateOTPByID(ctx, otp); err != nil {
+		return nil, err
+	}
 	return nil, domain.ErrOTPInvalidCode
 }
@@ -16,13 +16,16 @@ import (
 // OTPUsecaseSuite defines the test suite for OTPUsecase
 type OTPUsecaseSuite struct {
 	suite.Suite
-	mockOTPRepo   *domain_mocks.MockIOTPRepository
-	mockEmail     *domain_mocks.MockIEmailService
-	usecase       *OTPUsecase
-	timeout       time.Duration
-	otpExpiration time.Duration
-	maxAttempts   int
-	secretSalt    string
+	mockOTPRepo       *domain_mocks.MockIOTPRepository
+	mockEmail         *domain_mocks.MockIEmailService
+	usecase           *OTPUsecase
+	timeout           time.Duration
+	otpExpiration     time.Duration
+	maxAttempts       int
+	maxFailedAttempts int
+	secretSalt        string
+	purpose           domain.OTPPurpose
+	policy            OTPPolicy
 }
 
 func (s *OTPUsecaseSuite) SetupTest() {
@@ -31,14 +34,19 @@ func (s *OTPUsecaseSuite) SetupTest() {
 	s.timeout = 3 * time.Second
 	s.otpExpiration = 10 * time.Minute
 	s.maxAttempts = 5
+	s.maxFailedAttempts = 3
 	s.secretSalt = "test-salt"
+	s.purpose = domain.OTPPurposeEmailVerification
+	s.policy = OTPPolicy{Expiration: s.otpExpiration, MaxAttempts: s.maxAttempts, MaxFailedAttempts: s.maxFailedAttempts}
 	s.usecase = &OTPUsecase{
-		OTPRepo:            s.mockOTPRepo,
-		EmailService:       s.mockEmail,
-		ctxtimeout:         s.timeout,
-		otpExpiration:      s.otpExpiration,
-		otpMaximumAttempts: s.maxAttempts,
-		secretSalt:         s.secretSalt,
+		OTPRepo:      s.mockOTPRepo,
+		EmailService: s.mockEmail,
+		ctxtimeout:   s.timeout,
+		policies: map[domain.OTPPurpose]OTPPolicy{
+			domain.OTPPurposeEmailVerification: s.policy,
+			domain.OTPPurposeSensitiveAction:   {Expiration: 5 * time.Minute, MaxAttempts: 3, MaxFailedAttempts: 3},
+		},
+		secretSalt: s.secretSalt,
 	}
 }
 
@@ -49,8 +57,8 @@ func TestOTPUsecaseSuite(t *testing.T) {
 func (s *OTPUsecaseSuite) TestRequestOTP() {
 	s.Run("SuccessNewOTP", func() {
 		email := "test@example.com"
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(nil, domain.ErrOTPNotFound)
-		s.mockEmail.On("SendEmail", mock.Anything, email, "Your OTP Code", mock.Anything).Return(nil)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(nil, domain.ErrOTPNotFound)
+		s.mockEmail.On("SendTemplate", mock.Anything, email, domain.EmailTemplateOTP, mock.Anything).Return(nil)
 		s.mockOTPRepo.On("SaveOTP", mock.Anything, mock.MatchedBy(func(otp *domain.OTP) bool {
 			return otp.Email == email &&
 				otp.Attempts == 1 &&
@@ -58,7 +66,7 @@ func (s *OTPUsecaseSuite) TestRequestOTP() {
 				time.Until(otp.ExpiresAt) <= s.otpExpiration
 		})).Return(nil)
 
-		err := s.usecase.RequestOTP(email)
+		err := s.usecase.RequestOTP(email, s.purpose)
 
 		s.NoError(err)
 		s.resetMocks()
@@ -75,8 +83,8 @@ func (s *OTPUsecaseSuite) TestRequestOTP() {
 			Attempts:  1,
 			CreatedAt: time.Now().Add(-1 * time.Hour),
 		}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(existingOTP, nil)
-		s.mockEmail.On("SendEmail", mock.Anything, email, "Your OTP Code", mock.Anything).Return(nil)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(existingOTP, nil)
+		s.mockEmail.On("SendTemplate", mock.Anything, email, domain.EmailTemplateOTP, mock.Anything).Return(nil)
 		s.mockOTPRepo.On("UpdateOTPByID", mock.Anything, mock.MatchedBy(func(otp *domain.OTP) bool {
 			return otp.ID == existingOTP.ID &&
 				otp.Email == email &&
@@ -84,7 +92,7 @@ func (s *OTPUsecaseSuite) TestRequestOTP() {
 				!otp.CreatedAt.IsZero() &&
 				time.Until(otp.ExpiresAt) <= s.otpExpiration
 		})).Return(nil)
-		err := s.usecase.RequestOTP(email)
+		err := s.usecase.RequestOTP(email, s.purpose)
 
 		s.NoError(err)
 		s.resetMocks()
@@ -100,9 +108,9 @@ func (s *OTPUsecaseSuite) TestRequestOTP() {
 			Attempts:  1,
 			CreatedAt: time.Now(),
 		}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(existingOTP, nil)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(existingOTP, nil)
 
-		err := s.usecase.RequestOTP(email)
+		err := s.usecase.RequestOTP(email, s.purpose)
 
 		s.Error(err)
 		s.Equal(domain.ErrOTPStillValid, err)
@@ -119,9 +127,9 @@ func (s *OTPUsecaseSuite) TestRequestOTP() {
 			Attempts:  s.maxAttempts,
 			CreatedAt: time.Now().Add(-2 * time.Hour),
 		}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(existingOTP, nil)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(existingOTP, nil)
 
-		err := s.usecase.RequestOTP(email)
+		err := s.usecase.RequestOTP(email, s.purpose)
 
 		s.Error(err)
 		s.Equal(domain.ErrOTPMaxAttempts, err)
@@ -130,9 +138,9 @@ func (s *OTPUsecaseSuite) TestRequestOTP() {
 
 	s.Run("FindOTPError", func() {
 		email := "test@example.com"
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(nil, errors.New("find failed"))
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(nil, errors.New("find failed"))
 
-		err := s.usecase.RequestOTP(email)
+		err := s.usecase.RequestOTP(email, s.purpose)
 
 		s.Error(err)
 		s.Equal("find failed", err.Error())
@@ -141,10 +149,10 @@ func (s *OTPUsecaseSuite) TestRequestOTP() {
 
 	s.Run("SaveOTPError", func() {
 		email := "test@example.com"
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(nil, domain.ErrOTPNotFound)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(nil, domain.ErrOTPNotFound)
 		s.mockOTPRepo.On("SaveOTP", mock.Anything, mock.Anything).Return(errors.New("save failed"))
-		s.mockEmail.On("SendEmail", mock.Anything, email, "Your OTP Code", mock.Anything).Return(nil)
-		err := s.usecase.RequestOTP(email)
+		s.mockEmail.On("SendTemplate", mock.Anything, email, domain.EmailTemplateOTP, mock.Anything).Return(nil)
+		err := s.usecase.RequestOTP(email, s.purpose)
 
 		s.Error(err)
 		s.Contains(err.Error(), "failed to save OTP: save failed")
@@ -161,10 +169,10 @@ func (s *OTPUsecaseSuite) TestRequestOTP() {
 			Attempts:  1,
 			CreatedAt: time.Now().Add(-1 * time.Hour),
 		}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(existingOTP, nil)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(existingOTP, nil)
 		s.mockOTPRepo.On("UpdateOTPByID", mock.Anything, mock.Anything).Return(errors.New("update failed"))
-		s.mockEmail.On("SendEmail", mock.Anything, email, "Your OTP Code", mock.Anything).Return(nil)
-		err := s.usecase.RequestOTP(email)
+		s.mockEmail.On("SendTemplate", mock.Anything, email, domain.EmailTemplateOTP, mock.Anything).Return(nil)
+		err := s.usecase.RequestOTP(email, s.purpose)
 
 		s.Error(err)
 		s.Equal("update failed", err.Error())
@@ -173,23 +181,47 @@ func (s *OTPUsecaseSuite) TestRequestOTP() {
 
 	s.Run("SendEmailError", func() {
 		email := "test@example.com"
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(nil, domain.ErrOTPNotFound)
-		s.mockEmail.On("SendEmail", mock.Anything, email, "Your OTP Code", mock.Anything).Return(errors.New("email failed"))
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(nil, domain.ErrOTPNotFound)
+		s.mockEmail.On("SendTemplate", mock.Anything, email, domain.EmailTemplateOTP, mock.Anything).Return(errors.New("email failed"))
 		s.mockOTPRepo.On("SaveOTP", mock.Anything, mock.Anything).Return(nil)
-		err := s.usecase.RequestOTP(email)
+		err := s.usecase.RequestOTP(email, s.purpose)
 
 		s.Error(err)
 		s.Contains(err.Error(), "failed to send OTP email: email failed")
 		s.resetMocks()
 	})
 
+	s.Run("ScopedToPurpose", func() {
+		email := "test@example.com"
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, domain.OTPPurposeSensitiveAction).Return(nil, domain.ErrOTPNotFound)
+		s.mockEmail.On("SendTemplate", mock.Anything, email, domain.EmailTemplateOTP, mock.MatchedBy(func(data domain.EmailTemplateData) bool {
+			return data["Purpose"] == "sensitive_action" && data["ExpiresInMinutes"] == 5 && len(data["Code"].(string)) == 6
+		})).Return(nil)
+		s.mockOTPRepo.On("SaveOTP", mock.Anything, mock.MatchedBy(func(otp *domain.OTP) bool {
+			return otp.Purpose == domain.OTPPurposeSensitiveAction && time.Until(otp.ExpiresAt) <= 5*time.Minute
+		})).Return(nil)
+
+		err := s.usecase.RequestOTP(email, domain.OTPPurposeSensitiveAction)
+
+		s.NoError(err)
+		s.resetMocks()
+	})
+
+	s.Run("UnknownPurpose", func() {
+		err := s.usecase.RequestOTP("test@example.com", domain.OTPPurposeLogin)
+
+		s.Equal(domain.ErrOTPUnknownPurpose, err)
+		s.mockOTPRepo.AssertNotCalled(s.T(), "FindOTPByEmailAndPurpose", mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
 	s.Run("ContextTimeout", func() {
 		email := "test@example.com"
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Run(func(args mock.Arguments) {
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Run(func(args mock.Arguments) {
 			time.Sleep(4 * time.Second) // Exceed the 3-second timeout
 		}).Return(nil, errors.New("context deadline exceeded"))
 
-		err := s.usecase.RequestOTP(email)
+		err := s.usecase.RequestOTP(email, s.purpose)
 
 		s.Error(err)
 		s.Contains(err.Error(), "context deadline exceeded")
@@ -197,18 +229,18 @@ func (s *OTPUsecaseSuite) TestRequestOTP() {
 	})
 }
 
-func (s *OTPUsecaseSuite) TestGenerateRegistrationOTP() {
+func (s *OTPUsecaseSuite) TestGenerateOTP() {
 	s.Run("SuccessNewOTP", func() {
 		email := "test@example.com"
-		otp := &domain.OTP{Email: email}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(nil, domain.ErrOTPNotFound)
-		code, otpExist, err := s.usecase.generateRegistrationOTP(context.Background(), otp)
+		otp := &domain.OTP{Email: email, Purpose: s.purpose}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(nil, domain.ErrOTPNotFound)
+		code, otpExist, err := s.usecase.generateOTP(context.Background(), otp, s.policy)
 
 		s.NoError(err)
 		s.Equal(6, len(code))
 		s.False(otpExist)
 		s.Equal(email, otp.Email)
-		s.Equal(security.HashOTPCode(code+s.secretSalt), otp.CodeHash)
+		s.Equal(security.HashOTPCode(code, s.secretSalt), otp.CodeHash)
 		s.Equal(1, otp.Attempts)
 		s.False(otp.CreatedAt.IsZero())
 		s.True(time.Until(otp.ExpiresAt) <= s.otpExpiration)
@@ -225,18 +257,18 @@ func (s *OTPUsecaseSuite) TestGenerateRegistrationOTP() {
 			Attempts:  1,
 			CreatedAt: time.Now().Add(-1 * time.Hour),
 		}
-		otp := &domain.OTP{Email: email}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(existingOTP, nil)
-		code, otpExist, err := s.usecase.generateRegistrationOTP(context.Background(), otp)
+		otp := &domain.OTP{Email: email, Purpose: s.purpose}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(existingOTP, nil)
+		code, otpExist, err := s.usecase.generateOTP(context.Background(), otp, s.policy)
 
 		s.NoError(err)
 		s.Equal(6, len(code))
 		s.True(otpExist)
 		s.Equal(existingOTP.ID, otp.ID)
 		s.Equal(email, otp.Email)
-		s.Equal(security.HashOTPCode(code+s.secretSalt), otp.CodeHash)
+		s.Equal(security.HashOTPCode(code, s.secretSalt), otp.CodeHash)
 		s.Equal(2, otp.Attempts)
-		s.False(otp.CreatedAt.IsZero())
+		s.Equal(existingOTP.CreatedAt, otp.CreatedAt, "the 24 hours run from the first code")
 		s.True(time.Until(otp.ExpiresAt) <= s.otpExpiration)
 		s.resetMocks()
 	})
@@ -251,10 +283,10 @@ func (s *OTPUsecaseSuite) TestGenerateRegistrationOTP() {
 			Attempts:  1,
 			CreatedAt: time.Now(),
 		}
-		otp := &domain.OTP{Email: email}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(existingOTP, nil)
+		otp := &domain.OTP{Email: email, Purpose: s.purpose}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(existingOTP, nil)
 
-		code, otpExist, err := s.usecase.generateRegistrationOTP(context.Background(), otp)
+		code, otpExist, err := s.usecase.generateOTP(context.Background(), otp, s.policy)
 
 		s.Error(err)
 		s.Equal(domain.ErrOTPStillValid, err)
@@ -273,10 +305,10 @@ func (s *OTPUsecaseSuite) TestGenerateRegistrationOTP() {
 			Attempts:  s.maxAttempts,
 			CreatedAt: time.Now().Add(-2 * time.Hour),
 		}
-		otp := &domain.OTP{Email: email}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(existingOTP, nil)
+		otp := &domain.OTP{Email: email, Purpose: s.purpose}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(existingOTP, nil)
 
-		code, otpExist, err := s.usecase.generateRegistrationOTP(context.Background(), otp)
+		code, otpExist, err := s.usecase.generateOTP(context.Background(), otp, s.policy)
 
 		s.Error(err)
 		s.Equal(domain.ErrOTPMaxAttempts, err)
@@ -285,6 +317,31 @@ func (s *OTPUsecaseSuite) TestGenerateRegistrationOTP() {
 		s.resetMocks()
 	})
 
+	s.Run("ReplacesGuessedOutOTP", func() {
+		email := "test@example.com"
+		existingOTP := &domain.OTP{
+			ID:             "otp1",
+			Email:          email,
+			Purpose:        s.purpose,
+			CodeHash:       "hashed-code",
+			ExpiresAt:      time.Now().Add(5 * time.Minute),
+			Attempts:       1,
+			FailedAttempts: s.maxFailedAttempts,
+			CreatedAt:      time.Now(),
+		}
+		otp := &domain.OTP{Email: email, Purpose: s.purpose}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(existingOTP, nil)
+
+		code, otpExist, err := s.usecase.generateOTP(context.Background(), otp, s.policy)
+
+		s.NoError(err)
+		s.Equal(6, len(code))
+		s.True(otpExist)
+		s.Equal(2, otp.Attempts)
+		s.Equal(0, otp.FailedAttempts)
+		s.resetMocks()
+	})
+
 	s.Run("ResetAttemptsAfter24Hours", func() {
 		email := "test@example.com"
 		existingOTP := &domain.OTP{
@@ -295,28 +352,49 @@ func (s *OTPUsecaseSuite) TestGenerateRegistrationOTP() {
 			Attempts:  s.maxAttempts,
 			CreatedAt: time.Now().Add(-25 * time.Hour),
 		}
-		otp := &domain.OTP{Email: email}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(existingOTP, nil)
-		code, otpExist, err := s.usecase.generateRegistrationOTP(context.Background(), otp)
+		otp := &domain.OTP{Email: email, Purpose: s.purpose}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(existingOTP, nil)
+		code, otpExist, err := s.usecase.generateOTP(context.Background(), otp, s.policy)
 
 		s.NoError(err)
 		s.Equal(6, len(code))
 		s.True(otpExist)
 		s.Equal(existingOTP.ID, otp.ID)
 		s.Equal(email, otp.Email)
-		s.Equal(security.HashOTPCode(code+s.secretSalt), otp.CodeHash)
+		s.Equal(security.HashOTPCode(code, s.secretSalt), otp.CodeHash)
 		s.Equal(1, otp.Attempts)
-		s.False(otp.CreatedAt.IsZero())
+		s.WithinDuration(time.Now(), otp.CreatedAt, time.Second)
 		s.True(time.Until(otp.ExpiresAt) <= s.otpExpiration)
 		s.resetMocks()
 	})
 
+	s.Run("SendLimitIsNotTheGuessLimit", func() {
+		email := "test@example.com"
+		existingOTP := &domain.OTP{
+			ID:             "otp1",
+			Email:          email,
+			CodeHash:       "hashed-code",
+			ExpiresAt:      time.Now().Add(5 * time.Minute),
+			Attempts:       s.maxFailedAttempts,
+			FailedAttempts: s.maxFailedAttempts,
+			CreatedAt:      time.Now().Add(-time.Hour),
+		}
+		otp := &domain.OTP{Email: email, Purpose: s.purpose}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(existingOTP, nil)
+
+		_, _, err := s.usecase.generateOTP(context.Background(), otp, s.policy)
+
+		s.NoError(err, "three sends are below the five allowed")
+		s.Equal(s.maxFailedAttempts+1, otp.Attempts)
+		s.resetMocks()
+	})
+
 	s.Run("FindOTPError", func() {
 		email := "test@example.com"
-		otp := &domain.OTP{Email: email}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(nil, errors.New("find failed"))
+		otp := &domain.OTP{Email: email, Purpose: s.purpose}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(nil, errors.New("find failed"))
 
-		code, otpExist, err := s.usecase.generateRegistrationOTP(context.Background(), otp)
+		code, otpExist, err := s.usecase.generateOTP(context.Background(), otp, s.policy)
 
 		s.Error(err)
 		s.Equal("find failed", err.Error())
@@ -327,12 +405,12 @@ func (s *OTPUsecaseSuite) TestGenerateRegistrationOTP() {
 
 	s.Run("ContextTimeout", func() {
 		email := "test@example.com"
-		otp := &domain.OTP{Email: email}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Run(func(args mock.Arguments) {
+		otp := &domain.OTP{Email: email, Purpose: s.purpose}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Run(func(args mock.Arguments) {
 			time.Sleep(4 * time.Second) // Exceed the 3-second timeout
 		}).Return(nil, errors.New("context deadline exceeded"))
 
-		code, otpExist, err := s.usecase.generateRegistrationOTP(context.Background(), otp)
+		code, otpExist, err := s.usecase.generateOTP(context.Background(), otp, s.policy)
 
 		s.Error(err)
 		s.Contains(err.Error(), "context deadline exceeded")
@@ -378,7 +456,7 @@ func (s *OTPUsecaseSuite) TestDeleteByID() {
 	})
 }
 
-func (s *OTPUsecaseSuite) TestFindByEmail() {
+func (s *OTPUsecaseSuite) TestFindOTP() {
 	s.Run("Success", func() {
 		email := "test@example.com"
 		otp := &domain.OTP{
@@ -389,9 +467,9 @@ func (s *OTPUsecaseSuite) TestFindByEmail() {
 			Attempts:  1,
 			CreatedAt: time.Now(),
 		}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(otp, nil)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(otp, nil)
 
-		result, err := s.usecase.findByEmail(context.Background(), email)
+		result, err := s.usecase.findOTP(context.Background(), email, s.purpose)
 
 		s.NoError(err)
 		s.Equal(otp, result)
@@ -400,9 +478,9 @@ func (s *OTPUsecaseSuite) TestFindByEmail() {
 
 	s.Run("NotFound", func() {
 		email := "test@example.com"
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(nil, domain.ErrOTPNotFound)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(nil, domain.ErrOTPNotFound)
 
-		result, err := s.usecase.findByEmail(context.Background(), email)
+		result, err := s.usecase.findOTP(context.Background(), email, s.purpose)
 
 		s.Error(err)
 		s.Nil(result)
@@ -412,9 +490,9 @@ func (s *OTPUsecaseSuite) TestFindByEmail() {
 
 	s.Run("FindError", func() {
 		email := "test@example.com"
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(nil, errors.New("find failed"))
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(nil, errors.New("find failed"))
 
-		result, err := s.usecase.findByEmail(context.Background(), email)
+		result, err := s.usecase.findOTP(context.Background(), email, s.purpose)
 
 		s.Error(err)
 		s.Nil(result)
@@ -424,11 +502,11 @@ func (s *OTPUsecaseSuite) TestFindByEmail() {
 
 	s.Run("ContextTimeout", func() {
 		email := "test@example.com"
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Run(func(args mock.Arguments) {
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Run(func(args mock.Arguments) {
 			time.Sleep(4 * time.Second) // Exceed the 3-second timeout
 		}).Return(nil, errors.New("context deadline exceeded"))
 
-		result, err := s.usecase.findByEmail(context.Background(), email)
+		result, err := s.usecase.findOTP(context.Background(), email, s.purpose)
 
 		s.Error(err)
 		s.Nil(result)
@@ -441,7 +519,7 @@ func (s *OTPUsecaseSuite) TestVerifyOTP() {
 	s.Run("Success", func() {
 		email := "test@example.com"
 		code := "123456"
-		hashedCode := security.HashOTPCode(code + s.secretSalt)
+		hashedCode := security.HashOTPCode(code, s.secretSalt)
 		otp := &domain.OTP{
 			ID:        "otp1",
 			Email:     email,
@@ -450,20 +528,43 @@ func (s *OTPUsecaseSuite) TestVerifyOTP() {
 			Attempts:  1,
 			CreatedAt: time.Now(),
 		}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(otp, nil)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(otp, nil)
+		s.mockOTPRepo.On("DeleteOTPByID", mock.Anything, otp.ID).Return(nil).Once()
 
-		result, err := s.usecase.VerifyOTP(email, code)
+		result, err := s.usecase.VerifyOTP(email, s.purpose, code)
 
 		s.NoError(err)
 		s.Equal(otp, result)
 		s.resetMocks()
 	})
 
+	s.Run("AlreadyRedeemed", func() {
+		email := "test@example.com"
+		code := "123456"
+		otp := &domain.OTP{
+			ID:        "otp1",
+			Email:     email,
+			CodeHash:  security.HashOTPCode(code, s.secretSalt),
+			ExpiresAt: time.Now().Add(5 * time.Minute),
+			Attempts:  1,
+			CreatedAt: time.Now(),
+		}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(otp, nil)
+		// a concurrent request deleted it first
+		s.mockOTPRepo.On("DeleteOTPByID", mock.Anything, otp.ID).Return(domain.ErrOTPNotFound)
+
+		result, err := s.usecase.VerifyOTP(email, s.purpose, code)
+
+		s.Nil(result)
+		s.Equal(domain.ErrOTPNotFound, err)
+		s.resetMocks()
+	})
+
 	s.Run("NotFound", func() {
 		email := "test@example.com"
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(nil, domain.ErrOTPNotFound)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(nil, domain.ErrOTPNotFound)
 
-		result, err := s.usecase.VerifyOTP(email, "123456")
+		result, err := s.usecase.VerifyOTP(email, s.purpose, "123456")
 
 		s.Error(err)
 		s.Nil(result)
@@ -474,7 +575,7 @@ func (s *OTPUsecaseSuite) TestVerifyOTP() {
 	s.Run("ExpiredOTP", func() {
 		email := "test@example.com"
 		code := "123456"
-		hashedCode := security.HashOTPCode(code + s.secretSalt)
+		hashedCode := security.HashOTPCode(code, s.secretSalt)
 		otp := &domain.OTP{
 			ID:        "otp1",
 			Email:     email,
@@ -483,10 +584,10 @@ func (s *OTPUsecaseSuite) TestVerifyOTP() {
 			Attempts:  1,
 			CreatedAt: time.Now(),
 		}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(otp, nil)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(otp, nil)
 		s.mockOTPRepo.On("DeleteOTPByID", mock.Anything, otp.ID).Return(nil)
 
-		result, err := s.usecase.VerifyOTP(email, code)
+		result, err := s.usecase.VerifyOTP(email, s.purpose, code)
 
 		s.Error(err)
 		s.Nil(result)
@@ -497,7 +598,7 @@ func (s *OTPUsecaseSuite) TestVerifyOTP() {
 	s.Run("InvalidCode", func() {
 		email := "test@example.com"
 		code := "123456"
-		hashedCode := security.HashOTPCode("654321" + s.secretSalt)
+		hashedCode := security.HashOTPCode("654321", s.secretSalt)
 		otp := &domain.OTP{
 			ID:        "otp1",
 			Email:     email,
@@ -506,9 +607,12 @@ func (s *OTPUsecaseSuite) TestVerifyOTP() {
 			Attempts:  1,
 			CreatedAt: time.Now(),
 		}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(otp, nil)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(otp, nil)
+		s.mockOTPRepo.On("UpdateOTPByID", mock.Anything, mock.MatchedBy(func(otp *domain.OTP) bool {
+			return otp.ID == "otp1" && otp.FailedAttempts == 1
+		})).Return(nil)
 
-		result, err := s.usecase.VerifyOTP(email, code)
+		result, err := s.usecase.VerifyOTP(email, s.purpose, code)
 
 		s.Error(err)
 		s.Nil(result)
@@ -516,10 +620,65 @@ func (s *OTPUsecaseSuite) TestVerifyOTP() {
 		s.resetMocks()
 	})
 
+	s.Run("TooManyFailedAttempts", func() {
+		email := "test@example.com"
+		code := "123456"
+		otp := &domain.OTP{
+			ID:             "otp1",
+			Email:          email,
+			Purpose:        s.purpose,
+			CodeHash:       security.HashOTPCode(code, s.secretSalt),
+			ExpiresAt:      time.Now().Add(5 * time.Minute),
+			Attempts:       1,
+			FailedAttempts: s.maxFailedAttempts,
+			CreatedAt:      time.Now(),
+		}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(otp, nil)
+
+		result, err := s.usecase.VerifyOTP(email, s.purpose, code)
+
+		s.Nil(result)
+		s.Equal(domain.ErrOTPMaxAttempts, err, "even the right code is refused")
+		s.resetMocks()
+	})
+
+	s.Run("OtherPurposeNotFound", func() {
+		email := "test@example.com"
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, domain.OTPPurposeSensitiveAction).Return(nil, domain.ErrOTPNotFound)
+
+		result, err := s.usecase.VerifyOTP(email, domain.OTPPurposeSensitiveAction, "123456")
+
+		s.Nil(result)
+		s.Equal(domain.ErrOTPNotFound, err)
+		s.resetMocks()
+	})
+
+	s.Run("WrongSalt", func() {
+		email := "test@example.com"
+		code := "123456"
+		otp := &domain.OTP{
+			ID:        "otp1",
+			Email:     email,
+			Purpose:   s.purpose,
+			CodeHash:  security.HashOTPCode(code, "other-salt"),
+			ExpiresAt: time.Now().Add(5 * time.Minute),
+			Attempts:  1,
+			CreatedAt: time.Now(),
+		}
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(otp, nil)
+		s.mockOTPRepo.On("UpdateOTPByID", mock.Anything, mock.Anything).Return(nil)
+
+		result, err := s.usecase.VerifyOTP(email, s.purpose, code)
+
+		s.Nil(result)
+		s.Equal(domain.ErrOTPInvalidCode, err)
+		s.resetMocks()
+	})
+
 	s.Run("DeleteExpiredOTPError", func() {
 		email := "test@example.com"
 		code := "123456"
-		hashedCode := security.HashOTPCode(code + s.secretSalt)
+		hashedCode := security.HashOTPCode(code, s.secretSalt)
 		otp := &domain.OTP{
 			ID:        "otp1",
 			Email:     email,
@@ -528,10 +687,10 @@ func (s *OTPUsecaseSuite) TestVerifyOTP() {
 			Attempts:  1,
 			CreatedAt: time.Now(),
 		}
-		s.mockOTPRepo.On("FindOTPByEmail", mock.Anything, email).Return(otp, nil)
+		s.mockOTPRepo.On("FindOTPByEmailAndPurpose", mock.Anything, email, s.purpose).Return(otp, nil)
 		s.mockOTPRepo.On("DeleteOTPByID", mock.Anything, otp.ID).Return(errors.New("delete failed"))
 
-		result, err := s.usecase.VerifyOTP(email, code)
+		result, err := s.usecase.VerifyOTP(email, s.purpose, code)
 
 		s.Error(err)
 		s.Nil(result)
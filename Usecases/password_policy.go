@@ -0,0 +1,143 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/security"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordRules configure the password policy. Zero lengths fall back to DefaultPasswordRules,
+// a zero History turns the reuse check off.
+type PasswordRules struct {
+	MinLength        int
+	MaxLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	History          int // how many previous passwords may not be reused, the current one included
+}
+
+var DefaultPasswordRules = PasswordRules{
+	MinLength: 8,
+	MaxLength: 64,
+}
+
+type PasswordPolicy struct {
+	rules       PasswordRules
+	historyRepo domain.IPasswordHistoryRepository
+	breached    domain.IBreachedPasswordChecker
+}
+
+// NewPasswordPolicy builds the policy, breached may be nil when no breach list is configured
+func NewPasswordPolicy(rules PasswordRules, historyRepo domain.IPasswordHistoryRepository, breached domain.IBreachedPasswordChecker) domain.IPasswordPolicy {
+	if rules.MinLength <= 0 {
+		rules.MinLength = DefaultPasswordRules.MinLength
+	}
+	if rules.MaxLength <= 0 {
+		rules.MaxLength = DefaultPasswordRules.MaxLength
+	}
+	if rules.MaxLength < rules.MinLength {
+		rules.MaxLength = rules.MinLength
+	}
+	return &PasswordPolicy{
+		rules:       rules,
+		historyRepo: historyRepo,
+		breached:    breached,
+	}
+}
+
+func (p *PasswordPolicy) Validate(ctx context.Context, user *domain.User, password string) error {
+	var violations []domain.PasswordViolation
+	fail := func(rule domain.PasswordRule, message string) {
+		violations = append(violations, domain.PasswordViolation{Rule: rule, Message: message})
+	}
+
+	length := utf8.RuneCountInString(password)
+	if length < p.rules.MinLength {
+		fail(domain.PasswordRuleMinLength, fmt.Sprintf("must be at least %d characters long", p.rules.MinLength))
+	}
+	if length > p.rules.MaxLength {
+		fail(domain.PasswordRuleMaxLength, fmt.Sprintf("must be at most %d characters long", p.rules.MaxLength))
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	if p.rules.RequireUppercase && !upper {
+		fail(domain.PasswordRuleUppercase, "must contain an uppercase letter")
+	}
+	if p.rules.RequireLowercase && !lower {
+		fail(domain.PasswordRuleLowercase, "must contain a lowercase letter")
+	}
+	if p.rules.RequireDigit && !digit {
+		fail(domain.PasswordRuleDigit, "must contain a digit")
+	}
+	if p.rules.RequireSymbol && !symbol {
+		fail(domain.PasswordRuleSymbol, "must contain a symbol")
+	}
+
+	if p.breached != nil {
+		// the list is local, a failing lookup must not block users from setting a password
+		if found, err := p.breached.IsBreached(password); err == nil && found {
+			fail(domain.PasswordRuleNotBreached, "appears in a known data breach, choose another one")
+		}
+	}
+
+	if user != nil && user.ID != "" && p.rules.History > 0 {
+		reused, err := p.reused(ctx, user, password)
+		if err != nil {
+			return err
+		}
+		if reused {
+			fail(domain.PasswordRuleNotReused, fmt.Sprintf("must differ from your last %d passwords", p.rules.History))
+		}
+	}
+
+	if len(violations) > 0 {
+		return &domain.PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+// reused compares with the current hash too, accounts created before the history was kept have no entries
+func (p *PasswordPolicy) reused(ctx context.Context, user *domain.User, password string) (bool, error) {
+	if user.Password != "" && security.ValidatePassword(user.Password, password) == nil {
+		return true, nil
+	}
+	entries, err := p.historyRepo.FindRecent(ctx, user.ID, p.rules.History)
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range entries {
+		if security.ValidatePassword(entry.PasswordHash, password) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (p *PasswordPolicy) Remember(ctx context.Context, userID, passwordHash string) error {
+	if p.rules.History <= 0 || userID == "" {
+		return nil
+	}
+	return p.historyRepo.Create(ctx, &domain.PasswordHistory{
+		UserID:       userID,
+		PasswordHash: passwordHash,
+		CreatedAt:    time.Now(),
+	})
+}
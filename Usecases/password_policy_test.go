@@ -0,0 +1,166 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"g6/blog-api/Infrastructure/security"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PasswordPolicySuite struct {
+	suite.Suite
+	mockHistoryRepo *domain_mocks.MockIPasswordHistoryRepository
+	mockBreached    *domain_mocks.MockIBreachedPasswordChecker
+	policy          domain.IPasswordPolicy
+}
+
+func (s *PasswordPolicySuite) SetupTest() {
+	s.mockHistoryRepo = domain_mocks.NewMockIPasswordHistoryRepository(s.T())
+	s.mockBreached = domain_mocks.NewMockIBreachedPasswordChecker(s.T())
+	s.policy = NewPasswordPolicy(PasswordRules{
+		MinLength:        10,
+		MaxLength:        20,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+		History:          3,
+	}, s.mockHistoryRepo, s.mockBreached)
+}
+
+func TestPasswordPolicySuite(t *testing.T) {
+	suite.Run(t, new(PasswordPolicySuite))
+}
+
+func (s *PasswordPolicySuite) rules(err error) []domain.PasswordRule {
+	var policyErr *domain.PasswordPolicyError
+	s.Require().ErrorAs(err, &policyErr)
+	rules := make([]domain.PasswordRule, 0, len(policyErr.Violations))
+	for _, violation := range policyErr.Violations {
+		rules = append(rules, violation.Rule)
+	}
+	return rules
+}
+
+func (s *PasswordPolicySuite) TestValidate() {
+	s.Run("Accepted", func() {
+		s.mockBreached.On("IsBreached", "Tr1cky-Horse!").Return(false, nil)
+
+		err := s.policy.Validate(context.Background(), nil, "Tr1cky-Horse!")
+
+		s.NoError(err)
+		s.resetMocks()
+	})
+
+	s.Run("ReportsEveryFailedRule", func() {
+		s.mockBreached.On("IsBreached", "abc").Return(true, nil)
+
+		err := s.policy.Validate(context.Background(), nil, "abc")
+
+		s.Equal([]domain.PasswordRule{
+			domain.PasswordRuleMinLength,
+			domain.PasswordRuleUppercase,
+			domain.PasswordRuleDigit,
+			domain.PasswordRuleSymbol,
+			domain.PasswordRuleNotBreached,
+		}, s.rules(err))
+		s.resetMocks()
+	})
+
+	s.Run("TooLong", func() {
+		s.mockBreached.On("IsBreached", mock.Anything).Return(false, nil)
+
+		err := s.policy.Validate(context.Background(), nil, "Aa1!aaaaaaaaaaaaaaaaaaaa")
+
+		s.Equal([]domain.PasswordRule{domain.PasswordRuleMaxLength}, s.rules(err))
+		s.resetMocks()
+	})
+
+	s.Run("BreachCheckErrorIgnored", func() {
+		s.mockBreached.On("IsBreached", "Tr1cky-Horse!").Return(false, errors.New("lookup failed"))
+
+		err := s.policy.Validate(context.Background(), nil, "Tr1cky-Horse!")
+
+		s.NoError(err)
+		s.resetMocks()
+	})
+
+	s.Run("CurrentPasswordReused", func() {
+		current, _ := security.HashPassword("Tr1cky-Horse!")
+		user := &domain.User{ID: "user-1", Password: current}
+		s.mockBreached.On("IsBreached", "Tr1cky-Horse!").Return(false, nil)
+
+		err := s.policy.Validate(context.Background(), user, "Tr1cky-Horse!")
+
+		s.Equal([]domain.PasswordRule{domain.PasswordRuleNotReused}, s.rules(err))
+		s.mockHistoryRepo.AssertNotCalled(s.T(), "FindRecent", mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("PreviousPasswordReused", func() {
+		current, _ := security.HashPassword("Current-Pass1")
+		previous, _ := security.HashPassword("Tr1cky-Horse!")
+		user := &domain.User{ID: "user-1", Password: current}
+		s.mockBreached.On("IsBreached", "Tr1cky-Horse!").Return(false, nil)
+		s.mockHistoryRepo.On("FindRecent", mock.Anything, "user-1", 3).Return([]*domain.PasswordHistory{
+			{UserID: "user-1", PasswordHash: current},
+			{UserID: "user-1", PasswordHash: previous},
+		}, nil)
+
+		err := s.policy.Validate(context.Background(), user, "Tr1cky-Horse!")
+
+		s.Equal([]domain.PasswordRule{domain.PasswordRuleNotReused}, s.rules(err))
+		s.resetMocks()
+	})
+
+	s.Run("HistoryError", func() {
+		current, _ := security.HashPassword("Current-Pass1")
+		user := &domain.User{ID: "user-1", Password: current}
+		s.mockBreached.On("IsBreached", "Tr1cky-Horse!").Return(false, nil)
+		s.mockHistoryRepo.On("FindRecent", mock.Anything, "user-1", 3).Return(nil, errors.New("db down"))
+
+		err := s.policy.Validate(context.Background(), user, "Tr1cky-Horse!")
+
+		s.EqualError(err, "db down")
+		s.resetMocks()
+	})
+
+	s.Run("DefaultsWithoutBreachList", func() {
+		policy := NewPasswordPolicy(PasswordRules{}, s.mockHistoryRepo, nil)
+
+		s.NoError(policy.Validate(context.Background(), &domain.User{ID: "user-1"}, "longenough"))
+		s.Equal([]domain.PasswordRule{domain.PasswordRuleMinLength}, s.rules(policy.Validate(context.Background(), nil, "short")))
+		s.resetMocks()
+	})
+}
+
+func (s *PasswordPolicySuite) TestRemember() {
+	s.Run("Stored", func() {
+		s.mockHistoryRepo.On("Create", mock.Anything, mock.MatchedBy(func(entry *domain.PasswordHistory) bool {
+			return entry.UserID == "user-1" && entry.PasswordHash == "hash" && !entry.CreatedAt.IsZero()
+		})).Return(nil)
+
+		s.NoError(s.policy.Remember(context.Background(), "user-1", "hash"))
+		s.resetMocks()
+	})
+
+	s.Run("HistoryDisabled", func() {
+		policy := NewPasswordPolicy(PasswordRules{}, s.mockHistoryRepo, nil)
+
+		s.NoError(policy.Remember(context.Background(), "user-1", "hash"))
+		s.mockHistoryRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+}
+
+func (s *PasswordPolicySuite) resetMocks() {
+	s.mockHistoryRepo.ExpectedCalls = nil
+	s.mockHistoryRepo.Calls = nil
+	s.mockBreached.ExpectedCalls = nil
+	s.mockBreached.Calls = nil
+}
@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/security"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	maxAccessTokensPerUser = 20
+	maxAccessTokenLifetime = 365 * 24 * time.Hour
+	// last-used is written at most this often, so a busy CI job does not update the token on every request
+	accessTokenTouchInterval = time.Minute
+)
+
+type PersonalAccessTokenUsecase struct {
+	repo       domain.IPersonalAccessTokenRepository
+	userRepo   domain.IUserRepository
+	policy     domain.IPolicy
+	ctxtimeout time.Duration
+}
+
+func NewPersonalAccessTokenUsecase(repo domain.IPersonalAccessTokenRepository, userRepo domain.IUserRepository, policy domain.IPolicy, timeout time.Duration) domain.IPersonalAccessTokenUsecase {
+	return &PersonalAccessTokenUsecase{
+		repo:       repo,
+		userRepo:   userRepo,
+		policy:     policy,
+		ctxtimeout: timeout,
+	}
+}
+
+// Create issues a new token. The returned token carries the raw value, it cannot be retrieved again.
+func (uc *PersonalAccessTokenUsecase) Create(userID, name string, scopes []domain.TokenScope, expiresIn time.Duration) (*domain.PersonalAccessToken, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(scopes) == 0 || expiresIn <= 0 || expiresIn > maxAccessTokenLifetime {
+		return nil, domain.ErrInvalidInput
+	}
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return nil, domain.ErrInvalidTokenScope
+		}
+	}
+	slices.Sort(scopes)
+	scopes = slices.Compact(scopes)
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	user, err := uc.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+	if slices.Contains(scopes, domain.ScopeAdmin) && !uc.policy.HasPermission(domain.Actor{UserID: user.ID, Role: user.Role}, domain.PermTokenAdminScope) {
+		return nil, domain.ErrTokenScopeNotAllowed
+	}
+
+	count, err := uc.repo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxAccessTokensPerUser {
+		return nil, domain.ErrAccessTokenLimit
+	}
+
+	raw, err := security.GenerateOpaqueToken(domain.PersonalAccessTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+	tokenHash, _ := security.HashToken(raw)
+
+	now := time.Now()
+	token := &domain.PersonalAccessToken{
+		UserID:    userID,
+		Name:      name,
+		Token:     raw,
+		TokenHash: tokenHash,
+		Scopes:    scopes,
+		ExpiresAt: now.Add(expiresIn),
+		CreatedAt: now,
+	}
+	if err := uc.repo.Create(ctx, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (uc *PersonalAccessTokenUsecase) List(userID string) ([]*domain.PersonalAccessToken, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+	return uc.repo.FindByUserID(ctx, userID)
+}
+
+func (uc *PersonalAccessTokenUsecase) Revoke(userID, id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+	return uc.repo.Delete(ctx, userID, id)
+}
+
+// Authenticate resolves a raw bearer token to the token and its owner and records its use
+func (uc *PersonalAccessTokenUsecase) Authenticate(raw, ip string) (*domain.PersonalAccessToken, *domain.User, error) {
+	if !strings.HasPrefix(raw, domain.PersonalAccessTokenPrefix) {
+		return nil, nil, domain.ErrAccessTokenNotFound
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	tokenHash, _ := security.HashToken(raw)
+	token, err := uc.repo.FindByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	if now.After(token.ExpiresAt) {
+		return nil, nil, domain.ErrAccessTokenExpired
+	}
+
+	user, err := uc.userRepo.FindUserByID(ctx, token.UserID)
+	if err != nil {
+		return nil, nil, domain.ErrUserNotFound
+	}
+
+	if now.Sub(token.LastUsedAt) > accessTokenTouchInterval || token.LastUsedIP != ip {
+		// failing to record the use must not fail the request
+		_ = uc.repo.UpdateLastUsed(ctx, token.ID, now, ip)
+		token.LastUsedAt = now
+		token.LastUsedIP = ip
+	}
+	return token, user, nil
+}
@@ -0,0 +1,140 @@
+package usecases
+
+import (
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"g6/blog-api/Infrastructure/security"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// PersonalAccessTokenUsecaseSuite defines the test suite for PersonalAccessTokenUsecase
+type PersonalAccessTokenUsecaseSuite struct {
+	suite.Suite
+	mockRepo     *domain_mocks.MockIPersonalAccessTokenRepository
+	mockUserRepo *domain_mocks.MockIUserRepository
+	usecase      domain.IPersonalAccessTokenUsecase
+}
+
+func (s *PersonalAccessTokenUsecaseSuite) SetupTest() {
+	s.mockRepo = domain_mocks.NewMockIPersonalAccessTokenRepository(s.T())
+	s.mockUserRepo = domain_mocks.NewMockIUserRepository(s.T())
+	s.usecase = NewPersonalAccessTokenUsecase(s.mockRepo, s.mockUserRepo, security.NewPolicy(security.DefaultRolePermissions), 3*time.Second)
+}
+
+func TestPersonalAccessTokenUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(PersonalAccessTokenUsecaseSuite))
+}
+
+func (s *PersonalAccessTokenUsecaseSuite) TestCreate() {
+	s.Run("StoresOnlyTheHash", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1", Role: domain.RoleUser}, nil)
+		s.mockRepo.On("CountByUserID", mock.Anything, "1").Return(int64(0), nil)
+		var stored *domain.PersonalAccessToken
+		s.mockRepo.On("Create", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(1).(*domain.PersonalAccessToken)
+		}).Return(nil)
+
+		token, err := s.usecase.Create("1", "ci", []domain.TokenScope{domain.ScopeWritePosts, domain.ScopeReadPosts, domain.ScopeReadPosts}, 24*time.Hour)
+
+		s.NoError(err)
+		s.True(strings.HasPrefix(token.Token, domain.PersonalAccessTokenPrefix))
+		hash, _ := security.HashToken(token.Token)
+		s.Equal(hash, stored.TokenHash)
+		s.NotContains(stored.TokenHash, token.Token)
+		s.Equal([]domain.TokenScope{domain.ScopeReadPosts, domain.ScopeWritePosts}, token.Scopes)
+		s.WithinDuration(time.Now().Add(24*time.Hour), token.ExpiresAt, time.Minute)
+		s.resetMocks()
+	})
+
+	s.Run("AdminScopeNeedsAdmin", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1", Role: domain.RoleUser}, nil)
+
+		token, err := s.usecase.Create("1", "ci", []domain.TokenScope{domain.ScopeAdmin}, 24*time.Hour)
+
+		s.Nil(token)
+		s.Equal(domain.ErrTokenScopeNotAllowed, err)
+		s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("UnknownScope", func() {
+		token, err := s.usecase.Create("1", "ci", []domain.TokenScope{"delete:everything"}, 24*time.Hour)
+
+		s.Nil(token)
+		s.Equal(domain.ErrInvalidTokenScope, err)
+		s.resetMocks()
+	})
+
+	s.Run("LimitReached", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1", Role: domain.RoleUser}, nil)
+		s.mockRepo.On("CountByUserID", mock.Anything, "1").Return(int64(maxAccessTokensPerUser), nil)
+
+		token, err := s.usecase.Create("1", "ci", []domain.TokenScope{domain.ScopeReadPosts}, 24*time.Hour)
+
+		s.Nil(token)
+		s.Equal(domain.ErrAccessTokenLimit, err)
+		s.resetMocks()
+	})
+}
+
+func (s *PersonalAccessTokenUsecaseSuite) TestAuthenticate() {
+	raw := domain.PersonalAccessTokenPrefix + "abc"
+	hash, _ := security.HashToken(raw)
+
+	s.Run("RecordsLastUse", func() {
+		stored := &domain.PersonalAccessToken{ID: "t1", UserID: "1", TokenHash: hash, ExpiresAt: time.Now().Add(time.Hour)}
+		s.mockRepo.On("FindByTokenHash", mock.Anything, hash).Return(stored, nil)
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1"}, nil)
+		s.mockRepo.On("UpdateLastUsed", mock.Anything, "t1", mock.Anything, "10.0.0.1").Return(nil)
+
+		token, user, err := s.usecase.Authenticate(raw, "10.0.0.1")
+
+		s.NoError(err)
+		s.Equal("t1", token.ID)
+		s.Equal("1", user.ID)
+		s.Equal("10.0.0.1", token.LastUsedIP)
+		s.resetMocks()
+	})
+
+	s.Run("RecentUseIsNotWrittenAgain", func() {
+		stored := &domain.PersonalAccessToken{ID: "t1", UserID: "1", TokenHash: hash, ExpiresAt: time.Now().Add(time.Hour), LastUsedAt: time.Now(), LastUsedIP: "10.0.0.1"}
+		s.mockRepo.On("FindByTokenHash", mock.Anything, hash).Return(stored, nil)
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1"}, nil)
+
+		_, _, err := s.usecase.Authenticate(raw, "10.0.0.1")
+
+		s.NoError(err)
+		s.mockRepo.AssertNotCalled(s.T(), "UpdateLastUsed", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("Expired", func() {
+		stored := &domain.PersonalAccessToken{ID: "t1", UserID: "1", TokenHash: hash, ExpiresAt: time.Now().Add(-time.Minute)}
+		s.mockRepo.On("FindByTokenHash", mock.Anything, hash).Return(stored, nil)
+
+		_, _, err := s.usecase.Authenticate(raw, "10.0.0.1")
+
+		s.Equal(domain.ErrAccessTokenExpired, err)
+		s.resetMocks()
+	})
+
+	s.Run("NotAPersonalAccessToken", func() {
+		_, _, err := s.usecase.Authenticate("eyJhbGciOi", "10.0.0.1")
+
+		s.Equal(domain.ErrAccessTokenNotFound, err)
+		s.mockRepo.AssertNotCalled(s.T(), "FindByTokenHash", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+}
+
+func (s *PersonalAccessTokenUsecaseSuite) resetMocks() {
+	s.mockRepo.ExpectedCalls = nil
+	s.mockRepo.Calls = nil
+	s.mockUserRepo.ExpectedCalls = nil
+	s.mockUserRepo.Calls = nil
+}
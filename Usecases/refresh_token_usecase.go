@@ -3,52 +3,120 @@ package usecases
 import (
 	"context"
 	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/security"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type RefreshTokenUsecase struct {
-	Repo domain.IRefreshTokenRepository
+	Repo           domain.IRefreshTokenRepository
+	SecurityEvents domain.ISecurityEventRepository
 }
 
-func NewRefreshTokenUsecase(repo domain.IRefreshTokenRepository) domain.IRefreshTokenUsecase {
+func NewRefreshTokenUsecase(repo domain.IRefreshTokenRepository, securityEvents domain.ISecurityEventRepository) domain.IRefreshTokenUsecase {
 	return &RefreshTokenUsecase{
-		Repo: repo,
+		Repo:           repo,
+		SecurityEvents: securityEvents,
 	}
 }
 
+// find a stored token by its raw value, only the hash is looked up
 func (uc *RefreshTokenUsecase) FindByToken(token string) (*domain.RefreshToken, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	return uc.Repo.FindByToken(ctx, token)
+	tokenHash, _ := security.HashToken(token)
+	return uc.Repo.FindByTokenHash(ctx, tokenHash)
 }
 
+// save the first token of a new session
 func (uc *RefreshTokenUsecase) Save(token *domain.RefreshToken) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
+	if token.FamilyID == "" {
+		token.FamilyID = uuid.NewString()
+	}
+	token.TokenHash, _ = security.HashToken(token.Token)
 	return uc.Repo.Save(ctx, token)
 }
+
 func (uc *RefreshTokenUsecase) DeleteByUserID(userID string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	return uc.Repo.DeleteByUserID(ctx, userID)
 }
 
-func (uc *RefreshTokenUsecase) ReplaceToken(token *domain.RefreshToken) error {
+// Rotate marks current as used and stores next as its successor in the same family.
+// If current was already used, the family is revoked and ErrRefreshTokenReused returned.
+func (uc *RefreshTokenUsecase) Rotate(current *domain.RefreshToken, next *domain.RefreshToken) error {
+	if current.Used {
+		_ = uc.ReportReuse(current, next.IP, next.UserAgent)
+		return domain.ErrRefreshTokenReused
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	if err := uc.Repo.MarkUsed(ctx, current.ID, now); err != nil {
+		if err == domain.ErrRefreshTokenReused {
+			// another request rotated this token first, treat it as a replay
+			_ = uc.ReportReuse(current, next.IP, next.UserAgent)
+		}
+		return err
+	}
+
+	next.FamilyID = current.FamilyID
+	next.UserID = current.UserID
+	next.CreatedAt = current.CreatedAt
+	next.LastUsedAt = now
+	next.TokenHash, _ = security.HashToken(next.Token)
+	return uc.Repo.Save(ctx, next)
+}
+
+// ReportReuse ends the session a replayed token belongs to and records a security event
+func (uc *RefreshTokenUsecase) ReportReuse(token *domain.RefreshToken, ip, userAgent string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	return uc.Repo.ReplaceTokenByUserID(ctx, token)
+
+	if err := uc.Repo.RevokeFamily(ctx, token.FamilyID); err != nil {
+		return err
+	}
+	return uc.SecurityEvents.Record(ctx, &domain.SecurityEvent{
+		Type:      domain.SecurityEventRefreshTokenReuse,
+		UserID:    token.UserID,
+		Outcome:   domain.SecurityEventFailure,
+		IP:        ip,
+		UserAgent: userAgent,
+		Details:   "refresh token used twice, session " + token.FamilyID + " revoked",
+		CreatedAt: time.Now(),
+	})
 }
 
-// revoke token
+// revoke token, this ends the whole session the token belongs to
 func (uc *RefreshTokenUsecase) RevokedToken(token *domain.RefreshToken) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	return uc.Repo.RevokeToken(ctx, token.Token)
+	return uc.Repo.RevokeFamily(ctx, token.FamilyID)
+}
+
+// list the active sessions of a user
+func (uc *RefreshTokenUsecase) ListSessions(userID string) ([]*domain.RefreshToken, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return uc.Repo.FindActiveByUserID(ctx, userID)
+}
+
+// revoke one session of the user
+func (uc *RefreshTokenUsecase) RevokeSession(userID, sessionID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	return uc.Repo.RevokeByID(ctx, userID, sessionID)
 }
 
-// find token by user id
-func (uc *RefreshTokenUsecase) FindByUserID(userID string) (*domain.RefreshToken, error) {
+// log out everywhere else: revoke all sessions of the user but the current one
+func (uc *RefreshTokenUsecase) RevokeOtherSessions(userID, currentSessionID string) (int64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
-	return uc.Repo.FindTokenByUserID(ctx, userID)
+	return uc.Repo.RevokeAllExcept(ctx, userID, currentSessionID)
 }
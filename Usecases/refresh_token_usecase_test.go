@@ -4,6 +4,7 @@ import (
 	"errors"
 	domain "g6/blog-api/Domain"
 	domain_mocks "g6/blog-api/Domain/mocks"
+	"g6/blog-api/Infrastructure/security"
 	"testing"
 	"time"
 
@@ -14,17 +15,25 @@ import (
 // RefreshTokenUsecaseSuite defines the test suite for RefreshTokenUsecase
 type RefreshTokenUsecaseSuite struct {
 	suite.Suite
-	mockRepo *domain_mocks.MockIRefreshTokenRepository
-	usecase  *RefreshTokenUsecase
+	mockRepo   *domain_mocks.MockIRefreshTokenRepository
+	mockEvents *domain_mocks.MockISecurityEventRepository
+	usecase    *RefreshTokenUsecase
 }
 
 func (s *RefreshTokenUsecaseSuite) SetupTest() {
 	s.mockRepo = domain_mocks.NewMockIRefreshTokenRepository(s.T())
+	s.mockEvents = domain_mocks.NewMockISecurityEventRepository(s.T())
 	s.usecase = &RefreshTokenUsecase{
-		Repo: s.mockRepo,
+		Repo:           s.mockRepo,
+		SecurityEvents: s.mockEvents,
 	}
 }
 
+func hashOf(token string) string {
+	hash, _ := security.HashToken(token)
+	return hash
+}
+
 func TestRefreshTokenUsecaseSuite(t *testing.T) {
 	suite.Run(t, new(RefreshTokenUsecaseSuite))
 }
@@ -38,7 +47,7 @@ func (s *RefreshTokenUsecaseSuite) TestFindByToken() {
 			ExpiresAt: time.Now().Add(time.Hour),
 			Revoked:   false,
 		}
-		s.mockRepo.On("FindByToken", mock.Anything, token).Return(expectedToken, nil)
+		s.mockRepo.On("FindByTokenHash", mock.Anything, hashOf(token)).Return(expectedToken, nil)
 
 		result, err := s.usecase.FindByToken(token)
 
@@ -49,7 +58,7 @@ func (s *RefreshTokenUsecaseSuite) TestFindByToken() {
 
 	s.Run("NotFound", func() {
 		token := "refresh-token"
-		s.mockRepo.On("FindByToken", mock.Anything, token).Return(nil, errors.New("token not found"))
+		s.mockRepo.On("FindByTokenHash", mock.Anything, hashOf(token)).Return(nil, errors.New("token not found"))
 
 		result, err := s.usecase.FindByToken(token)
 
@@ -61,7 +70,7 @@ func (s *RefreshTokenUsecaseSuite) TestFindByToken() {
 
 	s.Run("ContextTimeout", func() {
 		token := "refresh-token"
-		s.mockRepo.On("FindByToken", mock.Anything, token).Run(func(args mock.Arguments) {
+		s.mockRepo.On("FindByTokenHash", mock.Anything, hashOf(token)).Run(func(args mock.Arguments) {
 			time.Sleep(4 * time.Second) // Exceed the 3-second timeout
 		}).Return(nil, errors.New("context deadline exceeded"))
 
@@ -87,6 +96,8 @@ func (s *RefreshTokenUsecaseSuite) TestSave() {
 		err := s.usecase.Save(token)
 
 		s.NoError(err)
+		s.Equal(hashOf("refresh-token"), token.TokenHash)
+		s.NotEmpty(token.FamilyID)
 		s.resetMocks()
 	})
 
@@ -161,53 +172,61 @@ func (s *RefreshTokenUsecaseSuite) TestDeleteByUserID() {
 	})
 }
 
-func (s *RefreshTokenUsecaseSuite) TestReplaceToken() {
+func (s *RefreshTokenUsecaseSuite) TestRotate() {
 	s.Run("Success", func() {
-		token := &domain.RefreshToken{
-			Token:     "new-refresh-token",
-			UserID:    "1",
-			ExpiresAt: time.Now().Add(time.Hour),
-			Revoked:   false,
-		}
-		s.mockRepo.On("ReplaceTokenByUserID", mock.Anything, token).Return(nil)
+		createdAt := time.Now().Add(-time.Hour)
+		current := &domain.RefreshToken{ID: "current-id", FamilyID: "family", UserID: "1", CreatedAt: createdAt}
+		next := &domain.RefreshToken{Token: "next-token", ExpiresAt: time.Now().Add(time.Hour)}
+		s.mockRepo.On("MarkUsed", mock.Anything, "current-id", mock.AnythingOfType("time.Time")).Return(nil)
+		s.mockRepo.On("Save", mock.Anything, next).Return(nil)
 
-		err := s.usecase.ReplaceToken(token)
+		err := s.usecase.Rotate(current, next)
 
 		s.NoError(err)
+		s.Equal("family", next.FamilyID)
+		s.Equal("1", next.UserID)
+		s.Equal(createdAt, next.CreatedAt)
+		s.Equal(hashOf("next-token"), next.TokenHash)
 		s.resetMocks()
 	})
 
-	s.Run("ReplaceError", func() {
-		token := &domain.RefreshToken{
-			Token:     "new-refresh-token",
-			UserID:    "1",
-			ExpiresAt: time.Now().Add(time.Hour),
-			Revoked:   false,
-		}
-		s.mockRepo.On("ReplaceTokenByUserID", mock.Anything, token).Return(errors.New("replace failed"))
+	s.Run("AlreadyUsedRevokesFamily", func() {
+		current := &domain.RefreshToken{ID: "current-id", FamilyID: "family", UserID: "1", Used: true}
+		next := &domain.RefreshToken{Token: "next-token", IP: "10.0.0.1"}
+		s.mockRepo.On("RevokeFamily", mock.Anything, "family").Return(nil)
+		s.mockEvents.On("Record", mock.Anything, mock.MatchedBy(func(e *domain.SecurityEvent) bool {
+			return e.Type == domain.SecurityEventRefreshTokenReuse && e.UserID == "1" && e.IP == "10.0.0.1"
+		})).Return(nil)
 
-		err := s.usecase.ReplaceToken(token)
+		err := s.usecase.Rotate(current, next)
 
-		s.Error(err)
-		s.Equal("replace failed", err.Error())
+		s.Equal(domain.ErrRefreshTokenReused, err)
+		s.mockRepo.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
 		s.resetMocks()
 	})
 
-	s.Run("ContextTimeout", func() {
-		token := &domain.RefreshToken{
-			Token:     "new-refresh-token",
-			UserID:    "1",
-			ExpiresAt: time.Now().Add(time.Hour),
-			Revoked:   false,
-		}
-		s.mockRepo.On("ReplaceTokenByUserID", mock.Anything, token).Run(func(args mock.Arguments) {
-			time.Sleep(4 * time.Second) // Exceed the 3-second timeout
-		}).Return(errors.New("context deadline exceeded"))
+	s.Run("LostRaceRevokesFamily", func() {
+		current := &domain.RefreshToken{ID: "current-id", FamilyID: "family", UserID: "1"}
+		next := &domain.RefreshToken{Token: "next-token"}
+		s.mockRepo.On("MarkUsed", mock.Anything, "current-id", mock.Anything).Return(domain.ErrRefreshTokenReused)
+		s.mockRepo.On("RevokeFamily", mock.Anything, "family").Return(nil)
+		s.mockEvents.On("Record", mock.Anything, mock.Anything).Return(nil)
 
-		err := s.usecase.ReplaceToken(token)
+		err := s.usecase.Rotate(current, next)
 
-		s.Error(err)
-		s.Contains(err.Error(), "context deadline exceeded")
+		s.Equal(domain.ErrRefreshTokenReused, err)
+		s.mockRepo.AssertNotCalled(s.T(), "Save", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("MarkUsedError", func() {
+		current := &domain.RefreshToken{ID: "current-id", FamilyID: "family"}
+		s.mockRepo.On("MarkUsed", mock.Anything, "current-id", mock.Anything).Return(errors.New("db down"))
+
+		err := s.usecase.Rotate(current, &domain.RefreshToken{Token: "next-token"})
+
+		s.EqualError(err, "db down")
+		s.mockEvents.AssertNotCalled(s.T(), "Record", mock.Anything, mock.Anything)
 		s.resetMocks()
 	})
 }
@@ -216,11 +235,12 @@ func (s *RefreshTokenUsecaseSuite) TestRevokedToken() {
 	s.Run("Success", func() {
 		token := &domain.RefreshToken{
 			Token:     "refresh-token",
+			FamilyID:  "family",
 			UserID:    "1",
 			ExpiresAt: time.Now().Add(time.Hour),
 			Revoked:   false,
 		}
-		s.mockRepo.On("RevokeToken", mock.Anything, token.Token).Return(nil)
+		s.mockRepo.On("RevokeFamily", mock.Anything, token.FamilyID).Return(nil)
 
 		err := s.usecase.RevokedToken(token)
 
@@ -231,11 +251,12 @@ func (s *RefreshTokenUsecaseSuite) TestRevokedToken() {
 	s.Run("RevokeError", func() {
 		token := &domain.RefreshToken{
 			Token:     "refresh-token",
+			FamilyID:  "family",
 			UserID:    "1",
 			ExpiresAt: time.Now().Add(time.Hour),
 			Revoked:   false,
 		}
-		s.mockRepo.On("RevokeToken", mock.Anything, token.Token).Return(errors.New("revoke failed"))
+		s.mockRepo.On("RevokeFamily", mock.Anything, token.FamilyID).Return(errors.New("revoke failed"))
 
 		err := s.usecase.RevokedToken(token)
 
@@ -247,11 +268,12 @@ func (s *RefreshTokenUsecaseSuite) TestRevokedToken() {
 	s.Run("ContextTimeout", func() {
 		token := &domain.RefreshToken{
 			Token:     "refresh-token",
+			FamilyID:  "family",
 			UserID:    "1",
 			ExpiresAt: time.Now().Add(time.Hour),
 			Revoked:   false,
 		}
-		s.mockRepo.On("RevokeToken", mock.Anything, token.Token).Run(func(args mock.Arguments) {
+		s.mockRepo.On("RevokeFamily", mock.Anything, token.FamilyID).Run(func(args mock.Arguments) {
 			time.Sleep(4 * time.Second) // Exceed the 3-second timeout
 		}).Return(errors.New("context deadline exceeded"))
 
@@ -263,47 +285,61 @@ func (s *RefreshTokenUsecaseSuite) TestRevokedToken() {
 	})
 }
 
-func (s *RefreshTokenUsecaseSuite) TestFindByUserID() {
+func (s *RefreshTokenUsecaseSuite) TestListSessions() {
 	s.Run("Success", func() {
 		userID := "1"
-		expectedToken := &domain.RefreshToken{
-			Token:     "refresh-token",
-			UserID:    userID,
-			ExpiresAt: time.Now().Add(time.Hour),
-			Revoked:   false,
+		sessions := []*domain.RefreshToken{
+			{ID: "a", Token: "laptop-token", UserID: userID, UserAgent: "Firefox"},
+			{ID: "b", Token: "phone-token", UserID: userID, UserAgent: "Safari"},
 		}
-		s.mockRepo.On("FindTokenByUserID", mock.Anything, userID).Return(expectedToken, nil)
+		s.mockRepo.On("FindActiveByUserID", mock.Anything, userID).Return(sessions, nil)
 
-		result, err := s.usecase.FindByUserID(userID)
+		result, err := s.usecase.ListSessions(userID)
 
 		s.NoError(err)
-		s.Equal(expectedToken, result)
+		s.Len(result, 2)
 		s.resetMocks()
 	})
 
-	s.Run("NotFound", func() {
-		userID := "1"
-		s.mockRepo.On("FindTokenByUserID", mock.Anything, userID).Return(nil, errors.New("token not found"))
+	s.Run("Error", func() {
+		s.mockRepo.On("FindActiveByUserID", mock.Anything, "1").Return(nil, errors.New("db error"))
 
-		result, err := s.usecase.FindByUserID(userID)
+		result, err := s.usecase.ListSessions("1")
 
 		s.Error(err)
 		s.Nil(result)
-		s.Equal("token not found", err.Error())
 		s.resetMocks()
 	})
+}
 
-	s.Run("ContextTimeout", func() {
-		userID := "1"
-		s.mockRepo.On("FindTokenByUserID", mock.Anything, userID).Run(func(args mock.Arguments) {
-			time.Sleep(4 * time.Second) // Exceed the 3-second timeout
-		}).Return(nil, errors.New("context deadline exceeded"))
+func (s *RefreshTokenUsecaseSuite) TestRevokeSession() {
+	s.Run("Success", func() {
+		s.mockRepo.On("RevokeByID", mock.Anything, "1", "session-id").Return(nil)
 
-		result, err := s.usecase.FindByUserID(userID)
+		err := s.usecase.RevokeSession("1", "session-id")
 
-		s.Error(err)
-		s.Nil(result)
-		s.Contains(err.Error(), "context deadline exceeded")
+		s.NoError(err)
+		s.resetMocks()
+	})
+
+	s.Run("NotFound", func() {
+		s.mockRepo.On("RevokeByID", mock.Anything, "1", "other-users-session").Return(domain.ErrSessionNotFound)
+
+		err := s.usecase.RevokeSession("1", "other-users-session")
+
+		s.Equal(domain.ErrSessionNotFound, err)
+		s.resetMocks()
+	})
+}
+
+func (s *RefreshTokenUsecaseSuite) TestRevokeOtherSessions() {
+	s.Run("Success", func() {
+		s.mockRepo.On("RevokeAllExcept", mock.Anything, "1", "current").Return(int64(2), nil)
+
+		revoked, err := s.usecase.RevokeOtherSessions("1", "current")
+
+		s.NoError(err)
+		s.Equal(int64(2), revoked)
 		s.resetMocks()
 	})
 }
@@ -311,4 +347,6 @@ func (s *RefreshTokenUsecaseSuite) TestFindByUserID() {
 func (s *RefreshTokenUsecaseSuite) resetMocks() {
 	s.mockRepo.ExpectedCalls = nil
 	s.mockRepo.Calls = nil
+	s.mockEvents.ExpectedCalls = nil
+	s.mockEvents.Calls = nil
 }
@@ -8,22 +8,22 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-
-	"golang.org/x/crypto/bcrypt"
 )
 
 type PasswordResetUsecase struct {
 	UserRepo          domain.IUserRepository
 	EmailService      domain.IEmailService
 	PasswordResetRepo domain.IPasswordResetRepository
+	PasswordPolicy    domain.IPasswordPolicy
 	PasswordExpiry    time.Duration
 }
 
-func NewPasswordResetUsecase(repo domain.IPasswordResetRepository, userRepo domain.IUserRepository, emailService domain.IEmailService, expiry time.Duration) domain.IPasswordResetUsecase {
+func NewPasswordResetUsecase(repo domain.IPasswordResetRepository, userRepo domain.IUserRepository, emailService domain.IEmailService, passwordPolicy domain.IPasswordPolicy, expiry time.Duration) domain.IPasswordResetUsecase {
 	return &PasswordResetUsecase{
 		PasswordResetRepo: repo,
 		UserRepo:          userRepo,
 		EmailService:      emailService,
+		PasswordPolicy:    passwordPolicy,
 		PasswordExpiry:    expiry,
 	}
 }
@@ -88,14 +88,12 @@ func (u *PasswordResetUsecase) RequestReset(email string) error {
 	}
 
 	// Send email
-	body := `<h1 style="color: #333; font-family: Arial, sans-serif;">Password Reset Request</h1>
-<p style="font-family: Arial, sans-serif; color: #555;">Dear ` + user.FirstName + " " + user.LastName + `,</p>
-<p style="font-family: Arial, sans-serif; color: #555;">Use the following token to reset your password for the Blog Platform:</p>
-<p style="font-family: Arial, sans-serif; color: #1a73e8; font-weight: bold;">` + token + `</p>
-<p style="font-family: Arial, sans-serif; color: #555;">This token expires in ` + u.PasswordExpiry.String() + `. If you did not request this, please ignore this email.</p>
-<p style="font-family: Arial, sans-serif; color: #555;">Best regards,</p>
-<p style="font-family: Arial, sans-serif; color: #555;">The Blog Platform Team</p>`
-	return u.EmailService.SendEmail(context.Background(), user.Email, "Password Reset Request", body)
+	return u.EmailService.SendTemplate(context.Background(), user.Email, domain.EmailTemplatePasswordReset, domain.EmailTemplateData{
+		"Locale":    user.Language,
+		"Name":      user.FirstName + " " + user.LastName,
+		"Token":     token,
+		"ExpiresIn": u.PasswordExpiry.String(),
+	})
 }
 
 func (u *PasswordResetUsecase) ResetPassword(email, token, newPassword string) error {
@@ -120,17 +118,23 @@ func (u *PasswordResetUsecase) ResetPassword(email, token, newPassword string) e
 		return err
 	}
 
+	// a refused password leaves the token unused, so the user can try another one
+	if err := u.PasswordPolicy.Validate(context.Background(), user, newPassword); err != nil {
+		return err
+	}
+
 	// Hash new password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	hashedPassword, err := security.HashPassword(newPassword)
 	if err != nil {
 		return err
 	}
-	user.Password = string(hashedPassword)
+	user.Password = hashedPassword
 
 	// Update user
 	if err := u.UserRepo.UpdateUser(context.Background(), user.ID, user); err != nil {
 		return err
 	}
+	_ = u.PasswordPolicy.Remember(context.Background(), user.ID, user.Password)
 
 	// Mark token as used
 	resetToken.Used = true
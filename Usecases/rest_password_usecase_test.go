@@ -20,6 +20,7 @@ type PasswordResetUsecaseSuite struct {
 	mockUserRepo  *domain_mocks.MockIUserRepository
 	mockEmail     *domain_mocks.MockIEmailService
 	mockResetRepo *domain_mocks.MockIPasswordResetRepository
+	mockPolicy    *domain_mocks.MockIPasswordPolicy
 	usecase       *PasswordResetUsecase
 	expiry        time.Duration
 }
@@ -28,11 +29,13 @@ func (s *PasswordResetUsecaseSuite) SetupTest() {
 	s.mockUserRepo = domain_mocks.NewMockIUserRepository(s.T())
 	s.mockEmail = domain_mocks.NewMockIEmailService(s.T())
 	s.mockResetRepo = domain_mocks.NewMockIPasswordResetRepository(s.T())
+	s.mockPolicy = domain_mocks.NewMockIPasswordPolicy(s.T())
 	s.expiry = 1 * time.Hour
 	s.usecase = &PasswordResetUsecase{
 		UserRepo:          s.mockUserRepo,
 		EmailService:      s.mockEmail,
 		PasswordResetRepo: s.mockResetRepo,
+		PasswordPolicy:    s.mockPolicy,
 		PasswordExpiry:    s.expiry,
 	}
 }
@@ -115,6 +118,7 @@ func (s *PasswordResetUsecaseSuite) TestRequestReset() {
 			Email:     email,
 			FirstName: "Test",
 			LastName:  "User",
+			Language:  "fr",
 		}
 
 		s.mockUserRepo.On("GetUserByEmail", mock.Anything, email).Return(user, nil)
@@ -126,7 +130,9 @@ func (s *PasswordResetUsecaseSuite) TestRequestReset() {
 				t.RateLimit == 1 &&
 				!t.CreatedAt.IsZero()
 		})).Return(nil)
-		s.mockEmail.On("SendEmail", mock.Anything, email, "Password Reset Request", mock.Anything).Return(nil)
+		s.mockEmail.On("SendTemplate", mock.Anything, email, domain.EmailTemplatePasswordReset, mock.MatchedBy(func(data domain.EmailTemplateData) bool {
+			return data["Locale"] == "fr" && data["Name"] == "Test User" && data["Token"] != ""
+		})).Return(nil)
 
 		err := s.usecase.RequestReset(email)
 
@@ -159,7 +165,7 @@ func (s *PasswordResetUsecaseSuite) TestRequestReset() {
 				!t.CreatedAt.IsZero() &&
 				time.Until(t.ExpiresAt) <= s.expiry
 		})).Return(nil)
-		s.mockEmail.On("SendEmail", mock.Anything, email, "Password Reset Request", mock.Anything).Return(nil)
+		s.mockEmail.On("SendTemplate", mock.Anything, email, domain.EmailTemplatePasswordReset, mock.Anything).Return(nil)
 		err := s.usecase.RequestReset(email)
 
 		s.NoError(err)
@@ -261,7 +267,7 @@ func (s *PasswordResetUsecaseSuite) TestRequestReset() {
 		s.mockUserRepo.On("GetUserByEmail", mock.Anything, email).Return(user, nil)
 		s.mockResetRepo.On("FindByEmail", mock.Anything, email).Return(nil, errors.New("not found"))
 		s.mockResetRepo.On("SaveResetToken", mock.Anything, mock.Anything).Return(nil)
-		s.mockEmail.On("SendEmail", mock.Anything, email, "Password Reset Request", mock.Anything).Return(errors.New("email failed"))
+		s.mockEmail.On("SendTemplate", mock.Anything, email, domain.EmailTemplatePasswordReset, mock.Anything).Return(errors.New("email failed"))
 
 		err := s.usecase.RequestReset(email)
 
@@ -288,6 +294,8 @@ func (s *PasswordResetUsecaseSuite) TestResetPassword() {
 		}
 		s.mockResetRepo.On("FindByEmail", mock.Anything, email).Return(resetToken, nil)
 		s.mockUserRepo.On("GetUserByEmail", mock.Anything, resetToken.Email).Return(user, nil)
+		s.mockPolicy.On("Validate", mock.Anything, user, newPassword).Return(nil)
+		s.mockPolicy.On("Remember", mock.Anything, user.ID, mock.Anything).Return(nil)
 		user.Password = newPassword
 		s.mockUserRepo.On("UpdateUser", mock.Anything, user.ID, user).Return(nil)
 		s.mockResetRepo.On("MarkAsUsed", mock.Anything, mock.MatchedBy(func(t *domain.PasswordResetToken) bool {
@@ -389,6 +397,30 @@ func (s *PasswordResetUsecaseSuite) TestResetPassword() {
 		s.resetMocks()
 	})
 
+	s.Run("PasswordPolicyViolation", func() {
+		email := "test@example.com"
+		token := "reset-token"
+		hashedToken, _ := security.HashToken(token)
+		user := &domain.User{ID: "1", Email: email}
+		resetToken := &domain.PasswordResetToken{
+			Email:     email,
+			TokenHash: hashedToken,
+			ExpiresAt: time.Now().Add(30 * time.Minute),
+			Used:      false,
+		}
+		policyErr := &domain.PasswordPolicyError{Violations: []domain.PasswordViolation{{Rule: domain.PasswordRuleNotBreached, Message: "appears in a known data breach, choose another one"}}}
+		s.mockResetRepo.On("FindByEmail", mock.Anything, email).Return(resetToken, nil)
+		s.mockUserRepo.On("GetUserByEmail", mock.Anything, email).Return(user, nil)
+		s.mockPolicy.On("Validate", mock.Anything, user, "password123").Return(policyErr)
+
+		err := s.usecase.ResetPassword(email, hashedToken, "password123")
+
+		s.Equal(policyErr, err)
+		s.False(resetToken.Used)
+		s.mockResetRepo.AssertNotCalled(s.T(), "MarkAsUsed", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
 	s.Run("HashPasswordError", func() {
 		email := "test@example.com"
 		token := "reset-token"
@@ -402,6 +434,7 @@ func (s *PasswordResetUsecaseSuite) TestResetPassword() {
 		}
 		s.mockResetRepo.On("FindByEmail", mock.Anything, email).Return(resetToken, nil)
 		s.mockUserRepo.On("GetUserByEmail", mock.Anything, email).Return(user, nil)
+		s.mockPolicy.On("Validate", mock.Anything, user, mock.Anything).Return(nil)
 		newPassword := strings.Repeat("newpassword123", 6)
 		err := s.usecase.ResetPassword(email, hashedToken, newPassword)
 
@@ -423,6 +456,7 @@ func (s *PasswordResetUsecaseSuite) TestResetPassword() {
 		}
 		s.mockResetRepo.On("FindByEmail", mock.Anything, email).Return(resetToken, nil)
 		s.mockUserRepo.On("GetUserByEmail", mock.Anything, email).Return(user, nil)
+		s.mockPolicy.On("Validate", mock.Anything, user, mock.Anything).Return(nil)
 		s.mockUserRepo.On("UpdateUser", mock.Anything, user.ID, mock.Anything).Return(errors.New("update failed"))
 
 		err := s.usecase.ResetPassword(email, hashedToken, "newpassword123")
@@ -445,7 +479,9 @@ func (s *PasswordResetUsecaseSuite) TestResetPassword() {
 		}
 		s.mockResetRepo.On("FindByEmail", mock.Anything, email).Return(resetToken, nil)
 		s.mockUserRepo.On("GetUserByEmail", mock.Anything, email).Return(user, nil)
+		s.mockPolicy.On("Validate", mock.Anything, user, mock.Anything).Return(nil)
 		s.mockUserRepo.On("UpdateUser", mock.Anything, user.ID, mock.Anything).Return(nil)
+		s.mockPolicy.On("Remember", mock.Anything, user.ID, mock.Anything).Return(nil)
 		s.mockResetRepo.On("MarkAsUsed", mock.Anything, mock.Anything).Return(errors.New("mark failed"))
 
 		err := s.usecase.ResetPassword(email, hashedToken, "newpassword123")
@@ -468,7 +504,9 @@ func (s *PasswordResetUsecaseSuite) TestResetPassword() {
 		}
 		s.mockResetRepo.On("FindByEmail", mock.Anything, email).Return(resetToken, nil)
 		s.mockUserRepo.On("GetUserByEmail", mock.Anything, email).Return(user, nil)
+		s.mockPolicy.On("Validate", mock.Anything, user, mock.Anything).Return(nil)
 		s.mockUserRepo.On("UpdateUser", mock.Anything, user.ID, mock.Anything).Return(nil)
+		s.mockPolicy.On("Remember", mock.Anything, user.ID, mock.Anything).Return(nil)
 		s.mockResetRepo.On("MarkAsUsed", mock.Anything, mock.Anything).Return(nil)
 		s.mockResetRepo.On("DeleteResetToken", mock.Anything, hashedToken).Return(errors.New("delete failed"))
 
@@ -487,4 +525,6 @@ func (s *PasswordResetUsecaseSuite) resetMocks() {
 	s.mockEmail.Calls = nil
 	s.mockResetRepo.ExpectedCalls = nil
 	s.mockResetRepo.Calls = nil
+	s.mockPolicy.ExpectedCalls = nil
+	s.mockPolicy.Calls = nil
 }
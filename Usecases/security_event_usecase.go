@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"time"
+)
+
+const (
+	defaultSecurityEventPageSize = 20
+	maxSecurityEventPageSize     = 100
+)
+
+type SecurityEventUsecase struct {
+	repo       domain.ISecurityEventRepository
+	ctxtimeout time.Duration
+}
+
+func NewSecurityEventUsecase(repo domain.ISecurityEventRepository, timeout time.Duration) domain.ISecurityEventUsecase {
+	return &SecurityEventUsecase{
+		repo:       repo,
+		ctxtimeout: timeout,
+	}
+}
+
+func (uc *SecurityEventUsecase) Record(event *domain.SecurityEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	event.CreatedAt = time.Now()
+	return uc.repo.Record(ctx, event)
+}
+
+func (uc *SecurityEventUsecase) Search(filter domain.SecurityEventFilter) ([]*domain.SecurityEvent, int64, error) {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, 0, domain.ErrInvalidInput
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = defaultSecurityEventPageSize
+	}
+	filter.PageSize = min(filter.PageSize, maxSecurityEventPageSize)
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.repo.Find(ctx, filter)
+}
+
+func (uc *SecurityEventUsecase) RecentActivity(userID string, page, pageSize int) ([]*domain.SecurityEvent, int64, error) {
+	if userID == "" {
+		return nil, 0, domain.ErrInvalidInput
+	}
+	return uc.Search(domain.SecurityEventFilter{UserID: userID, Page: page, PageSize: pageSize})
+}
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SecurityEventUsecaseSuite struct {
+	suite.Suite
+	mockRepo *domain_mocks.MockISecurityEventRepository
+	usecase  domain.ISecurityEventUsecase
+}
+
+func (s *SecurityEventUsecaseSuite) SetupTest() {
+	s.mockRepo = domain_mocks.NewMockISecurityEventRepository(s.T())
+	s.usecase = NewSecurityEventUsecase(s.mockRepo, 3*time.Second)
+}
+
+func TestSecurityEventUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(SecurityEventUsecaseSuite))
+}
+
+func (s *SecurityEventUsecaseSuite) TestRecord() {
+	event := &domain.SecurityEvent{Type: domain.SecurityEventLogin, UserID: "1", Outcome: domain.SecurityEventSuccess}
+	s.mockRepo.On("Record", mock.Anything, event).Return(nil)
+
+	err := s.usecase.Record(event)
+
+	s.NoError(err)
+	s.WithinDuration(time.Now(), event.CreatedAt, time.Second)
+}
+
+func (s *SecurityEventUsecaseSuite) TestSearch() {
+	s.Run("DefaultsPaging", func() {
+		s.SetupTest()
+		s.mockRepo.On("Find", mock.Anything, domain.SecurityEventFilter{UserID: "1", Page: 1, PageSize: 20}).Return([]*domain.SecurityEvent{}, int64(0), nil)
+
+		_, _, err := s.usecase.Search(domain.SecurityEventFilter{UserID: "1"})
+		s.NoError(err)
+	})
+
+	s.Run("CapsPageSize", func() {
+		s.SetupTest()
+		s.mockRepo.On("Find", mock.Anything, domain.SecurityEventFilter{Page: 3, PageSize: 100}).Return([]*domain.SecurityEvent{}, int64(0), nil)
+
+		_, _, err := s.usecase.Search(domain.SecurityEventFilter{Page: 3, PageSize: 1000})
+		s.NoError(err)
+	})
+
+	s.Run("FromNotBeforeTo", func() {
+		s.SetupTest()
+		now := time.Now()
+
+		_, _, err := s.usecase.Search(domain.SecurityEventFilter{From: now, To: now})
+		s.ErrorIs(err, domain.ErrInvalidInput)
+		s.mockRepo.AssertNotCalled(s.T(), "Find", mock.Anything, mock.Anything)
+	})
+}
+
+func (s *SecurityEventUsecaseSuite) TestRecentActivity() {
+	s.Run("FiltersByUser", func() {
+		s.SetupTest()
+		events := []*domain.SecurityEvent{{ID: "e1", UserID: "1"}}
+		s.mockRepo.On("Find", mock.Anything, domain.SecurityEventFilter{UserID: "1", Page: 2, PageSize: 10}).Return(events, int64(11), nil)
+
+		result, total, err := s.usecase.RecentActivity("1", 2, 10)
+		s.NoError(err)
+		s.Equal(events, result)
+		s.Equal(int64(11), total)
+	})
+
+	s.Run("RequiresUser", func() {
+		s.SetupTest()
+
+		_, _, err := s.usecase.RecentActivity("", 1, 20)
+		s.ErrorIs(err, domain.ErrInvalidInput)
+	})
+}
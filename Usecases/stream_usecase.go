@@ -0,0 +1,295 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/redis"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StreamSettings is how much of each topic is kept for resuming clients and how far a
+// subscriber may fall behind before it is dropped
+type StreamSettings struct {
+	Retain    int64         // events kept per topic, about
+	RetainFor time.Duration // how long a topic without events is kept
+	Buffer    int           // events waiting for a slow subscriber
+}
+
+var DefaultStreamSettings = StreamSettings{
+	Retain:    1000,
+	RetainFor: 24 * time.Hour,
+	Buffer:    64,
+}
+
+// streamMessage is what goes through pub/sub, the topic travels with the event
+type streamMessage struct {
+	Topic string             `json:"topic"`
+	Event domain.StreamEvent `json:"event"`
+}
+
+type streamSubscriber struct {
+	live chan domain.StreamEvent
+}
+
+type StreamUsecase struct {
+	redisClient redis.RedisClient
+	settings    StreamSettings
+	ctxtimeout  time.Duration
+
+	mu          sync.Mutex
+	subscribers map[string]map[*streamSubscriber]struct{}
+}
+
+func NewStreamUsecase(redisClient redis.RedisClient, settings StreamSettings, timeout time.Duration) domain.IStreamUsecase {
+	if settings.Retain <= 0 {
+		settings.Retain = DefaultStreamSettings.Retain
+	}
+	if settings.RetainFor <= 0 {
+		settings.RetainFor = DefaultStreamSettings.RetainFor
+	}
+	if settings.Buffer <= 0 {
+		settings.Buffer = DefaultStreamSettings.Buffer
+	}
+	return &StreamUsecase{
+		redisClient: redisClient,
+		settings:    settings,
+		ctxtimeout:  timeout,
+		subscribers: map[string]map[*streamSubscriber]struct{}{},
+	}
+}
+
+// Publish logs the event in the topic's stream, whose entry ID becomes the event ID, then
+// announces it to every server
+func (uc *StreamUsecase) Publish(topic string, eventType domain.StreamEventType, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	service := uc.redisClient.Service()
+	logKey := service.GenerateStreamLogKey(topic)
+	id, err := uc.redisClient.AddToStream(ctx, logKey, uc.settings.Retain, map[string]any{
+		"type": string(eventType),
+		"data": string(payload),
+	})
+	if err != nil {
+		return err
+	}
+	_ = uc.redisClient.Expire(ctx, logKey, uc.settings.RetainFor)
+
+	message, err := json.Marshal(streamMessage{
+		Topic: topic,
+		Event: domain.StreamEvent{ID: id, Type: eventType, Data: payload},
+	})
+	if err != nil {
+		return err
+	}
+	return uc.redisClient.Publish(ctx, service.GenerateStreamChannel(topic), string(message))
+}
+
+func (uc *StreamUsecase) Subscribe(ctx context.Context, topic, lastEventID string) (<-chan domain.StreamEvent, error) {
+	// subscribe before replaying, an event published in between comes twice and is skipped
+	// rather than lost
+	subscriber := &streamSubscriber{live: make(chan domain.StreamEvent, uc.settings.Buffer)}
+	uc.mu.Lock()
+	if uc.subscribers[topic] == nil {
+		uc.subscribers[topic] = map[*streamSubscriber]struct{}{}
+	}
+	uc.subscribers[topic][subscriber] = struct{}{}
+	uc.mu.Unlock()
+
+	var replay []domain.StreamEvent
+	if lastEventID != "" {
+		var err error
+		if replay, err = uc.replay(ctx, topic, lastEventID); err != nil {
+			uc.unsubscribe(topic, subscriber)
+			return nil, err
+		}
+	}
+
+	events := make(chan domain.StreamEvent)
+	go func() {
+		defer close(events)
+		defer uc.unsubscribe(topic, subscriber)
+
+		send := func(event domain.StreamEvent) bool {
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		last := lastEventID
+		for _, event := range replay {
+			if !send(event) {
+				return
+			}
+			if event.ID != "" {
+				last = event.ID
+			}
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-subscriber.live:
+				if !ok {
+					return
+				}
+				if last != "" && !streamIDAfter(event.ID, last) {
+					continue
+				}
+				if !send(event) {
+					return
+				}
+				last = event.ID
+			}
+		}
+	}()
+	return events, nil
+}
+
+// replay returns the kept events after lastEventID, or a reset event when some of them are
+// no longer kept
+func (uc *StreamUsecase) replay(ctx context.Context, topic, lastEventID string) ([]domain.StreamEvent, error) {
+	reset := []domain.StreamEvent{{Type: domain.StreamEventReset, Data: json.RawMessage("{}")}}
+	if _, _, ok := parseStreamID(lastEventID); !ok {
+		return reset, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, uc.ctxtimeout)
+	defer cancel()
+
+	logKey := uc.redisClient.Service().GenerateStreamLogKey(topic)
+	oldest, err := uc.redisClient.StreamRange(ctx, logKey, "-", "+", 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(oldest) > 0 && streamIDAfter(oldest[0].ID, lastEventID) {
+		return reset, nil
+	}
+
+	entries, err := uc.redisClient.StreamRange(ctx, logKey, "("+lastEventID, "+", uc.settings.Retain)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]domain.StreamEvent, 0, len(entries))
+	for _, entry := range entries {
+		eventType, _ := entry.Values["type"].(string)
+		data, _ := entry.Values["data"].(string)
+		events = append(events, domain.StreamEvent{ID: entry.ID, Type: domain.StreamEventType(eventType), Data: json.RawMessage(data)})
+	}
+	return events, nil
+}
+
+func (uc *StreamUsecase) Listen(ctx context.Context) error {
+	pubsub := uc.redisClient.PSubscribe(ctx, uc.redisClient.Service().GenerateStreamChannelPattern())
+	defer pubsub.Close()
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return err
+	}
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			var decoded streamMessage
+			if err := json.Unmarshal([]byte(message.Payload), &decoded); err != nil {
+				continue
+			}
+			uc.dispatch(decoded.Topic, decoded.Event)
+		}
+	}
+}
+
+// dispatch hands the event to the topic's local subscribers. One that fell behind is dropped,
+// it reconnects and catches up from the stream.
+func (uc *StreamUsecase) dispatch(topic string, event domain.StreamEvent) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	for subscriber := range uc.subscribers[topic] {
+		select {
+		case subscriber.live <- event:
+		default:
+			uc.remove(topic, subscriber)
+		}
+	}
+}
+
+func (uc *StreamUsecase) unsubscribe(topic string, subscriber *streamSubscriber) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.remove(topic, subscriber)
+}
+
+// remove is called with the lock held
+func (uc *StreamUsecase) remove(topic string, subscriber *streamSubscriber) {
+	if _, ok := uc.subscribers[topic][subscriber]; !ok {
+		return
+	}
+	delete(uc.subscribers[topic], subscriber)
+	if len(uc.subscribers[topic]) == 0 {
+		delete(uc.subscribers, topic)
+	}
+	close(subscriber.live)
+}
+
+// parseStreamID reads a Redis stream entry ID, <milliseconds>-<sequence>
+func parseStreamID(id string) (ms, seq uint64, ok bool) {
+	msPart, seqPart, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, false
+	}
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err = strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return ms, seq, true
+}
+
+// streamIDAfter reports whether stream entry ID a comes after b
+func streamIDAfter(a, b string) bool {
+	aMs, aSeq, aOK := parseStreamID(a)
+	bMs, bSeq, bOK := parseStreamID(b)
+	if !aOK || !bOK {
+		return aOK
+	}
+	return aMs > bMs || (aMs == bMs && aSeq > bSeq)
+}
+
+// RunStreamListener keeps the stream listening to the other servers until ctx is done, a lost
+// Redis connection is retried after retry
+func RunStreamListener(ctx context.Context, streams domain.IStreamUsecase, retry time.Duration) {
+	for {
+		err := streams.Listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("stream listener: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+	}
+}
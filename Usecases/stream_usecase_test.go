@@ -0,0 +1,170 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/redis"
+	redis_mocks "g6/blog-api/Infrastructure/redis/mocks"
+	"strconv"
+	"testing"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type StreamUsecaseSuite struct {
+	suite.Suite
+	mockRedis *redis_mocks.MockRedisClient
+	usecase   *StreamUsecase
+}
+
+func (s *StreamUsecaseSuite) SetupTest() {
+	s.mockRedis = redis_mocks.NewMockRedisClient(s.T())
+	s.usecase = NewStreamUsecase(s.mockRedis, StreamSettings{Retain: 100, RetainFor: time.Hour, Buffer: 4}, 3*time.Second).(*StreamUsecase)
+}
+
+func TestStreamUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(StreamUsecaseSuite))
+}
+
+func (s *StreamUsecaseSuite) TestPublish() {
+	s.mockRedis.On("Service").Return(&redis.RedisService{})
+	s.mockRedis.On("AddToStream", mock.Anything, "streamlog:post:p1", int64(100), map[string]any{
+		"type": "reactions",
+		"data": `{"blog_id":"p1","likes":3,"dislikes":1}`,
+	}).Return("1700000000000-0", nil)
+	s.mockRedis.On("Expire", mock.Anything, "streamlog:post:p1", time.Hour).Return(nil)
+	s.mockRedis.On("Publish", mock.Anything, "stream:post:p1", mock.MatchedBy(func(message string) bool {
+		var decoded streamMessage
+		return json.Unmarshal([]byte(message), &decoded) == nil &&
+			decoded.Topic == "post:p1" &&
+			decoded.Event.ID == "1700000000000-0" &&
+			decoded.Event.Type == domain.StreamEventReactions
+	})).Return(nil)
+
+	err := s.usecase.Publish(domain.PostTopic("p1"), domain.StreamEventReactions, domain.ReactionsStreamData{BlogID: "p1", Likes: 3, Dislikes: 1})
+
+	s.NoError(err)
+}
+
+func (s *StreamUsecaseSuite) TestSubscribe() {
+	s.Run("Live", func() {
+		s.SetupTest()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events, err := s.usecase.Subscribe(ctx, "post:p1", "")
+		s.Require().NoError(err)
+		s.usecase.dispatch("post:p2", domain.StreamEvent{ID: "1-0", Type: domain.StreamEventReactions})
+		s.usecase.dispatch("post:p1", domain.StreamEvent{ID: "2-0", Type: domain.StreamEventCommentCreated})
+
+		s.Equal("2-0", s.receive(events).ID)
+	})
+
+	s.Run("Resumes", func() {
+		s.SetupTest()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("StreamRange", mock.Anything, "streamlog:post:p1", "-", "+", int64(1)).Return([]goredis.XMessage{{ID: "50-0"}}, nil)
+		s.mockRedis.On("StreamRange", mock.Anything, "streamlog:post:p1", "(100-0", "+", int64(100)).Return([]goredis.XMessage{
+			{ID: "101-0", Values: map[string]any{"type": "comment_created", "data": `{"id":"c1"}`}},
+		}, nil)
+
+		events, err := s.usecase.Subscribe(ctx, "post:p1", "100-0")
+		s.Require().NoError(err)
+		// published while replaying, the first one arrives twice
+		s.usecase.dispatch("post:p1", domain.StreamEvent{ID: "101-0", Type: domain.StreamEventCommentCreated})
+		s.usecase.dispatch("post:p1", domain.StreamEvent{ID: "102-0", Type: domain.StreamEventCommentDeleted})
+
+		replayed := s.receive(events)
+		s.Equal("101-0", replayed.ID)
+		s.Equal(domain.StreamEventCommentCreated, replayed.Type)
+		s.JSONEq(`{"id":"c1"}`, string(replayed.Data))
+		s.Equal("102-0", s.receive(events).ID)
+	})
+
+	s.Run("ResetWhenEventsWereDropped", func() {
+		s.SetupTest()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.mockRedis.On("Service").Return(&redis.RedisService{})
+		s.mockRedis.On("StreamRange", mock.Anything, "streamlog:post:p1", "-", "+", int64(1)).Return([]goredis.XMessage{{ID: "200-0"}}, nil)
+
+		events, err := s.usecase.Subscribe(ctx, "post:p1", "100-0")
+		s.Require().NoError(err)
+
+		s.Equal(domain.StreamEventReset, s.receive(events).Type)
+	})
+
+	s.Run("ResetOnInvalidID", func() {
+		s.SetupTest()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events, err := s.usecase.Subscribe(ctx, "post:p1", "garbage")
+		s.Require().NoError(err)
+
+		s.Equal(domain.StreamEventReset, s.receive(events).Type)
+		s.usecase.dispatch("post:p1", domain.StreamEvent{ID: "1-0", Type: domain.StreamEventReactions})
+		s.Equal("1-0", s.receive(events).ID)
+	})
+
+	s.Run("DropsSlowSubscriber", func() {
+		s.SetupTest()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events, err := s.usecase.Subscribe(ctx, "post:p1", "")
+		s.Require().NoError(err)
+		for i := range 10 {
+			s.usecase.dispatch("post:p1", domain.StreamEvent{ID: strconv.Itoa(i) + "-0"})
+		}
+
+		s.Eventually(func() bool {
+			select {
+			case _, ok := <-events:
+				return !ok
+			default:
+				return false
+			}
+		}, time.Second, time.Millisecond)
+	})
+
+	s.Run("UnsubscribesWhenDone", func() {
+		s.SetupTest()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		events, err := s.usecase.Subscribe(ctx, "post:p1", "")
+		s.Require().NoError(err)
+		cancel()
+
+		for range events {
+		}
+		s.usecase.mu.Lock()
+		defer s.usecase.mu.Unlock()
+		s.Empty(s.usecase.subscribers)
+	})
+}
+
+func (s *StreamUsecaseSuite) TestStreamIDAfter() {
+	s.True(streamIDAfter("2-0", "1-5"))
+	s.True(streamIDAfter("1-6", "1-5"))
+	s.False(streamIDAfter("1-5", "1-5"))
+	s.False(streamIDAfter("1-4", "1-5"))
+	s.True(streamIDAfter("1-0", "garbage"))
+}
+
+func (s *StreamUsecaseSuite) receive(events <-chan domain.StreamEvent) domain.StreamEvent {
+	select {
+	case event, ok := <-events:
+		s.Require().True(ok, "stream closed")
+		return event
+	case <-time.After(time.Second):
+		s.FailNow("no event received")
+		return domain.StreamEvent{}
+	}
+}
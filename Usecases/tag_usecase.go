@@ -0,0 +1,312 @@
+package usecases
+
+import (
+	"context"
+	domain "g6/blog-api/Domain"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	defaultTagLimit = 10
+	maxTagLimit     = 100
+)
+
+type TagUsecase struct {
+	repo       domain.ITagRepository
+	postRepo   domain.BlogPostRepository
+	transactor domain.ITransactor
+	ctxtimeout time.Duration
+}
+
+// NewTagUsecase keeps the tags of the posts in postRepo canonical, renames and merges rewrite the
+// posts in a transaction of transactor
+func NewTagUsecase(repo domain.ITagRepository, postRepo domain.BlogPostRepository, transactor domain.ITransactor, timeout time.Duration) domain.ITagUsecase {
+	return &TagUsecase{
+		repo:       repo,
+		postRepo:   postRepo,
+		transactor: transactor,
+		ctxtimeout: timeout,
+	}
+}
+
+func (uc *TagUsecase) Normalize(ctx context.Context, names []string) ([]string, error) {
+	slugs := make([]string, 0, len(names))
+	for _, name := range names {
+		slug := domain.TagSlug(name)
+		if slug == "" {
+			continue
+		}
+		tag, err := uc.repo.FindBySlug(ctx, slug)
+		if err == domain.ErrTagNotFound {
+			now := time.Now()
+			tag = &domain.Tag{Name: tagName(name), Slug: slug, Synonyms: []string{}, CreatedAt: now, UpdatedAt: now}
+			err = uc.repo.Create(ctx, tag)
+			if err == domain.ErrTagConflict {
+				// another post brought the tag up in the meantime
+				tag, err = uc.repo.FindBySlug(ctx, slug)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(slugs, tag.Slug) {
+			slugs = append(slugs, tag.Slug)
+		}
+	}
+	return slugs, nil
+}
+
+func (uc *TagUsecase) Resolve(ctx context.Context, names []string) ([]string, error) {
+	slugs := make([]string, 0, len(names))
+	for _, name := range names {
+		slug := domain.TagSlug(name)
+		if slug == "" {
+			continue
+		}
+		tag, err := uc.repo.FindBySlug(ctx, slug)
+		if err == nil {
+			slug = tag.Slug
+		} else if err != domain.ErrTagNotFound {
+			return nil, err
+		}
+		if !slices.Contains(slugs, slug) {
+			slugs = append(slugs, slug)
+		}
+	}
+	return slugs, nil
+}
+
+func (uc *TagUsecase) RefreshUsage(ctx context.Context, slugs []string) error {
+	for _, slug := range uniqueValues(slugs) {
+		count, derr := uc.postRepo.CountByTag(ctx, slug)
+		if derr != nil {
+			return derr.Err
+		}
+		if err := uc.repo.SetUsageCount(ctx, slug, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *TagUsecase) Autocomplete(prefix string, limit int) ([]*domain.Tag, error) {
+	slug := domain.TagSlug(prefix)
+	if slug == "" {
+		return []*domain.Tag{}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.repo.Search(ctx, slug, tagLimit(limit))
+}
+
+func (uc *TagUsecase) Popular(limit int) ([]*domain.Tag, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.repo.Popular(ctx, tagLimit(limit))
+}
+
+func (uc *TagUsecase) Get(slug string) (*domain.Tag, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.find(ctx, slug)
+}
+
+func (uc *TagUsecase) Create(tag *domain.Tag) (*domain.Tag, error) {
+	slug := domain.TagSlug(tag.Name)
+	if slug == "" {
+		return nil, domain.ErrInvalidTagName
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	now := time.Now()
+	created := &domain.Tag{
+		Name:        tagName(tag.Name),
+		Slug:        slug,
+		Description: strings.TrimSpace(tag.Description),
+		Synonyms:    tagSynonyms(tag.Synonyms, slug),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := uc.checkFree(ctx, "", append([]string{slug}, created.Synonyms...)); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Create(ctx, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (uc *TagUsecase) Update(slug string, tag *domain.Tag) (*domain.Tag, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	current, err := uc.find(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	current.Description = strings.TrimSpace(tag.Description)
+	current.Synonyms = tagSynonyms(tag.Synonyms, current.Slug)
+	current.UpdatedAt = time.Now()
+	if err := uc.checkFree(ctx, current.ID, current.Synonyms); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Update(ctx, current); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+func (uc *TagUsecase) Rename(slug, name string) (*domain.Tag, error) {
+	newSlug := domain.TagSlug(name)
+	if newSlug == "" {
+		return nil, domain.ErrInvalidTagName
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	current, err := uc.find(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	oldSlug := current.Slug
+	current.Name = tagName(name)
+	current.UpdatedAt = time.Now()
+	if newSlug == oldSlug {
+		// only the spelling of the name changed, the posts stay as they are
+		if err := uc.repo.Update(ctx, current); err != nil {
+			return nil, err
+		}
+		return current, nil
+	}
+
+	if err := uc.checkFree(ctx, current.ID, []string{newSlug}); err != nil {
+		return nil, err
+	}
+	// links and searches with the old slug keep finding the tag
+	current.Slug = newSlug
+	current.Synonyms = tagSynonyms(append(current.Synonyms, oldSlug), newSlug)
+	err = uc.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Update(ctx, current); err != nil {
+			return err
+		}
+		if derr := uc.postRepo.ReplaceTags(ctx, []string{oldSlug}, newSlug); derr != nil {
+			return derr.Err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.refreshed(ctx, current)
+}
+
+func (uc *TagUsecase) Merge(source, target string) (*domain.Tag, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	from, err := uc.find(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	into, err := uc.find(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	if from.ID == into.ID {
+		return nil, domain.ErrTagMergeSelf
+	}
+
+	into.Synonyms = tagSynonyms(append(append(into.Synonyms, from.Slug), from.Synonyms...), into.Slug)
+	if into.Description == "" {
+		into.Description = from.Description
+	}
+	into.UpdatedAt = time.Now()
+	err = uc.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Delete(ctx, from.ID); err != nil {
+			return err
+		}
+		if err := uc.repo.Update(ctx, into); err != nil {
+			return err
+		}
+		if derr := uc.postRepo.ReplaceTags(ctx, []string{from.Slug}, into.Slug); derr != nil {
+			return derr.Err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.refreshed(ctx, into)
+}
+
+// find looks the tag up by the slug of what was asked for
+func (uc *TagUsecase) find(ctx context.Context, slug string) (*domain.Tag, error) {
+	slug = domain.TagSlug(slug)
+	if slug == "" {
+		return nil, domain.ErrTagNotFound
+	}
+	return uc.repo.FindBySlug(ctx, slug)
+}
+
+// checkFree fails with ErrTagConflict when a tag other than the one with id uses any of the slugs
+func (uc *TagUsecase) checkFree(ctx context.Context, id string, slugs []string) error {
+	for _, slug := range slugs {
+		tag, err := uc.repo.FindBySlug(ctx, slug)
+		if err == domain.ErrTagNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if tag.ID != id {
+			return domain.ErrTagConflict
+		}
+	}
+	return nil
+}
+
+// refreshed recounts the posts of the tag after they were rewritten
+func (uc *TagUsecase) refreshed(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	count, derr := uc.postRepo.CountByTag(ctx, tag.Slug)
+	if derr != nil {
+		return nil, derr.Err
+	}
+	if err := uc.repo.SetUsageCount(ctx, tag.Slug, count); err != nil {
+		return nil, err
+	}
+	tag.UsageCount = count
+	return tag, nil
+}
+
+// tagName is the name as written, on one line
+func tagName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// tagSynonyms are the slugs of the synonyms without repeats and the tag's own slug
+func tagSynonyms(synonyms []string, slug string) []string {
+	slugs := make([]string, 0, len(synonyms))
+	for _, synonym := range synonyms {
+		synonym = domain.TagSlug(synonym)
+		if synonym != "" && synonym != slug && !slices.Contains(slugs, synonym) {
+			slugs = append(slugs, synonym)
+		}
+	}
+	return slugs
+}
+
+func tagLimit(limit int) int {
+	if limit <= 0 {
+		return defaultTagLimit
+	}
+	return min(limit, maxTagLimit)
+}
@@ -0,0 +1,293 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TagUsecaseSuite struct {
+	suite.Suite
+	mockRepo       *domain_mocks.MockITagRepository
+	mockPostRepo   *domain_mocks.MockBlogPostRepository
+	mockTransactor *domain_mocks.MockITransactor
+	usecase        domain.ITagUsecase
+}
+
+func (s *TagUsecaseSuite) SetupTest() {
+	s.mockRepo = domain_mocks.NewMockITagRepository(s.T())
+	s.mockPostRepo = domain_mocks.NewMockBlogPostRepository(s.T())
+	s.mockTransactor = domain_mocks.NewMockITransactor(s.T())
+	s.usecase = NewTagUsecase(s.mockRepo, s.mockPostRepo, s.mockTransactor, 3*time.Second)
+}
+
+func TestTagUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(TagUsecaseSuite))
+}
+
+// expectTransaction runs the callback of the transaction as if it committed
+func (s *TagUsecaseSuite) expectTransaction() {
+	s.mockTransactor.On("WithTransaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+}
+
+func TestTagSlug(t *testing.T) {
+	for name, want := range map[string]string{
+		"Go":             "go",
+		"  Go Lang! ":    "go-lang",
+		"C++":            "c",
+		"node.js":        "node-js",
+		"Über---Cool":    "über-cool",
+		"web3":           "web3",
+		"!!!":            "",
+		"machine_learn ": "machine-learn",
+	} {
+		if got := domain.TagSlug(name); got != want {
+			t.Errorf("TagSlug(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func (s *TagUsecaseSuite) TestNormalize() {
+	s.Run("SynonymsAndNewTags", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(&domain.Tag{ID: "t1", Slug: "go"}, nil)
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(&domain.Tag{ID: "t1", Slug: "go"}, nil)
+		s.mockRepo.On("FindBySlug", mock.Anything, "web-assembly").Return(nil, domain.ErrTagNotFound)
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(tag *domain.Tag) bool {
+			return tag.Name == "Web Assembly" && tag.Slug == "web-assembly"
+		})).Return(nil)
+
+		tags, err := s.usecase.Normalize(context.Background(), []string{"golang", " Go ", "", "Web   Assembly", "#"})
+
+		s.NoError(err)
+		s.Equal([]string{"go", "web-assembly"}, tags)
+	})
+
+	s.Run("CreatedMeanwhile", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindBySlug", mock.Anything, "rust").Return(nil, domain.ErrTagNotFound).Once()
+		s.mockRepo.On("Create", mock.Anything, mock.Anything).Return(domain.ErrTagConflict)
+		s.mockRepo.On("FindBySlug", mock.Anything, "rust").Return(&domain.Tag{ID: "t2", Slug: "rust"}, nil).Once()
+
+		tags, err := s.usecase.Normalize(context.Background(), []string{"Rust"})
+
+		s.NoError(err)
+		s.Equal([]string{"rust"}, tags)
+	})
+
+	s.Run("DBFailure", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(nil, errors.New("db error"))
+
+		_, err := s.usecase.Normalize(context.Background(), []string{"go"})
+
+		s.EqualError(err, "db error")
+	})
+}
+
+func (s *TagUsecaseSuite) TestResolve() {
+	s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(&domain.Tag{ID: "t1", Slug: "go"}, nil)
+	s.mockRepo.On("FindBySlug", mock.Anything, "unknown").Return(nil, domain.ErrTagNotFound)
+
+	tags, err := s.usecase.Resolve(context.Background(), []string{"golang", "Unknown"})
+
+	s.NoError(err)
+	s.Equal([]string{"go", "unknown"}, tags)
+	s.mockRepo.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *TagUsecaseSuite) TestRefreshUsage() {
+	s.mockPostRepo.On("CountByTag", mock.Anything, "go").Return(4, nil).Once()
+	s.mockPostRepo.On("CountByTag", mock.Anything, "web").Return(0, nil).Once()
+	s.mockRepo.On("SetUsageCount", mock.Anything, "go", 4).Return(nil).Once()
+	s.mockRepo.On("SetUsageCount", mock.Anything, "web", 0).Return(nil).Once()
+
+	err := s.usecase.RefreshUsage(context.Background(), []string{"go", "web", "go"})
+
+	s.NoError(err)
+}
+
+func (s *TagUsecaseSuite) TestAutocomplete() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockRepo.On("Search", mock.Anything, "go-l", 100).Return([]*domain.Tag{{Slug: "go"}}, nil)
+
+		tags, err := s.usecase.Autocomplete("Go L", 500)
+
+		s.NoError(err)
+		s.Len(tags, 1)
+	})
+
+	s.Run("EmptyPrefix", func() {
+		s.SetupTest()
+
+		tags, err := s.usecase.Autocomplete(" - ", 0)
+
+		s.NoError(err)
+		s.Empty(tags)
+	})
+}
+
+func (s *TagUsecaseSuite) TestPopular() {
+	s.mockRepo.On("Popular", mock.Anything, 10).Return([]*domain.Tag{{Slug: "go", UsageCount: 9}}, nil)
+
+	tags, err := s.usecase.Popular(0)
+
+	s.NoError(err)
+	s.Len(tags, 1)
+}
+
+func (s *TagUsecaseSuite) TestCreate() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(nil, domain.ErrTagNotFound)
+		s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(nil, domain.ErrTagNotFound)
+		s.mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(tag *domain.Tag) bool {
+			return tag.Slug == "go" && tag.Description == "The Go language"
+		})).Return(nil)
+
+		tag, err := s.usecase.Create(&domain.Tag{Name: "Go", Description: " The Go language ", Synonyms: []string{"Golang", "go", "golang"}})
+
+		s.NoError(err)
+		s.Equal([]string{"golang"}, tag.Synonyms)
+	})
+
+	s.Run("SynonymOfOtherTag", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(nil, domain.ErrTagNotFound)
+		s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(&domain.Tag{ID: "t9", Slug: "golang"}, nil)
+
+		_, err := s.usecase.Create(&domain.Tag{Name: "Go", Synonyms: []string{"golang"}})
+
+		s.Equal(domain.ErrTagConflict, err)
+	})
+
+	s.Run("InvalidName", func() {
+		s.SetupTest()
+
+		_, err := s.usecase.Create(&domain.Tag{Name: "++"})
+
+		s.Equal(domain.ErrInvalidTagName, err)
+	})
+}
+
+func (s *TagUsecaseSuite) TestUpdate() {
+	s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(&domain.Tag{ID: "t1", Name: "Go", Slug: "go", Synonyms: []string{"golang"}}, nil)
+	s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(&domain.Tag{ID: "t1", Slug: "go"}, nil)
+	s.mockRepo.On("FindBySlug", mock.Anything, "go-lang").Return(nil, domain.ErrTagNotFound)
+	s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(tag *domain.Tag) bool {
+		return tag.ID == "t1" && tag.Description == "Go"
+	})).Return(nil)
+
+	tag, err := s.usecase.Update("go", &domain.Tag{Description: "Go", Synonyms: []string{"golang", "go lang"}})
+
+	s.NoError(err)
+	s.Equal([]string{"golang", "go-lang"}, tag.Synonyms)
+}
+
+func (s *TagUsecaseSuite) TestRename() {
+	s.Run("RetagsPosts", func() {
+		s.SetupTest()
+		s.expectTransaction()
+		s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(&domain.Tag{ID: "t1", Name: "golang", Slug: "golang", Synonyms: []string{}}, nil)
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(nil, domain.ErrTagNotFound)
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(tag *domain.Tag) bool {
+			return tag.Name == "Go" && tag.Slug == "go"
+		})).Return(nil)
+		s.mockPostRepo.On("ReplaceTags", mock.Anything, []string{"golang"}, "go").Return(nil)
+		s.mockPostRepo.On("CountByTag", mock.Anything, "go").Return(7, nil)
+		s.mockRepo.On("SetUsageCount", mock.Anything, "go", 7).Return(nil)
+
+		tag, err := s.usecase.Rename("golang", "Go")
+
+		s.NoError(err)
+		s.Equal([]string{"golang"}, tag.Synonyms)
+		s.Equal(7, tag.UsageCount)
+	})
+
+	s.Run("SameSlug", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(&domain.Tag{ID: "t1", Name: "go", Slug: "go"}, nil)
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(tag *domain.Tag) bool { return tag.Name == "GO" })).Return(nil)
+
+		_, err := s.usecase.Rename("go", "GO")
+
+		s.NoError(err)
+		s.mockPostRepo.AssertNotCalled(s.T(), "ReplaceTags", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	s.Run("Taken", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(&domain.Tag{ID: "t1", Slug: "golang"}, nil)
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(&domain.Tag{ID: "t2", Slug: "go"}, nil)
+
+		_, err := s.usecase.Rename("golang", "Go")
+
+		s.Equal(domain.ErrTagConflict, err)
+	})
+
+	s.Run("RetagFailureRollsBack", func() {
+		s.SetupTest()
+		s.expectTransaction()
+		s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(&domain.Tag{ID: "t1", Slug: "golang"}, nil)
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(nil, domain.ErrTagNotFound)
+		s.mockRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+		s.mockPostRepo.On("ReplaceTags", mock.Anything, []string{"golang"}, "go").Return(&domain.DomainError{Err: errors.New("db error"), Code: http.StatusInternalServerError})
+
+		_, err := s.usecase.Rename("golang", "Go")
+
+		s.EqualError(err, "db error")
+		s.mockRepo.AssertNotCalled(s.T(), "SetUsageCount", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func (s *TagUsecaseSuite) TestMerge() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.expectTransaction()
+		s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(&domain.Tag{ID: "t2", Slug: "golang", Description: "Gophers", Synonyms: []string{"go-lang"}}, nil)
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(&domain.Tag{ID: "t1", Slug: "go", Synonyms: []string{"golang-dev"}}, nil)
+		s.mockRepo.On("Delete", mock.Anything, "t2").Return(nil)
+		s.mockRepo.On("Update", mock.Anything, mock.MatchedBy(func(tag *domain.Tag) bool { return tag.ID == "t1" })).Return(nil)
+		s.mockPostRepo.On("ReplaceTags", mock.Anything, []string{"golang"}, "go").Return(nil)
+		s.mockPostRepo.On("CountByTag", mock.Anything, "go").Return(12, nil)
+		s.mockRepo.On("SetUsageCount", mock.Anything, "go", 12).Return(nil)
+
+		tag, err := s.usecase.Merge("golang", "go")
+
+		s.NoError(err)
+		s.Equal([]string{"golang-dev", "golang", "go-lang"}, tag.Synonyms)
+		s.Equal("Gophers", tag.Description)
+		s.Equal(12, tag.UsageCount)
+	})
+
+	s.Run("Self", func() {
+		s.SetupTest()
+		// golang is a synonym of go
+		s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(&domain.Tag{ID: "t1", Slug: "go"}, nil)
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(&domain.Tag{ID: "t1", Slug: "go"}, nil)
+
+		_, err := s.usecase.Merge("golang", "go")
+
+		s.Equal(domain.ErrTagMergeSelf, err)
+	})
+
+	s.Run("TargetNotFound", func() {
+		s.SetupTest()
+		s.mockRepo.On("FindBySlug", mock.Anything, "golang").Return(&domain.Tag{ID: "t2", Slug: "golang"}, nil)
+		s.mockRepo.On("FindBySlug", mock.Anything, "go").Return(nil, domain.ErrTagNotFound)
+
+		_, err := s.usecase.Merge("golang", "go")
+
+		s.Equal(domain.ErrTagNotFound, err)
+	})
+}
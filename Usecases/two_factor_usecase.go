@@ -0,0 +1,270 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/security"
+	"slices"
+	"strings"
+	"time"
+)
+
+const recoveryCodeCount = 10
+
+type TwoFactorUsecase struct {
+	repo          domain.ITwoFactorRepository
+	userRepo      domain.IUserRepository
+	issuer        string
+	secretKey     string
+	defaultPolicy []domain.UserRole
+	ctxtimeout    time.Duration
+}
+
+func NewTwoFactorUsecase(repo domain.ITwoFactorRepository, userRepo domain.IUserRepository, issuer, secretKey string, defaultRequiredRoles []domain.UserRole, timeout time.Duration) domain.ITwoFactorUsecase {
+	return &TwoFactorUsecase{
+		repo:          repo,
+		userRepo:      userRepo,
+		issuer:        issuer,
+		secretKey:     secretKey,
+		defaultPolicy: defaultRequiredRoles,
+		ctxtimeout:    timeout,
+	}
+}
+
+// Enroll creates a fresh, not yet enabled TOTP secret for the user.
+// Calling it again before confirmation replaces the pending secret.
+func (uc *TwoFactorUsecase) Enroll(userID string) (*domain.TwoFactorEnrollment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	user, err := uc.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	existing, err := uc.repo.FindByUserID(ctx, userID)
+	if err != nil && err != domain.ErrTwoFactorNotFound {
+		return nil, err
+	}
+	if existing != nil && existing.Enabled {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := security.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	cipher, err := security.EncryptSecret(uc.secretKey, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := uc.repo.Upsert(ctx, &domain.TwoFactor{
+		UserID:       userID,
+		SecretCipher: cipher,
+		Enabled:      false,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.TwoFactorEnrollment{
+		Secret:     secret,
+		OTPAuthURI: security.TOTPAuthURI(uc.issuer, user.Email, secret),
+	}, nil
+}
+
+// Confirm enables two-factor authentication once the user proves the app is set up,
+// and returns the plain recovery codes. They are only ever shown here.
+func (uc *TwoFactorUsecase) Confirm(userID, code string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	twoFactor, err := uc.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if twoFactor.Enabled {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+	if err := uc.checkTOTP(twoFactor, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := uc.newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	twoFactor.Enabled = true
+	twoFactor.RecoveryCodeHashes = hashes
+	twoFactor.ConfirmedAt = now
+	twoFactor.UpdatedAt = now
+	if err := uc.repo.Upsert(ctx, twoFactor); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// Verify accepts either a current TOTP code or an unused recovery code
+func (uc *TwoFactorUsecase) Verify(userID, code string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	twoFactor, err := uc.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !twoFactor.Enabled {
+		return domain.ErrTwoFactorNotEnabled
+	}
+
+	if err := uc.checkTOTP(twoFactor, code); err == nil {
+		twoFactor.UpdatedAt = time.Now()
+		return uc.repo.Upsert(ctx, twoFactor)
+	}
+
+	// fall back to recovery codes, each one works only once
+	hash, _ := security.HashToken(normalizeRecoveryCode(code))
+	idx := slices.Index(twoFactor.RecoveryCodeHashes, hash)
+	if idx < 0 {
+		return domain.ErrTwoFactorInvalidCode
+	}
+	twoFactor.RecoveryCodeHashes = slices.Delete(twoFactor.RecoveryCodeHashes, idx, idx+1)
+	twoFactor.UpdatedAt = time.Now()
+	return uc.repo.Upsert(ctx, twoFactor)
+}
+
+func (uc *TwoFactorUsecase) Disable(userID, code string) error {
+	if err := uc.Verify(userID, code); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+	return uc.repo.DeleteByUserID(ctx, userID)
+}
+
+func (uc *TwoFactorUsecase) RegenerateRecoveryCodes(userID, code string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	twoFactor, err := uc.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !twoFactor.Enabled {
+		return nil, domain.ErrTwoFactorNotEnabled
+	}
+	if err := uc.checkTOTP(twoFactor, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := uc.newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	twoFactor.RecoveryCodeHashes = hashes
+	twoFactor.UpdatedAt = time.Now()
+	if err := uc.repo.Upsert(ctx, twoFactor); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (uc *TwoFactorUsecase) IsEnabled(userID string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	twoFactor, err := uc.repo.FindByUserID(ctx, userID)
+	if err == domain.ErrTwoFactorNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return twoFactor.Enabled, nil
+}
+
+func (uc *TwoFactorUsecase) IsRequired(role domain.UserRole) (bool, error) {
+	policy, err := uc.GetPolicy()
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(policy.RequiredRoles, role), nil
+}
+
+// GetPolicy returns the saved policy, or the configured default when none was saved yet
+func (uc *TwoFactorUsecase) GetPolicy() (*domain.TwoFactorPolicy, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	policy, err := uc.repo.GetPolicy(ctx)
+	if err == domain.ErrNotFound {
+		return &domain.TwoFactorPolicy{RequiredRoles: uc.defaultPolicy}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (uc *TwoFactorUsecase) UpdatePolicy(policy *domain.TwoFactorPolicy) error {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	for _, role := range policy.RequiredRoles {
+		if role != domain.RoleUser && role != domain.RoleAdmin && role != domain.RoleSuperAdmin {
+			return errors.New("unknown role in policy: " + string(role))
+		}
+	}
+	policy.UpdatedAt = time.Now()
+	return uc.repo.SavePolicy(ctx, policy)
+}
+
+// checkTOTP validates the code and records its time step so it cannot be replayed
+func (uc *TwoFactorUsecase) checkTOTP(twoFactor *domain.TwoFactor, code string) error {
+	secret, err := security.DecryptSecret(uc.secretKey, twoFactor.SecretCipher)
+	if err != nil {
+		return err
+	}
+	step, ok := security.ValidateTOTPCode(secret, code, time.Now())
+	if !ok || step <= twoFactor.LastUsedStep {
+		return domain.ErrTwoFactorInvalidCode
+	}
+	twoFactor.LastUsedStep = step
+	return nil
+}
+
+func (uc *TwoFactorUsecase) newRecoveryCodes() ([]string, []string, error) {
+	codes, err := security.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hash, _ := security.HashToken(code)
+		hashes = append(hashes, hash)
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// ParseRoles turns a comma separated role list from configuration into roles
+func ParseRoles(value string) []domain.UserRole {
+	var roles []domain.UserRole
+	for _, role := range strings.Split(value, ",") {
+		role = strings.TrimSpace(role)
+		if role != "" {
+			roles = append(roles, domain.UserRole(role))
+		}
+	}
+	return roles
+}
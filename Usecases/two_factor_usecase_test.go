@@ -0,0 +1,193 @@
+package usecases
+
+import (
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"g6/blog-api/Infrastructure/security"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const testTwoFactorKey = "test-two-factor-key"
+
+// TwoFactorUsecaseSuite defines the test suite for TwoFactorUsecase
+type TwoFactorUsecaseSuite struct {
+	suite.Suite
+	mockRepo     *domain_mocks.MockITwoFactorRepository
+	mockUserRepo *domain_mocks.MockIUserRepository
+	usecase      domain.ITwoFactorUsecase
+}
+
+func (s *TwoFactorUsecaseSuite) SetupTest() {
+	s.mockRepo = domain_mocks.NewMockITwoFactorRepository(s.T())
+	s.mockUserRepo = domain_mocks.NewMockIUserRepository(s.T())
+	s.usecase = NewTwoFactorUsecase(s.mockRepo, s.mockUserRepo, "BlogAPI", testTwoFactorKey, []domain.UserRole{domain.RoleSuperAdmin}, 3*time.Second)
+}
+
+func TestTwoFactorUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(TwoFactorUsecaseSuite))
+}
+
+// enabledTwoFactor builds a stored, enabled record and returns it with its plain secret
+func (s *TwoFactorUsecaseSuite) enabledTwoFactor(recoveryCodes ...string) (*domain.TwoFactor, string) {
+	secret, _ := security.GenerateTOTPSecret()
+	cipher, _ := security.EncryptSecret(testTwoFactorKey, secret)
+	hashes := []string{}
+	for _, code := range recoveryCodes {
+		hash, _ := security.HashToken(code)
+		hashes = append(hashes, hash)
+	}
+	return &domain.TwoFactor{
+		UserID:             "1",
+		SecretCipher:       cipher,
+		Enabled:            true,
+		RecoveryCodeHashes: hashes,
+	}, secret
+}
+
+func (s *TwoFactorUsecaseSuite) TestEnroll() {
+	s.Run("Success", func() {
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1", Email: "test@example.com"}, nil)
+		s.mockRepo.On("FindByUserID", mock.Anything, "1").Return(nil, domain.ErrTwoFactorNotFound)
+		s.mockRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(tf *domain.TwoFactor) bool {
+			return tf.UserID == "1" && !tf.Enabled && tf.SecretCipher != ""
+		})).Return(nil)
+
+		enrollment, err := s.usecase.Enroll("1")
+
+		s.NoError(err)
+		s.NotEmpty(enrollment.Secret)
+		s.Contains(enrollment.OTPAuthURI, "otpauth://totp/")
+		s.Contains(enrollment.OTPAuthURI, "secret="+enrollment.Secret)
+		s.resetMocks()
+	})
+
+	s.Run("AlreadyEnabled", func() {
+		twoFactor, _ := s.enabledTwoFactor()
+		s.mockUserRepo.On("FindUserByID", mock.Anything, "1").Return(&domain.User{ID: "1"}, nil)
+		s.mockRepo.On("FindByUserID", mock.Anything, "1").Return(twoFactor, nil)
+
+		enrollment, err := s.usecase.Enroll("1")
+
+		s.Nil(enrollment)
+		s.Equal(domain.ErrTwoFactorAlreadyEnabled, err)
+		s.resetMocks()
+	})
+}
+
+func (s *TwoFactorUsecaseSuite) TestConfirm() {
+	s.Run("Success", func() {
+		twoFactor, secret := s.enabledTwoFactor()
+		twoFactor.Enabled = false
+		code, _ := security.GenerateTOTPCode(secret, time.Now())
+		s.mockRepo.On("FindByUserID", mock.Anything, "1").Return(twoFactor, nil)
+		s.mockRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(tf *domain.TwoFactor) bool {
+			return tf.Enabled && len(tf.RecoveryCodeHashes) == recoveryCodeCount
+		})).Return(nil)
+
+		codes, err := s.usecase.Confirm("1", code)
+
+		s.NoError(err)
+		s.Len(codes, recoveryCodeCount)
+		s.resetMocks()
+	})
+
+	s.Run("InvalidCode", func() {
+		twoFactor, _ := s.enabledTwoFactor()
+		twoFactor.Enabled = false
+		s.mockRepo.On("FindByUserID", mock.Anything, "1").Return(twoFactor, nil)
+
+		codes, err := s.usecase.Confirm("1", "000000")
+
+		s.Nil(codes)
+		s.Equal(domain.ErrTwoFactorInvalidCode, err)
+		s.resetMocks()
+	})
+}
+
+func (s *TwoFactorUsecaseSuite) TestVerify() {
+	s.Run("TOTPCode", func() {
+		twoFactor, secret := s.enabledTwoFactor()
+		code, _ := security.GenerateTOTPCode(secret, time.Now())
+		s.mockRepo.On("FindByUserID", mock.Anything, "1").Return(twoFactor, nil)
+		s.mockRepo.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+
+		s.NoError(s.usecase.Verify("1", code))
+		s.resetMocks()
+	})
+
+	s.Run("ReplayedCode", func() {
+		twoFactor, secret := s.enabledTwoFactor()
+		code, _ := security.GenerateTOTPCode(secret, time.Now())
+		step, _ := security.ValidateTOTPCode(secret, code, time.Now())
+		twoFactor.LastUsedStep = step
+		s.mockRepo.On("FindByUserID", mock.Anything, "1").Return(twoFactor, nil)
+
+		s.Equal(domain.ErrTwoFactorInvalidCode, s.usecase.Verify("1", code))
+		s.resetMocks()
+	})
+
+	s.Run("RecoveryCodeIsSingleUse", func() {
+		twoFactor, _ := s.enabledTwoFactor("abcde-12345", "fffff-00000")
+		s.mockRepo.On("FindByUserID", mock.Anything, "1").Return(twoFactor, nil)
+		s.mockRepo.On("Upsert", mock.Anything, mock.MatchedBy(func(tf *domain.TwoFactor) bool {
+			return len(tf.RecoveryCodeHashes) == 1
+		})).Return(nil)
+
+		s.NoError(s.usecase.Verify("1", " ABCDE-12345 "))
+		s.Equal(domain.ErrTwoFactorInvalidCode, s.usecase.Verify("1", "abcde-12345"))
+		s.resetMocks()
+	})
+
+	s.Run("NotEnabled", func() {
+		twoFactor, _ := s.enabledTwoFactor()
+		twoFactor.Enabled = false
+		s.mockRepo.On("FindByUserID", mock.Anything, "1").Return(twoFactor, nil)
+
+		s.Equal(domain.ErrTwoFactorNotEnabled, s.usecase.Verify("1", "123456"))
+		s.resetMocks()
+	})
+}
+
+func (s *TwoFactorUsecaseSuite) TestPolicy() {
+	s.Run("DefaultWhenNotSaved", func() {
+		s.mockRepo.On("GetPolicy", mock.Anything).Return(nil, domain.ErrNotFound)
+
+		required, err := s.usecase.IsRequired(domain.RoleSuperAdmin)
+		s.NoError(err)
+		s.True(required)
+
+		required, err = s.usecase.IsRequired(domain.RoleUser)
+		s.NoError(err)
+		s.False(required)
+		s.resetMocks()
+	})
+
+	s.Run("SavedPolicy", func() {
+		s.mockRepo.On("GetPolicy", mock.Anything).Return(&domain.TwoFactorPolicy{RequiredRoles: []domain.UserRole{domain.RoleAdmin}}, nil)
+
+		required, err := s.usecase.IsRequired(domain.RoleAdmin)
+
+		s.NoError(err)
+		s.True(required)
+		s.resetMocks()
+	})
+
+	s.Run("UpdateRejectsUnknownRole", func() {
+		err := s.usecase.UpdatePolicy(&domain.TwoFactorPolicy{RequiredRoles: []domain.UserRole{"owner"}})
+
+		s.Error(err)
+		s.mockRepo.AssertNotCalled(s.T(), "SavePolicy", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+}
+
+func (s *TwoFactorUsecaseSuite) resetMocks() {
+	s.mockRepo.ExpectedCalls = nil
+	s.mockRepo.Calls = nil
+	s.mockUserRepo.ExpectedCalls = nil
+	s.mockUserRepo.Calls = nil
+}
@@ -13,13 +13,19 @@ import (
 type UserUsecase struct {
 	userRepo       domain.IUserRepository
 	storageService domain.StorageService
+	policy         domain.IPolicy
+	passwordPolicy domain.IPasswordPolicy
+	events         domain.IEventBus
 	ctxtimeout     time.Duration
 }
 
-func NewUserUsecase(userRepo domain.IUserRepository, storageService domain.StorageService, timeout time.Duration) domain.IUserUsecase {
+func NewUserUsecase(userRepo domain.IUserRepository, storageService domain.StorageService, policy domain.IPolicy, passwordPolicy domain.IPasswordPolicy, events domain.IEventBus, timeout time.Duration) domain.IUserUsecase {
 	return &UserUsecase{
 		userRepo:       userRepo,
 		storageService: storageService,
+		policy:         policy,
+		passwordPolicy: passwordPolicy,
+		events:         events,
 		ctxtimeout:     timeout,
 	}
 }
@@ -38,12 +44,20 @@ func (uc *UserUsecase) Register(request *domain.User) error {
 	if err == nil && (user != domain.User{}) {
 		return errors.New("email already exists")
 	}
+	if err := uc.passwordPolicy.Validate(ctx, nil, request.Password); err != nil {
+		return err
+	}
 	hashed, _ := security.HashPassword(request.Password)
 	request.Password = hashed
 	request.IsVerified = false
 	request.CreatedAt = time.Now()
 	request.UpdatedAt = time.Now()
-	return uc.userRepo.CreateUser(ctx, request)
+	if err := uc.userRepo.CreateUser(ctx, request); err != nil {
+		return err
+	}
+	// the password is set already, a missing history entry only weakens the reuse check
+	_ = uc.passwordPolicy.Remember(ctx, request.ID, hashed)
+	return nil
 }
 
 // Logout
@@ -58,11 +72,10 @@ func (uc *UserUsecase) ChangeRole(initiatorRole string, targetUserID string, req
 	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
 	defer cancel()
 
-	initRole := domain.UserRole(initiatorRole)
+	initiator := domain.Actor{Role: domain.UserRole(initiatorRole)}
 	newRole := request.Role
 
-	// only super admin or admin can change roles
-	if initRole != domain.RoleSuperAdmin && initRole != domain.RoleAdmin {
+	if !uc.policy.HasPermission(initiator, domain.PermUserPromote) && !uc.policy.HasPermission(initiator, domain.PermUserRoleManage) {
 		return errors.New("unauthorized: only superadmin or admin can change roles")
 	}
 
@@ -70,29 +83,12 @@ func (uc *UserUsecase) ChangeRole(initiatorRole string, targetUserID string, req
 	if err != nil {
 		return errors.New("target user not found")
 	}
-
-	//only super admin can change to super admin
-	if newRole == domain.RoleSuperAdmin && initRole != domain.RoleSuperAdmin {
-		return errors.New("unauthorized: only superadmin can assign superadmin role")
-	}
 	if newRole == target.Role {
 		return errors.New("no change in role")
 	}
-
-	if target.Role == domain.RoleSuperAdmin && initRole != domain.RoleSuperAdmin {
-		return errors.New("only superadmin can modify superadmin role")
-	}
-	if initRole == domain.RoleAdmin {
-		switch target.Role {
-		case domain.RoleAdmin:
-			return errors.New("unauthorized: admin cannot modify other admins")
-		case domain.RoleSuperAdmin:
-			return errors.New("unauthorized: admin cannot modify superadmin")
-		case domain.RoleUser:
-			if newRole != domain.RoleAdmin {
-				return errors.New("unauthorized: admin can only promote users to admin")
-			}
-		}
+	// with the default policy admins may only promote users, superadmins may make any change
+	if !uc.policy.CanChangeRole(initiator, target.Role, newRole) {
+		return errors.New("unauthorized: the policy does not allow this role change")
 	}
 
 	// Proceed with changing the role
@@ -178,9 +174,23 @@ func (uc *UserUsecase) UpdateProfile(userID string, update domain.UserProfileUpd
 	if update.LastName != "" {
 		user.LastName = update.LastName
 	}
+	if update.Language != "" {
+		user.Language = update.Language
+	}
 
-	// Update in repository
-	if err := uc.userRepo.UpdateUser(ctx, user.ID, user); err != nil {
+	// Update in repository, the user.updated subscribers bring the author name of the posts
+	// up to date
+	err = uc.events.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.UpdateUser(ctx, user.ID, user); err != nil {
+			return err
+		}
+		return uc.events.Publish(ctx, domain.EventUserUpdated, user.ID, domain.UserEventData{
+			ID:        user.ID,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+		})
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -201,6 +211,9 @@ func (uc *UserUsecase) ChangePassword(userID, oldPassword, newPassword string) e
 	if err := security.ValidatePassword(user.Password, oldPassword); err != nil {
 		return errors.New("invalid old password")
 	}
+	if err := uc.passwordPolicy.Validate(ctx, user, newPassword); err != nil {
+		return err
+	}
 
 	// Hash new password
 	hashedPassword, err := security.HashPassword(newPassword)
@@ -210,5 +223,28 @@ func (uc *UserUsecase) ChangePassword(userID, oldPassword, newPassword string) e
 
 	// Update password
 	user.Password = hashedPassword
-	return uc.userRepo.UpdateUser(ctx, user.ID, user)
+	if err := uc.userRepo.UpdateUser(ctx, user.ID, user); err != nil {
+		return err
+	}
+	_ = uc.passwordPolicy.Remember(ctx, user.ID, hashedPassword)
+	return nil
+}
+
+func (uc *UserUsecase) RehashPassword(user *domain.User, password string) error {
+	if !security.PasswordNeedsRehash(user.Password) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	hashedPassword, err := security.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	// the user was read at login, writing all of it back could undo changes made since
+	if err := uc.userRepo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		return err
+	}
+	user.Password = hashedPassword
+	return nil
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // UserUsecaseSuite defines the test suite for UserUsecase
@@ -19,6 +20,8 @@ type UserUsecaseSuite struct {
 	suite.Suite
 	mockUserRepo *domain_mocks.MockIUserRepository
 	mockStorage  *domain_mocks.MockStorageService
+	mockPolicy   *domain_mocks.MockIPasswordPolicy
+	mockEvents   *domain_mocks.MockIEventBus
 	usecase      *UserUsecase
 	timeout      time.Duration
 }
@@ -26,10 +29,15 @@ type UserUsecaseSuite struct {
 func (s *UserUsecaseSuite) SetupTest() {
 	s.mockUserRepo = domain_mocks.NewMockIUserRepository(s.T())
 	s.mockStorage = domain_mocks.NewMockStorageService(s.T())
+	s.mockPolicy = domain_mocks.NewMockIPasswordPolicy(s.T())
+	s.mockEvents = domain_mocks.NewMockIEventBus(s.T())
 	s.timeout = 5 * time.Second
 	s.usecase = &UserUsecase{
 		userRepo:       s.mockUserRepo,
 		storageService: s.mockStorage,
+		policy:         security.NewPolicy(security.DefaultRolePermissions),
+		passwordPolicy: s.mockPolicy,
+		events:         s.mockEvents,
 		ctxtimeout:     s.timeout,
 	}
 }
@@ -52,7 +60,9 @@ func (s *UserUsecaseSuite) TestRegister() {
 		// hashed password
 		hashedPassword, _ := security.HashPassword(user.Password)
 		user.Password = hashedPassword
+		s.mockPolicy.On("Validate", mock.Anything, (*domain.User)(nil), hashedPassword).Return(nil)
 		s.mockUserRepo.On("CreateUser", mock.Anything, user).Return(nil)
+		s.mockPolicy.On("Remember", mock.Anything, user.ID, mock.Anything).Return(nil)
 
 		err := s.usecase.Register(user)
 
@@ -60,6 +70,24 @@ func (s *UserUsecaseSuite) TestRegister() {
 		s.resetMocks()
 	})
 
+	s.Run("PasswordPolicyViolation", func() {
+		user := &domain.User{
+			Username: "testuser",
+			Email:    "test@example.com",
+			Password: "short",
+		}
+		policyErr := &domain.PasswordPolicyError{Violations: []domain.PasswordViolation{{Rule: domain.PasswordRuleMinLength, Message: "must be at least 8 characters long"}}}
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, user.Username).Return(domain.User{}, errors.New("not found"))
+		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, user.Email).Return(domain.User{}, errors.New("not found"))
+		s.mockPolicy.On("Validate", mock.Anything, (*domain.User)(nil), "short").Return(policyErr)
+
+		err := s.usecase.Register(user)
+
+		s.Equal(policyErr, err)
+		s.mockUserRepo.AssertNotCalled(s.T(), "CreateUser", mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
 	s.Run("UsernameExists", func() {
 		user := &domain.User{
 			Username: "testuser",
@@ -115,6 +143,7 @@ func (s *UserUsecaseSuite) TestRegister() {
 		}
 		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, user.Username).Return(domain.User{}, errors.New("not found"))
 		s.mockUserRepo.On("FindByUsernameOrEmail", mock.Anything, user.Email).Return(domain.User{}, errors.New("not found"))
+		s.mockPolicy.On("Validate", mock.Anything, (*domain.User)(nil), user.Password).Return(nil)
 		s.mockUserRepo.On("CreateUser", mock.Anything, mock.Anything).Return(errors.New("create failed"))
 
 		err := s.usecase.Register(user)
@@ -162,6 +191,30 @@ func (s *UserUsecaseSuite) TestChangeRole() {
 		s.resetMocks()
 	})
 
+	s.Run("SuccessAdminPromotesUser", func() {
+		userID := "1"
+		targetUser := &domain.User{ID: userID, Role: domain.RoleUser, Username: "testuser"}
+		s.mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(targetUser, nil)
+		s.mockUserRepo.On("ChangeRole", mock.Anything, userID, string(domain.RoleAdmin), targetUser.Username).Return(nil)
+
+		err := s.usecase.ChangeRole(string(domain.RoleAdmin), userID, domain.User{Role: domain.RoleAdmin})
+
+		s.NoError(err)
+		s.resetMocks()
+	})
+
+	s.Run("SuccessSuperAdminDemotesAdmin", func() {
+		userID := "1"
+		targetUser := &domain.User{ID: userID, Role: domain.RoleAdmin, Username: "testuser"}
+		s.mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(targetUser, nil)
+		s.mockUserRepo.On("ChangeRole", mock.Anything, userID, string(domain.RoleUser), targetUser.Username).Return(nil)
+
+		err := s.usecase.ChangeRole(string(domain.RoleSuperAdmin), userID, domain.User{Role: domain.RoleUser})
+
+		s.NoError(err)
+		s.resetMocks()
+	})
+
 	s.Run("UnauthorizedNonAdmin", func() {
 		userID := "1"
 		newRole := domain.RoleAdmin
@@ -194,7 +247,7 @@ func (s *UserUsecaseSuite) TestChangeRole() {
 		err := s.usecase.ChangeRole(string(domain.RoleAdmin), userID, domain.User{Role: newRole})
 
 		s.Error(err)
-		s.Equal("unauthorized: only superadmin can assign superadmin role", err.Error())
+		s.Equal("unauthorized: the policy does not allow this role change", err.Error())
 		s.resetMocks()
 	})
 
@@ -220,7 +273,7 @@ func (s *UserUsecaseSuite) TestChangeRole() {
 		err := s.usecase.ChangeRole(string(domain.RoleAdmin), userID, domain.User{Role: newRole})
 
 		s.Error(err)
-		s.Equal("only superadmin can modify superadmin role", err.Error())
+		s.Equal("unauthorized: the policy does not allow this role change", err.Error())
 		s.resetMocks()
 	})
 
@@ -233,7 +286,7 @@ func (s *UserUsecaseSuite) TestChangeRole() {
 		err := s.usecase.ChangeRole(string(domain.RoleAdmin), userID, domain.User{Role: newRole})
 
 		s.Error(err)
-		s.Equal("unauthorized: admin cannot modify other admins", err.Error())
+		s.Equal("unauthorized: the policy does not allow this role change", err.Error())
 		s.resetMocks()
 	})
 
@@ -246,7 +299,7 @@ func (s *UserUsecaseSuite) TestChangeRole() {
 		err := s.usecase.ChangeRole(string(domain.RoleAdmin), userID, domain.User{Role: newRole})
 
 		s.Error(err)
-		s.Equal("unauthorized: only superadmin can assign superadmin role", err.Error())
+		s.Equal("unauthorized: the policy does not allow this role change", err.Error())
 		s.resetMocks()
 	})
 
@@ -406,6 +459,8 @@ func (s *UserUsecaseSuite) TestUpdateProfile() {
 				u.LastName == update.LastName &&
 				u.AvatarURL == avatarURL
 		})).Return(nil)
+		s.expectTransaction()
+		s.mockEvents.On("Publish", mock.Anything, domain.EventUserUpdated, userID, domain.UserEventData{ID: userID, FirstName: "Test", LastName: "User"}).Return(nil)
 
 		result, err := s.usecase.UpdateProfile(userID, update, fileName)
 
@@ -434,6 +489,8 @@ func (s *UserUsecaseSuite) TestUpdateProfile() {
 				u.LastName == update.LastName &&
 				u.AvatarURL == ""
 		})).Return(nil)
+		s.expectTransaction()
+		s.mockEvents.On("Publish", mock.Anything, domain.EventUserUpdated, userID, domain.UserEventData{ID: userID, FirstName: "Test", LastName: "User"}).Return(nil)
 
 		result, err := s.usecase.UpdateProfile(userID, update, "")
 
@@ -483,12 +540,30 @@ func (s *UserUsecaseSuite) TestUpdateProfile() {
 		update := domain.UserProfileUpdate{Bio: "Updated bio"}
 		s.mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
 		s.mockUserRepo.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(errors.New("update failed"))
+		s.expectTransaction()
 
 		result, err := s.usecase.UpdateProfile(userID, update, "")
 
 		s.Error(err)
 		s.Nil(result)
 		s.Equal("update failed", err.Error())
+		s.mockEvents.AssertNotCalled(s.T(), "Publish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("PublishError", func() {
+		userID := "1"
+		user := &domain.User{ID: userID, Username: "testuser"}
+		update := domain.UserProfileUpdate{FirstName: "Renamed"}
+		s.mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
+		s.mockUserRepo.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(nil)
+		s.expectTransaction()
+		s.mockEvents.On("Publish", mock.Anything, domain.EventUserUpdated, userID, mock.Anything).Return(errors.New("db error"))
+
+		result, err := s.usecase.UpdateProfile(userID, update, "")
+
+		s.Error(err)
+		s.Nil(result)
 		s.resetMocks()
 	})
 }
@@ -502,11 +577,30 @@ func (s *UserUsecaseSuite) TestChangePassword() {
 		hashedOldPassword, _ := security.HashPassword(oldPassword)
 		user := &domain.User{ID: userID, Password: hashedOldPassword}
 		s.mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
+		s.mockPolicy.On("Validate", mock.Anything, user, newPassword).Return(nil)
 		s.mockUserRepo.On("UpdateUser", mock.Anything, userID, user).Return(nil)
+		s.mockPolicy.On("Remember", mock.Anything, userID, mock.Anything).Return(nil)
 
 		err := s.usecase.ChangePassword(userID, oldPassword, newPassword)
 
 		s.NoError(err)
+		s.NoError(security.ValidatePassword(user.Password, newPassword))
+		s.resetMocks()
+	})
+
+	s.Run("PasswordPolicyViolation", func() {
+		userID := "1"
+		oldPassword := "oldpassword123"
+		hashedOldPassword, _ := security.HashPassword(oldPassword)
+		user := &domain.User{ID: userID, Password: hashedOldPassword}
+		policyErr := &domain.PasswordPolicyError{Violations: []domain.PasswordViolation{{Rule: domain.PasswordRuleNotReused, Message: "must differ from your last 5 passwords"}}}
+		s.mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
+		s.mockPolicy.On("Validate", mock.Anything, user, oldPassword).Return(policyErr)
+
+		err := s.usecase.ChangePassword(userID, oldPassword, oldPassword)
+
+		s.Equal(policyErr, err)
+		s.Equal(hashedOldPassword, user.Password)
 		s.resetMocks()
 	})
 
@@ -545,6 +639,7 @@ func (s *UserUsecaseSuite) TestChangePassword() {
 		s.Require().NoError(err)
 		// mock hash password cause error
 		newPassword := strings.Repeat("z", 1000) // Simulate a long password that might cause hashing to fail
+		s.mockPolicy.On("Validate", mock.Anything, user, newPassword).Return(nil)
 		err = s.usecase.ChangePassword(userID, oldPassword, newPassword)
 
 		s.Error(err)
@@ -559,6 +654,7 @@ func (s *UserUsecaseSuite) TestChangePassword() {
 		user := &domain.User{ID: userID, Password: hashedPassword}
 		s.mockUserRepo.On("FindUserByID", mock.Anything, userID).Return(user, nil)
 
+		s.mockPolicy.On("Validate", mock.Anything, user, newPassword).Return(nil)
 		s.mockUserRepo.On("UpdateUser", mock.Anything, userID, mock.Anything).Return(errors.New("update failed"))
 
 		err := s.usecase.ChangePassword(userID, oldPassword, newPassword)
@@ -569,9 +665,63 @@ func (s *UserUsecaseSuite) TestChangePassword() {
 	})
 }
 
+func (s *UserUsecaseSuite) TestRehashPassword() {
+	s.Run("LegacyHashReplaced", func() {
+		legacyHash, _ := security.BcryptHasher{Cost: bcrypt.MinCost}.Hash("password123")
+		user := &domain.User{ID: "1", Password: legacyHash}
+		s.mockUserRepo.On("UpdatePassword", mock.Anything, "1", mock.Anything).Return(nil)
+
+		err := s.usecase.RehashPassword(user, "password123")
+
+		s.NoError(err)
+		s.NotEqual(legacyHash, user.Password)
+		s.False(security.PasswordNeedsRehash(user.Password))
+		s.NoError(security.ValidatePassword(user.Password, "password123"))
+		s.mockUserRepo.AssertCalled(s.T(), "UpdatePassword", mock.Anything, "1", user.Password)
+		s.mockUserRepo.AssertNotCalled(s.T(), "UpdateUser", mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("CurrentHashKept", func() {
+		currentHash, _ := security.HashPassword("password123")
+		user := &domain.User{ID: "1", Password: currentHash}
+
+		err := s.usecase.RehashPassword(user, "password123")
+
+		s.NoError(err)
+		s.Equal(currentHash, user.Password)
+		s.mockUserRepo.AssertNotCalled(s.T(), "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+		s.resetMocks()
+	})
+
+	s.Run("UpdatePasswordError", func() {
+		legacyHash, _ := security.BcryptHasher{Cost: bcrypt.MinCost}.Hash("password123")
+		user := &domain.User{ID: "1", Password: legacyHash}
+		s.mockUserRepo.On("UpdatePassword", mock.Anything, "1", mock.Anything).Return(errors.New("update failed"))
+
+		err := s.usecase.RehashPassword(user, "password123")
+
+		s.EqualError(err, "update failed")
+		// the user keeps the hash that is still stored
+		s.Equal(legacyHash, user.Password)
+		s.resetMocks()
+	})
+}
+
 func (s *UserUsecaseSuite) resetMocks() {
 	s.mockUserRepo.ExpectedCalls = nil
 	s.mockUserRepo.Calls = nil
 	s.mockStorage.ExpectedCalls = nil
 	s.mockStorage.Calls = nil
+	s.mockPolicy.ExpectedCalls = nil
+	s.mockPolicy.Calls = nil
+	s.mockEvents.ExpectedCalls = nil
+	s.mockEvents.Calls = nil
+}
+
+// expectTransaction runs the functions given to the event bus's Transaction right away
+func (s *UserUsecaseSuite) expectTransaction() {
+	s.mockEvents.On("Transaction", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
 }
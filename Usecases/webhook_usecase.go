@@ -0,0 +1,439 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	domain "g6/blog-api/Domain"
+	"g6/blog-api/Infrastructure/security"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultWebhookDeliveryPageSize = 20
+	maxWebhookDeliveryPageSize     = 100
+
+	webhookUserAgent = "g6-blog-api-webhooks/1.0"
+	// webhookResponseLimit is how much of a response is read, receivers only need to answer 2xx
+	webhookResponseLimit = 64 << 10
+)
+
+// WebhookSettings is how deliveries are retried. The n-th failed attempt waits RetryBase
+// times 2^(n-1), at most RetryMax, and after MaxAttempts attempts the delivery is dead.
+// A delivery whose worker does not report back within Lease is sent again.
+type WebhookSettings struct {
+	MaxAttempts    int
+	RetryBase      time.Duration
+	RetryMax       time.Duration
+	Lease          time.Duration
+	BatchSize      int           // deliveries sent per ProcessDue at most
+	RequestTimeout time.Duration // a receiver that does not answer within it failed
+}
+
+var DefaultWebhookSettings = WebhookSettings{
+	MaxAttempts:    8,
+	RetryBase:      30 * time.Second,
+	RetryMax:       time.Hour,
+	Lease:          2 * time.Minute,
+	BatchSize:      50,
+	RequestTimeout: 10 * time.Second,
+}
+
+// webhookPayload is the body of every delivery
+type webhookPayload struct {
+	ID        string                  `json:"id"`
+	Type      domain.WebhookEventType `json:"type"`
+	CreatedAt time.Time               `json:"created_at"`
+	Data      any                     `json:"data"`
+}
+
+type WebhookUsecase struct {
+	webhooks   domain.IWebhookRepository
+	deliveries domain.IWebhookDeliveryRepository
+	client     *http.Client
+	settings   WebhookSettings
+	wake       chan struct{}
+	ctxtimeout time.Duration
+}
+
+// NewWebhookUsecase delivers events to the webhooks in webhooks through client, a nil client
+// does not follow redirects. Unset settings take their default.
+func NewWebhookUsecase(webhooks domain.IWebhookRepository, deliveries domain.IWebhookDeliveryRepository, client *http.Client, settings WebhookSettings, timeout time.Duration) domain.IWebhookUsecase {
+	if client == nil {
+		client = &http.Client{
+			// a redirect is an answer of the receiver, not a reason to send the event elsewhere
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		}
+	}
+	if settings.MaxAttempts <= 0 {
+		settings.MaxAttempts = DefaultWebhookSettings.MaxAttempts
+	}
+	if settings.RetryBase <= 0 {
+		settings.RetryBase = DefaultWebhookSettings.RetryBase
+	}
+	if settings.RetryMax <= 0 {
+		settings.RetryMax = DefaultWebhookSettings.RetryMax
+	}
+	if settings.Lease <= 0 {
+		settings.Lease = DefaultWebhookSettings.Lease
+	}
+	if settings.BatchSize <= 0 {
+		settings.BatchSize = DefaultWebhookSettings.BatchSize
+	}
+	if settings.RequestTimeout <= 0 {
+		settings.RequestTimeout = DefaultWebhookSettings.RequestTimeout
+	}
+	return &WebhookUsecase{
+		webhooks:   webhooks,
+		deliveries: deliveries,
+		client:     client,
+		settings:   settings,
+		wake:       make(chan struct{}, 1),
+		ctxtimeout: timeout,
+	}
+}
+
+func (uc *WebhookUsecase) Create(webhook *domain.Webhook) (*domain.Webhook, error) {
+	if err := validateWebhook(webhook); err != nil {
+		return nil, err
+	}
+	if webhook.Secret == "" {
+		secret, err := security.GenerateOpaqueToken(domain.WebhookSecretPrefix)
+		if err != nil {
+			return nil, err
+		}
+		webhook.Secret = secret
+	}
+	now := time.Now()
+	webhook.CreatedAt = now
+	webhook.UpdatedAt = now
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	if err := uc.webhooks.Create(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (uc *WebhookUsecase) List() ([]*domain.Webhook, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.webhooks.FindAll(ctx)
+}
+
+func (uc *WebhookUsecase) FindByID(id string) (*domain.Webhook, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	return uc.webhooks.FindByID(ctx, id)
+}
+
+func (uc *WebhookUsecase) Update(id string, webhook *domain.Webhook) (*domain.Webhook, error) {
+	if err := validateWebhook(webhook); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	existing, err := uc.webhooks.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	existing.URL = webhook.URL
+	existing.Events = webhook.Events
+	existing.Active = webhook.Active
+	existing.Description = webhook.Description
+	if webhook.Secret != "" {
+		existing.Secret = webhook.Secret
+	}
+	existing.UpdatedAt = time.Now()
+	if err := uc.webhooks.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (uc *WebhookUsecase) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	if err := uc.webhooks.Delete(ctx, id); err != nil {
+		return err
+	}
+	return uc.deliveries.DeleteByWebhook(ctx, id)
+}
+
+func (uc *WebhookUsecase) Dispatch(event domain.WebhookEventType, data any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	webhooks, err := uc.webhooks.FindSubscribed(ctx, event)
+	if err != nil || len(webhooks) == 0 {
+		return err
+	}
+	eventID, payload, err := newWebhookEvent(event, data)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deliveries := make([]*domain.WebhookDelivery, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		deliveries = append(deliveries, &domain.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			EventID:       eventID,
+			Event:         event,
+			Payload:       payload,
+			Status:        domain.WebhookDeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+	if err := uc.deliveries.Enqueue(ctx, deliveries); err != nil {
+		return err
+	}
+	// a worker that is waiting picks them up right away
+	select {
+	case uc.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (uc *WebhookUsecase) SendTest(id string) (*domain.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	webhook, err := uc.webhooks.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	eventID, payload, err := newWebhookEvent(domain.WebhookTest, map[string]string{
+		"webhook_id": webhook.ID,
+		"message":    "This is a test event, the webhook is set up correctly.",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// logged like any other delivery, but claimed for this request so no worker sends it too
+	now := time.Now()
+	delivery := &domain.WebhookDelivery{
+		WebhookID:     webhook.ID,
+		EventID:       eventID,
+		Event:         domain.WebhookTest,
+		Payload:       payload,
+		Status:        domain.WebhookDeliverySending,
+		NextAttemptAt: now.Add(uc.settings.Lease),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := uc.deliveries.Enqueue(ctx, []*domain.WebhookDelivery{delivery}); err != nil {
+		return nil, err
+	}
+	attempt := uc.post(context.Background(), webhook, delivery)
+	if err := uc.record(context.Background(), delivery, attempt, true); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (uc *WebhookUsecase) Deliveries(filter domain.WebhookDeliveryFilter) ([]*domain.WebhookDelivery, int64, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = defaultWebhookDeliveryPageSize
+	}
+	filter.PageSize = min(filter.PageSize, maxWebhookDeliveryPageSize)
+
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	if _, err := uc.webhooks.FindByID(ctx, filter.WebhookID); err != nil {
+		return nil, 0, err
+	}
+	return uc.deliveries.Find(ctx, filter)
+}
+
+func (uc *WebhookUsecase) FindDelivery(webhookID, id string) (*domain.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), uc.ctxtimeout)
+	defer cancel()
+
+	delivery, err := uc.deliveries.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.WebhookID != webhookID {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+	return delivery, nil
+}
+
+func (uc *WebhookUsecase) Wake() <-chan struct{} {
+	return uc.wake
+}
+
+func (uc *WebhookUsecase) ProcessDue(ctx context.Context) (int, error) {
+	processed := 0
+	for processed < uc.settings.BatchSize {
+		now := time.Now()
+		delivery, err := uc.deliveries.ClaimNext(ctx, now, now.Add(uc.settings.Lease))
+		if err != nil {
+			return processed, err
+		}
+		if delivery == nil {
+			return processed, nil
+		}
+		processed++
+		if err := uc.deliver(ctx, delivery); err != nil {
+			return processed, err
+		}
+	}
+	return processed, nil
+}
+
+// deliver makes one attempt and records its outcome. A delivery whose webhook was deleted or
+// disabled in the meantime is given up.
+func (uc *WebhookUsecase) deliver(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	findCtx, cancel := context.WithTimeout(ctx, uc.ctxtimeout)
+	webhook, err := uc.webhooks.FindByID(findCtx, delivery.WebhookID)
+	cancel()
+	switch {
+	case err == domain.ErrWebhookNotFound:
+		return uc.record(ctx, delivery, domain.WebhookAttempt{At: time.Now(), Error: "webhook was deleted"}, true)
+	case err != nil:
+		// the claim runs out and the delivery is tried again
+		return err
+	case !webhook.Active:
+		return uc.record(ctx, delivery, domain.WebhookAttempt{At: time.Now(), Error: "webhook is disabled"}, true)
+	}
+	return uc.record(ctx, delivery, uc.post(ctx, webhook, delivery), false)
+}
+
+// post sends the delivery to the webhook, any answer but a 2xx is a failure
+func (uc *WebhookUsecase) post(ctx context.Context, webhook *domain.Webhook, delivery *domain.WebhookDelivery) domain.WebhookAttempt {
+	ctx, cancel := context.WithTimeout(ctx, uc.settings.RequestTimeout)
+	defer cancel()
+
+	start := time.Now()
+	attempt := domain.WebhookAttempt{At: start}
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set("X-Webhook-ID", delivery.EventID)
+	req.Header.Set("X-Webhook-Event", string(delivery.Event))
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(start.Unix(), 10))
+	req.Header.Set("X-Webhook-Signature", security.SignWebhook(webhook.Secret, start.Unix(), body))
+
+	resp, err := uc.client.Do(req)
+	attempt.Duration = time.Since(start)
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, webhookResponseLimit))
+
+	attempt.StatusCode = resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		attempt.Error = "unexpected response " + resp.Status
+	}
+	return attempt
+}
+
+// record adds the attempt to the delivery's log and schedules the next one, unless it
+// succeeded or final says not to retry
+func (uc *WebhookUsecase) record(ctx context.Context, delivery *domain.WebhookDelivery, attempt domain.WebhookAttempt, final bool) error {
+	now := time.Now()
+	delivery.Attempts = append(delivery.Attempts, attempt)
+	delivery.UpdatedAt = now
+	switch {
+	case attempt.Error == "":
+		delivery.Status = domain.WebhookDeliveryDelivered
+		delivery.DeliveredAt = now
+	case final || len(delivery.Attempts) >= uc.settings.MaxAttempts:
+		delivery.Status = domain.WebhookDeliveryDead
+	default:
+		delivery.Status = domain.WebhookDeliveryPending
+		delivery.NextAttemptAt = now.Add(retryDelay(uc.settings.RetryBase, uc.settings.RetryMax, len(delivery.Attempts)))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, uc.ctxtimeout)
+	defer cancel()
+	return uc.deliveries.Update(ctx, delivery)
+}
+
+// newWebhookEvent builds the body sent for an event, its ID lets receivers ignore repeats
+func newWebhookEvent(event domain.WebhookEventType, data any) (string, string, error) {
+	eventID, err := security.GenerateOpaqueToken("evt_")
+	if err != nil {
+		return "", "", err
+	}
+	payload, err := json.Marshal(webhookPayload{
+		ID:        eventID,
+		Type:      event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return eventID, string(payload), nil
+}
+
+// validateWebhook checks the URL and events and drops repeated events
+func validateWebhook(webhook *domain.Webhook) error {
+	parsed, err := url.Parse(webhook.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return domain.ErrInvalidWebhookURL
+	}
+	if len(webhook.Events) == 0 {
+		return domain.ErrUnknownWebhookEvent
+	}
+	events := make([]domain.WebhookEventType, 0, len(webhook.Events))
+	for _, event := range webhook.Events {
+		if !slices.Contains(domain.WebhookEventTypes, event) {
+			return domain.ErrUnknownWebhookEvent
+		}
+		if !slices.Contains(events, event) {
+			events = append(events, event)
+		}
+	}
+	webhook.Events = events
+	return nil
+}
+
+// RunWebhookWorker sends due deliveries every interval, and as soon as one is queued, until
+// ctx is done. Several servers may run it at once, each delivery is claimed by one of them.
+func RunWebhookWorker(ctx context.Context, webhooks domain.IWebhookUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := webhooks.ProcessDue(ctx); err != nil {
+			log.Printf("webhooks: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-webhooks.Wake():
+		}
+	}
+}
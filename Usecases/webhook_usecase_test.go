@@ -0,0 +1,404 @@
+package usecases
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	domain "g6/blog-api/Domain"
+	domain_mocks "g6/blog-api/Domain/mocks"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// webhookReceiver is an integration's endpoint, it answers with status and keeps what it got
+type webhookReceiver struct {
+	*httptest.Server
+	status   int
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newWebhookReceiver(status int) *webhookReceiver {
+	receiver := &webhookReceiver{status: status}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receiver.mu.Lock()
+		receiver.requests = append(receiver.requests, r)
+		receiver.bodies = append(receiver.bodies, body)
+		receiver.mu.Unlock()
+		w.WriteHeader(receiver.status)
+	}))
+	return receiver
+}
+
+func (r *webhookReceiver) received() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+type WebhookUsecaseSuite struct {
+	suite.Suite
+	mockWebhooks   *domain_mocks.MockIWebhookRepository
+	mockDeliveries *domain_mocks.MockIWebhookDeliveryRepository
+	usecase        domain.IWebhookUsecase
+}
+
+func (s *WebhookUsecaseSuite) SetupTest() {
+	s.mockWebhooks = domain_mocks.NewMockIWebhookRepository(s.T())
+	s.mockDeliveries = domain_mocks.NewMockIWebhookDeliveryRepository(s.T())
+	s.usecase = NewWebhookUsecase(s.mockWebhooks, s.mockDeliveries, nil, WebhookSettings{
+		MaxAttempts: 3,
+		RetryBase:   time.Minute,
+		RetryMax:    90 * time.Second,
+	}, 3*time.Second)
+}
+
+func TestWebhookUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(WebhookUsecaseSuite))
+}
+
+func (s *WebhookUsecaseSuite) TestCreate() {
+	s.Run("GeneratesSecret", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("Create", mock.Anything, mock.MatchedBy(func(webhook *domain.Webhook) bool {
+			return strings.HasPrefix(webhook.Secret, domain.WebhookSecretPrefix) &&
+				len(webhook.Events) == 1 &&
+				!webhook.CreatedAt.IsZero()
+		})).Return(nil)
+
+		webhook, err := s.usecase.Create(&domain.Webhook{
+			URL:    "https://hooks.example.com/blog",
+			Events: []domain.WebhookEventType{domain.WebhookPostPublished, domain.WebhookPostPublished},
+			Active: true,
+		})
+
+		s.NoError(err)
+		s.Equal([]domain.WebhookEventType{domain.WebhookPostPublished}, webhook.Events)
+	})
+
+	s.Run("KeepsGivenSecret", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("Create", mock.Anything, mock.MatchedBy(func(webhook *domain.Webhook) bool {
+			return webhook.Secret == "my-own-secret-of-some-length"
+		})).Return(nil)
+
+		_, err := s.usecase.Create(&domain.Webhook{
+			URL:    "http://localhost:9000/hook",
+			Secret: "my-own-secret-of-some-length",
+			Events: []domain.WebhookEventType{domain.WebhookCommentCreated},
+		})
+
+		s.NoError(err)
+	})
+
+	s.Run("InvalidURL", func() {
+		s.SetupTest()
+
+		for _, url := range []string{"ftp://example.com", "/relative", "https://", "not a url"} {
+			_, err := s.usecase.Create(&domain.Webhook{URL: url, Events: []domain.WebhookEventType{domain.WebhookPostUpdated}})
+			s.ErrorIs(err, domain.ErrInvalidWebhookURL, url)
+		}
+	})
+
+	s.Run("UnknownEvent", func() {
+		s.SetupTest()
+
+		_, err := s.usecase.Create(&domain.Webhook{URL: "https://example.com", Events: []domain.WebhookEventType{domain.WebhookTest}})
+
+		s.ErrorIs(err, domain.ErrUnknownWebhookEvent)
+	})
+}
+
+func (s *WebhookUsecaseSuite) TestUpdate() {
+	s.Run("KeepsSecret", func() {
+		s.SetupTest()
+		existing := &domain.Webhook{ID: "w1", URL: "https://old.example.com", Secret: "old-secret", Active: true}
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(existing, nil)
+		s.mockWebhooks.On("Update", mock.Anything, mock.MatchedBy(func(webhook *domain.Webhook) bool {
+			return webhook.URL == "https://new.example.com" && webhook.Secret == "old-secret" && !webhook.Active
+		})).Return(nil)
+
+		updated, err := s.usecase.Update("w1", &domain.Webhook{
+			URL:    "https://new.example.com",
+			Events: []domain.WebhookEventType{domain.WebhookPostDeleted},
+		})
+
+		s.NoError(err)
+		s.Equal([]domain.WebhookEventType{domain.WebhookPostDeleted}, updated.Events)
+	})
+
+	s.Run("NotFound", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(nil, domain.ErrWebhookNotFound)
+
+		_, err := s.usecase.Update("w1", &domain.Webhook{URL: "https://example.com", Events: []domain.WebhookEventType{domain.WebhookPostDeleted}})
+
+		s.ErrorIs(err, domain.ErrWebhookNotFound)
+	})
+}
+
+func (s *WebhookUsecaseSuite) TestDelete() {
+	s.mockWebhooks.On("Delete", mock.Anything, "w1").Return(nil)
+	s.mockDeliveries.On("DeleteByWebhook", mock.Anything, "w1").Return(nil)
+
+	s.NoError(s.usecase.Delete("w1"))
+}
+
+func (s *WebhookUsecaseSuite) TestDispatch() {
+	s.Run("QueuesForSubscribers", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("FindSubscribed", mock.Anything, domain.WebhookPostPublished).Return([]*domain.Webhook{{ID: "w1"}, {ID: "w2"}}, nil)
+		s.mockDeliveries.On("Enqueue", mock.Anything, mock.MatchedBy(func(deliveries []*domain.WebhookDelivery) bool {
+			if len(deliveries) != 2 || deliveries[0].WebhookID != "w1" || deliveries[1].WebhookID != "w2" {
+				return false
+			}
+			var payload struct {
+				ID   string                 `json:"id"`
+				Type string                 `json:"type"`
+				Data domain.WebhookPostData `json:"data"`
+			}
+			return json.Unmarshal([]byte(deliveries[0].Payload), &payload) == nil &&
+				payload.ID == deliveries[0].EventID &&
+				payload.Type == "post.published" &&
+				payload.Data.ID == "p1" &&
+				deliveries[0].EventID == deliveries[1].EventID &&
+				deliveries[0].Status == domain.WebhookDeliveryPending
+		})).Return(nil)
+
+		err := s.usecase.Dispatch(domain.WebhookPostPublished, domain.WebhookPostData{ID: "p1", Title: "Hello"})
+
+		s.NoError(err)
+		select {
+		case <-s.usecase.Wake():
+		default:
+			s.Fail("the worker was not woken up")
+		}
+	})
+
+	s.Run("NoSubscribers", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("FindSubscribed", mock.Anything, domain.WebhookCommentCreated).Return([]*domain.Webhook{}, nil)
+
+		s.NoError(s.usecase.Dispatch(domain.WebhookCommentCreated, domain.WebhookCommentData{ID: "c1"}))
+	})
+}
+
+func (s *WebhookUsecaseSuite) TestProcessDue() {
+	delivery := func() *domain.WebhookDelivery {
+		return &domain.WebhookDelivery{
+			ID:        "d1",
+			WebhookID: "w1",
+			EventID:   "evt_1",
+			Event:     domain.WebhookPostUpdated,
+			Payload:   `{"id":"evt_1","type":"post.updated","data":{"id":"p1"}}`,
+			Status:    domain.WebhookDeliverySending,
+		}
+	}
+
+	s.Run("DeliveredAndSigned", func() {
+		s.SetupTest()
+		receiver := newWebhookReceiver(http.StatusNoContent)
+		defer receiver.Close()
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(delivery(), nil).Once()
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(&domain.Webhook{ID: "w1", URL: receiver.URL + "/hook", Secret: "s3cret", Active: true}, nil)
+		s.mockDeliveries.On("Update", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.Status == domain.WebhookDeliveryDelivered &&
+				!d.DeliveredAt.IsZero() &&
+				len(d.Attempts) == 1 &&
+				d.Attempts[0].StatusCode == http.StatusNoContent &&
+				d.Attempts[0].Error == ""
+		})).Return(nil)
+
+		processed, err := s.usecase.ProcessDue(context.Background())
+
+		s.NoError(err)
+		s.Equal(1, processed)
+		s.Require().Equal(1, receiver.received())
+		req, body := receiver.requests[0], receiver.bodies[0]
+		s.Equal("/hook", req.URL.Path)
+		s.Equal("application/json", req.Header.Get("Content-Type"))
+		s.Equal("evt_1", req.Header.Get("X-Webhook-ID"))
+		s.Equal("post.updated", req.Header.Get("X-Webhook-Event"))
+		s.Equal(delivery().Payload, string(body))
+		// what a receiver does to check the signature
+		mac := hmac.New(sha256.New, []byte("s3cret"))
+		mac.Write([]byte(req.Header.Get("X-Webhook-Timestamp") + "." + string(body)))
+		s.Equal("sha256="+hex.EncodeToString(mac.Sum(nil)), req.Header.Get("X-Webhook-Signature"))
+	})
+
+	s.Run("FailureIsRetried", func() {
+		s.SetupTest()
+		receiver := newWebhookReceiver(http.StatusInternalServerError)
+		defer receiver.Close()
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(delivery(), nil).Once()
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(&domain.Webhook{ID: "w1", URL: receiver.URL, Secret: "s3cret", Active: true}, nil)
+		s.mockDeliveries.On("Update", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			wait := time.Until(d.NextAttemptAt)
+			return d.Status == domain.WebhookDeliveryPending &&
+				d.Attempts[0].StatusCode == http.StatusInternalServerError &&
+				strings.Contains(d.Attempts[0].Error, "500") &&
+				wait > 59*time.Second && wait <= time.Minute
+		})).Return(nil)
+
+		_, err := s.usecase.ProcessDue(context.Background())
+
+		s.NoError(err)
+	})
+
+	s.Run("LastAttemptDies", func() {
+		s.SetupTest()
+		receiver := newWebhookReceiver(http.StatusBadGateway)
+		defer receiver.Close()
+		previous := delivery()
+		previous.Attempts = []domain.WebhookAttempt{{StatusCode: 502}, {StatusCode: 502}}
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(previous, nil).Once()
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(&domain.Webhook{ID: "w1", URL: receiver.URL, Active: true}, nil)
+		s.mockDeliveries.On("Update", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.Status == domain.WebhookDeliveryDead && len(d.Attempts) == 3
+		})).Return(nil)
+
+		_, err := s.usecase.ProcessDue(context.Background())
+
+		s.NoError(err)
+	})
+
+	s.Run("RedirectIsAFailure", func() {
+		s.SetupTest()
+		receiver := newWebhookReceiver(http.StatusFound)
+		defer receiver.Close()
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(delivery(), nil).Once()
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(&domain.Webhook{ID: "w1", URL: receiver.URL, Active: true}, nil)
+		s.mockDeliveries.On("Update", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.Status == domain.WebhookDeliveryPending && d.Attempts[0].StatusCode == http.StatusFound
+		})).Return(nil)
+
+		_, err := s.usecase.ProcessDue(context.Background())
+
+		s.NoError(err)
+	})
+
+	s.Run("DeletedWebhookGivesUp", func() {
+		s.SetupTest()
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(delivery(), nil).Once()
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(nil, domain.ErrWebhookNotFound)
+		s.mockDeliveries.On("Update", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.Status == domain.WebhookDeliveryDead && d.Attempts[0].Error == "webhook was deleted"
+		})).Return(nil)
+
+		_, err := s.usecase.ProcessDue(context.Background())
+
+		s.NoError(err)
+	})
+
+	s.Run("RepositoryFailure", func() {
+		s.SetupTest()
+		s.mockDeliveries.On("ClaimNext", mock.Anything, mock.Anything, mock.Anything).Return(delivery(), nil).Once()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(nil, errors.New("db error"))
+
+		processed, err := s.usecase.ProcessDue(context.Background())
+
+		s.Error(err)
+		s.Equal(1, processed)
+	})
+}
+
+func (s *WebhookUsecaseSuite) TestSendTest() {
+	s.Run("Delivered", func() {
+		s.SetupTest()
+		receiver := newWebhookReceiver(http.StatusOK)
+		defer receiver.Close()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(&domain.Webhook{ID: "w1", URL: receiver.URL, Secret: "s3cret"}, nil)
+		s.mockDeliveries.On("Enqueue", mock.Anything, mock.MatchedBy(func(deliveries []*domain.WebhookDelivery) bool {
+			return len(deliveries) == 1 &&
+				deliveries[0].Event == domain.WebhookTest &&
+				deliveries[0].Status == domain.WebhookDeliverySending
+		})).Return(nil)
+		s.mockDeliveries.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+		delivery, err := s.usecase.SendTest("w1")
+
+		s.NoError(err)
+		s.Equal(domain.WebhookDeliveryDelivered, delivery.Status)
+		s.Equal(http.StatusOK, delivery.Attempts[0].StatusCode)
+		s.Equal(1, receiver.received())
+		s.Equal("webhook.test", receiver.requests[0].Header.Get("X-Webhook-Event"))
+	})
+
+	s.Run("FailureIsNotRetried", func() {
+		s.SetupTest()
+		receiver := newWebhookReceiver(http.StatusUnauthorized)
+		defer receiver.Close()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(&domain.Webhook{ID: "w1", URL: receiver.URL}, nil)
+		s.mockDeliveries.On("Enqueue", mock.Anything, mock.Anything).Return(nil)
+		s.mockDeliveries.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+		delivery, err := s.usecase.SendTest("w1")
+
+		s.NoError(err)
+		s.Equal(domain.WebhookDeliveryDead, delivery.Status)
+		s.Equal(http.StatusUnauthorized, delivery.Attempts[0].StatusCode)
+	})
+
+	s.Run("Unreachable", func() {
+		s.SetupTest()
+		receiver := newWebhookReceiver(http.StatusOK)
+		receiver.Close()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(&domain.Webhook{ID: "w1", URL: receiver.URL}, nil)
+		s.mockDeliveries.On("Enqueue", mock.Anything, mock.Anything).Return(nil)
+		s.mockDeliveries.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+		delivery, err := s.usecase.SendTest("w1")
+
+		s.NoError(err)
+		s.Equal(domain.WebhookDeliveryDead, delivery.Status)
+		s.Zero(delivery.Attempts[0].StatusCode)
+		s.NotEmpty(delivery.Attempts[0].Error)
+	})
+}
+
+func (s *WebhookUsecaseSuite) TestDeliveries() {
+	s.Run("Success", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(&domain.Webhook{ID: "w1"}, nil)
+		s.mockDeliveries.On("Find", mock.Anything, domain.WebhookDeliveryFilter{WebhookID: "w1", Page: 1, PageSize: 100}).Return([]*domain.WebhookDelivery{}, int64(0), nil)
+
+		_, _, err := s.usecase.Deliveries(domain.WebhookDeliveryFilter{WebhookID: "w1", PageSize: 500})
+
+		s.NoError(err)
+	})
+
+	s.Run("UnknownWebhook", func() {
+		s.SetupTest()
+		s.mockWebhooks.On("FindByID", mock.Anything, "w1").Return(nil, domain.ErrWebhookNotFound)
+
+		_, _, err := s.usecase.Deliveries(domain.WebhookDeliveryFilter{WebhookID: "w1"})
+
+		s.ErrorIs(err, domain.ErrWebhookNotFound)
+	})
+}
+
+func (s *WebhookUsecaseSuite) TestFindDelivery() {
+	s.mockDeliveries.On("FindByID", mock.Anything, "d1").Return(&domain.WebhookDelivery{ID: "d1", WebhookID: "w2"}, nil)
+
+	_, err := s.usecase.FindDelivery("w1", "d1")
+
+	s.ErrorIs(err, domain.ErrWebhookDeliveryNotFound)
+}
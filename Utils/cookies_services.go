@@ -2,11 +2,37 @@ package utils
 
 import (
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
+// the CSRF token is a cookie the page can read and has to send back in the header
+const (
+	CSRFCookieName = "csrf_token"
+	CSRFHeaderName = "X-CSRF-Token"
+)
+
+// CookieSettings are the configured attributes of the session cookies
+type CookieSettings struct {
+	Secure   bool
+	Domain   string
+	SameSite http.SameSite // zero means Strict
+}
+
+// ParseSameSite reads strict, lax or none, anything else is Strict
+func ParseSameSite(value string) http.SameSite {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "lax":
+		return http.SameSiteLaxMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteStrictMode
+	}
+}
+
 type CookieOptions struct {
 	Name     string
 	Value    string
@@ -42,11 +68,9 @@ func GetCookie(c *gin.Context, name string) (string, error) {
 	return cookie, nil
 }
 
-// DeleteCookie deletes a cookie from the Gin context
-func DeleteCookie(c *gin.Context, name string) {
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:   name,
-		Value:  "",
-		MaxAge: -1,
-	})
+// DeleteCookie expires a cookie. Path and Domain have to be the ones it was set with, otherwise the browser keeps it.
+func DeleteCookie(c *gin.Context, opts CookieOptions) {
+	opts.Value = ""
+	opts.MaxAge = -1
+	SetCookie(c, opts)
 }